@@ -5,6 +5,7 @@ import (
 	v1 "k8s.io/api/storage/v1"
 
 	"github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/pkg/userlabels"
 )
 
 func (out *BackupStorage) FromCR(in *v1alpha1.BackupStorage) {
@@ -17,6 +18,8 @@ func (out *BackupStorage) FromCR(in *v1alpha1.BackupStorage) {
 	out.Url = &in.Spec.EndpointURL
 	out.VerifyTLS = in.Spec.VerifyTLS
 	out.ForcePathStyle = in.Spec.ForcePathStyle
+	out.Labels = pointer.To(Labels(userlabels.Filter(in.GetLabels())))
+	out.Annotations = pointer.To(Annotations(userlabels.FilterAnnotations(in.GetAnnotations())))
 }
 
 func (out *MonitoringInstance) FromCR(in *v1alpha1.MonitoringConfig) {
@@ -26,6 +29,8 @@ func (out *MonitoringInstance) FromCR(in *v1alpha1.MonitoringConfig) {
 	out.AllowedNamespaces = &in.Spec.AllowedNamespaces
 	out.VerifyTLS = in.Spec.VerifyTLS
 	out.Type = MonitoringInstanceBaseWithNameType(in.Spec.Type)
+	out.Labels = pointer.To(Labels(userlabels.Filter(in.GetLabels())))
+	out.Annotations = pointer.To(Annotations(userlabels.FilterAnnotations(in.GetAnnotations())))
 }
 
 func (out *StorageClass) FromCR(in *v1.StorageClass) {
@@ -30,6 +30,22 @@ const (
 	BackupStorageTypeS3    BackupStorageType = "s3"
 )
 
+// Defines values for ChangeRequestOperation.
+const (
+	DeleteDatabaseCluster       ChangeRequestOperation = "deleteDatabaseCluster"
+	DeleteDatabaseClusterBackup ChangeRequestOperation = "deleteDatabaseClusterBackup"
+)
+
+// Defines values for ChangeRequestState.
+const (
+	Approved        ChangeRequestState = "approved"
+	Executed        ChangeRequestState = "executed"
+	ExecutionFailed ChangeRequestState = "failed"
+	Expired         ChangeRequestState = "expired"
+	Pending         ChangeRequestState = "pending"
+	Rejected        ChangeRequestState = "rejected"
+)
+
 // Defines values for CreateBackupStorageParamsType.
 const (
 	CreateBackupStorageParamsTypeAzure CreateBackupStorageParamsType = "azure"
@@ -51,11 +67,11 @@ const (
 
 // Defines values for DatabaseClusterSpecProxyExposeType.
 const (
-	ClusterIP    DatabaseClusterSpecProxyExposeType = "ClusterIP"
-	External     DatabaseClusterSpecProxyExposeType = "external"
-	Internal     DatabaseClusterSpecProxyExposeType = "internal"
-	LoadBalancer DatabaseClusterSpecProxyExposeType = "LoadBalancer"
-	NodePort     DatabaseClusterSpecProxyExposeType = "NodePort"
+	DatabaseClusterSpecProxyExposeTypeClusterIP    DatabaseClusterSpecProxyExposeType = "ClusterIP"
+	DatabaseClusterSpecProxyExposeTypeExternal     DatabaseClusterSpecProxyExposeType = "external"
+	DatabaseClusterSpecProxyExposeTypeInternal     DatabaseClusterSpecProxyExposeType = "internal"
+	DatabaseClusterSpecProxyExposeTypeLoadBalancer DatabaseClusterSpecProxyExposeType = "LoadBalancer"
+	DatabaseClusterSpecProxyExposeTypeNodePort     DatabaseClusterSpecProxyExposeType = "NodePort"
 )
 
 // Defines values for DatabaseClusterSpecProxyType.
@@ -73,12 +89,79 @@ const (
 	Unknown DatabaseClusterStatusConditionsStatus = "Unknown"
 )
 
+// Defines values for DatabaseClusterBulkRequestAction.
+const (
+	DatabaseClusterBulkRequestActionBackup DatabaseClusterBulkRequestAction = "backup"
+	DatabaseClusterBulkRequestActionDelete DatabaseClusterBulkRequestAction = "delete"
+	DatabaseClusterBulkRequestActionPatch  DatabaseClusterBulkRequestAction = "patch"
+	DatabaseClusterBulkRequestActionPause  DatabaseClusterBulkRequestAction = "pause"
+	DatabaseClusterBulkRequestActionResume DatabaseClusterBulkRequestAction = "resume"
+)
+
+// Defines values for DatabaseClusterBulkResultResult.
+const (
+	DatabaseClusterBulkResultResultFailed          DatabaseClusterBulkResultResult = "failed"
+	DatabaseClusterBulkResultResultPendingApproval DatabaseClusterBulkResultResult = "pendingApproval"
+	DatabaseClusterBulkResultResultSucceeded       DatabaseClusterBulkResultResult = "succeeded"
+)
+
+// Defines values for DatabaseClusterDiagnosticCheckStatus.
+const (
+	Ok       DatabaseClusterDiagnosticCheckStatus = "ok"
+	Problems DatabaseClusterDiagnosticCheckStatus = "problems"
+	Skipped  DatabaseClusterDiagnosticCheckStatus = "skipped"
+)
+
+// Defines values for DatabaseClusterDiagnosticFindingSeverity.
+const (
+	DatabaseClusterDiagnosticFindingSeverityCritical DatabaseClusterDiagnosticFindingSeverity = "critical"
+	DatabaseClusterDiagnosticFindingSeverityInfo     DatabaseClusterDiagnosticFindingSeverity = "info"
+	DatabaseClusterDiagnosticFindingSeverityWarning  DatabaseClusterDiagnosticFindingSeverity = "warning"
+)
+
+// Defines values for DatabaseClusterEventSource.
+const (
+	Kubernetes DatabaseClusterEventSource = "kubernetes"
+	Timeline   DatabaseClusterEventSource = "timeline"
+)
+
+// Defines values for DatabaseClusterEventType.
+const (
+	DatabaseClusterEventTypeNormal  DatabaseClusterEventType = "Normal"
+	DatabaseClusterEventTypeWarning DatabaseClusterEventType = "Warning"
+)
+
 // Defines values for DatabaseClusterRestoreSpecDataSourcePitrType.
 const (
 	DatabaseClusterRestoreSpecDataSourcePitrTypeDate   DatabaseClusterRestoreSpecDataSourcePitrType = "date"
 	DatabaseClusterRestoreSpecDataSourcePitrTypeLatest DatabaseClusterRestoreSpecDataSourcePitrType = "latest"
 )
 
+// Defines values for DatabaseClusterScheduleAction.
+const (
+	Pause  DatabaseClusterScheduleAction = "pause"
+	Resume DatabaseClusterScheduleAction = "resume"
+	Scale  DatabaseClusterScheduleAction = "scale"
+)
+
+// Defines values for DatabaseClusterScheduleRunResult.
+const (
+	Failed    DatabaseClusterScheduleRunResult = "failed"
+	Succeeded DatabaseClusterScheduleRunResult = "succeeded"
+)
+
+// Defines values for ErrorCategory.
+const (
+	ErrorCategoryConflict           ErrorCategory = "conflict"
+	ErrorCategoryFailedPrecondition ErrorCategory = "failedPrecondition"
+	ErrorCategoryForbidden          ErrorCategory = "forbidden"
+	ErrorCategoryInternal           ErrorCategory = "internal"
+	ErrorCategoryInvalid            ErrorCategory = "invalid"
+	ErrorCategoryNotFound           ErrorCategory = "notFound"
+	ErrorCategoryUnauthenticated    ErrorCategory = "unauthenticated"
+	ErrorCategoryUnavailable        ErrorCategory = "unavailable"
+)
+
 // Defines values for MonitoringInstanceBaseType.
 const (
 	MonitoringInstanceBaseTypePmm MonitoringInstanceBaseType = "pmm"
@@ -99,6 +182,21 @@ const (
 	MonitoringInstanceUpdateParamsTypePmm MonitoringInstanceUpdateParamsType = "pmm"
 )
 
+// Defines values for OperationState.
+const (
+	OperationStateFailed    OperationState = "failed"
+	OperationStatePending   OperationState = "pending"
+	OperationStateRunning   OperationState = "running"
+	OperationStateSucceeded OperationState = "succeeded"
+)
+
+// Defines values for OperationType.
+const (
+	OperationTypeDatabaseClusterDeletion OperationType = "databaseClusterDeletion"
+	OperationTypeDatabaseClusterRestore  OperationType = "databaseClusterRestore"
+	OperationTypeUpgradePlanApproval     OperationType = "upgradePlanApproval"
+)
+
 // Defines values for PodSchedulingPolicySpecEngineType.
 const (
 	PodSchedulingPolicySpecEngineTypePostgresql PodSchedulingPolicySpecEngineType = "postgresql"
@@ -114,27 +212,54 @@ const (
 	UpgradeEngine UpgradeTaskPendingTask = "upgradeEngine"
 )
 
+// Defines values for ListDatabaseClustersParamsEngineType.
+const (
+	ListDatabaseClustersParamsEngineTypePostgresql ListDatabaseClustersParamsEngineType = "postgresql"
+	ListDatabaseClustersParamsEngineTypePsmdb      ListDatabaseClustersParamsEngineType = "psmdb"
+	ListDatabaseClustersParamsEngineTypePxc        ListDatabaseClustersParamsEngineType = "pxc"
+)
+
 // Defines values for ListPodSchedulingPolicyParamsEngineType.
 const (
-	Postgresql ListPodSchedulingPolicyParamsEngineType = "postgresql"
-	Psmdb      ListPodSchedulingPolicyParamsEngineType = "psmdb"
-	Pxc        ListPodSchedulingPolicyParamsEngineType = "pxc"
+	ListPodSchedulingPolicyParamsEngineTypePostgresql ListPodSchedulingPolicyParamsEngineType = "postgresql"
+	ListPodSchedulingPolicyParamsEngineTypePsmdb      ListPodSchedulingPolicyParamsEngineType = "psmdb"
+	ListPodSchedulingPolicyParamsEngineTypePxc        ListPodSchedulingPolicyParamsEngineType = "pxc"
 )
 
+// Annotations User-managed annotations of the resource. Annotations with the prefix of a percona.com,
+// kubernetes.io or k8s.io domain are reserved; they cannot be set or changed and are
+// omitted from responses.
+// On update, the given annotations replace all user-managed annotations of the resource.
+type Annotations map[string]string
+
 // BackupStorage Backup storage information
 type BackupStorage struct {
 	// AllowedNamespaces List of namespaces allowed to use this backup storage
 	// Deprecated: this property has been marked as deprecated upstream, but no `x-deprecated-reason` was set
-	AllowedNamespaces *[]string         `json:"allowedNamespaces,omitempty"`
-	BucketName        string            `json:"bucketName"`
-	Description       *string           `json:"description,omitempty"`
-	ForcePathStyle    *bool             `json:"forcePathStyle,omitempty"`
-	Name              string            `json:"name"`
-	Namespace         string            `json:"namespace,omitempty"`
-	Region            string            `json:"region,omitempty"`
-	Type              BackupStorageType `json:"type"`
-	Url               *string           `json:"url,omitempty"`
-	VerifyTLS         *bool             `json:"verifyTLS,omitempty"`
+	AllowedNamespaces *[]string `json:"allowedNamespaces,omitempty"`
+
+	// Annotations User-managed annotations of the resource. Annotations with the prefix of a percona.com,
+	// kubernetes.io or k8s.io domain are reserved; they cannot be set or changed and are
+	// omitted from responses.
+	// On update, the given annotations replace all user-managed annotations of the resource.
+	Annotations    *Annotations `json:"annotations,omitempty"`
+	BucketName     string       `json:"bucketName"`
+	Description    *string      `json:"description,omitempty"`
+	ForcePathStyle *bool        `json:"forcePathStyle,omitempty"`
+
+	// Labels User-managed labels of the resource. Labels with the prefix of a percona.com,
+	// kubernetes.io or k8s.io domain and the clusterName label are reserved;
+	// they cannot be set or changed and are omitted from responses.
+	// On update, the given labels replace all user-managed labels of the resource.
+	// RBAC policies may grant permissions on the resources with a label by using an object
+	// of the form `<namespace>/label:<key>=<value>`, e.g. `dev/label:team=a`.
+	Labels    *Labels           `json:"labels,omitempty"`
+	Name      string            `json:"name"`
+	Namespace string            `json:"namespace,omitempty"`
+	Region    string            `json:"region,omitempty"`
+	Type      BackupStorageType `json:"type"`
+	Url       *string           `json:"url,omitempty"`
+	VerifyTLS *bool             `json:"verifyTLS,omitempty"`
 }
 
 // BackupStorageType defines model for BackupStorage.Type.
@@ -143,6 +268,50 @@ type BackupStorageType string
 // BackupStoragesList defines model for BackupStoragesList.
 type BackupStoragesList = []BackupStorage
 
+// ChangeRequest A request to perform an operation that requires approval by a second user.
+type ChangeRequest struct {
+	CreatedAt time.Time  `json:"createdAt"`
+	DecidedAt *time.Time `json:"decidedAt,omitempty"`
+	DecidedBy *string    `json:"decidedBy,omitempty"`
+
+	// Error Error of the last failed execution of the operation
+	Error     *string                `json:"error,omitempty"`
+	ExpiresAt time.Time              `json:"expiresAt"`
+	Name      string                 `json:"name"`
+	Namespace string                 `json:"namespace"`
+	Operation ChangeRequestOperation `json:"operation"`
+
+	// Parameters Parameters of the requested operation
+	Parameters  *ChangeRequestParameters `json:"parameters,omitempty"`
+	RequestedBy string                   `json:"requestedBy"`
+
+	// State State of the change request. An approved change request becomes executed once
+	// its operation has been performed, or failed if the operation could not be performed.
+	// A failed change request can be approved again until it expires.
+	State ChangeRequestState `json:"state"`
+
+	// Target Name of the resource the operation is performed on
+	Target string `json:"target"`
+}
+
+// ChangeRequestOperation defines model for ChangeRequest.Operation.
+type ChangeRequestOperation string
+
+// ChangeRequestState State of the change request. An approved change request becomes executed once
+// its operation has been performed, or failed if the operation could not be performed.
+// A failed change request can be approved again until it expires.
+type ChangeRequestState string
+
+// ChangeRequestList defines model for ChangeRequestList.
+type ChangeRequestList struct {
+	Items []ChangeRequest `json:"items"`
+}
+
+// ChangeRequestParameters Parameters of the requested operation
+type ChangeRequestParameters struct {
+	CleanupBackupStorage *bool `json:"cleanupBackupStorage,omitempty"`
+}
+
 // CreateBackupStorageParams Backup storage parameters
 type CreateBackupStorageParams struct {
 	AccessKey string `json:"accessKey"`
@@ -151,11 +320,25 @@ type CreateBackupStorageParams struct {
 	// Deprecated: this property has been marked as deprecated upstream, but no `x-deprecated-reason` was set
 	AllowedNamespaces *[]string `json:"allowedNamespaces,omitempty"`
 
+	// Annotations User-managed annotations of the resource. Annotations with the prefix of a percona.com,
+	// kubernetes.io or k8s.io domain are reserved; they cannot be set or changed and are
+	// omitted from responses.
+	// On update, the given annotations replace all user-managed annotations of the resource.
+	Annotations *Annotations `json:"annotations,omitempty"`
+
 	// BucketName The cloud storage bucket/container name
 	BucketName     string  `json:"bucketName"`
 	Description    *string `json:"description,omitempty"`
 	ForcePathStyle *bool   `json:"forcePathStyle,omitempty"`
 
+	// Labels User-managed labels of the resource. Labels with the prefix of a percona.com,
+	// kubernetes.io or k8s.io domain and the clusterName label are reserved;
+	// they cannot be set or changed and are omitted from responses.
+	// On update, the given labels replace all user-managed labels of the resource.
+	// RBAC policies may grant permissions on the resources with a label by using an object
+	// of the form `<namespace>/label:<key>=<value>`, e.g. `dev/label:team=a`.
+	Labels *Labels `json:"labels,omitempty"`
+
 	// Name A user defined string name of the storage in the DNS name format https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#dns-label-names
 	Name      string                        `json:"name"`
 	Region    string                        `json:"region,omitempty"`
@@ -936,6 +1119,60 @@ type DatabaseClusterBackupList struct {
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// DatabaseClusterBulkRequest An action to apply to several database clusters.
+type DatabaseClusterBulkRequest struct {
+	Action DatabaseClusterBulkRequestAction `json:"action"`
+
+	// BackupStorageName Name of the backup storage used by the `backup` action
+	BackupStorageName *string `json:"backupStorageName,omitempty"`
+
+	// CleanupBackupStorage If set, the `delete` action removes the backed up data from storage
+	CleanupBackupStorage *bool `json:"cleanupBackupStorage,omitempty"`
+
+	// Patch JSON Merge Patch applied to each database cluster by the `patch` action
+	Patch *map[string]interface{} `json:"patch,omitempty"`
+
+	// Selector Selects the database clusters by names, by a label selector, or both.
+	// At least one of `names` and `labelSelector` must be set.
+	Selector DatabaseClusterBulkSelector `json:"selector"`
+}
+
+// DatabaseClusterBulkRequestAction defines model for DatabaseClusterBulkRequest.Action.
+type DatabaseClusterBulkRequestAction string
+
+// DatabaseClusterBulkResponse defines model for DatabaseClusterBulkResponse.
+type DatabaseClusterBulkResponse struct {
+	Items []DatabaseClusterBulkResult `json:"items"`
+}
+
+// DatabaseClusterBulkResult The result of the action for a database cluster.
+type DatabaseClusterBulkResult struct {
+	// ChangeRequest A request to perform an operation that requires approval by a second user.
+	ChangeRequest *ChangeRequest `json:"changeRequest,omitempty"`
+
+	// Error Error response
+	Error     *Error                          `json:"error,omitempty"`
+	Name      string                          `json:"name"`
+	Namespace *string                         `json:"namespace,omitempty"`
+	Result    DatabaseClusterBulkResultResult `json:"result"`
+}
+
+// DatabaseClusterBulkResultResult defines model for DatabaseClusterBulkResult.Result.
+type DatabaseClusterBulkResultResult string
+
+// DatabaseClusterBulkSelector Selects the database clusters by names, by a label selector, or both.
+// At least one of `names` and `labelSelector` must be set.
+type DatabaseClusterBulkSelector struct {
+	// LabelSelector Kubernetes label selector, e.g. `env=dev,team in (a,b)`
+	LabelSelector *string `json:"labelSelector,omitempty"`
+
+	// Names Names of the database clusters
+	Names *[]string `json:"names,omitempty"`
+
+	// Namespaces Namespaces to select the database clusters from. Defaults to all namespaces the user can read.
+	Namespaces *[]string `json:"namespaces,omitempty"`
+}
+
 // DatabaseClusterComponentContainer defines model for DatabaseClusterComponentContainer.
 type DatabaseClusterComponentContainer struct {
 	Name     *string `json:"name,omitempty"`
@@ -966,6 +1203,102 @@ type DatabaseClusterCredential struct {
 	Username      *string `json:"username,omitempty"`
 }
 
+// DatabaseClusterDeletionProtection Deletion protection of a database cluster. A protected database cluster cannot be deleted or purged,
+// neither by the user nor when it expires, until the protection is disabled.
+// It is stored in the `everest.percona.com/deletion-protection` annotation of the database cluster.
+type DatabaseClusterDeletionProtection struct {
+	Enabled bool `json:"enabled"`
+}
+
+// DatabaseClusterDiagnosticCheck defines model for DatabaseClusterDiagnosticCheck.
+type DatabaseClusterDiagnosticCheck struct {
+	// Message The reason the check has been skipped
+	Message *string `json:"message,omitempty"`
+	Name    string  `json:"name"`
+
+	// Status - `ok`: the check has not found any problem.
+	// - `problems`: the check has found problems.
+	// - `skipped`: the check could not run, e.g. the version service is unreachable.
+	Status DatabaseClusterDiagnosticCheckStatus `json:"status"`
+}
+
+// DatabaseClusterDiagnosticCheckStatus - `ok`: the check has not found any problem.
+// - `problems`: the check has found problems.
+// - `skipped`: the check could not run, e.g. the version service is unreachable.
+type DatabaseClusterDiagnosticCheckStatus string
+
+// DatabaseClusterDiagnosticFinding defines model for DatabaseClusterDiagnosticFinding.
+type DatabaseClusterDiagnosticFinding struct {
+	// Check Name of the check that found the problem
+	Check   string `json:"check"`
+	Message string `json:"message"`
+
+	// Object The object the problem has been found on
+	Object      *DatabaseClusterDiagnosticObject         `json:"object,omitempty"`
+	Remediation *string                                  `json:"remediation,omitempty"`
+	Severity    DatabaseClusterDiagnosticFindingSeverity `json:"severity"`
+}
+
+// DatabaseClusterDiagnosticObject The object the problem has been found on
+type DatabaseClusterDiagnosticObject struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
+
+// DatabaseClusterDiagnosticFindingSeverity defines model for DatabaseClusterDiagnosticFinding.Severity.
+type DatabaseClusterDiagnosticFindingSeverity string
+
+// DatabaseClusterDiagnostics The result of the health checks of a database cluster.
+type DatabaseClusterDiagnostics struct {
+	Checks []DatabaseClusterDiagnosticCheck `json:"checks"`
+
+	// Findings The problems found, the most severe first
+	Findings []DatabaseClusterDiagnosticFinding `json:"findings"`
+
+	// Healthy False if any of the findings is critical
+	Healthy bool `json:"healthy"`
+}
+
+// DatabaseClusterEvent An event in the timeline of a database cluster.
+type DatabaseClusterEvent struct {
+	// Count Number of occurrences of a Kubernetes event
+	Count          *int                       `json:"count,omitempty"`
+	InvolvedObject DatabaseClusterEventObject `json:"involvedObject"`
+	Message        string                     `json:"message"`
+
+	// Reason Reason of the event. For the transitions recorded by Everest, it is `StatusChanged` for the status of the database cluster
+	// or the reason of the condition otherwise.
+	Reason string `json:"reason"`
+
+	// Source Where the event comes from:
+	// - `kubernetes`: a Kubernetes event.
+	// - `timeline`: a transition of the status or of a condition of the database cluster recorded by Everest.
+	Source DatabaseClusterEventSource `json:"source"`
+
+	// Time Time of the last occurrence of the event
+	Time time.Time                `json:"time"`
+	Type DatabaseClusterEventType `json:"type"`
+}
+
+// DatabaseClusterEventObject defines model for .
+type DatabaseClusterEventObject struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
+
+// DatabaseClusterEventSource Where the event comes from:
+// - `kubernetes`: a Kubernetes event.
+// - `timeline`: a transition of the status or of a condition of the database cluster recorded by Everest.
+type DatabaseClusterEventSource string
+
+// DatabaseClusterEventType defines model for DatabaseClusterEvent.Type.
+type DatabaseClusterEventType string
+
+// DatabaseClusterEventList defines model for DatabaseClusterEventList.
+type DatabaseClusterEventList struct {
+	Items []DatabaseClusterEvent `json:"items"`
+}
+
 // DatabaseClusterList DatabaseClusterList is an object that contains the list of the existing database clusters.
 type DatabaseClusterList struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
@@ -1064,6 +1397,62 @@ type DatabaseClusterRestoreList struct {
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// DatabaseClusterSchedule A schedule that pauses, resumes or scales a database cluster at given times.
+type DatabaseClusterSchedule struct {
+	Action DatabaseClusterScheduleAction `json:"action"`
+
+	// Cron Standard cron expression with five fields (minute, hour, day of month, month, day of week)
+	Cron          string  `json:"cron"`
+	DbClusterName *string `json:"dbClusterName,omitempty"`
+	Enabled       *bool   `json:"enabled,omitempty"`
+	Name          string  `json:"name"`
+	Namespace     *string `json:"namespace,omitempty"`
+
+	// Scale The replica counts and resources the database cluster is scaled to. Required by the `scale` action.
+	Scale  *DatabaseClusterScheduleScale  `json:"scale,omitempty"`
+	Status *DatabaseClusterScheduleStatus `json:"status,omitempty"`
+
+	// TimeZone IANA time zone the cron expression is evaluated in. Defaults to UTC.
+	TimeZone *string `json:"timeZone,omitempty"`
+}
+
+// DatabaseClusterScheduleAction defines model for DatabaseClusterSchedule.Action.
+type DatabaseClusterScheduleAction string
+
+// DatabaseClusterScheduleList defines model for DatabaseClusterScheduleList.
+type DatabaseClusterScheduleList struct {
+	Items []DatabaseClusterSchedule `json:"items"`
+}
+
+// DatabaseClusterScheduleRun defines model for DatabaseClusterScheduleRun.
+type DatabaseClusterScheduleRun struct {
+	Action  string                           `json:"action"`
+	Message *string                          `json:"message,omitempty"`
+	Result  DatabaseClusterScheduleRunResult `json:"result"`
+	Time    time.Time                        `json:"time"`
+}
+
+// DatabaseClusterScheduleRunResult defines model for DatabaseClusterScheduleRun.Result.
+type DatabaseClusterScheduleRunResult string
+
+// DatabaseClusterScheduleScale The replica counts and resources the database cluster is scaled to. Required by the `scale` action.
+type DatabaseClusterScheduleScale struct {
+	EngineCPU      *string `json:"engineCPU,omitempty"`
+	EngineMemory   *string `json:"engineMemory,omitempty"`
+	EngineReplicas *int32  `json:"engineReplicas,omitempty"`
+	ProxyReplicas  *int32  `json:"proxyReplicas,omitempty"`
+}
+
+// DatabaseClusterScheduleStatus defines model for DatabaseClusterScheduleStatus.
+type DatabaseClusterScheduleStatus struct {
+	// History The most recent executions, newest first
+	History *[]DatabaseClusterScheduleRun `json:"history,omitempty"`
+
+	// LastScheduleTime The time the schedule was last evaluated at
+	LastScheduleTime *time.Time `json:"lastScheduleTime,omitempty"`
+	NextRunTime      *time.Time `json:"nextRunTime,omitempty"`
+}
+
 // DatabaseEngine DatabaseEngine is the Schema for the databaseengines API.
 type DatabaseEngine struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object.
@@ -1213,7 +1602,33 @@ type DatabaseEngineList struct {
 
 // Error Error response
 type Error struct {
-	Message *string `json:"message,omitempty"`
+	// Category Category of the error that does not depend on the transport.
+	// `invalid` errors are caused by the request and must not be retried without changing it,
+	// while `unavailable` and `internal` errors may succeed when retried.
+	Category *ErrorCategory `json:"category,omitempty"`
+
+	// Code Stable, machine-readable code of the error, e.g. `InvalidRequest`, `NotFound`,
+	// `AlreadyExists`, `Conflict`, `Forbidden`, `PreconditionFailed` or `Internal`.
+	// Unlike the message, the code does not change between releases.
+	Code *string `json:"code,omitempty"`
+
+	// FieldViolations The fields of the request that caused the error.
+	FieldViolations *[]FieldViolation `json:"fieldViolations,omitempty"`
+	Message         *string           `json:"message,omitempty"`
+}
+
+// ErrorCategory Category of the error that does not depend on the transport.
+// `invalid` errors are caused by the request and must not be retried without changing it,
+// while `unavailable` and `internal` errors may succeed when retried.
+type ErrorCategory string
+
+// FieldViolation Violation of a field of the request.
+type FieldViolation struct {
+	// Field JSON path of the field, e.g. `spec.engine.replicas`.
+	Field string `json:"field"`
+
+	// Reason Reason the field is invalid.
+	Reason string `json:"reason"`
 }
 
 // KubernetesClusterInfo kubernetes cluster info
@@ -1246,6 +1661,14 @@ type ResourcesCapacity struct {
 	MemoryBytes *uint64 `json:"memoryBytes,omitempty"`
 }
 
+// Labels User-managed labels of the resource. Labels with the prefix of a percona.com,
+// kubernetes.io or k8s.io domain and the clusterName label are reserved;
+// they cannot be set or changed and are omitted from responses.
+// On update, the given labels replace all user-managed labels of the resource.
+// RBAC policies may grant permissions on the resources with a label by using an object
+// of the form `<namespace>/label:<key>=<value>`, e.g. `dev/label:team=a`.
+type Labels map[string]string
+
 // LoadBalancerConfig LoadBalancerConfig is the Schema for the Load Balancer Config API.
 type LoadBalancerConfig struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object.
@@ -1296,9 +1719,23 @@ type MonitoringInstance = MonitoringInstanceBaseWithName
 type MonitoringInstanceBase struct {
 	// AllowedNamespaces List of namespaces allowed to use this monitoring instance
 	// Deprecated: this property has been marked as deprecated upstream, but no `x-deprecated-reason` was set
-	AllowedNamespaces *[]string                  `json:"allowedNamespaces,omitempty"`
-	Type              MonitoringInstanceBaseType `json:"type,omitempty"`
-	Url               string                     `json:"url,omitempty"`
+	AllowedNamespaces *[]string `json:"allowedNamespaces,omitempty"`
+
+	// Annotations User-managed annotations of the resource. Annotations with the prefix of a percona.com,
+	// kubernetes.io or k8s.io domain are reserved; they cannot be set or changed and are
+	// omitted from responses.
+	// On update, the given annotations replace all user-managed annotations of the resource.
+	Annotations *Annotations `json:"annotations,omitempty"`
+
+	// Labels User-managed labels of the resource. Labels with the prefix of a percona.com,
+	// kubernetes.io or k8s.io domain and the clusterName label are reserved;
+	// they cannot be set or changed and are omitted from responses.
+	// On update, the given labels replace all user-managed labels of the resource.
+	// RBAC policies may grant permissions on the resources with a label by using an object
+	// of the form `<namespace>/label:<key>=<value>`, e.g. `dev/label:team=a`.
+	Labels *Labels                    `json:"labels,omitempty"`
+	Type   MonitoringInstanceBaseType `json:"type,omitempty"`
+	Url    string                     `json:"url,omitempty"`
 
 	// VerifyTLS VerifyTLS is set to ensure TLS/SSL verification.
 	VerifyTLS *bool `json:"verifyTLS,omitempty"`
@@ -1313,6 +1750,20 @@ type MonitoringInstanceBaseWithName struct {
 	// Deprecated: this property has been marked as deprecated upstream, but no `x-deprecated-reason` was set
 	AllowedNamespaces *[]string `json:"allowedNamespaces,omitempty"`
 
+	// Annotations User-managed annotations of the resource. Annotations with the prefix of a percona.com,
+	// kubernetes.io or k8s.io domain are reserved; they cannot be set or changed and are
+	// omitted from responses.
+	// On update, the given annotations replace all user-managed annotations of the resource.
+	Annotations *Annotations `json:"annotations,omitempty"`
+
+	// Labels User-managed labels of the resource. Labels with the prefix of a percona.com,
+	// kubernetes.io or k8s.io domain and the clusterName label are reserved;
+	// they cannot be set or changed and are omitted from responses.
+	// On update, the given labels replace all user-managed labels of the resource.
+	// RBAC policies may grant permissions on the resources with a label by using an object
+	// of the form `<namespace>/label:<key>=<value>`, e.g. `dev/label:team=a`.
+	Labels *Labels `json:"labels,omitempty"`
+
 	// Name A user defined string name of the storage in the DNS name format https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#dns-label-names
 	Name      string                             `json:"name,omitempty"`
 	Namespace string                             `json:"namespace,omitempty"`
@@ -1332,6 +1783,20 @@ type MonitoringInstanceCreateParams struct {
 	// Deprecated: this property has been marked as deprecated upstream, but no `x-deprecated-reason` was set
 	AllowedNamespaces *[]string `json:"allowedNamespaces,omitempty"`
 
+	// Annotations User-managed annotations of the resource. Annotations with the prefix of a percona.com,
+	// kubernetes.io or k8s.io domain are reserved; they cannot be set or changed and are
+	// omitted from responses.
+	// On update, the given annotations replace all user-managed annotations of the resource.
+	Annotations *Annotations `json:"annotations,omitempty"`
+
+	// Labels User-managed labels of the resource. Labels with the prefix of a percona.com,
+	// kubernetes.io or k8s.io domain and the clusterName label are reserved;
+	// they cannot be set or changed and are omitted from responses.
+	// On update, the given labels replace all user-managed labels of the resource.
+	// RBAC policies may grant permissions on the resources with a label by using an object
+	// of the form `<namespace>/label:<key>=<value>`, e.g. `dev/label:team=a`.
+	Labels *Labels `json:"labels,omitempty"`
+
 	// Name A user defined string name of the storage in the DNS name format https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#dns-label-names
 	Name      string                             `json:"name,omitempty"`
 	Namespace string                             `json:"namespace,omitempty"`
@@ -1362,10 +1827,24 @@ type MonitoringInstancePMM struct {
 type MonitoringInstanceUpdateParams struct {
 	// AllowedNamespaces List of namespaces allowed to use this monitoring instance
 	// Deprecated: this property has been marked as deprecated upstream, but no `x-deprecated-reason` was set
-	AllowedNamespaces *[]string                          `json:"allowedNamespaces,omitempty"`
-	Pmm               *PMMMonitoringInstanceSpec         `json:"pmm,omitempty"`
-	Type              MonitoringInstanceUpdateParamsType `json:"type,omitempty"`
-	Url               string                             `json:"url,omitempty"`
+	AllowedNamespaces *[]string `json:"allowedNamespaces,omitempty"`
+
+	// Annotations User-managed annotations of the resource. Annotations with the prefix of a percona.com,
+	// kubernetes.io or k8s.io domain are reserved; they cannot be set or changed and are
+	// omitted from responses.
+	// On update, the given annotations replace all user-managed annotations of the resource.
+	Annotations *Annotations `json:"annotations,omitempty"`
+
+	// Labels User-managed labels of the resource. Labels with the prefix of a percona.com,
+	// kubernetes.io or k8s.io domain and the clusterName label are reserved;
+	// they cannot be set or changed and are omitted from responses.
+	// On update, the given labels replace all user-managed labels of the resource.
+	// RBAC policies may grant permissions on the resources with a label by using an object
+	// of the form `<namespace>/label:<key>=<value>`, e.g. `dev/label:team=a`.
+	Labels *Labels                            `json:"labels,omitempty"`
+	Pmm    *PMMMonitoringInstanceSpec         `json:"pmm,omitempty"`
+	Type   MonitoringInstanceUpdateParamsType `json:"type,omitempty"`
+	Url    string                             `json:"url,omitempty"`
 
 	// VerifyTLS VerifyTLS is set to ensure TLS/SSL verification.
 	VerifyTLS *bool `json:"verifyTLS,omitempty"`
@@ -1380,6 +1859,45 @@ type MonitoringInstancesList = []MonitoringInstance
 // NamespaceList defines model for NamespaceList.
 type NamespaceList = []string
 
+// NamespaceQuota Everest quota of a namespace. Absent fields are not limited.
+type NamespaceQuota struct {
+	// AllowedEngines Engine types that can be used in the namespace
+	AllowedEngines *[]string `json:"allowedEngines,omitempty"`
+
+	// Cpu Total amount of CPU that can be requested by all database clusters
+	Cpu *string `json:"cpu,omitempty"`
+
+	// Disk Total amount of storage that can be requested by all database clusters
+	Disk *string `json:"disk,omitempty"`
+
+	// MaxBackups Maximum number of database cluster backups in the namespace
+	MaxBackups *int `json:"maxBackups,omitempty"`
+
+	// MaxDatabaseClusters Maximum number of database clusters in the namespace
+	MaxDatabaseClusters *int `json:"maxDatabaseClusters,omitempty"`
+
+	// Memory Total amount of memory that can be requested by all database clusters
+	Memory *string `json:"memory,omitempty"`
+}
+
+// NamespaceQuotaUsage defines model for NamespaceQuotaUsage.
+type NamespaceQuotaUsage struct {
+	Namespace string `json:"namespace"`
+
+	// Quota Everest quota of a namespace. Absent fields are not limited.
+	Quota *NamespaceQuota        `json:"quota,omitempty"`
+	Usage NamespaceResourceUsage `json:"usage"`
+}
+
+// NamespaceResourceUsage defines model for NamespaceResourceUsage.
+type NamespaceResourceUsage struct {
+	Backups          int    `json:"backups"`
+	Cpu              string `json:"cpu"`
+	DatabaseClusters int    `json:"databaseClusters"`
+	Disk             string `json:"disk"`
+	Memory           string `json:"memory"`
+}
+
 // OIDCConfig Everest OIDC provider configuration
 type OIDCConfig struct {
 	// ClientId OIDC application clientID
@@ -1392,6 +1910,38 @@ type OIDCConfig struct {
 	Scopes []string `json:"scopes"`
 }
 
+// Operation An asynchronous action whose progress is derived from the resources it is performed on.
+type Operation struct {
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	CreatedBy   string     `json:"createdBy"`
+	ExpiresAt   time.Time  `json:"expiresAt"`
+
+	// Messages Progress messages of the action
+	Messages  *[]string `json:"messages,omitempty"`
+	Name      string    `json:"name"`
+	Namespace string    `json:"namespace"`
+
+	// Result The resource the action resulted in, once the operation has succeeded
+	Result *map[string]interface{} `json:"result,omitempty"`
+	State  OperationState          `json:"state"`
+
+	// Target Name of the resource the action is performed on
+	Target string        `json:"target"`
+	Type   OperationType `json:"type"`
+}
+
+// OperationState defines model for Operation.State.
+type OperationState string
+
+// OperationType defines model for Operation.Type.
+type OperationType string
+
+// OperationList defines model for OperationList.
+type OperationList struct {
+	Items []Operation `json:"items"`
+}
+
 // PodSchedulingPolicy PodSchedulingPolicy is the Schema for the Pod Scheduling Policy API.
 type PodSchedulingPolicy struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object.
@@ -5125,14 +5675,28 @@ type UpdateBackupStorageParams struct {
 	// Deprecated: this property has been marked as deprecated upstream, but no `x-deprecated-reason` was set
 	AllowedNamespaces *[]string `json:"allowedNamespaces,omitempty"`
 
+	// Annotations User-managed annotations of the resource. Annotations with the prefix of a percona.com,
+	// kubernetes.io or k8s.io domain are reserved; they cannot be set or changed and are
+	// omitted from responses.
+	// On update, the given annotations replace all user-managed annotations of the resource.
+	Annotations *Annotations `json:"annotations,omitempty"`
+
 	// BucketName The cloud storage bucket/container name
 	BucketName     *string `json:"bucketName,omitempty"`
 	Description    *string `json:"description,omitempty"`
 	ForcePathStyle *bool   `json:"forcePathStyle,omitempty"`
-	Region         *string `json:"region,omitempty"`
-	SecretKey      *string `json:"secretKey,omitempty"`
-	Url            *string `json:"url,omitempty"`
-	VerifyTLS      *bool   `json:"verifyTLS,omitempty"`
+
+	// Labels User-managed labels of the resource. Labels with the prefix of a percona.com,
+	// kubernetes.io or k8s.io domain and the clusterName label are reserved;
+	// they cannot be set or changed and are omitted from responses.
+	// On update, the given labels replace all user-managed labels of the resource.
+	// RBAC policies may grant permissions on the resources with a label by using an object
+	// of the form `<namespace>/label:<key>=<value>`, e.g. `dev/label:team=a`.
+	Labels    *Labels `json:"labels,omitempty"`
+	Region    *string `json:"region,omitempty"`
+	SecretKey *string `json:"secretKey,omitempty"`
+	Url       *string `json:"url,omitempty"`
+	VerifyTLS *bool   `json:"verifyTLS,omitempty"`
 }
 
 // Upgrade defines model for Upgrade.
@@ -5270,18 +5834,99 @@ type IoK8sApimachineryPkgApisMetaV1StatusV2 struct {
 	Status *string `json:"status,omitempty"`
 }
 
+// ListContinue defines model for ListContinue.
+type ListContinue = string
+
+// ListLabelSelector defines model for ListLabelSelector.
+type ListLabelSelector = string
+
+// ListLimit defines model for ListLimit.
+type ListLimit = int64
+
+// ListSortBy defines model for ListSortBy.
+type ListSortBy = string
+
 // ListDataImportersParams defines parameters for ListDataImporters.
 type ListDataImportersParams struct {
 	// SupportedEngines Filter data importers by supported database engine type. Accepts a comma-separated list.
 	SupportedEngines *[]string `form:"supportedEngines,omitempty" json:"supportedEngines,omitempty"`
 }
 
+// ListBackupStoragesParams defines parameters for ListBackupStorages.
+type ListBackupStoragesParams struct {
+	// LabelSelector Kubernetes label selector to filter the items by, e.g. `env=dev,team in (a,b)`.
+	LabelSelector *ListLabelSelector `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+}
+
 // DeleteDatabaseClusterBackupParams defines parameters for DeleteDatabaseClusterBackup.
 type DeleteDatabaseClusterBackupParams struct {
 	// CleanupBackupStorage If set, remove the backed up data from storage
 	CleanupBackupStorage *bool `form:"cleanupBackupStorage,omitempty" json:"cleanupBackupStorage,omitempty"`
 }
 
+// ListDatabaseClustersParams defines parameters for ListDatabaseClusters.
+type ListDatabaseClustersParams struct {
+	// Limit Maximum number of items to return. All items are returned if it is not set.
+	Limit *ListLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Continue Token returned in the list metadata of the previous page to request the next page with.
+	Continue *ListContinue `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector Kubernetes label selector to filter the items by, e.g. `env=dev,team in (a,b)`.
+	LabelSelector *ListLabelSelector `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// SortBy Field to sort the items by, prefixed with `-` for descending order.
+	// Supported fields are `name`, `creationTimestamp` and `status`; database clusters can also be sorted by `engineType`.
+	SortBy *ListSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+
+	// EngineType Return only the database clusters of the given engine type.
+	EngineType *ListDatabaseClustersParamsEngineType `form:"engineType,omitempty" json:"engineType,omitempty"`
+
+	// Status Return only the items in the given status.
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// ListDatabaseClustersParamsEngineType defines parameters for ListDatabaseClusters.
+type ListDatabaseClustersParamsEngineType string
+
+// ListDatabaseClusterBackupsParams defines parameters for ListDatabaseClusterBackups.
+type ListDatabaseClusterBackupsParams struct {
+	// Limit Maximum number of items to return. All items are returned if it is not set.
+	Limit *ListLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Continue Token returned in the list metadata of the previous page to request the next page with.
+	Continue *ListContinue `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector Kubernetes label selector to filter the items by, e.g. `env=dev,team in (a,b)`.
+	LabelSelector *ListLabelSelector `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// SortBy Field to sort the items by, prefixed with `-` for descending order.
+	// Supported fields are `name`, `creationTimestamp` and `status`; database clusters can also be sorted by `engineType`.
+	SortBy *ListSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+
+	// Status Return only the items in the given status.
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// ListDatabaseClusterRestoresParams defines parameters for ListDatabaseClusterRestores.
+type ListDatabaseClusterRestoresParams struct {
+	// Limit Maximum number of items to return. All items are returned if it is not set.
+	Limit *ListLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Continue Token returned in the list metadata of the previous page to request the next page with.
+	Continue *ListContinue `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector Kubernetes label selector to filter the items by, e.g. `env=dev,team in (a,b)`.
+	LabelSelector *ListLabelSelector `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// SortBy Field to sort the items by, prefixed with `-` for descending order.
+	// Supported fields are `name`, `creationTimestamp` and `status`; database clusters can also be sorted by `engineType`.
+	SortBy *ListSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+
+	// Status Return only the items in the given status.
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+}
+
 // CreateDatabaseClusterSecretParams defines parameters for CreateDatabaseClusterSecret.
 type CreateDatabaseClusterSecretParams struct {
 	// SecretName Optional name of the secret to be created. If not provided, a random name will be generated.
@@ -5294,6 +5939,9 @@ type DeleteDatabaseClusterParams struct {
 	CleanupBackupStorage *bool `form:"cleanupBackupStorage,omitempty" json:"cleanupBackupStorage,omitempty"`
 }
 
+// PatchDatabaseClusterApplicationMergePatchPlusJSONBody defines parameters for PatchDatabaseCluster.
+type PatchDatabaseClusterApplicationMergePatchPlusJSONBody = map[string]interface{}
+
 // GetDatabaseClusterComponentLogsParams defines parameters for GetDatabaseClusterComponentLogs.
 type GetDatabaseClusterComponentLogsParams struct {
 	// Container Container name. If omitted, the first container in the pod spec is used.
@@ -5321,6 +5969,63 @@ type GetDatabaseClusterComponentLogsParams struct {
 	LimitBytes *int `form:"limitBytes,omitempty" json:"limitBytes,omitempty"`
 }
 
+// GetDatabaseClusterDiagnosticsParams defines parameters for GetDatabaseClusterDiagnostics.
+type GetDatabaseClusterDiagnosticsParams struct {
+	// Check Names of the checks to run. If omitted, all the checks are run.
+	Check *[]string `form:"check,omitempty" json:"check,omitempty"`
+}
+
+// GetDatabaseClusterEventsParams defines parameters for GetDatabaseClusterEvents.
+type GetDatabaseClusterEventsParams struct {
+	// Since Return the events that happened after this time
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+}
+
+// GetDatabaseClusterLogsParams defines parameters for GetDatabaseClusterLogs.
+type GetDatabaseClusterLogsParams struct {
+	// Component Types of the components to get the logs of, e.g. `pxc` or `proxysql`. If omitted, the logs of all the components are returned.
+	Component *[]string `form:"component,omitempty" json:"component,omitempty"`
+
+	// Container Names of the containers to get the logs of. If omitted, the logs of all the containers are returned.
+	Container *[]string `form:"container,omitempty" json:"container,omitempty"`
+
+	// Filter Return only the lines containing this string
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// Regex Interpret the filter as a regular expression
+	Regex *bool `form:"regex,omitempty" json:"regex,omitempty"`
+
+	// Follow Stream logs continuously
+	Follow *bool `form:"follow,omitempty" json:"follow,omitempty"`
+
+	// TailLines Number of lines from the end of the logs of each container to show
+	TailLines *int `form:"tailLines,omitempty" json:"tailLines,omitempty"`
+
+	// SinceSeconds Return logs newer than this many seconds
+	SinceSeconds *int `form:"sinceSeconds,omitempty" json:"sinceSeconds,omitempty"`
+
+	// SinceTime RFC3339 timestamp to start logs from
+	SinceTime *time.Time `form:"sinceTime,omitempty" json:"sinceTime,omitempty"`
+
+	// Timestamps Include timestamps in log lines
+	Timestamps *bool `form:"timestamps,omitempty" json:"timestamps,omitempty"`
+
+	// Previous Also return the logs of the previous instance of the containers that have restarted, e.g. after a crash
+	Previous *bool `form:"previous,omitempty" json:"previous,omitempty"`
+
+	// LimitBytes Maximum bytes to return for each container
+	LimitBytes *int `form:"limitBytes,omitempty" json:"limitBytes,omitempty"`
+
+	// Download Return the logs as a tar.gz archive with one file per container
+	Download *bool `form:"download,omitempty" json:"download,omitempty"`
+}
+
+// ListMonitoringInstancesParams defines parameters for ListMonitoringInstances.
+type ListMonitoringInstancesParams struct {
+	// LabelSelector Kubernetes label selector to filter the items by, e.g. `env=dev,team in (a,b)`.
+	LabelSelector *ListLabelSelector `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+}
+
 // ListPodSchedulingPolicyParams defines parameters for ListPodSchedulingPolicy.
 type ListPodSchedulingPolicyParams struct {
 	// EngineType Database engine type that Pod Scheduling Policy is applicable to.
@@ -5333,6 +6038,9 @@ type ListPodSchedulingPolicyParams struct {
 // ListPodSchedulingPolicyParamsEngineType defines parameters for ListPodSchedulingPolicy.
 type ListPodSchedulingPolicyParamsEngineType string
 
+// BulkDatabaseClusterActionJSONRequestBody defines body for BulkDatabaseClusterAction for application/json ContentType.
+type BulkDatabaseClusterActionJSONRequestBody = DatabaseClusterBulkRequest
+
 // CreateLoadBalancerConfigJSONRequestBody defines body for CreateLoadBalancerConfig for application/json ContentType.
 type CreateLoadBalancerConfigJSONRequestBody = LoadBalancerConfig
 
@@ -5360,9 +6068,21 @@ type CreateDatabaseClusterJSONRequestBody = DatabaseCluster
 // CreateDatabaseClusterSecretJSONRequestBody defines body for CreateDatabaseClusterSecret for application/json ContentType.
 type CreateDatabaseClusterSecretJSONRequestBody = Secret
 
+// PatchDatabaseClusterApplicationMergePatchPlusJSONRequestBody defines body for PatchDatabaseCluster for application/merge-patch+json ContentType.
+type PatchDatabaseClusterApplicationMergePatchPlusJSONRequestBody = PatchDatabaseClusterApplicationMergePatchPlusJSONBody
+
 // UpdateDatabaseClusterJSONRequestBody defines body for UpdateDatabaseCluster for application/json ContentType.
 type UpdateDatabaseClusterJSONRequestBody = DatabaseCluster
 
+// UpdateDatabaseClusterDeletionProtectionJSONRequestBody defines body for UpdateDatabaseClusterDeletionProtection for application/json ContentType.
+type UpdateDatabaseClusterDeletionProtectionJSONRequestBody = DatabaseClusterDeletionProtection
+
+// CreateDatabaseClusterScheduleJSONRequestBody defines body for CreateDatabaseClusterSchedule for application/json ContentType.
+type CreateDatabaseClusterScheduleJSONRequestBody = DatabaseClusterSchedule
+
+// UpdateDatabaseClusterScheduleJSONRequestBody defines body for UpdateDatabaseClusterSchedule for application/json ContentType.
+type UpdateDatabaseClusterScheduleJSONRequestBody = DatabaseClusterSchedule
+
 // ApproveUpgradePlanJSONRequestBody defines body for ApproveUpgradePlan for application/json ContentType.
 type ApproveUpgradePlanJSONRequestBody = UpgradePlanApproval
 
@@ -5832,6 +6552,9 @@ type ServerInterface interface {
 	// List data importers
 	// (GET /data-importers)
 	ListDataImporters(ctx echo.Context, params ListDataImportersParams) error
+	// Apply an action to several database clusters
+	// (POST /database-clusters/bulk)
+	BulkDatabaseClusterAction(ctx echo.Context) error
 	// List load balancer configs
 	// (GET /load-balancer-configs)
 	ListLoadBalancerConfig(ctx echo.Context) error
@@ -5852,7 +6575,7 @@ type ServerInterface interface {
 	ListNamespaces(ctx echo.Context) error
 	// List backup storages
 	// (GET /namespaces/{namespace}/backup-storages)
-	ListBackupStorages(ctx echo.Context, namespace string) error
+	ListBackupStorages(ctx echo.Context, namespace string, params ListBackupStoragesParams) error
 	// Create backup storage
 	// (POST /namespaces/{namespace}/backup-storages)
 	CreateBackupStorage(ctx echo.Context, namespace string) error
@@ -5865,6 +6588,18 @@ type ServerInterface interface {
 	// Update backup storage
 	// (PATCH /namespaces/{namespace}/backup-storages/{name})
 	UpdateBackupStorage(ctx echo.Context, namespace string, name string) error
+	// List change requests
+	// (GET /namespaces/{namespace}/change-requests)
+	ListChangeRequests(ctx echo.Context, namespace string) error
+	// Get change request
+	// (GET /namespaces/{namespace}/change-requests/{name})
+	GetChangeRequest(ctx echo.Context, namespace string, name string) error
+	// Approve change request
+	// (POST /namespaces/{namespace}/change-requests/{name}/approve)
+	ApproveChangeRequest(ctx echo.Context, namespace string, name string) error
+	// Reject change request
+	// (POST /namespaces/{namespace}/change-requests/{name}/reject)
+	RejectChangeRequest(ctx echo.Context, namespace string, name string) error
 	// Create database cluster backup
 	// (POST /namespaces/{namespace}/database-cluster-backups)
 	CreateDatabaseClusterBackup(ctx echo.Context, namespace string) error
@@ -5888,16 +6623,16 @@ type ServerInterface interface {
 	UpdateDatabaseClusterRestore(ctx echo.Context, namespace string, name string) error
 	// List database clusters
 	// (GET /namespaces/{namespace}/database-clusters)
-	ListDatabaseClusters(ctx echo.Context, namespace string) error
+	ListDatabaseClusters(ctx echo.Context, namespace string, params ListDatabaseClustersParams) error
 	// Create database cluster
 	// (POST /namespaces/{namespace}/database-clusters)
 	CreateDatabaseCluster(ctx echo.Context, namespace string) error
 	// List database cluster backups
 	// (GET /namespaces/{namespace}/database-clusters/{cluster-name}/backups)
-	ListDatabaseClusterBackups(ctx echo.Context, namespace string, clusterName string) error
+	ListDatabaseClusterBackups(ctx echo.Context, namespace string, clusterName string, params ListDatabaseClusterBackupsParams) error
 	// List database cluster restores
 	// (GET /namespaces/{namespace}/database-clusters/{cluster-name}/restores)
-	ListDatabaseClusterRestores(ctx echo.Context, namespace string, clusterName string) error
+	ListDatabaseClusterRestores(ctx echo.Context, namespace string, clusterName string, params ListDatabaseClusterRestoresParams) error
 	// List data import jobs for a database cluster
 	// (GET /namespaces/{namespace}/database-clusters/{dbName}/data-import-jobs)
 	ListDataImportJobs(ctx echo.Context, namespace string, dbName string) error
@@ -5910,6 +6645,9 @@ type ServerInterface interface {
 	// Get database cluster
 	// (GET /namespaces/{namespace}/database-clusters/{name})
 	GetDatabaseCluster(ctx echo.Context, namespace string, name string) error
+	// Patch database cluster
+	// (PATCH /namespaces/{namespace}/database-clusters/{name})
+	PatchDatabaseCluster(ctx echo.Context, namespace string, name string) error
 	// Update database cluster
 	// (PUT /namespaces/{namespace}/database-clusters/{name})
 	UpdateDatabaseCluster(ctx echo.Context, namespace string, name string) error
@@ -5922,9 +6660,39 @@ type ServerInterface interface {
 	// Get database cluster credentials
 	// (GET /namespaces/{namespace}/database-clusters/{name}/credentials)
 	GetDatabaseClusterCredentials(ctx echo.Context, namespace string, name string) error
+	// Get database cluster deletion protection
+	// (GET /namespaces/{namespace}/database-clusters/{name}/deletion-protection)
+	GetDatabaseClusterDeletionProtection(ctx echo.Context, namespace string, name string) error
+	// Update database cluster deletion protection
+	// (PUT /namespaces/{namespace}/database-clusters/{name}/deletion-protection)
+	UpdateDatabaseClusterDeletionProtection(ctx echo.Context, namespace string, name string) error
+	// Get database cluster diagnostics
+	// (GET /namespaces/{namespace}/database-clusters/{name}/diagnostics)
+	GetDatabaseClusterDiagnostics(ctx echo.Context, namespace string, name string, params GetDatabaseClusterDiagnosticsParams) error
+	// Get database cluster events
+	// (GET /namespaces/{namespace}/database-clusters/{name}/events)
+	GetDatabaseClusterEvents(ctx echo.Context, namespace string, name string, params GetDatabaseClusterEventsParams) error
+	// Get database cluster logs
+	// (GET /namespaces/{namespace}/database-clusters/{name}/logs)
+	GetDatabaseClusterLogs(ctx echo.Context, namespace string, name string, params GetDatabaseClusterLogsParams) error
 	// Get the Point-in-Time recovery info
 	// (GET /namespaces/{namespace}/database-clusters/{name}/pitr)
 	GetDatabaseClusterPitr(ctx echo.Context, namespace string, name string) error
+	// List database cluster schedules
+	// (GET /namespaces/{namespace}/database-clusters/{name}/schedules)
+	ListDatabaseClusterSchedules(ctx echo.Context, namespace string, name string) error
+	// Create database cluster schedule
+	// (POST /namespaces/{namespace}/database-clusters/{name}/schedules)
+	CreateDatabaseClusterSchedule(ctx echo.Context, namespace string, name string) error
+	// Delete database cluster schedule
+	// (DELETE /namespaces/{namespace}/database-clusters/{name}/schedules/{schedule})
+	DeleteDatabaseClusterSchedule(ctx echo.Context, namespace string, name string, schedule string) error
+	// Get database cluster schedule
+	// (GET /namespaces/{namespace}/database-clusters/{name}/schedules/{schedule})
+	GetDatabaseClusterSchedule(ctx echo.Context, namespace string, name string, schedule string) error
+	// Update database cluster schedule
+	// (PUT /namespaces/{namespace}/database-clusters/{name}/schedules/{schedule})
+	UpdateDatabaseClusterSchedule(ctx echo.Context, namespace string, name string, schedule string) error
 	// List database engines
 	// (GET /namespaces/{namespace}/database-engines)
 	ListDatabaseEngines(ctx echo.Context, namespace string) error
@@ -5957,7 +6725,7 @@ type ServerInterface interface {
 	UpdateSplitHorizonDNSConfig(ctx echo.Context, namespace string, name string) error
 	// List monitoring instances
 	// (GET /namespaces/{namespace}/monitoring-instances)
-	ListMonitoringInstances(ctx echo.Context, namespace string) error
+	ListMonitoringInstances(ctx echo.Context, namespace string, params ListMonitoringInstancesParams) error
 	// Create monitoring instance
 	// (POST /namespaces/{namespace}/monitoring-instances)
 	CreateMonitoringInstance(ctx echo.Context, namespace string) error
@@ -5970,6 +6738,27 @@ type ServerInterface interface {
 	// Update monitoring instance
 	// (PATCH /namespaces/{namespace}/monitoring-instances/{name})
 	UpdateMonitoringInstance(ctx echo.Context, namespace string, name string) error
+	// List operations
+	// (GET /namespaces/{namespace}/operations)
+	ListOperations(ctx echo.Context, namespace string) error
+	// Get operation
+	// (GET /namespaces/{namespace}/operations/{name})
+	GetOperation(ctx echo.Context, namespace string, name string) error
+	// Namespace quota
+	// (GET /namespaces/{namespace}/quota)
+	GetNamespaceQuota(ctx echo.Context, namespace string) error
+	// List deleted database clusters
+	// (GET /namespaces/{namespace}/trash/database-clusters)
+	ListDeletedDatabaseClusters(ctx echo.Context, namespace string) error
+	// Purge deleted database cluster
+	// (DELETE /namespaces/{namespace}/trash/database-clusters/{name})
+	PurgeDatabaseCluster(ctx echo.Context, namespace string, name string) error
+	// Get deleted database cluster
+	// (GET /namespaces/{namespace}/trash/database-clusters/{name})
+	GetDeletedDatabaseCluster(ctx echo.Context, namespace string, name string) error
+	// Restore deleted database cluster
+	// (POST /namespaces/{namespace}/trash/database-clusters/{name}/restore)
+	RestoreDatabaseCluster(ctx echo.Context, namespace string, name string) error
 	// Get user permissions
 	// (GET /permissions)
 	GetUserPermissions(ctx echo.Context) error
@@ -6041,6 +6830,17 @@ func (w *ServerInterfaceWrapper) ListDataImporters(ctx echo.Context) error {
 	return err
 }
 
+// BulkDatabaseClusterAction converts echo context to params.
+func (w *ServerInterfaceWrapper) BulkDatabaseClusterAction(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.BulkDatabaseClusterAction(ctx)
+	return err
+}
+
 // ListLoadBalancerConfig converts echo context to params.
 func (w *ServerInterfaceWrapper) ListLoadBalancerConfig(ctx echo.Context) error {
 	var err error
@@ -6141,8 +6941,17 @@ func (w *ServerInterfaceWrapper) ListBackupStorages(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListBackupStoragesParams
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", ctx.QueryParams(), &params.LabelSelector)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter labelSelector: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListBackupStorages(ctx, namespace)
+	err = w.Handler.ListBackupStorages(ctx, namespace, params)
 	return err
 }
 
@@ -6242,8 +7051,8 @@ func (w *ServerInterfaceWrapper) UpdateBackupStorage(ctx echo.Context) error {
 	return err
 }
 
-// CreateDatabaseClusterBackup converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateDatabaseClusterBackup(ctx echo.Context) error {
+// ListChangeRequests converts echo context to params.
+func (w *ServerInterfaceWrapper) ListChangeRequests(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "namespace" -------------
 	var namespace string
@@ -6256,12 +7065,12 @@ func (w *ServerInterfaceWrapper) CreateDatabaseClusterBackup(ctx echo.Context) e
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateDatabaseClusterBackup(ctx, namespace)
+	err = w.Handler.ListChangeRequests(ctx, namespace)
 	return err
 }
 
-// DeleteDatabaseClusterBackup converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteDatabaseClusterBackup(ctx echo.Context) error {
+// GetChangeRequest converts echo context to params.
+func (w *ServerInterfaceWrapper) GetChangeRequest(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "namespace" -------------
 	var namespace string
@@ -6281,22 +7090,13 @@ func (w *ServerInterfaceWrapper) DeleteDatabaseClusterBackup(ctx echo.Context) e
 
 	ctx.Set(BearerAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteDatabaseClusterBackupParams
-	// ------------- Optional query parameter "cleanupBackupStorage" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "cleanupBackupStorage", ctx.QueryParams(), &params.CleanupBackupStorage)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cleanupBackupStorage: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteDatabaseClusterBackup(ctx, namespace, name, params)
+	err = w.Handler.GetChangeRequest(ctx, namespace, name)
 	return err
 }
 
-// GetDatabaseClusterBackup converts echo context to params.
-func (w *ServerInterfaceWrapper) GetDatabaseClusterBackup(ctx echo.Context) error {
+// ApproveChangeRequest converts echo context to params.
+func (w *ServerInterfaceWrapper) ApproveChangeRequest(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "namespace" -------------
 	var namespace string
@@ -6317,12 +7117,12 @@ func (w *ServerInterfaceWrapper) GetDatabaseClusterBackup(ctx echo.Context) erro
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetDatabaseClusterBackup(ctx, namespace, name)
+	err = w.Handler.ApproveChangeRequest(ctx, namespace, name)
 	return err
 }
 
-// CreateDatabaseClusterRestore converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateDatabaseClusterRestore(ctx echo.Context) error {
+// RejectChangeRequest converts echo context to params.
+func (w *ServerInterfaceWrapper) RejectChangeRequest(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "namespace" -------------
 	var namespace string
@@ -6332,8 +7132,113 @@ func (w *ServerInterfaceWrapper) CreateDatabaseClusterRestore(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
-
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RejectChangeRequest(ctx, namespace, name)
+	return err
+}
+
+// CreateDatabaseClusterBackup converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateDatabaseClusterBackup(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateDatabaseClusterBackup(ctx, namespace)
+	return err
+}
+
+// DeleteDatabaseClusterBackup converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteDatabaseClusterBackup(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteDatabaseClusterBackupParams
+	// ------------- Optional query parameter "cleanupBackupStorage" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cleanupBackupStorage", ctx.QueryParams(), &params.CleanupBackupStorage)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cleanupBackupStorage: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteDatabaseClusterBackup(ctx, namespace, name, params)
+	return err
+}
+
+// GetDatabaseClusterBackup converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterBackup(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterBackup(ctx, namespace, name)
+	return err
+}
+
+// CreateDatabaseClusterRestore converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateDatabaseClusterRestore(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.CreateDatabaseClusterRestore(ctx, namespace)
 	return err
@@ -6430,8 +7335,52 @@ func (w *ServerInterfaceWrapper) ListDatabaseClusters(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListDatabaseClustersParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", ctx.QueryParams(), &params.Continue)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter continue: %s", err))
+	}
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", ctx.QueryParams(), &params.LabelSelector)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter labelSelector: %s", err))
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", ctx.QueryParams(), &params.SortBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sortBy: %s", err))
+	}
+
+	// ------------- Optional query parameter "engineType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "engineType", ctx.QueryParams(), &params.EngineType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter engineType: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListDatabaseClusters(ctx, namespace)
+	err = w.Handler.ListDatabaseClusters(ctx, namespace, params)
 	return err
 }
 
@@ -6474,8 +7423,45 @@ func (w *ServerInterfaceWrapper) ListDatabaseClusterBackups(ctx echo.Context) er
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListDatabaseClusterBackupsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", ctx.QueryParams(), &params.Continue)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter continue: %s", err))
+	}
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", ctx.QueryParams(), &params.LabelSelector)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter labelSelector: %s", err))
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", ctx.QueryParams(), &params.SortBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sortBy: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListDatabaseClusterBackups(ctx, namespace, clusterName)
+	err = w.Handler.ListDatabaseClusterBackups(ctx, namespace, clusterName, params)
 	return err
 }
 
@@ -6500,8 +7486,45 @@ func (w *ServerInterfaceWrapper) ListDatabaseClusterRestores(ctx echo.Context) e
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListDatabaseClusterRestoresParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", ctx.QueryParams(), &params.Continue)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter continue: %s", err))
+	}
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", ctx.QueryParams(), &params.LabelSelector)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter labelSelector: %s", err))
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", ctx.QueryParams(), &params.SortBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sortBy: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListDatabaseClusterRestores(ctx, namespace, clusterName)
+	err = w.Handler.ListDatabaseClusterRestores(ctx, namespace, clusterName, params)
 	return err
 }
 
@@ -6572,37 +7595,493 @@ func (w *ServerInterfaceWrapper) DeleteDatabaseCluster(ctx echo.Context) error {
 	// ------------- Path parameter "namespace" -------------
 	var namespace string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteDatabaseClusterParams
+	// ------------- Optional query parameter "cleanupBackupStorage" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cleanupBackupStorage", ctx.QueryParams(), &params.CleanupBackupStorage)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cleanupBackupStorage: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteDatabaseCluster(ctx, namespace, name, params)
+	return err
+}
+
+// GetDatabaseCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseCluster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseCluster(ctx, namespace, name)
+	return err
+}
+
+// PatchDatabaseCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchDatabaseCluster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchDatabaseCluster(ctx, namespace, name)
+	return err
+}
+
+// UpdateDatabaseCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateDatabaseCluster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateDatabaseCluster(ctx, namespace, name)
+	return err
+}
+
+// GetDatabaseClusterComponents converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterComponents(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterComponents(ctx, namespace, name)
+	return err
+}
+
+// GetDatabaseClusterComponentLogs converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterComponentLogs(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Path parameter "component_name" -------------
+	var componentName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "component_name", ctx.Param("component_name"), &componentName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter component_name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDatabaseClusterComponentLogsParams
+	// ------------- Optional query parameter "container" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "container", ctx.QueryParams(), &params.Container)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter container: %s", err))
+	}
+
+	// ------------- Optional query parameter "follow" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "follow", ctx.QueryParams(), &params.Follow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter follow: %s", err))
+	}
+
+	// ------------- Optional query parameter "tailLines" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tailLines", ctx.QueryParams(), &params.TailLines)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tailLines: %s", err))
+	}
+
+	// ------------- Optional query parameter "sinceSeconds" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sinceSeconds", ctx.QueryParams(), &params.SinceSeconds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sinceSeconds: %s", err))
+	}
+
+	// ------------- Optional query parameter "sinceTime" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sinceTime", ctx.QueryParams(), &params.SinceTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sinceTime: %s", err))
+	}
+
+	// ------------- Optional query parameter "timestamps" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timestamps", ctx.QueryParams(), &params.Timestamps)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter timestamps: %s", err))
+	}
+
+	// ------------- Optional query parameter "previous" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "previous", ctx.QueryParams(), &params.Previous)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter previous: %s", err))
+	}
+
+	// ------------- Optional query parameter "limitBytes" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limitBytes", ctx.QueryParams(), &params.LimitBytes)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limitBytes: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterComponentLogs(ctx, namespace, name, componentName, params)
+	return err
+}
+
+// GetDatabaseClusterCredentials converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterCredentials(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterCredentials(ctx, namespace, name)
+	return err
+}
+
+// GetDatabaseClusterDeletionProtection converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterDeletionProtection(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterDeletionProtection(ctx, namespace, name)
+	return err
+}
+
+// UpdateDatabaseClusterDeletionProtection converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateDatabaseClusterDeletionProtection(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateDatabaseClusterDeletionProtection(ctx, namespace, name)
+	return err
+}
+
+// GetDatabaseClusterDiagnostics converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterDiagnostics(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDatabaseClusterDiagnosticsParams
+	// ------------- Optional query parameter "check" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "check", ctx.QueryParams(), &params.Check)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter check: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterDiagnostics(ctx, namespace, name, params)
+	return err
+}
+
+// GetDatabaseClusterEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterEvents(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDatabaseClusterEventsParams
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterEvents(ctx, namespace, name, params)
+	return err
+}
+
+// GetDatabaseClusterLogs converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterLogs(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDatabaseClusterLogsParams
+	// ------------- Optional query parameter "component" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "component", ctx.QueryParams(), &params.Component)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter component: %s", err))
+	}
+
+	// ------------- Optional query parameter "container" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "container", ctx.QueryParams(), &params.Container)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter container: %s", err))
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "regex" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "regex", ctx.QueryParams(), &params.Regex)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter regex: %s", err))
+	}
+
+	// ------------- Optional query parameter "follow" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "follow", ctx.QueryParams(), &params.Follow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter follow: %s", err))
+	}
+
+	// ------------- Optional query parameter "tailLines" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tailLines", ctx.QueryParams(), &params.TailLines)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tailLines: %s", err))
+	}
+
+	// ------------- Optional query parameter "sinceSeconds" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sinceSeconds", ctx.QueryParams(), &params.SinceSeconds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sinceSeconds: %s", err))
+	}
+
+	// ------------- Optional query parameter "sinceTime" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sinceTime", ctx.QueryParams(), &params.SinceTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sinceTime: %s", err))
+	}
+
+	// ------------- Optional query parameter "timestamps" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timestamps", ctx.QueryParams(), &params.Timestamps)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter timestamps: %s", err))
+	}
+
+	// ------------- Optional query parameter "previous" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "previous", ctx.QueryParams(), &params.Previous)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter previous: %s", err))
 	}
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	// ------------- Optional query parameter "limitBytes" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "limitBytes", ctx.QueryParams(), &params.LimitBytes)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limitBytes: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteDatabaseClusterParams
-	// ------------- Optional query parameter "cleanupBackupStorage" -------------
+	// ------------- Optional query parameter "download" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "cleanupBackupStorage", ctx.QueryParams(), &params.CleanupBackupStorage)
+	err = runtime.BindQueryParameter("form", true, false, "download", ctx.QueryParams(), &params.Download)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cleanupBackupStorage: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter download: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteDatabaseCluster(ctx, namespace, name, params)
+	err = w.Handler.GetDatabaseClusterLogs(ctx, namespace, name, params)
 	return err
 }
 
-// GetDatabaseCluster converts echo context to params.
-func (w *ServerInterfaceWrapper) GetDatabaseCluster(ctx echo.Context) error {
+// GetDatabaseClusterPitr converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterPitr(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "namespace" -------------
 	var namespace string
@@ -6623,12 +8102,12 @@ func (w *ServerInterfaceWrapper) GetDatabaseCluster(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetDatabaseCluster(ctx, namespace, name)
+	err = w.Handler.GetDatabaseClusterPitr(ctx, namespace, name)
 	return err
 }
 
-// UpdateDatabaseCluster converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateDatabaseCluster(ctx echo.Context) error {
+// ListDatabaseClusterSchedules converts echo context to params.
+func (w *ServerInterfaceWrapper) ListDatabaseClusterSchedules(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "namespace" -------------
 	var namespace string
@@ -6649,12 +8128,12 @@ func (w *ServerInterfaceWrapper) UpdateDatabaseCluster(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateDatabaseCluster(ctx, namespace, name)
+	err = w.Handler.ListDatabaseClusterSchedules(ctx, namespace, name)
 	return err
 }
 
-// GetDatabaseClusterComponents converts echo context to params.
-func (w *ServerInterfaceWrapper) GetDatabaseClusterComponents(ctx echo.Context) error {
+// CreateDatabaseClusterSchedule converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateDatabaseClusterSchedule(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "namespace" -------------
 	var namespace string
@@ -6675,12 +8154,12 @@ func (w *ServerInterfaceWrapper) GetDatabaseClusterComponents(ctx echo.Context)
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetDatabaseClusterComponents(ctx, namespace, name)
+	err = w.Handler.CreateDatabaseClusterSchedule(ctx, namespace, name)
 	return err
 }
 
-// GetDatabaseClusterComponentLogs converts echo context to params.
-func (w *ServerInterfaceWrapper) GetDatabaseClusterComponentLogs(ctx echo.Context) error {
+// DeleteDatabaseClusterSchedule converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteDatabaseClusterSchedule(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "namespace" -------------
 	var namespace string
@@ -6698,81 +8177,23 @@ func (w *ServerInterfaceWrapper) GetDatabaseClusterComponentLogs(ctx echo.Contex
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
-	// ------------- Path parameter "component_name" -------------
-	var componentName string
+	// ------------- Path parameter "schedule" -------------
+	var schedule string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "component_name", ctx.Param("component_name"), &componentName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "schedule", ctx.Param("schedule"), &schedule, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter component_name: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter schedule: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetDatabaseClusterComponentLogsParams
-	// ------------- Optional query parameter "container" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "container", ctx.QueryParams(), &params.Container)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter container: %s", err))
-	}
-
-	// ------------- Optional query parameter "follow" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "follow", ctx.QueryParams(), &params.Follow)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter follow: %s", err))
-	}
-
-	// ------------- Optional query parameter "tailLines" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "tailLines", ctx.QueryParams(), &params.TailLines)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tailLines: %s", err))
-	}
-
-	// ------------- Optional query parameter "sinceSeconds" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "sinceSeconds", ctx.QueryParams(), &params.SinceSeconds)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sinceSeconds: %s", err))
-	}
-
-	// ------------- Optional query parameter "sinceTime" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "sinceTime", ctx.QueryParams(), &params.SinceTime)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sinceTime: %s", err))
-	}
-
-	// ------------- Optional query parameter "timestamps" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "timestamps", ctx.QueryParams(), &params.Timestamps)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter timestamps: %s", err))
-	}
-
-	// ------------- Optional query parameter "previous" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "previous", ctx.QueryParams(), &params.Previous)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter previous: %s", err))
-	}
-
-	// ------------- Optional query parameter "limitBytes" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "limitBytes", ctx.QueryParams(), &params.LimitBytes)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limitBytes: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetDatabaseClusterComponentLogs(ctx, namespace, name, componentName, params)
+	err = w.Handler.DeleteDatabaseClusterSchedule(ctx, namespace, name, schedule)
 	return err
 }
 
-// GetDatabaseClusterCredentials converts echo context to params.
-func (w *ServerInterfaceWrapper) GetDatabaseClusterCredentials(ctx echo.Context) error {
+// GetDatabaseClusterSchedule converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterSchedule(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "namespace" -------------
 	var namespace string
@@ -6790,15 +8211,23 @@ func (w *ServerInterfaceWrapper) GetDatabaseClusterCredentials(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
+	// ------------- Path parameter "schedule" -------------
+	var schedule string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "schedule", ctx.Param("schedule"), &schedule, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter schedule: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetDatabaseClusterCredentials(ctx, namespace, name)
+	err = w.Handler.GetDatabaseClusterSchedule(ctx, namespace, name, schedule)
 	return err
 }
 
-// GetDatabaseClusterPitr converts echo context to params.
-func (w *ServerInterfaceWrapper) GetDatabaseClusterPitr(ctx echo.Context) error {
+// UpdateDatabaseClusterSchedule converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateDatabaseClusterSchedule(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "namespace" -------------
 	var namespace string
@@ -6816,10 +8245,18 @@ func (w *ServerInterfaceWrapper) GetDatabaseClusterPitr(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
+	// ------------- Path parameter "schedule" -------------
+	var schedule string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "schedule", ctx.Param("schedule"), &schedule, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter schedule: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetDatabaseClusterPitr(ctx, namespace, name)
+	err = w.Handler.UpdateDatabaseClusterSchedule(ctx, namespace, name, schedule)
 	return err
 }
 
@@ -7056,8 +8493,17 @@ func (w *ServerInterfaceWrapper) ListMonitoringInstances(ctx echo.Context) error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMonitoringInstancesParams
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", ctx.QueryParams(), &params.LabelSelector)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter labelSelector: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListMonitoringInstances(ctx, namespace)
+	err = w.Handler.ListMonitoringInstances(ctx, namespace, params)
 	return err
 }
 
@@ -7157,6 +8603,164 @@ func (w *ServerInterfaceWrapper) UpdateMonitoringInstance(ctx echo.Context) erro
 	return err
 }
 
+// ListOperations converts echo context to params.
+func (w *ServerInterfaceWrapper) ListOperations(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListOperations(ctx, namespace)
+	return err
+}
+
+// GetOperation converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOperation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOperation(ctx, namespace, name)
+	return err
+}
+
+// GetNamespaceQuota converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNamespaceQuota(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNamespaceQuota(ctx, namespace)
+	return err
+}
+
+// ListDeletedDatabaseClusters converts echo context to params.
+func (w *ServerInterfaceWrapper) ListDeletedDatabaseClusters(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListDeletedDatabaseClusters(ctx, namespace)
+	return err
+}
+
+// PurgeDatabaseCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) PurgeDatabaseCluster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PurgeDatabaseCluster(ctx, namespace, name)
+	return err
+}
+
+// GetDeletedDatabaseCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDeletedDatabaseCluster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDeletedDatabaseCluster(ctx, namespace, name)
+	return err
+}
+
+// RestoreDatabaseCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) RestoreDatabaseCluster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "namespace", ctx.Param("namespace"), &namespace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RestoreDatabaseCluster(ctx, namespace, name)
+	return err
+}
+
 // GetUserPermissions converts echo context to params.
 func (w *ServerInterfaceWrapper) GetUserPermissions(ctx echo.Context) error {
 	var err error
@@ -7334,13 +8938,13 @@ func RegisterHandlers(router EchoRouter, si ServerInterface) {
 // Registers handlers, and prepends BaseURL to the paths, so that the paths
 // can be served under a prefix.
 func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
-
 	wrapper := ServerInterfaceWrapper{
 		Handler: si,
 	}
 
 	router.GET(baseURL+"/cluster-info", wrapper.GetKubernetesClusterInfo)
 	router.GET(baseURL+"/data-importers", wrapper.ListDataImporters)
+	router.POST(baseURL+"/database-clusters/bulk", wrapper.BulkDatabaseClusterAction)
 	router.GET(baseURL+"/load-balancer-configs", wrapper.ListLoadBalancerConfig)
 	router.POST(baseURL+"/load-balancer-configs", wrapper.CreateLoadBalancerConfig)
 	router.DELETE(baseURL+"/load-balancer-configs/:config-name", wrapper.DeleteLoadBalancerConfig)
@@ -7352,6 +8956,10 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/namespaces/:namespace/backup-storages/:name", wrapper.DeleteBackupStorage)
 	router.GET(baseURL+"/namespaces/:namespace/backup-storages/:name", wrapper.GetBackupStorage)
 	router.PATCH(baseURL+"/namespaces/:namespace/backup-storages/:name", wrapper.UpdateBackupStorage)
+	router.GET(baseURL+"/namespaces/:namespace/change-requests", wrapper.ListChangeRequests)
+	router.GET(baseURL+"/namespaces/:namespace/change-requests/:name", wrapper.GetChangeRequest)
+	router.POST(baseURL+"/namespaces/:namespace/change-requests/:name/approve", wrapper.ApproveChangeRequest)
+	router.POST(baseURL+"/namespaces/:namespace/change-requests/:name/reject", wrapper.RejectChangeRequest)
 	router.POST(baseURL+"/namespaces/:namespace/database-cluster-backups", wrapper.CreateDatabaseClusterBackup)
 	router.DELETE(baseURL+"/namespaces/:namespace/database-cluster-backups/:name", wrapper.DeleteDatabaseClusterBackup)
 	router.GET(baseURL+"/namespaces/:namespace/database-cluster-backups/:name", wrapper.GetDatabaseClusterBackup)
@@ -7367,11 +8975,22 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/namespaces/:namespace/database-clusters/:dbName/secret", wrapper.CreateDatabaseClusterSecret)
 	router.DELETE(baseURL+"/namespaces/:namespace/database-clusters/:name", wrapper.DeleteDatabaseCluster)
 	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name", wrapper.GetDatabaseCluster)
+	router.PATCH(baseURL+"/namespaces/:namespace/database-clusters/:name", wrapper.PatchDatabaseCluster)
 	router.PUT(baseURL+"/namespaces/:namespace/database-clusters/:name", wrapper.UpdateDatabaseCluster)
 	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name/components", wrapper.GetDatabaseClusterComponents)
 	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name/components/:component_name/logs", wrapper.GetDatabaseClusterComponentLogs)
 	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name/credentials", wrapper.GetDatabaseClusterCredentials)
+	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name/deletion-protection", wrapper.GetDatabaseClusterDeletionProtection)
+	router.PUT(baseURL+"/namespaces/:namespace/database-clusters/:name/deletion-protection", wrapper.UpdateDatabaseClusterDeletionProtection)
+	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name/diagnostics", wrapper.GetDatabaseClusterDiagnostics)
+	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name/events", wrapper.GetDatabaseClusterEvents)
+	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name/logs", wrapper.GetDatabaseClusterLogs)
 	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name/pitr", wrapper.GetDatabaseClusterPitr)
+	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name/schedules", wrapper.ListDatabaseClusterSchedules)
+	router.POST(baseURL+"/namespaces/:namespace/database-clusters/:name/schedules", wrapper.CreateDatabaseClusterSchedule)
+	router.DELETE(baseURL+"/namespaces/:namespace/database-clusters/:name/schedules/:schedule", wrapper.DeleteDatabaseClusterSchedule)
+	router.GET(baseURL+"/namespaces/:namespace/database-clusters/:name/schedules/:schedule", wrapper.GetDatabaseClusterSchedule)
+	router.PUT(baseURL+"/namespaces/:namespace/database-clusters/:name/schedules/:schedule", wrapper.UpdateDatabaseClusterSchedule)
 	router.GET(baseURL+"/namespaces/:namespace/database-engines", wrapper.ListDatabaseEngines)
 	router.GET(baseURL+"/namespaces/:namespace/database-engines/upgrade-plan", wrapper.GetUpgradePlan)
 	router.POST(baseURL+"/namespaces/:namespace/database-engines/upgrade-plan/approval", wrapper.ApproveUpgradePlan)
@@ -7387,6 +9006,13 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/namespaces/:namespace/monitoring-instances/:name", wrapper.DeleteMonitoringInstance)
 	router.GET(baseURL+"/namespaces/:namespace/monitoring-instances/:name", wrapper.GetMonitoringInstance)
 	router.PATCH(baseURL+"/namespaces/:namespace/monitoring-instances/:name", wrapper.UpdateMonitoringInstance)
+	router.GET(baseURL+"/namespaces/:namespace/operations", wrapper.ListOperations)
+	router.GET(baseURL+"/namespaces/:namespace/operations/:name", wrapper.GetOperation)
+	router.GET(baseURL+"/namespaces/:namespace/quota", wrapper.GetNamespaceQuota)
+	router.GET(baseURL+"/namespaces/:namespace/trash/database-clusters", wrapper.ListDeletedDatabaseClusters)
+	router.DELETE(baseURL+"/namespaces/:namespace/trash/database-clusters/:name", wrapper.PurgeDatabaseCluster)
+	router.GET(baseURL+"/namespaces/:namespace/trash/database-clusters/:name", wrapper.GetDeletedDatabaseCluster)
+	router.POST(baseURL+"/namespaces/:namespace/trash/database-clusters/:name/restore", wrapper.RestoreDatabaseCluster)
 	router.GET(baseURL+"/permissions", wrapper.GetUserPermissions)
 	router.GET(baseURL+"/pod-scheduling-policies", wrapper.ListPodSchedulingPolicy)
 	router.POST(baseURL+"/pod-scheduling-policies", wrapper.CreatePodSchedulingPolicy)
@@ -7398,420 +9024,552 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/session", wrapper.CreateSession)
 	router.GET(baseURL+"/settings", wrapper.GetSettings)
 	router.GET(baseURL+"/version", wrapper.VersionInfo)
-
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
-
-	"H4sIAAAAAAAC/+y9C3cbubEu+ldwmL2W7QlJyZ5JTqKz9sqVJc9EGT90JTlzT4beMdgNkoi6gU4DLZkz",
-	"8X+/CwWgn2iyqYcte+qsszMWGw2gC4VCffXCr6NIppkUTGg1Ovh1pKIVSyn88zmNLovsXMucLpn5gcYx",
-	"11wKmpzmMmO55kyNDhY0UWw8ipmKcp6Z56MD9y5R9mXCxULmKYWH41FWe/vXEU0Sec3i1zRlKqOR/TFm",
-	"Wc4iqlk8OtB50en/JVeayAUR5VvE9UO0JIViRK+4IvPGNEbjEdcshQH0OmOjg5HSORfL0cex/4HmOV2b",
-	"v+dFdMm0mVWweWM6gecLmUfslOrVuV4nzH7SghaJLgnmXplLmTAqzDuib7DyK7tPx6MPk6WcmB8n6pJn",
-	"E5nZJZpkkgvNcku/j+NRzpbByQ7vwb7364iJIh0d/DxS347GI/pLkbPRu3F31kWeBL/miuV8sb54ed6g",
-	"il3lNlFg3v8ueG4Y4WdLocbauFeq8eX8XyzSZpwG/yrDMWbAkgP+K2eL0cHod3vVBthz3L/XZP0Adxzl",
-	"jGrWaHZKc2p7vvk+yUwfTLNcdbdJFDGlfmTrIE2/iE3UHP1ixUiUyCIuv9623ouk0JQLlhNRW+FPtfma",
-	"kzw0ZMhJzBZcMDNTMwTMyxBOr1hNxMGfx6/P7WMr8MhK60wd7O1dFnOWC6aZmnK5F8tIme+MWKbVnrxi",
-	"+RVn13vXMr/kYjm55no1sYys9mB19n4XCzVJ6JwlE/hhNB6xDzTNEqD3tZrE7CpEqtvvesWinOk+xnuY",
-	"MqHaLPX5b5AVx1TTkzSTuf6bnHfZoPGYcGVXHoSFWWj4M6aacmjzLzlX5PD0ZNrdxBn/O8uVW5EWq52e",
-	"uGeO3ewoV/Y3w3x2POA7rkhudrdiQsOxan6mgtgvms7EOcvNm0StZJHEJJLiiuWa5CySS8F/KbtTZqub",
-	"cRKqmdIE1l7QhFzRpGBjQkU8Eyldk5yZnkkhal1AGzWdiVcyt4f8QcnwS66nl38Cbo9kmhaC6zVs7ZzP",
-	"Cy1ztRezK5bsKb6c0Dxacc0iXeRsj2Z8AtMV5rvUNI1/lzMlizwCru+wziUXcZeaP3IRm4Wifs/CXCui",
-	"mZ/MZ5+9OL8gvn9LWEvDqqmqkdNQgosFy23TRS5T6IaJGPYN/BElnAlNVDFPuTYL9e+CKW0oPZ2JIyqE",
-	"1GTOSJHFRjZPZ+JEkCOasuSIKnb/1DQUVBNDtiA9U6ap4eXaPq32icpYZB402TqSYsGX3UU4gt8b7Gyb",
-	"Frll2vreIXbzkH/J+XQmLlZMMWKFkiI0Z8QMzRc88gxb7UmWkzkzC1ooFhuOJWmhNAwl85RoORO1/epl",
-	"uRfYtW4eKTI1w0ztLKcyY8Jsy2/P4dXpqC05jBStJPsEGCa/YpNCXAp5LSYLzpJYlaI0ro0VPhSPWy28",
-	"rKkRyPCePZ099ezv09BiWr7ujnNu+d1LMvuXO9FgLC1r3TZXO6N61e3RHLe+P9PCL1PMcxZpma+rLqtR",
-	"zP6BxeZ2a80ZoeXblCx4wojMCa16GZOYZUzEZrml6NImTIVvAxT4ljhFw875/Ns6Sglx5rRfJzsJSKDD",
-	"8uGxVauUY+G1lz3n3xLbA7lka3JyTLhIuDAS4EQbUma5vOKxYWkjx65zrtlEisRIoKzQBJgLJmo3OGci",
-	"Mi//tGLCiSdowRVRTI9NF2y+kvLSdqVsGysX3WY4h7PSbzUWk/mavI9yFhvxQRNlnxvGfD8TZqOxNDPE",
-	"qA3nl7Mc20g7oyRVW84djZ1lskd4l5LP4XfPXHXl6/xbpzQG+wtOPCClWs3q+y5nC5Ybunp2ttqEZ53a",
-	"StYGs+LLE9PLItMeGl+ytSLvD386/+fh0dGL8/N//vji//7z5Pg9SC74/fzF0dmLi9rj98Hv84fO27OX",
-	"3a96UT2Ec1BUZ5T5SS5aen1whO2KdHPQ7xvtHed5cWX29UTBg7dnLw2VThakECWzje2GswN4vlQEBqrN",
-	"rqazV8ptcxpn8Hu1hkunIG1nGbu8h3Ws1RIbzQb9O9sxSm2D/8Z39yYVv0njv/uWNQZiQhU5Ixcvz/fO",
-	"z18S6IxHIKuHMpIZKsRHLTwRlhpd0PAxACM0zZdMHyWF6j3hL9pNekWN7YxEtmmApq2Jd7SL8vgPTSyE",
-	"gpSmulAh/c4ATc3iQx1S8sqH/lM0Txm5tozaUe5I2RtRBeyORZEka/N99vgdHZhPYRPTS4iR/iXnYdL+",
-	"zT7oJagZXK8oTDMvRCm9W2d8Z8CEKv1mDppd/AMTzCqv3fFfBtv56ZheiHSPybJ6Xle7ajpwnR5c6D9+",
-	"V03NgLQly622rpQzzzYn88o+8KO7dhsG68pCTfOeNT/3j4atuOtp+BIbRmTBYXX5RVGR5wCz4MfB3/Vx",
-	"0EZuAH5vOtxgEwBTmj1mHXwERmtomIkztwFe/MAVYNDWhNXnsxmQOzQZkC0WA/I5DQal+XKQKbhpGQrY",
-	"OD+B/YHclfmBdK0PpGF8IA/W9rB5l7J8M5YutwclOSsUnSfMLAzVbLkGJctuwWpHCgCgpos5VeyoOoPR",
-	"oIcGva/QoNe/dc4zFjUY2BviKjZtGNG6m8RpsKcsT7kyvK8CWmSnTdNsaB9PrnnMSFZr5BVgg2W6xiBv",
-	"R6y/QXNmDYVaei2MEUrcBM5kwkLGH5Z7faI8NVr2L5nwaH1WJIysZBKrhjUJlAHbfg5CKIPWJC8SNibz",
-	"QpNYMgumvKWg9vpM0LksNLle2Z1t3iI0yxLAZpLInFyveLSqHHmhZkHh9UMui0wFZZd9FLK6+IcBHafc",
-	"2FNCThYkLRLNswReIUvbYc2Wa6AaFWtCI6CS21cGEi9Nj5pIYQa15lsmihQWK65GMZjTdFB2T655koAZ",
-	"0Toyp2Q2mo1qW98ZofPalEBhmY2+abajSVKb9XS427NlEzZa38Q30DLlEXgbpThzH/H27GVgAV43GzjJ",
-	"x0CBzGhu4Ckp8kTZNaDWTenOhhW9Yt7wYA598o2luqOJZTgwNVBLDwPAxmTBzTGhNMs8lM+oXs3EOTcY",
-	"SkgxKcUqTMl0aTi25DoA3nXjgB3DcGAEJ67ZV7V9piqIFlvJ29iGzzmYeaczYXaVIhEVhHG9Yjn0CQZl",
-	"s0IVNzxWRbQyHzUbZTJWs5HZGjNn1FGz0RPzd/tD4Csb7xoZOxs9GRMgFAh3qVd3zQJ+DuCzD9mwao89",
-	"tHA+WrPddQUoYAEsI4T2PSGHAkw5a2CglFHhWrMrlq/1yhydvPT939d3bvhGx97+e6oFtXpR+3seffOo",
-	"vVMruXPHs79i+Tww87+bn5uztj/Z7Viy58uXVilx0zNKjPIS05vM3CcGvwuGv9tvalmN7AeGrEFtoLPF",
-	"y1eeA1X4S8vb5z1vweO1ezy1vG/dgd80G/ijyv1Mrr5taNiB8XZw3oXgR9xEB0dSGEjBXSRdV6MKty31",
-	"HAM+qeZznnC99opNallBxCTLGfymnHWXOtfCnBFFNVfmOJ2J+boLW8icLcyJB6zW1GmMTJ07feia6xXh",
-	"ekrAwusM2yHn40ywD4ZaqvLJNmcL2op/00ykxQiCsdjxQWUC9FYQwwLQTI1nwgvlUs0re7SrM66mwMSS",
-	"i9ZIamwkvoQzo3yz4jJvTu9SrDyYVIBq1r5s52l0L6NyXNGEG+2/9CnXepsJr89o0Eaj2uK7pclyGTEG",
-	"Xk1YhsqtW9Gju0M8Vb53nNqVr/XntR1aCi1LxRY3MV13jtfJAs7xmXhBo5V1aZi+/nb+5rV12jq2ADUb",
-	"ugQIpbwzF7SCjR1/L3PiwprGZDayzni7sFOz/fyJbh+YRbGO7Gll+/a+eyVTBt89G+0gP8P7vBlu1trY",
-	"1V+ls772U5/o6ca0cZUldN0TFlA9tDRfFSk1agyNQbHyEWcDx/qXnJ8Hcd/f7IMyLqCN9HpBUcdfkNIQ",
-	"iD+yD0oTrvtTS5IXPc784cGGPA0awk/Smhkc2gxdlBAvZJtAbB96vRfAikgVkSoiVUSqiFQRqSJSbWgC",
-	"qsjgJIxfgOoYoMp5q0XppHckYu7nklWbB6wbQG04ZW3HF+uMEaWpIaY/q8vZVZDEDTclZ3y5Mhv5mnD9",
-	"yIml7ENkw3EylcbzKfmrvDbbYUy49vgtU2OSLeF4MIeMBTx2IYMK4HadtwoF2dEPt81Zblvc1lfOcvSU",
-	"P1xPuQ1NQUf5g3KU1+D2VvOUF4fn3RQX08p54zDJBX3ivy2feG2LdNziMVOA68t4tO3BI0aNfSsUXbCj",
-	"utUysG16WjoA460DLki2VFoAahkVIYJ01ZZtlBRiwTVs7iyXcWGhbQGrMxPHZfLoAekdHjCsW+lKrXGY",
-	"bFGYxSE5SxhVVt/thnDbIPRAzL8NTndyyIeq1+1RHXIyYaBbHFLF4IHdKYuELi2tAOjZnlX9e6fkFGYM",
-	"Qevx3NoabbupkSexwXg/v5u68UxnwKQyIYxGK+LbEMUymlPNDLQUcburjOs81MfpycVZmFbmjYA55+Ti",
-	"rDKo1VfH6U92z5pF4SkD4WjAVId883oyc9gM+bzdJGRzaTQi1yuWWyOPn6f7ZJsj0WzsLdCWXUtGUqb3",
-	"ymLkTAGB7RXIkLgBS5iJBulfZImk8Yk5PK5och4SEm/bTYgo0jnLDXEUi6TBAXOmr5mLlJ1zkcilIrZr",
-	"FQjxbYEg/0XB8G3PnAG84x81kaDfV+WLvXDGLZRn7da+9D83+G/6iVjs6MxbLUthPBM+LTuRZZLAQ+U3",
-	"n5toKDganpreR5xuV/VscG3PyCOZ8bCdo9Gg7L9kYrfikX2sJcmZQW2tYPVvnwWD1cup9fJnKchyKTZ8",
-	"SWtTdPmqWoqxTxAve9tuQehz9p73ZFMel89qcaaAVp3KZc7YuZRa6ZxmRiujRLBrH9XWt096Rntee9re",
-	"iE7DM8sCWTmgvH2ifQhaCPh0tQRt1J2tn2br7ZaV6ui14AnbK3NLpzdiNBj4XQ/HWDy8yR7iHe2tAGRr",
-	"ZBaEfXBQpbHCIZcbpmBjCvbDSMGeiTfgTpkrmRSa2T6s76Lm3JmSl4xCJ+ACzilPzB+P9h5BK+9B6NK0",
-	"teIu8sJ6ZH/+tcqIAiqVgoaK1oRkXiMMEHQ8yuFwGimWLKYp1dGKqceP/mfvL49//p+9d79/vAf/efLN",
-	"k72//NejJ6OP7zC3HHPLMbf8Brnlg/dwbR7VVrbRVmasas9y9fbs5WOzc93GxNx1zF3/reWuOynXJ56a",
-	"27rkwWBue73bHhV3cP75uy1KW//23xDoZ8jC07TQBuc1z27y3/9NZBKfs2RhZUE8d9DBgpAexe95p1Ho",
-	"XDh+7nGbl3JduNVFJ1tNd7AsEy4mDStdU1nvKAlxME36uJYl/fbiiNi4VOk7Bf+WOUTM/s60BW0p1Qdk",
-	"Nnq2v//Hyf7Tyf6zi6d/ONj/7mD/D/+wAZS9ld/K7WBn094Q4AH3WeLm3xA2Yb9uChxpC8e5l62HJlA7",
-	"bljetnWk93nj66p8ze++xa68BVq5PkPhx2HFodc5dnTmfWO86VJw7jHPgUdn/ljyscIzUYiY5QkIcR+Y",
-	"HJAt7IoZTp00Y5dtpUcHvv1YDnrXOpuJ128uXhyQt5nZq3Ba2KPA0GpNMgmeNaVpklg918CJhNHYIgkz",
-	"MM1Lr360AcvnDAKxgvYp+6RrmHL0L18NGKRSLnhquO1pyDg1KPqHOmN26UxLOLi/zFkHxv/mNOwSwDlj",
-	"zrn2Wz4uzWAABbaqFudlBUABsX6zAMHYmXUnyuZde/8dnb71xDL/LKdQj9i3VgzNcvPC/zyezX7/n8mT",
-	"vzx+/PP+5M/vfv94NpvCv7558pcn/yn/+v2TJ48f//zjqx8uTl+840/+87Mo0kv7138e/8xevBvez5Mn",
-	"f/mv9plgpKHMJ+67PHxPWSrz9a2J8gq6qWpjwF9fNGnCMTxl6eZ2HQ1Xs7QhurzJfPOREyVUBfN3qSp3",
-	"ZdkT/NgylWRGwCjNhCZXMilSaMaDp6biv7Bbr/U5/6Wysph/e7dY7zy+lAWvK19Aqn7L9q8bTmW3/NCw",
-	"Oo+zD5EhhVR6mTP178T8odJ43j2ad1TmaukcJCrjBKIVFUsQgZv0uEKx3OqzKqzDvW02CPpHgijbRiXb",
-	"N3sQQPjQbh3Zjpi++TaDclVUubc0re3xe0Z1kbPeQEP/vB6W2fEG1zLzFr59O7bHfUHA5gir39Vhz18d",
-	"P28Eg24YxDbuG0FlCdd/lTn/RYpjoax+FV7n83rT1+dV0/aKUxJsSo7OvCUl+PiO3RPDlNdUCm5dJ4Fy",
-	"TuWz8tSqftkssauGmyj6KtCqS8x2XxUd2+/fvYdnkILmHR1NVcsFvHg2rL4iVKyC8jR8wPFUgee8Iopq",
-	"BIGP644NkHX+kX15PBM26Non9EAKEK/CrK2WXTNSWEO7cmb2mTheC5ryyH/uYeLd236rkSXVrN1LHShP",
-	"yYmNGgZzjcv2c5YaO4dNQc1n9e+pJ0lKYQSHNjqVIKcyPjdf32gdiNfd4NcG5gELfIMBG8NkMp4GqFym",
-	"4ZzKuAw/qdPCkB7IkNJLH+Jdsgu9ojwxhJoJLhSPGaG15QmzJUS+hbMvmWralqOVVMx6AKiPmfM7o5Zi",
-	"AkxowQOkQ4zrCRBlPB60IuC3iWszH9v472uu2EzAMjvEUiS6FlgJY293eYq+YoFbo/lTmk0u2VrVe+mN",
-	"+U9pBqX2ABj1X6Kwsy74heCa9sUMAA+rNDwQWvSDQa+EprIQsJCRTLNC11LZStdaMLxy0xUEjRNkL6WC",
-	"LlmZe6QmlXDYGwVYwQd//tbX7cwHwbZWztodNq6c33J205cdcUVkyrUz0tVlEaR/ONsbYCzHNHxR1rhk",
-	"H7KER1wn61oa40yU0gHsMoLwNEsA7MLiT/wZBrbnaTUVp6uzDxFjsRvt0zLaMC0qo0bAh7zjcOY2IrCU",
-	"llndGhUOu5SxC0/iYmmTZ8Mq1Gm4YQiEBJp24thyiNczy14zOWcyttvcnfs0yqVSWy1qWS4/BDxCp+bn",
-	"0gINfzRsoVNSN19RQWhmjvCcU81mIvBCldUKWXBVrY8lv2LCa/7kcCYimaY23JhE1JkHFNOVYbE8r2ux",
-	"saAElSExZeJoq9ZEX7z1MEOu/aqtdlz2IZMqZGmG35ud2bZb1HTuQrrODBAO6F4np/Xn7YS1k1MfQpLb",
-	"54+PTo7PzNrBaE9mUNDQHA+ebBD40VhfDcoSOMbqanO/OtiYUh0DnpwaGJgzpWzmc2MukAXO9UoWGuLg",
-	"dErV5YA0tfEokTR+ThMqIqig0otlXgbbtfeh6Y3MXTO3OEZ8OtYd5vNwgOXkdKPjwzGAeX3sc/bKN8ek",
-	"Pt8xeS1jdipzbZ00kMhQZayAa7PcADkj1SVPdW+Kbw/XBJX/rE+2PuZoPPKDDvG87GjwgT0wtSSYhpew",
-	"bghKGM1ZTMyBYdiyFZUDZqP//m/yyH/hI/Kf/5D/taLqsbMU9QzxxLTb3AT6hf4em/7Ups5mxf7+sz/a",
-	"/yUbWpL/Zfp0IQk38WtYCfK53RqNWaBXA70an8+rsd2gbZm1Zc9OpVhK8+ErapUhpxQ50/ZyLgsQhe8G",
-	"lYFRK5rHQUPduXtSuhz8383cCGsKhaCZHj3FZuP1aSv2abtcSHgwomxjp16pQB2poXKpDmGqaewsllo2",
-	"hnL8sP17S06F15f5okmDKtcoqNZDO9WzgM36PbUcHvvS7T63sb71TAXX+9ZIGxflsPkKh83Zi9Cs8ZHl",
-	"1QQ7JDBGml+x8z4342H9cds3aMGYKIHNY/AvgFnySTBuQgprWFDBLeGeNeNuy0+qXi6jeLrf1qPklp1X",
-	"fcdMU57Y41EKRqjRc6rIhu7FBBxSpcviGl1KJlTpi5wKBSNd8JBW223TuFoC4oZcfL+bsC5b+7I1Evy8",
-	"sPYA/sEW4APjXBr1vHaTQy2spOrW+eps4SRvbDAnPkTcA44wwM671pp3Qxg6WGjnugFEDpFIYJ8efEdE",
-	"780XaXXzhSuURspCaeUzEQNiFctyMauqhRXZ2oHxZXUa7Z0HKf3wkomlXo0Ovn32v//4p8BE5YCrQ7pt",
-	"2qJ96lOWp7WrQ8pM32pxrqmNOzTMHZMig4/4XuY2NEdEbGwEZbA3rjzvJmvy9JmtvgRjW5aZVtvo5w/v",
-	"pjJ41cmfx60JcUUMYeUC4tBmAmKWcma3jMPuwbs8/ISDN6GU4nY/rPRSFSKz/b1eCDHL5TKnaUo1jwiH",
-	"mMkFZ3mdQaxiDC96a0b5dY+U23x1ljmFbGqWg7Apc2Zq2xIgneEpK38NPGSRLmsN2PwZRoU5rN2Y3iAy",
-	"ttGt1ysGNV6geIJ7KbeVJXnMDH6iZFnQnArNWAxxrdZNZyNKq51Oq6R8z9UN35GZpUNmwPotnn+6/+w7",
-	"WIzyh4Zm+fPh5B908su7x+4f+5M//3N88O6b2p/vrCoYvAImdJA56eVlrSfq2FVgIxd5wcbke4jwJm9t",
-	"ElAdGZvno/EIGozGI9cieFltWNP0QYw1Dq9VNiCw08hCyqkrZDmNZLpXVT5oyYynf2yq4j9bsrx7/PPE",
-	"/esb/9OTv4AKvanBk2/2QP0uyfvu50lF6qlRxGvPnvzXVu9P4FyqJG+5z8rV2hDG0KkmvEMcZHmOdwMh",
-	"q8q1reOqDFwMFtusX+qyLQ3MXysF/jnVzX37W+1aKV+JwWVZVXeJ1A20boO5AHHw0MHxuCXYWfXE/bsD",
-	"LFSa1J5sLlpfQfU80txARaZ0zmha6mEQ0Z8lkFDCPuie5JBdQlKcrrklRMRO61MFpHRGGx6ZsjkYpUbe",
-	"xs9u5G7XsUzNUXTrXnu010Z4CwxVKv2Nnuw0/DiP3Z+TWbG//y0jJ6fmvMoyLpZP+j4hwH+2E19LKDCc",
-	"oCnr8VfwK6rZyWnIZ+EeVXAffqgZnSsegmHCIxTzhEfBAdyTsn/4e6fuPw4QgCupgrfpCcGgEotLrnKn",
-	"nPsR8qusah2gp7ph6FFoumZ64QCNv7onfna+Za3WhxcmztSdMxqtwhb1IffXsQ86p40MykpX7zjudtO7",
-	"+6/rS6WCSlJGLa1f1uePolItCyDJAff2hdPCT52otwkhuR5C0gF1Fwz8WYeMOzRedy3O0BocjUN7j2Sa",
-	"MhGzuDy5Q4N1W3kt21kg3IWX/pCvyhhVp/rRWU13dbWlbMmpvtwyXtURBYWhdvcjFQaZ2D7KtAcRe0sp",
-	"JDbaMZzyvJBJIq/NqzkzfBa51HgoolkIzZPaKNXsHIIov98PdjATE/DxlOkYUa1u1jKnMYt9k3bKip/v",
-	"40ZQrfv1Sa2jVMbcXg3QjAgrhFE+Slhu50wTu/glhXS9bFrgE6abwrb747C11DSpOzkGM1sfLHBKRmlk",
-	"aoCEPhkx/C7I2gZ/3lOxKthsWCE9VygDy+lhOb3fajk9Vx1m16J69rXpp65w80kr25TJq1vSVuvfIHO+",
-	"hCLp7aiYPpV7QKGb5jxu4Xzw9NrdBdG33OWV0huupw5fVfwvOQeTadnDcAO0W+Bg0QfaGlBpmmYdzG2p",
-	"/EhZXnHH6bDBY6Y0F7T3ThL/0E8CoH+3AlKQ4ZY0dNHCDzRTlYXUu9tyBoZH8wqJmbZmTMfykN6cyKUK",
-	"+t+4eKsGlGU4Mc3qUXtgaSn1Rl6ebDYBuxTLXNUrEtVStGvBdCCKO4SozdEecGfwJp0nLOyYeRloVblm",
-	"4Ih0zhmqGzcuGVECRHJzu9P7sf3Wee5LcRg9duvGh7V/d3O9qL/8d7DpjeuAN2SaF8dYEfzhVQTvas5Y",
-	"GvwBlwY/8qt45COxwdQazNsJpMI4O0Oo3A1k/9dvFGiiutwdpRscQQOMbH1fEzjPKn4lOUuov4+hbkrt",
-	"hOW42PSbboAAcQObYTB5G6lxd03dyv01JBx0KSGRZ2Ln3rsMoc9tty0r13SXrIoWI+XYnTXy5tMcOnBO",
-	"uNFBmcp8sLdXKJYf2GTf/+fp/v609n8Hf/iubnmo15dU6lrmcbPTXEodam1G8Ou4rfUAPh50qt7ZeYoH",
-	"6QM/SPEIfchH6Gmw1lNPfafW0dOqaE/zhDOlj522X0mSZ/vPvp08fTb59unFs28P/vDngz/8+R+D0UMY",
-	"37UwlUd2Gdc5gLgWxqML7dfflcEyMFrTSyY2QKlm/a3OzGyjO/3cAQt25tDXNgHr2g2z6TpIh0ZdNOr+",
-	"Zo26bsPsbNV1701D9e5uV4Td7srN1xPcVdl1wy0ratMhFdP+RsiajxJSOztFB6dYr/3z1Gv/lEUiBzFH",
-	"neWm91dW0kgauq5d/e0jpsykQx/cmppplrEcKr3XzZlTrFe5TXXcybdTF6EuViLo3rG4TzAWw6EO0SKu",
-	"zm3Y4t2ze2rS9g69P/5QuIH7p/dcaPh/hinBX4L7oRYcNdQFUKNuIyO7JGnrBLyLiAg35iAjRa3t3dj+",
-	"vZ6NNouHbbPwIAtNFw/RdPGip25y8/kW5OsvTUbEi4j3t4Z47QYBpGtJb/5lSzZtTWRwVbvcFmhK2K01",
-	"Uawb40eosxa+8ME8a56ssMl4/Y7IK5pzWSh3zYItimer6NnCPcfPnQQobz73SSz1qOxIK5LwS0Y8IUsR",
-	"8cIWHidvT8ymWxY8ZmXZVTUTXBhoB/f/lIHdMjcwz1/8ABebuN54vsFTYXoM14X1lV+hq7IGo60C5YKs",
-	"fS6oD9RRm8shlvStWRwUF8uE1aYdQEH1TgKxO/6vWgbrpMxgrbUurwVpjNXhmN2uD9zY2ccbXZ0XzqN7",
-	"wBfkt3BQb07bNsTjhMIuSOdFn4zw1R3rUiJYidjo+nnRkOJVbUh/piqXj9uIKa+UuD4T1KYCf92wO+ir",
-	"kjx1UVG75yw4g+lMeIqQF61nfk1bL4+rH2wBEMNNUiaK8JQurTkpgBxzrnlknc2BGDXz5l+pWgVFMTw9",
-	"dXaswQmsJWW6eXHNsPV+4gzbmD3Dqlc0s5Ilpdl2NthwxQZywm+bE8qigr01P5FBftMM0v3BEBk5Bjlm",
-	"IMeERvbJcm9thlwgp7PZoAl9mlQoE+9cul13Cd2FRqcJFWdsESiB2XhuP71zsWOtkYfY3o/mdd7OTGZi",
-	"Jn5iJJZQfqOeegc1WK/KOqn1zq1rLFlX6PzHKmTOFwGxpQfmLKL24qdWHwbn00RJPxOnLPsJKu/6q3n9",
-	"ROwAo9k8K3rFSCG40Ha6kRSK/buAOvF+/Dlb0Ssui9xXDqJkXrjK5g4q2uozVJDC7GxdCKMoV1VJzQq+",
-	"eflqCkRSxXJp0GVVc8jjzcPTkz2LOVdUxEmXzmpMrlc8WtnCtd6LRYliOWdqJuSCRCsWXdqiLIouWLIu",
-	"KUOTZANdNhW89y6ojvvpdQ2KOT7SnYsL2WLBoLZWsi4LR1t6xQUwndHWr6GMmdlvVPM5T7heE65mwlkb",
-	"nGfIFnWxDGAr+TsbG/i+oLBGWfXI2pF8ZJDpCZKkI5ab/ZWsyXUuoX7MjjWh5RXLrzi73ruW+SUXy4kZ",
-	"dmI3itoDeu79Dv4z2rk4aUozX2KeapnyaJtfJVvRUFlfJ0xOzdN2aSZ4ZZNICYlvg28P9XB/lXX49ZpQ",
-	"L+qPPa4vM6mlY/LGBOuJ1DbZeKDs9z3UJtMlo70guiWLm7atHcR2OPkfxTeKbxTfvznx/YBEYcca36OX",
-	"V5bAsFfeacdcEEou/6Q21PLfzUNvx93sma/a3M4j72206Ih/mI545+NFB/xDcsC/yHMZ8FfBz1Al0xy/",
-	"3SvdehXY0BiVEuEvABALuTG7ygfXGCoGbkiDhxfh9LDy2lG4EfQ1iH0Yyl9TEL43/aUTLc2rQ+0da+Wl",
-	"aZUbwx3WVUU9uOjApQv8PFpmz0bj0TL7dvRuvIsvtTZzNnyDnddeC3rEGsWfa9QL0erdkAU86y/qH1jF",
-	"uizp8SoFsh2z4hVPEl6nnC3YU0/4Gx2MClvk6uN4FHN1ee5q/wx7w9aof77WbPAwQ9IPS/Iclt/3cTyK",
-	"aEYjrtdf6bce+c/rcJx/MK6td4jNutemDLlapScoyDQkviVxTTEyCCODfiuRQd2dsj0PpvtOYLsIf5HS",
-	"Ro9JqGJMfWNVvZBLtp5YTsgotxVubUk7auBA1c5vivq9SaNBcKQOixx2/tVHYEPYdfcKxC71hoRRDCHg",
-	"HUZ+s/LaKFXe6ErFuhbk3VdXRuk3A8pTvgy227lEZZgqW6tUDoOa3c7DcDPc7kaQM3RzF+LOh4Y7A+oE",
-	"Ys+HhD2rK7u9/dBFo7orqDYtbvfd51Sxn7heQX5Q4HKq2gXm7o26VX8UCLkbj4o8GbkAxnfBCT8POmu2",
-	"jxUMwH3tTb87IdbSYFxev2t2eWmbT7tzGe2CSX3wpM87y9K0m21WxwXqkmcTmVmdYAJ8zfLyqrHClkVp",
-	"3thw086uWM4X64uX58FgRPvIl7nXkjChipyRi5fne+fnLwm87S8aDRyTw1i2wXa3ZF+4ZW3IHeWHEBpc",
-	"XvfuZFQ9jNbbMZyh4vj1uX1smfDu7OqxUJOEzlky8Rb2WsWbNJ3UeO5u1rxk9y73Du2ku7A3kBYDWMPW",
-	"YTylOU3V3Um28a6vn756NfALrVfxDsSiGbJj5TCSo/MjzfiPbN2stkEzfsnWd8Yx4cpJ5a+3kGUu1L82",
-	"8zjl4sY9DjG3nL561SW3AXZD5dVbUBzuhinvlRmthtNgxuAHKa/uD1IKAzpH4NArT+JO31vPyzcnx0d9",
-	"xivvVjZt/K0o+ZZrma06eBLQUaEXuCzY3X3tmh4HVWelCpa/PXvZ0085G7u3u0b1SGYhcy+87B4OVys6",
-	"Nmn3jfV5lmOGTIWB+8sH3YfeYyw8lTGpmhLXFq2FaC38rVgLA3tlu7kw8FJgwywg2W/dJxQPG8/tgjev",
-	"j/W71PdUXiRLYubivIgUbhEhjsF8dODCJleB8d9J6Pvh2fn/W156U44WnkzthcraFjACsZ7M5mZG85bB",
-	"jp/7QPFMxoFBhIyZp2NfSt+cKWLa1chYSby8SGqXUWUyDlAP4olyFh8Xhs+qhT9ZCln+/OIDi4qwMfHC",
-	"wBL7FstdwBT0CTmQ7gF8oPnBTNW5XhXVXC3WNh+0nD37YDa3yzizlxxaA2jtskIIauIa9ny0klKxmaCW",
-	"CtDzFZcgNO3lfTlJzbYtrX1l/7b8S/UaVzMBxs+SJmXde8Gq2+CWoE4rI0ZS0+s148uVVmPCp0ZGlJeb",
-	"Vx2njGll48IcjKstUe3+bPLYy7uZcLJpXIZytdcnSLIxYTqaPhnPhFGSCs2MmC1SQz+uwZIL0jWXxdJ+",
-	"DEvc0P7wsuFkkNEYmy04E7OR/cLZyJ9IpkdnqIaPTKmOVkxVCbYqk3b/wpMX1fz+j2kzE+atx+pJRdMV",
-	"X648Sf2t8c2l2JAve+hD0ap1qxFYszwtZwhrYKGuHZynRtHi2q0i2Z+Jx2YdbR6oYaqJzJ5MySERRcnZ",
-	"m0YQshzAdaRs4GTZV88WZCIKmgSAwoolUJwKxhoTqpSMOISKliRsEt5+Tnes9oKERvTG8ebIDUadr+Ep",
-	"3FM6Z8mmbObD/n6cGlB+W8NMb1WYMaHkkq3HLse2jK0zUoNqV+fSct4lW0Mrp/t0Pv2SrcPSCz4BXi/v",
-	"hirnBIo4Aw0heBmYm07wivMyTdb0/cjVgzZEX3Eo3EXtRY2LSlv7O014XAseNVvhRIzJa6nNf1584MoI",
-	"mmPJ1Gup4c8p+UFb6rwM36poOw/uGlDbbXhMpYmpqb1/uRbHCLHARpDaeViJXd4Pa/rwddyEFBMfPNrt",
-	"xM4f6tPVvmBTf/19/aBNPy/1uHZZ7UzU3oaI4zJx3sm5RlzvnFmlOsvhIg9wSxLnpvLRtbZDq9QnNGIx",
-	"iUEOW/WVarbkEUlZbpO1otV0OFxqxaSaXdcOSm0BKms+KXlu632oA0YYW4nwvZH6txcGcHigMEBhgMLg",
-	"SxQGNwqbt5pGl6V+sgpVW1UBceMxflNnMaLh3O21C9BznJsjp2LJyNPJ0/396ZD7/FqUqulX5XTvRnb2",
-	"6eZDsZNj5VKTb4jVHvQDckBIgyo0oXom6pooT9nYYz3L186k4YFYTKS/N9qQezoTN5pDxKhiLlkkZXom",
-	"DJ6TqSsM6reFmQTzX08es+ly6nNRyjswn9j5qrXSLLUGLYPY3P3wOl+Di++KCV3QJFkTdsUjXX4imHm4",
-	"thA4DKDrHKVCotkuoVHxw2edUbkdVoR/wgK8OdsMSSxcMFAQkEm3xwBgsGM06C8XIA8tKDp8fQxGKdPq",
-	"QmYykct1/etsdo5BNO5tg/3m7lgxFHvdIgfCA9QIUCNAjQDhAQoDFAYoDO4DHtzyM7oa3LvdZxEKochk",
-	"PMS1YpTMfs+KVWkjOUlkBNXlDRuYVxr3GMiYjckvUjBrnTfMA7qyTaHPZPxYPXmCnhn0zNy9Z2ZFlV1g",
-	"K8r6HTW17WC22b34acyauiWBoIiK6nZeMbE2AxafNmfjFAebrBnHLCYZyyd2FSVZcKg00Z4IcZMP+Isb",
-	"nW+GhI39f1vnCygPXpoFtSnQLv5dsHxN4I6K8tgvAy2cUYQrEkHZMBkrC+LBYWVQ59g+btPQrz3MWUjz",
-	"XN0EALZbWMXM64H2C4KKYADeVqh2k07Y3+ctlEJXm+TWSqF5qbyU+R50w3K++b0pifDRDT1xF93Q6WI2",
-	"4eaL0RIHK2wz8eXDt5dghLlFWl+tl0YZvl/NzgIyf7RJfkZkOi26/sypQ7VuZiK1Jf0MAa5oYjazNQu6",
-	"c8903xY1RiOXym7UsuzNzBBuNhrbE6vOHLPRiTAPqDsfGvxQigmo9TyzbDwbbRNS2/JfBtUJK8kQrq/+",
-	"qvHcyzigiDmOSjEDapuVMO58t0c9T5KZmDN7a6IBKRLKLfHYpfLZb+zUK0+kvCwyTyUfQDcT3Ggs3pxr",
-	"7XmG2G4hXIqn/R36g/3izsb3jSPvvVF934PEFOQxvPjk/UxUX2GVOFkAc5V5eTUFpvxAsuH7rKZn63tV",
-	"U39kNfPHVGj+pDzTpwRoDAI7luKRtsN6jvUdzET18RUgtHq4JafL+nTRjIaxQdBYay3gAHdSLGQ+53HM",
-	"oGhQOdhcet9ItfCGbxv0m87EYaLkuN0wKiMXDZtcr5hovke4Ml+mmL5bATYepVxt5eZ2k6+SoYXUyNNB",
-	"nu4s/wa27rT9fJxdJiTtpK9bna+dwFeqg+D4qamClpLwq9kU8CD2WK4QtarDtd4sX7Wht72qwEFiBfp4",
-	"dZ9i7W1oPJ0J8E9V6qmI2x6r6hXQ7VNGhTlSvYnjkaqazEZmCX0UXtnp418/PmlE3lV9IvBA4IHAA4EH",
-	"Ao9PCTxEKxO9Tun6AeOMuzZHh2oeVW6+srxrrYbanZ1s9UOr51yrH36dI9ofa72HWHnMdV7ddr7dsXah",
-	"XfjGj2E/o/MiVPVDSxeDUfacmvfEfKfRjhoPheaTqkVpoAQl08deWSnVUqScx6I07Fe0M9zP8sYkuCqz",
-	"1KkieSGEy9axxv6ZsPvFKo5uoe0pBTOCo6oiQc0uTbXNl3MhM1I4JRmuV1rYrVbyAHwUL8efzsQLWPZ6",
-	"176UsK2hMOBWptrKhCRhX7jb9c7hbi079BjuK7uLcLeWfRtj3h5KzFsN7daD32bCRr+RWwW/zcRPBh5p",
-	"X4mZpEWieVb5s9W4LH2kfMiGavGkGY5GK4CGDe8BzZ0DXMHWsy41UOptTJzXcqzrkG9UrI+rW+1KI4Ai",
-	"j43ASdYOiDf2TUNSOdWZX5WF1O1dgqW8eqyePPEHU1uQzsRtJOnYyLXdJCFpCsKa5K0k4azY3/82qgke",
-	"+IFtl4ozYVnK+y5r1KykInqhEAwiGEQwiGAQwSB6odALhV4o9EKhFwq9UOiFQuCBwAOBBwIPBB7ohUIv",
-	"FHqhviAv1K1Tt1wGlNB8cBZUfU37UqHoleQxyQqty5tIv7Z0qAYZMCdqcE5UH90wMQoTo9AlhcgQkSEi",
-	"Q0SG6JJClxSa79ElhS4pdEmhSwpdUgg8EHgg8EDggcADXVLokkKXFCZGffWJUQ1HyefMjtp9IpgihSlS",
-	"mCKF/iiEhQgLERYiLER/FPqj0B+F/ij0R6E/Cv1R6I9C4IHAA4EHAg8EHuiPQn8U+qMedopUMGkqlx8C",
-	"nHBqfvanfOkziaRY8GVhgQHxuOD4ObHNs6Bh15BzSE4WkL0/H8uPlskYr5bCq6XuPoOqP2WqfSjfS85U",
-	"iWKqdau7PeuOFFgDe8WuHZynWcIjrt0qkv2ZeAzOOnDNGKaayOyJ0VTgDNo+QnWHL3EdmVGVrPrq2YJw",
-	"KfXWazBvm16Ft/riRZ54kSde5Im3+qIwQGGAwuD2t/r2Bfv9tHOwX/uC3zG5o2C/Sr/CAugPpQC6aAT1",
-	"ERvTNxO3CuoLAujmldEbCxmEzzoI2bNY0UbvmQV4c7bFD9EyanV6DACGgDnRxcClNbuitdJdOJNH/euI",
-	"4U9ANN4YSVQxd8eKodjrFjkQHqBGgBoBagQID1AYoDBAYXAf8OCWn9HV4N7tPou+kndDy91tqXRX+ti+",
-	"zip36Jn5cj0zWNsOa9thLhGG9GFIH4b0YUgf5hJhLhHmEmEuEeYSYS4R5hJhLhECDwQeCDwQeGAuEeYS",
-	"YS4R5hJhbTuMecOKdljRDivaoRcKwSCCQQSDCAbRC4VeKPRCoRcKvVDohUIvFHqhEHgg8EDggcADgQd6",
-	"odALhV6oL7Winc2AEpoPzoJq3AHUkwpFrySPSVZol87yFaZDNciAOVGDc6L66IaJUZgYhS4pRIaIDBEZ",
-	"IjJElxS6pNB8jy4pdEmhSwpdUuiSQuCBwAOBBwIPBB7okkKXFLqkMDHqq0+MajhKPmd21O4TwRQpTJHC",
-	"FCn0RyEsRFiIsBBhIfqj0B+F/ij0R6E/Cv1R6I9CfxQCDwQeCDwQeCDwQH8U+qPQH/WwU6SG/DIeZSqN",
-	"513eOD1/dfzcn/ulFyWSYsGXhYUKxCMF2/b4OYmSQmmWBzQL++I5y69YQAU4qj0dOObxc2LfIu61LGhm",
-	"Nos7JEMMmKA/O8yPmskYL7rCi67uPp+rP4GrrSLcSwZXiamqdas7YetuHVgDe+GvHZynWcIjrt0qkv2Z",
-	"eAyuQ3AUGaaayOyJ0ZvgRNw+QnWjMHEdmVGVrPrq2YJwRfbWSzlvm+yFdwzjtaJ4rSheK4p3DKMwQGGA",
-	"wuD2dwz3hR7+tHPoYfu64TG5o9DDSr/CcuwPpRy7aIQYEhthOBO3CjEMAujmBdYbyyqEzzoIILRY0cYS",
-	"mgV4c7bFK9IysXV6DACGgHHTReSlNSuntRleOANM/euI4U9ANN40SlQxd8eKodjrFjkQHqBGgBoBagQI",
-	"D1AYoDBAYXAf8OCWn9HV4N7tPou+AnxDi+9tqbtXevy+zpp76Jn5cj0zWGkPK+1hZhMGGGKAIQYYYoAh",
-	"ZjZhZhNmNmFmE2Y2YWYTZjZhZhMCDwQeCDwQeGBmE2Y2YWYTZjZhpT2MecP6elhfD+vroRcKwSCCQQSD",
-	"CAbRC4VeKPRCoRcKvVDohUIvFHqhEHgg8EDggcADgQd6odALhV6oL7W+ns2AEpoPzoJq3EjUkwpFrySP",
-	"SVZol87yFaZDNciAOVGDc6L66IaJUZgYhS4pRIaIDBEZIjJElxS6pNB8jy4pdEmhSwpdUuiSQuCBwAOB",
-	"BwIPBB7okkKXFLqkMDHqq0+MajhKPmd21O4TwRQpTJHCFCn0RyEsRFiIsBBhIfqj0B+F/ij0R6E/Cv1R",
-	"6I9CfxQCDwQeCDwQeCDwQH8U+qPQH/WwU6RCSVNMLLkI3NP/An7353zpNYmkWPBlYaEB8cjg+Dlx7bOg",
-	"bddQdEhaFlC+PyXLD5fJGG+Xwtul7j6Jqj9rqn0u30vaVAlkqnWrez7rvhRYA3vLrh2cp1nCI67dKpL9",
-	"mXgM/jrwzhimmsjsiVFW4BjaPkJ1jS9xHZlRlaz66tmCcC/11pswb5thhRf74l2eeJcn3uWJF/uiMEBh",
-	"gMLg9hf79sX7/bRzvF/7jt8xuaN4v0q/whroD6UGumjE9REb1jcTt4rrCwLo5q3RG2sZhM86iNqzWNEG",
-	"8JkFeHO2xRXRsmt1egwAhoBF0YXBpTXTojXUXTirR/3riOFPQDTeHklUMXfHiqHY6xY5EB6gRoAaAWoE",
-	"CA9QGKAwQGFwH/Dglp/R1eDe7T6Lvqp3QyvebSl2V7rZvs5Cd+iZ+XI9M1jeDsvbYToRRvVhVB9G9WFU",
-	"H6YTYToRphNhOhGmE2E6EaYTYToRAg8EHgg8EHhgOhGmE2E6EaYTYXk7jHnDonZY1A6L2qEXCsEggkEE",
-	"gwgG0QuFXij0QqEXCr1Q6IVCLxR6oRB4IPBA4IHAA4EHeqHQC4VeqC+1qJ3NgBKaD86CalwD1JMKRa8k",
-	"j0lWaJfO8hWmQzXIgDlRg3Oi+uiGiVGYGIUuKUSGiAwRGSIyRJcUuqTQfI8uKXRJoUsKXVLokkLggcAD",
-	"gQcCDwQe6JJClxS6pDAx6qtPjGo4Sj5ndtTuE8EUKUyRwhQp9EchLERYiLAQYSH6o9Afhf4o9EehPwr9",
-	"UeiPQn8UAg8EHgg8EHgg8EB/FPqj0B/1sFOkgklTufwQ4IRT87M/5UufSSTFgi8LCwyIxwXHz4ltngUN",
-	"u4acQ3KygOz9+Vh+tEzGeLUUXi119xlU/SlT7UP5XnKmShRTrVvd7Vl3pMAa2Ct27eA8zRIece1WkezP",
-	"xGNw1oFrxjDVRGZPjKYCZ9D2Eao7fInryIyqZNVXzxaES6m3XoN52/QqvNUXL/LEizzxIk+81ReFAQoD",
-	"FAa3v9W3L9jvp52D/doX/I7JHQX7VfoVFkB/KAXQRSOoj9iYvpm4VVBfEEA3r4zeWMggfNZByJ7FijZ6",
-	"zyzAm7MtfoiWUavTYwAwBMyJLgYurdkVrZXuwpk86l9HDH8CovHGSKKKuTtWDMVet8iB8AA1AtQIUCNA",
-	"eIDCAIUBCoP7gAe3/IyuBvdu91n0lbwbWu5uS6W70sf2dVa5Q8/Ml+uZwdp2WNsOc4kwpA9D+jCkD0P6",
-	"MJcIc4kwlwhziTCXCHOJMJcIc4kQeCDwQOCBwANziTCXCHOJMJcIa9thzBtWtMOKdljRDr1QCAYRDCIY",
-	"RDCIXij0QqEXCr1Q6IVCLxR6odALhcADgQcCDwQeCDzQC4VeKPRCfakV7WwGlNB8cBZU4w6gnlQoeiV5",
-	"TLJCu3SWrzAdqkEGzIkanBPVRzdMjMLEKHRJITJEZIjIEJEhuqTQJYXme3RJoUsKXVLokkKXFAIPBB4I",
-	"PBB4IPBAlxS6pNAlhYlRX31iVMNR8jmzo3afCKZIYYoUpkihPwphIcJChIUIC9Efhf4o9EehPwr9UeiP",
-	"Qn8U+qMQeCDwQOCBwAOBB/qj0B+F/qiHnSI15JfxKPsQdTnj9P878md+6UGJpFjwZWFhAvEowbQ8fk6i",
-	"pFCa5QGdgoklF6w7xAv4feAox8+Ja58FrclmDYckgsFa9yeB+eEyGeN9Vnif1d2nbfXnabU1gXtJ1Cqh",
-	"U7VudV9r3XsDa2Dv9bWD8zRLeMS1W0WyPxOPwUMI/iDDVBOZPTHqERx820eoLg4mriMzqpJVXz1bEG7C",
-	"3nr35m1zuvAqYbw9FG8PxdtD8SphFAYoDFAY3P4q4b4Iw592jjBs3yo8JncUYVjpV1h1/aFUXReNSEJi",
-	"Awln4laRhEEA3bynemP1hPBZB3GCFivakEGzAG/Otjg/Wpa0To8BwBCwYbrAu7RmzLSmwQtnZ6l/HTH8",
-	"CYjGW0CJKubuWDEUe90iB8ID1AhQI0CNAOEBCgMUBigM7gMe3PIzuhrcu91n0Vdnb2iNvS3l9UrH3tdZ",
-	"Wg89M1+uZwYL6mFBPUxgwjhCjCPEOEKMI8QEJkxgwgQmTGDCBCZMYMIEJkxgQuCBwAOBBwIPTGDCBCZM",
-	"YMIEJiyohzFvWEYPy+hhGT30QiEYRDCIYBDBIHqh0AuFXij0QqEXCr1Q6IVCLxQCDwQeCDwQeCDwQC8U",
-	"eqHQC/WlltGzGVBC88FZUI2Lh3pSoeiV5DHJCu3SWb7CdKgGGTAnanBOVB/dMDEKE6PQJYXIEJEhIkNE",
-	"huiSQpcUmu/RJYUuKXRJoUsKXVIIPBB4IPBA4IHAA11S6JJClxQmRn31iVENR8nnzI7afSKYIoUpUpgi",
-	"hf4ohIUICxEWIixEfxT6o9Afhf4o9EehPwr9UeiPQuCBwAOBBwIPBB7oj0J/FPqjHnaKVDBpKpcfApxw",
-	"an72p3zpM4mkWPBlYYEB8bjg+DmxzbOgYdeQc0hOFpC9Px/Lj5bJGK+Wwqul7j6Dqj9lqn0o30vOVIli",
-	"qnWruz3rjhRYA3vFrh2cp1nCI67dKpL9mXgMzjpwzRimmsjsidFU4AzaPkJ1hy9xHZlRlaz66tmCcCn1",
-	"1mswb5tehbf64kWeeJEnXuSJt/qiMEBhgMLg9rf69gX7/bRzsF/7gt8xuaNgv0q/wgLoD6UAumgE9REb",
-	"0zcTtwrqCwLo5pXRGwsZhM86CNmzWNFG75kFeHO2xQ/RMmp1egwAhoA50cXApTW7orXSXTiTR/3riOFP",
-	"QDTeGElUMXfHiqHY6xY5EB6gRoAaAWoECA9QGKAwQGFwH/Dglp/R1eDe7T6LvpJ3Q8vdbal0V/rYvs4q",
-	"d+iZ+XI9M1jbDmvbYS4RhvRhSB+G9GFIH+YSYS4R5hJhLhHmEmEuEeYSYS4RAg8EHgg8EHhgLhHmEmEu",
-	"EeYSYW07jHnDinZY0Q4r2qEXCsEggkEEgwgG0QuFXij0QqEXCr1Q6IVCLxR6oRB4IPBA4IHAA4EHeqHQ",
-	"C4VeqC+1op3NgBKaD86CatwB1JMKRa8kj0lWaJfO8hWmQzXIgDlRg3Oi+uiGiVGYGIUuKUSGiAwRGSIy",
-	"RJcUuqTQfI8uKXRJoUsKXVLokkLggcADgQcCDwQe6JJClxS6pDAx6qtPjGo4Sj5ndtTuE8EUKUyRwhQp",
-	"9EchLERYiLAQYSH6o9Afhf4o9EehPwr9UeiPQn8UAg8EHgg8EHgg8EB/FPqj0B/1sFOkbvbLeMTEkgt2",
-	"AT+3WeZF+Qx0AvNfuSDHz4l9qWGUT3i0Noq14atqYxrKMFGk4NH6EBkdRCq9zJn6d2L+UGk8rxGkh3q1",
-	"OYaIZ6RJ4YQPQAvzTy7eKjY6WNBEsc4BcCrjyuV1CnM/h04c/7nUpLli+RWLQVzBpwfe6+pVbuTabGAS",
-	"7TmcmGb2+FkkdOnypETMI9DgXP6PIyxXFn/O18Czx89JlBRKs7zGenMpE0aFoUhClX7jZv8DEw7tdRf4",
-	"ZbCdVwAhEydnkTm+ltXTkiwWO1qnQIgsdZfnH78LuzwHcGig95dcBZy3PQ2dLmc7bCnV3oFWpbBVSLqe",
-	"SgbLwENaNM3431muguQ9PD1xzxp8dWV/Y3aElJa5YaVO7Ai9qOY9JeeG6Lny4juS4orlsD5yKfgvZW/K",
-	"n4eJTaUDL5+giRWbVn1I6ZrkDOhRiFoPXr99JcE9uJAHZKV1pg729pZcTy//pKZc7kUyTQtzEuwZOuZ8",
-	"XhhlfC9mVyzZU3w5oXm04ppFusjZHs34BCYrQLefpvHvSrdTSDEvD8TyH/+Vs8XoYPQ7M3AmhdFi9ty3",
-	"7gXWvCNPP45Hl1zE3fX5kYvYYa6afl8tg/dXnr04vyh9ZXapHDdVKKZaIENcLiBVE/QNZyEiTMTWswxB",
-	"BAk320oV85RrRVxKIig55Kg0T1ivcjw16OKIpiw5oord+/IY4qmJIVlwgVKmaUw1rSktm7bvOYtyFtit",
-	"9neykklsFDD4w3QLbE8ilpsdCoeOu85aapqQ+RqSTxd1DdgpGcfmZatHe3SUMAXHvyCv6Ac74Dn/hdle",
-	"cC/f+172bNKH08oTwixIsINmoIFZ4YbsrvHNlLygkVUCYfnB0GklO02yFRVFynIekWhFcxpplqsxeTR5",
-	"NCaP/vnI6LyPpo8soymWc5oADc38Km98xaIgM+ZUsT9+R5iIZAxKgpn0uCs9aD7nOqf5mjzOpFJ8nqzB",
-	"DGBfeGJ7tJLHKMRT4lPZAbP4NdNSJmrKmV5MZb7cW+k02csX0Xd//O5Pv1MsMhSafDcK7D+epoWm8ySg",
-	"3534R2OjbigGmFXnhrOYUEXudWeYodIyr2x/bvdGbVFFHgMAdeLRiwqvGKYyBhjwBKwf5s3GoKZjF5vT",
-	"bE+oBr1H8xToA3qVRX6CJ2EdCEX+/Yj8lhTXVMQ0jx11Hqlyze99zuWkgpDATP14i/jZIm6qTizQ8zaM",
-	"tWESs4PnXJht3ZAMwjOWkR1TcgLqZ5bLKx67q5jJdc41m8A+4SIrtON5o07bT+RMRGxKDhPnv6qsuHXP",
-	"EfeRcHF18Blka3ofg+PA/NOWM1hXmq0/F0DUVV9YGqAEu2I5kYU2MwPfSM4oBJOVbH14ejId9aLYNou8",
-	"dY6zBY14wgFKZblc5jRNwQq0oiIGJVsumvI8wD8VLDYsFMtIGe6JWKbhHwu+LCxK2bM97f3O/hfwswrC",
-	"9IDCAgVBAtasF1csN8fwMpFzmhjZZRu29QjJ4+gIZrNNfX1zcnzkWrZBb62TEOg9zxKu/ypz/osUx6/P",
-	"q+Fa+zPUzAO8c6uyeB+gMm1Xtm0slKWn8qv9eVSlmbhDXWkmtihLM/E5taVPcGJV5LztkTUT3TNrJhqH",
-	"1r1T8+ZAZTwyojy0XVjUYNqYKbMhKxNQeN+1t4fRDY9lSrl4TVN2XiwW/EN3tOeBVn5vmh5IDA/BaEqU",
-	"fWw2qzfGiGW9BTjMbX2cU1vG6IxlCY/oOTP76ETXLL+gcPI4MABY7D7QNDMKo//XNJLpaDxKuXjJxFKv",
-	"RgffjkcZ1WaHjQ5G//P4Zzr55XDyj/3Jnyfvfj+bTZ/83v3y7tdn44//FVodnYSKy7w89wQw/2yI9Kac",
-	"mjhBRY5ft9p1hZUBk3wBhrXukEfVw8bQtZ/N+QtOmhtPgE6jPICBjw7N6GZYs9xxDU1EdJqxlCx4wgDx",
-	"MOHW8KbaRBlOXsa/cwO79dh0weYrKS9tV8q2cdEZDW2/EUn/fmr+nOpETe0Za3j4vXWssDTT3PfkYwMu",
-	"GkOD8t+AFE2tomKUiE6DruqjQ3Ka8yuzQM4k3yXi5JKtkZAhm7pjyZK8QcN6OZ0+84155ncNCJEmWHZY",
-	"3R9Rd7CvKtGUric6URM70tbPrX3Ku5DVud42KLytwLob98MgX8Owg+ZOnQ1RqR0+YGdDkC43dzc0mMTo",
-	"BIOV7bATorfpjdwQzR0RC+XWCI2XD80REd6u6Ip4UK6I0Bq9hQ87pTlNN8QUBaXq1v52A9qWxGG8jYBi",
-	"K6BALf/r1PJRub8H5T4oHrXM6ZIdJVSpkKW/ekristoyBKkYYce0OXEMQSmJoBHEzcJL8LMNuTo1Oocy",
-	"K/V3mRRGyDhfT7wWNOUR5EXD2lnVZDoTM1Ef2xnBhRSTMpgs/j9dBOJGtlOhUSTzMiNaR0BcLsgb+PhX",
-	"TNOpWZiAVpUk8trO9MWHjIqwfhVqZYTjtSLXKwalogNzMi+RK3iLMPNaHFawvzDvS4i17KH4nEaXReYW",
-	"80Ynru2hJGTFeN2FiyKmlIuE7EgbF7j3uhW6muUMIhFHB+CQbAOYdriq8gGAhqsK5fSxeWOOw0M8P45H",
-	"8yK67APcF6CqySIuv9623nMoguUwsa1e9MA0FjKP2CnVq3O9tn7iLhPmbNn3uhVsfaQu8iT4+xXL+WJ9",
-	"8fI8NF6Yh5Y5jZktr944d4s8N/KkD/0A5WybKtreYZ8QuUSQ/q9rwqWMag/ZNWm+ZJsnI9gH7SfQ7hJY",
-	"yX6pNbMPc1o54pwmVOy4pd6U2RR+2Mx00qm3zaCixCFEGgyHRW5eF1RdhhjeDblzf92+thDlMDNnCk16",
-	"4qKFnMjMIylv/4C4BL5cOuldrpCnE4fAZC8MGkvVmQMQoMO5KVPKyIjQ/tjOhUb8glbvzDMhbnTL5odv",
-	"BUwyVyWEqstS7Q306iN4c0bj9Wg8ElKfuX/mTGkKqoajio0ZDsf0domjWH6Us9icKzRRXQJlVKlrmcdh",
-	"yaJY7qk0cLBTlqe8SgVrDsYEnScsDsu/rPlm1ziwVbh3+LUZ4mzHDlmfemWJ90d7UWJO+87GXRRJciTT",
-	"lOvuLMejD5OlBOf4RF3ybCIzKzUmYB5guT0IP0KfZjqvg+Qe3s1V9Sk366JdJak2rar3cf2jQxTlEvQg",
-	"mvGURitzdq6n2eXS/KCmRrOZXj2dmuPeaIYBS6Z7UlODy0gnewnHWugV0zyqKqzYoLQVvWJjwkWUFLDz",
-	"kjJh7YrmXBaKWGuyE0WQgFRae1K6hg5sjg9cwLEgv1Yq7Jj4iX0MgFMpNBdFQKT4J9C/y4l1BmGzw+Bv",
-	"ShKeck2ky/ws0jnLzfDA/iRnusgFi61Rr7Ir1xIH8yuWw0UWcGMIkIpeUZ4YtrfBKGU+sMzovwtW2gfn",
-	"Ve41Vwoe2NtXnKXKmxlrRi2zBNZsDBoZmFa1NNPMObuyF17AIewSDMuZVHQ/slSx6XMultAoEDZt1VV0",
-	"mjPiQvqYJ5n70qbn0nx3tKJiyeLy0hQIS6Vkwa5JykVhyAWLa0SeT5X2S++NtxYXemrb6JxClbfXlCtp",
-	"SVlmX4N8jWjiKdVArQueg+VdZVIoNiaFgKjZtSzsfHIWMV6SUstLJqwhkQrC8hySnOAUC8L6nKXWAXSi",
-	"WXokCxGwj3TblC6lks9UMVdmuc0zYDk3e1gOl8zjCovZ3VXL+II2/gPLvEv3q2Uhr0P7sgEyd7T2Ga+2",
-	"2Fab+8uZ+0kpUohLIa9FmaVnu/FLkbCFJoWALSViIlOudZWn6SNPXfmB+kRhddMsYZqRx4wD/89ZRA3q",
-	"4NqbCqJVIS4hlKp6CiQoU3qVa/Sk+h5XXkxIy5ftb7IfUholbvQl3h4tkxiUKSrI1dPp0z+QWFZRoJUV",
-	"BHjfSH1hltF8hNN4wpzyDVOap2C+/MbuQf4Lc2HkMklscOyUHIGdu/RbmHFzBoK0r29bGw5kRO7+YB9o",
-	"pAd5m0A3qu/eEHzP7d6l2m3SBWeqJkYeqZrXpI4XKrO/dSRZE4r32kXuS7UksUHHKRfMCgsn3uzOdhJp",
-	"Sv4O8sAHzWu4hAnsZk4S17o0a20lFClEGZ5rIG95eRHMfEpOZVYktKwowIgtijclRnUES9y92ygiKSzu",
-	"i9YT6EImEyriSSnOo3VIZimWLF5yEVCY/RPrqXl79rLtoCnXZdD3z8RMHL84PXtxdHjx4pj8WAY32l2m",
-	"tMyIOcXpklb9O9ugIE+nz/YNB0MZw6a44QpAnLCn5hyYW14x/9pT/9p0GLgcpC5Zp/aRkTlBQ5V/6A2z",
-	"ThMwq292kmFtOpeFhrz7jLv+yILypMgbSlNEFVOWn6uaiOYkspZBJiKze5m7xqqlDRv6hFG5i6D1kqZ0",
-	"sVFtz29qtRCzBjDa2OwQgz9ie/2XIn87f/O6LfpegQsOTiQSSyssM6n0gn8wIqiKbBIM0pSptpzOjO5n",
-	"oIL9qF9YLidcxOyD2bDke3uVltFDaJYxWtcppIgsNq3VL4DJK1+40l3EtaJXhpwtGk7JG6d6A3++sA4b",
-	"dTAThMwAlc5GZFJjtvJHJ0i9qaW6cM28CIfJz/vvpgN6sCqJnTwTOofKTq6L2SjsCCyBdLvcxqpIqZgY",
-	"6AoKXu2xX2t7TnrXuyHClJCaHd4poW6jg2ScgCpEKMRGNwIj6qoPVUFnPHG7aOdJnSwaXgdXOced4aAC",
-	"NLdTqV/f+TY/ZpryRP3z6lnfXnctGmWZKqsUqXal3WGvDv+vP2u9uLSKtJZeYNRfD0iNmoZndvMZUL/a",
-	"1JSc15FVGQdxbUavNl2p3yimK5UBjkZbxMhvHlcHyZaypTpauXBRm77uc6XBeVr2buGR0z+oUkXq5AsV",
-	"66qV5zdYXCP3wLM6NjpIIYz+5AYJOSDNLg9LtyMrAVyNECuQPBhzSxW6Es8SzRPTyuIpeW1z3xtPrTTy",
-	"a2X7BDedGbdR6WCTfW/noyZgaIG6WGEq2JJZFanb0j5EAofI6986HR6+DTXEuIjvYFDyRrjLRzMXHmVp",
-	"HvPFguVVdIcDNSyuhviRi/hzB2uIXrcG+O1uTR/y+LpCNFbs2NIt0L3FiN7X6DPsnvRIbp2vDxea5ecs",
-	"kuZzQvWvSz+vTVzTPIVjV9lXyJwtpLtbs1yvWsCEtUXEU3JuVtSpLzZex1pP6rE5IH80vWRwqCeACDQj",
-	"FJANmTjbrVRlR7p5epV9ruQ1SaR1g15TrstZ0ssyXbHV/aDi5eNRwQPM//bkuL2a095lKte7b6na/BvO",
-	"ByoUyyfLgsdsr8RUufpdwWN158fghvPPfpo11bgDG4INaJI0iui5Ftai5a1PGNx338F9kYxDMKVYLq3k",
-	"/OvFxalfG9O2ij+1kmdM9gkvU1gH7hF30N7hGVjTwzC08I5DC2+BKLwR35tqvPyfbgtivDVblE6LWwGQ",
-	"69W6NXMXL2M+bjb63uqBs5H70FsgE3LoNfUoobmrDybs9nNUhO03L4zAZNbMKa9Ynhstk4dr+9Uj8gOS",
-	"ueFx51axMlrHAZmNzguIGzFYNK9/6b2zo9EmwDjlJj/gqLKhF0XO9RoCTO1R8ZzRnOWHhV6Zv4B5wJUJ",
-	"P1fdmm8YfTR9gNewQ6vfEdOFdRzYUrGHSVLfwcR7Hw9PT3yFOfLevCRzZ/04IHYy5Y0Il0zYuxDekxUA",
-	"Z6vQ+eBR61zggmQJ5WKi2QcNNghb/sM8c0qBnDtr/Xzt/B/vmZ1NpBPX1Egs/d4pE/CHPRftUzDD5NyA",
-	"O156kFSUMyacI59rCFg9ZXkkBS2/1u7GmrPxYPR0uj/dd2UvBc346GD07XR/as6AjOoVrMqe86ZPPLWX",
-	"oZooYHQw9Fz62brXLKD0Rr5GHJnZMKX7ym1Rn68BX1Ly+Uk8Ohj9wHRlZzyy7U6s39gDaJjws/197zZk",
-	"1mkDVb0sM+z9ywkWR40tkis8IDBf+/yF3bcokmp3GsJ+d4eTeWE05NDgb4XqGf4Pn2L4E69BOcMHcw3H",
-	"I1WkKc3Xo4ORI5939Gu6VKODn0cVfUfvzAt75jiZ8DSTOcTGbWU354ZOElfEwL/p+alSszexljl7jqmm",
-	"J+XAZgOUEXoHP7fH/54brNEec74mqsjgr7iKRvEl56Ae0GEEKf/g4ElTOlHMjGPaJ67eKzf9QwnlkUee",
-	"o7JXG6Niplet2fA4DmWD5EDhG318d4/7pk5MyDbCLbPzloGgzSaH1XYOlL7wJB69+zgefZi4k2TiVeGJ",
-	"Y5/WpjL7LJE0nsxpQkXE8onL49hlu5kOiO/A53btvuteSho/d72UiYL3xpbd0ZA5b8GcQR6o8aghN/H0",
-	"Jr4kyEdbSnMDl0XgxzVCUrDr4CghdjqCt3oYCpS/5zJe3yMvhWhpFMDQB5ROcHBy2g+OR/UQMRd39gn3",
-	"Au6DG+g1sHLBJR6yEfpldlhA94ruvV/tP+D1j3ZvJUyzDbvMNnDhNSEWbV0/yMh70/n70N47hr6Ce2+j",
-	"HlUPCg7uc3JkcdNCFiJ2XqRXzuzws/e+vvNddCfgzYNesTKwptKrajTr7L26itUGtPepOu1oxMU9u/Oe",
-	"tcx64z07EP/edkv9wDTuJzznHsie+YHpG2+YrNi0YawNHa6uueWOsal6v61N87D1WucfQb32i9vvdi99",
-	"Ur22eRvL5lPW+jfr913VUltTKujSCgxn++6zPtSyaO+RI8tRdjM2NNbjlfsmUZ+xXwZbk8jGlm0hf+39",
-	"Js33fi3//XHPJgJPnLV+J7tQM4cYAgK6dG+kU6sh8tlesuMkbDdPuStVy695MIpI86PR8HQLw1OLyWpb",
-	"waXZOyrvbmxq9gw+vG++8ZHE33wDscTv3783//nV/A8hs9INPhsd+B+rgOMDMhupb/1Wmo3GzQbuPiXT",
-	"ym3ZssnHsR/A6ECtzg3j+s4bnVaJ+Pax/ftpo01ZYcA2sX/+097eVbUqk+PdOPBnp5XNrndfUEwiJnRO",
-	"k8nT2aj+FR9Lut2IgPSXImf3SEPofyMZy1IFGynpZvhPGkEg/z/tF2ygaat9nbhtwvXYOxtS5aFJ0rvX",
-	"TgMf7cpx9CipzS/8/GbX5nrhAXBTi2uHczecAP3qUFvRGa4T2We7W1pb/NgHb3ssrDvv9l03+k57fPyg",
-	"NLXvQlGRuJcGWEJ32UsDrZ8hNo94h8+9w3jJr5iwvwErBDbAD0wj939ynIIn1M1spbtsKbjReICFdIfj",
-	"g7wRybp1galL//JpYtXVBz2GVNxt96zL9peWG6bLwoKoXdYaNd0v0Ab7yTVdH7k48ZG/9l1bd2oXY0q7",
-	"IJf/lM7dyvWDvxfpHrveXCip/fpd5FJ98z902RD+2B650Efnzw52B39Fnyh4tv/000/myJURcQLCzuPZ",
-	"p5+HjRVmMcrEDvrv4fiOcBwQGBuUdDeQjjc1CPRt3h7VDlIptshLC+seprwc71IX0tFiRwd88MM3++Bv",
-	"r46eLGy1bVuvplRIWUyKzN1zmMu0rZ224vyjhFFRZG3NuzONqtosRqJ9BfaXnaTZQAPMPYiVH5hGmXKP",
-	"MuXdQ9bEcMtWxp2HpH2YnmXO7gCcuZ7uBp2d2c5+I/DMf+1QfOZJ/dAA2obv+AwIbcNsPi1E2zARxGjD",
-	"MVpeygQvJj1hd5STpcy7iaC8M5zmN/FdA7WHIjp306ocNW6nVp015OKXoFchRvpcGGmzNLkpSrqDTd2F",
-	"Sbijv1ykdAOVCHfuBqi0edsOSxW6r51rHW64eT/B5v0yINnnyF/6SiDZokhQFnZ8+Q8LE+1cX6k+ddU1",
-	"FLVuFAvXWKpxk3oY5qFPs5Ex4eeWZZAazNeqhATPHKF3T/rp7MrdODtoAP2NWD4Hn68PzdT5QA7UYSdp",
-	"sr5nCyeaNm9l2twmjYaf47ud33u/+uMfbJp7tUC9mx7rzpeldnYDBc735246XxR0uh1k2lLqobZaD9s1",
-	"jNrKHWorfk99DgdxR0bUHcY3FhK+E3drfuf5LYwwATly5qeMguQLEiRu1VCS3KUkyaut8DkMBnu/xvPX",
-	"IEZqNWUn/5Lzm5ZqJubd3hu870KO2Bq5fzNTRPHhmWH++qEJjnKZdpUXD7Zec8Xa9I4BQ2Pf3Wz72kIU",
-	"OwWN2VduvVeHGlDO7Qx32LMBIt8N748/v6TwN1vaK8H8ZS12RRo2FbjRREjt77OLx4SSnIpYpu46MZcT",
-	"uISiUS4rMFh0Hnp3xPrkdia3/D3mJfv08xuV+meJ6s0gS0pHrNhKALvJy91E4B2Ff9112BdqJ5iMg4Fm",
-	"Dy/QbJuqdtNIszuNMEPh8SXEkuGuvJsgsq3O34EFp+9yTwZjx3BbPvAosZu5rx9AWBiKkjuLwfp8zlvr",
-	"kKk+c4fbFa9oziVcMepf7g0FvVNF46iaLMq2L0DlqK0XSoy7iWCP6lvggUiOvV/Lf//TPkvkchd5Ypp7",
-	"5i+7CoiO5jDvP7HQeSmXKHfuuIZeZ9V7rympr/ztxj3y1bRhhcBkLVOuNYvHMJcFz5UmZc1tH4uUyRgY",
-	"i3BFCtVvuS5fHO00q3OdM5rarWC64KKQhUrWPaMsZJLI68YQMVvQItGjgwVNFBt3LUTdFSjSuVnnBUm4",
-	"YM3b6Ks7aZZwbbVawXChuWjKk5edm2FT+oGnRTo6eLq/v78/HqVcuL+7d/x3p3YGd2zY0QW7hvvyqbC3",
-	"padUrIlikbR30AfdB1xE7LxsUpvVbrP4/ujbb7/9M9E8ZUrTNANKaJprOzNDsE0zuOAt/4W9y93KYDbR",
-	"9vF2i6KIkiJm1TQgQC6RS7tufctStr4lm9TXomSRLGdXTgmsNorSVER9Jk3/xi1n88ryFZmvwTou3XUs",
-	"PYMmPOX6uWnax5zf/ekP//uPWxl0u9ak2Qe9Bzewm7+Yu7Wh9m/zT7jof3Qwerb/7A+T/aeT/acXT/cP",
-	"9s3//wc5N4zFxdIpBTPRbfX0H+QlV5oJ00wKcvCn/T/tz9x9Lb3CBlWvO1W9YCd8dvUrZzETmtNkF02r",
-	"9ta9xL0E1KfaPFF5+hJAW7lgKDnuSnI09sAdiY1JvdebSJCM63wH0XEqudATLiZGqSE5i+QVy9dw7dcn",
-	"EiWnZsIoQ74AGQIrhdLjRtJjy1771HoHE0vAGDcJ2Hfv3iqb54Ub/7eQrGu/FWPW7yJmnZV809kulsxD",
-	"d4vvaIfNsldky5zGbJIlVAzdORkTMaA6IK7MietENa+pqScDz8RhHHMbm5msx4RrQhMlAxeU+s5pZFoT",
-	"rllqTnWqiWAsdp7FjOULmacsJjMxZwuZMzin6cKcRXY20EdFZD9XPxcWm8lePZ0+ne7DdLgC6ZWmTMR2",
-	"nEIxa8UxX270hs73TmfiYsWITOJyWGZaK0JzRmKW5SyCFFUzOR9QaoOt/PDPpvthjeKt7e7UrMvXLFHq",
-	"34mi5EbnsOe8zPKKlyJvHLuqTyU/9miW5fKKJgPi5UuRETiGy422pXbGF7CRD4Ei7MFt5vu4paf8xEPP",
-	"BgGePrNDwzJUgrqBSNpMMDR+BAXHblEelss3kf2TSpIq4HzXUFE387tB8E7l+jLAO/OT/VJQt6MuHvS3",
-	"M9eV674JMdygSODtd1IzvvM3vpnuLy6zfx897LBM3P93FZU5SATczVFtm0wWjOoiZ2pPZQnXk5XM+S9S",
-	"TGKhJpEUC77cyfR2Dp381XZCjl+fkyPopPTNg/JPO7aEoAkOOnN9Hb8+P3LT2fWa961zmn4pqDpIEDTX",
-	"3cJct51fp7XNGKT/7hX3tjNkb5p4eAZfwI64hxzpICn6Uqa3fXEwm/rTXhk7+INwZw/Kru5d84XMyen5",
-	"q+Pnw/Z2/3Frj9ABJ+hdHMM3zd3ezvp992j3pG7fWAbdhfi542uzP7du8N0XY+L6zs71foffzqtCahvM",
-	"8BCzpwdx03aBM9BSdocb+wemcVd/MRr/F6QToNTYYvy7I5GRUR2tBtoF71BuWPPFVyc62t/y5eMiu1Cn",
-	"ZkHUHWEkZ3BEjITy8G6NoXckEu8XtqVScC3NTp6UpqJdDKXV+zcyjb4qXz8pR9/VCuTvxG7WgXroGlHg",
-	"y9ECegsLaIgRa/urIvfuds5A1za+J/TEHy6OyxR5b7jqvTtsFNPTmXhOFYuJtNFD/vmKEcNsLNL8ipFL",
-	"tibXXK+I3cOFJTtEGapGX+dFtCJUjQlf2K4OSJam7yEFV5D35t/QWf1NX1XSjkCbY/Qbbbss+9D26t1r",
-	"Id1vtrTYrIK86ueLz1fmMrB8KGxuapQN7Px+adN/hAeP3x2P65saVEPCa0cT6s0kghcGYRp+Gnz0apex",
-	"0UJ658OHJOSDtom2mFXQTRt+oOXzVjvwB6Zvt/1e/Za2Hx6juLfDlsudTvJd7JO32t3WhoDn6+fW9ocY",
-	"HNNt2v5nMTGinPp65JSzKN436MhYnnKluBQDbICh5Mjy9bKSQaFsySnIfIqKPGdCJ2uSyOUSkpPAkPLN",
-	"C1vZ5+CbmThUqkhttXdbm8t87dnzwyOSyYRH6zHEeZtuFXlPEx75yO+5nL8/mIn379/PRDYmuUzYQcyu",
-	"xpUJUo1Jzmg8Jt+0WrTDTcfkmzH5Zq+3mU/8brSby/nGJssxgelWPbrJGhFiCAqZW5aqrc9vE9Z9t//a",
-	"X2eCkNmo1mo2OiA/m1+J/4/5f7MRvDcbjeu/VeRpPTC0av30zWxk/3w3Hth7m7TdDpt/791iCE/zHcYw",
-	"/3k3Ex8dJQ9FvI30dTYbTvi5nN/frIMJuorlp7XtfJ85sq2h0Kh0szxZIymzxpJ5yX5Y6BUT2k2MzIr9",
-	"/Wd/JOZXmfNf7OfYC1QyGU/MjOIiMeIdRCbfzaMD9RnLLojvwie7XhZzlgswIvniLD2VJ05lfF72cwrC",
-	"e5v2etxK9TFqnz09TmVMqt6I7c6cKW7F5gkjWvbVkrTdXRglsq5VMlGkhr7Zh8jMTKXxfGR9A8ucqX8n",
-	"o3cDigr6qn7uEAxPFL5hRRWhmiSMKk2ekrxIWN+EV1SdFUmr2N4nvaoksHron7qFf6pnW9V2eZBzdvdW",
-	"hQZa9zt1wrv0PsBVaKQeRBX8hs/vQRn4BbgfBrlQgos8aD/0Q5u+82/D2bj3qx15cjMvSphV++w8vfeI",
-	"3eCwrJt6wpt+t6ppgSlsrpxWo9uDsc7iDVufyB9y89070Dly6431A9O4q/Dge2Aw7+b7ZuiFWLfeOM7m",
-	"/VvbOw9d4/0clRFw49+l/f5Ta7y+7U6VzWlGI67XtmThFeUJ2FbKrvze/HGQHegHpquGrrzqWTmre2Tc",
-	"DaMi/+6O2Fyllry2dJ5pK0o7G6RiYMAchKS4uKIJtyfXC8vh8PvffrogWl4y0Y+Yzt0wt4q0evbn+yfw",
-	"hZT2qhWqNUszrR7U0tap/lIuZaF3NjxvNVBxpYrSPlUuLfhTErlcWn9mdSVKbUqu9GEZsAxG8rRQmqyo",
-	"uxP6fSKXXLwHwTXnCdcbjF11nrmHIoOqeU1Dz1EP39AsZX+3B3qWm2/Xzu4PtA4GcfhfrJbxJUUH/Ga3",
-	"LYuKnOv16ODndxs2MRc3ch4ppjUXyx18/1D91L3lFQM/FwgtSBKbUxDM1PbD3WeenR9jMHNvoHJtwp64",
-	"PzDBcprYivKWilcs98ffcCK6l9o0NM0sE4Rk2t/tSye2mv290dANsxsJS6L5t/tp1qT4r6PnjOYsNwxq",
-	"FsBgM0sCiziLPBkdjPauno7ME9dnm8aGfmu9MgdLzhKojatlW2098uX7S/hYU2W6fr7+Ptv3B9R67Fwt",
-	"cKN+q9r97W59xbpbzJacMaVlXu/e/XK7bp9Dqk+tV/vDTp0+b6cLNboi5+73oV1WgU9VV7WoqaHd0KZE",
-	"BaDUEKdl50Nkb3fU+gbJUzfIXBa6V75WIzY21y2YjbypVdp1fVc/De24DB4wqh5NEmkIIZbk+HlZ/DGT",
-	"Ni1NyLjOgmEo/PHdx/8/AAD//561TlnyvQUA",
+	"H4sIAAAAAAAC/+z9C3fbOJI4jn4V/DV7Tye9kuyke+Y34z179iZOusczefhnp7fvf1vZMURCEtYUwAFA",
+	"x+refPd7UHgQJEGJ8iNx0tiz05FJEM+qQr3rt1HG1yVnhCk5OvpttCI4JwJ+vnyHl/rfnMhM0FJRzkZH",
+	"o/8kQlLOEF8gtSJIEMkrkZEpOicsR1QhyuDFxcli8hqrbHWBTJ/6C8xQVeZYEcQFyklBFJkxQf5ZEamQ",
+	"4miBaYE+ULVC3z95ik4FyTjLqR4Z/YBpQXJEm8OiFZZoTghDa57TBSU5kpRlZDpjo/FIZiuyxnoNalOS",
+	"0dFIKkHZcvTx48fxqMQCr4myi31FpTrmTFFWke6i3/FLwpAgqhKM5G6JBZUKrYnCOVbYbUgpyBXllUQl",
+	"XhK9Jr+8FUGMXCvzQi9yOhqPqO7+nxURm9F4xPBazzJz89i2gjFM+RWek+KcFCRTXHTn/fdqTgQjikhU",
+	"6JZI2qaw2bRQRMC8qCJrieabMSLT5RRdEHb17zm5GiuC13q1j/B4/viib75FYxIDJk3XVHUn+xpf03W1",
+	"Rqxazw24mGkpbnd+ip4VhX2IBQnOYwGAJxHjCkmieicKA4cTXHCxxmp0NKJM/en70Xi0pkxPYnT0ZOxm",
+	"T5kiSyL89M+5UM833fn/QEmR69lKLlRrW0tBFvSa5Aa4LyYXaAEYIDPCcsqWiIuciOmMnVdlyYUiOVro",
+	"7sxCL/T8L8boIhME69He0TWRCq/LC4RZji6kwqqSF/+GNCTOsSQoKyqpiJAowwzhQnI0JzAxkqP5Rp/w",
+	"kjLyblOSC4Mrsf2SZqXhhpFrvC4L/XLSmcxoHMMz8y0g2TPGuIJv4E+cG9zGxangJRGKEhmBmnFrn3+S",
+	"REzWmOElyRGuu+ySpGA8s/EWQRf0WjfGqCQi4wxPM74ez9ilx5Yp5YgLdPln+JXzNabMgpwk4ork/6a7",
+	"2ujN1SCnt5Yo/UW2wsxMK9ftZ4yvqYLTFHytvy45k0ROZ+ytI4VjmNWSXhHWWI0gZYEzgnBRoGrokuEo",
+	"7f7x+f+QTOn9e46zy6o8V1zgJenf+QUuJGnvtvkWSfMxosygjH45HpWNc8NFwT+Q/A1eE1nizDzMSSlI",
+	"hhXJR0dKVJ3+NUbpZTD/FbL9aFSqJEFqRSWaN6ah4VXjVhRa7AMsBN7ov3ET6v5FkMXoaPSHg/riO7Aw",
+	"ehAC6MfxaF5ll0S9AWTYBZaR9wsuMnKK1epcbQp7qyxwVSi/1faTOecFwUx/A7R05zxfmVYfHaZGBvf7",
+	"2X07Hl1PlnyiH07kJS0nvDTAMCk5ZYoIc1IfxyNBltHFDe/BfPfbiDBNVH8Zye9G4xH+tRJk9H7cnXUl",
+	"iuhqroigi827V+eNXTTw1N5EmPc/Kyo0yP1idqhxlvaT97swRWrY1AN6WNt2Jo1PY3B4DLThzLAD3evj",
+	"GQoYoZIIjWcIM6QxDEASqRVWyC5NIlyWgl/hQpNzjCSwSkAoph3EBEpN8meqceVp4jNRtN6QJmxnNL/R",
+	"J+Zq7LwlQsQ4lJf6sSNjBZYKeECSI3JNskoFnKbfh9jY5LrUm7LPdG+COR/Ho3oaAVQbVvaFvXyPzd07",
+	"GsefG0CJgn+TJ90GbQ1gOq0/s8BPpOo9CamwivC45wr4crPZ5h5zEKlvUgtvJG+9Q3OS8TWR9sBIjjjL",
+	"yIxRJQPQ9Wy6BWySjxEXaNFg6uvmGa+KHNmr1X8ynbFn7pPWJDSXMyf1HPFS39gVU1QzjMiCh7ke3aGV",
+	"hvXS9Mh+NtKbp0kB/DQfmV9maaPxyAzfPTxNEXXHkyssAID0CKd+hGf1CGf1CC/9CC/rEV46wP/BDqVJ",
+	"CRZLEqEZmpy1eYDWVlJZbyCKIU+cXNZIEIK8n4mDoia0jQNKE+JkjNI2ANgR2ibZ8mR3EP1tdNilv61l",
+	"mi53Tuy0gZDNza/f1Udg96JBrFq0WF9SVdlhySK3WHdqsLuNT2ES8nY8XUB1OixdlhEp/07ilOQrZ/ha",
+	"OgBNFgte5X7fTOuDjDOFKSMCMdx3Mz4cRrHNdFSSCJSTBWUkR2ZKsA4H0zXjD3++eHNuXptLFq2UKuXR",
+	"wUFDdjrIeSb1vmSkVPKAXxFxRcmHgw9cXFK2nGhZbGLAWh4AHBz8IWdyAmuawIPROBA18Qc5yclVbGtv",
+	"z6FKkgmi+kD8YfKvNVqG89/C12oW5GRdcqH+xuddMGi8RlSakwcY0gcNf2rVAoU2/8PnEj07Pekymrik",
+	"Vj8YAbXTE/vOgpsZ5co8I7kbD+COgvwriCTMoKfVHpoVaT2JFsOFRHIFrELG2RURCgmS8SWjv/ruQH1k",
+	"eEtFpEJw9gwX6AoXFRlrQX3G1niDzMWPKhZ0AW001/CaCyP6HnmAX1I1NfoBjXfrilG1AVIg6LxSXMiD",
+	"nFyR4kDS5QSLbEUVyVQlyAEu6QSmy4DsTNf5H9zVLWMQfklZHtHsUZbrg8IOZ2Gu9abpR3rZZy/P34Ws",
+	"AZV2D+umMthOvROULUAtSKXRWuhuCMsBb+CPrKCEKSSr+Zoq6W49vdPTGTv2KhGj3tBc2wlDx3hNimMs",
+	"yf3vpt5BOdHbFt1Pp7AN8LTGE1mSrMuIZJwtaEQXfgzPG+BsmlaW+QpxBxnkQf/D59MZe7cikiBDlIyS",
+	"Tw9NFzRzAFvjJBFoTvSBVtKqltaVVDCUlhEVn7EAXx0tp6zTzTcSTfUwUzPLKS8J02j53Tl8Ou3ojjQV",
+	"rSn7pLTqr0nFLhn/wCZGR+lJaR6MFb9EX7RaOFoTbBARjg9wu2eeT2OHaeA6IszAc9e7aeVuNBhL8aDb",
+	"5mmXWK1i3J5auf50C3dMORWg997UXdajaPyBw6YGtbSI4r/GWgMPlhBc9zJGOSmdTph19ya+C99FduA7",
+	"ZBkTM+fz70LdXQwyp/3c30mEAj3zL18YBk5aEN442nP+HTI9oEuyQScvEGUFZZoCnIC2XotEWmOAsKZj",
+	"HwRVZMJZoSlQWSmjAIeJGgSnxBh2fl4RZskTtKASSaLGugsyX3F+abqSpo2hixYZzuGudKhmVeGZIDlh",
+	"iuJCmvcaMC9mTCMaWZeKuq5gOHecfmywOSguapSzV2PnmMwV3t3J5/DcAVfIfJ1/Z5nMaH/RiUeoVKtZ",
+	"iHeCLIjQ++rA2XATDnSCkwwGM+TLbaajRbo9NL4kG4kunv18/o9nx8cvz8//8feX/+8/Tl5YU4V+fv7y",
+	"+Ozlu+D1RXR97tL56exVRHFUv4R7kNV3lH7EFy0JIjrCbsa7ZeJptLeQ58iVxuuJhBc/nb3Su3SyQBXz",
+	"wGYU/XYAB5cSwUDTUZcPDJnb5jTO4Hl9hstATbYdZMzxPguluhbZaDbox2wLKAGC/86xexuL3zGhm5YB",
+	"ABEmK0HQu1fnB+fnrxB0RjOg1UMBSQ8Vg6OWPBGnGl2hIaaAMNofq8fsEZPbTXpJjenMWSqnOzVTHe7C",
+	"X/+xicWkIGMmjfF3WtD0GvI2k+dfuqUouibogwHUDnOHfG9IVoAdi6ooNnp9wxTT/8Pn8a39m3nRu6F6",
+	"cLARUIlExTz1bt3xnQG12v3t3Bg2fyQs0G+3NDfRdm46uhfE7Wu0rN/zRXsWwAOPxl3re9virrl1Ka2G",
+	"rOUtYF640W27LYPF9OCi58zP3athJ257Gn7EW1XwdsisEgLErFAvv3tdHwchckPgd9rXLToB3cRes6YT",
+	"A2gNDrOwij39m1xTCTJoa8Ly8+kM0B2qDNAOjQH6nAqD/dTmjWOOaVM/gf4B3ZX6AXW1D6ihfEAPVvew",
+	"HUuJ2C5Le/TASJBK4nlB9MFgRZYbYLIMCtYYyUAAbVkmk0IvKfS+UoVeP+qclyRrALBTxNVg2lCiTSMm",
+	"PcCeUyLWVEpndmpxkZ02jTFtF5MPNAeDt2/kGGAty3SVQU6PGH6BBTGKQsUdF0YQRnYCZ7wgMeUPEY6f",
+	"8LdGS//FC5ptzqqCoBXXjomhNgmYAdN+DkSohNZIVAUZo3mlUM6JEaacpiD4fMbwnFcKfVgZzNZfaVN+",
+	"AbIZeOJ9WNFsVZsMY82ixOtHwatSRmmXeRXTuriXER7HI/YUoZMFWleFomUBn6Cl6TDQ5WpRDbMNwhns",
+	"Um0eBvcEqRBnelCjvtUWJjisvB4FUQYd+O7RB1oUoEY0JtMpmo1mowD1rRJaBFMChmU2+rbZTrsV1rOe",
+	"DjewtnTCmuubuAaKr2mmv2CcndlFaF1IxHOh2cBSPgIMZImFFk9RJQppzgAbM6W9G1b4ijjFg7700bdm",
+	"1+2eGIADVQM2+6EFsDFaUH1NSEVKJ8prjc2MnVOWEcQ4m3iyClPSXWqI9VCXjy0RdcoBM4aGwAzPLV4F",
+	"eCZrEc06gDbQ8DkFNe90xjRWGU9dQtWKCOgTFMr6hGpoeCSrbKUXNRuVPJezkUaNmVXqyNnosf67vRBY",
+	"ZeNbTWNno8djBBsFxJ2r1V2DgJvDG+MN09VhBa+daGFttBrdVS1QwAEYQIjhPdLeSWRdqg0A0JpgZluT",
+	"KyI2aqWvTuq9DO5rnVvWaMHbrac+UMMXtdfzzbfftDG1pjt3PPsrIuYyGuUxb83aPDLo6MHz1SvDlNjp",
+	"aSZGOorpVGZ2idF1wfB3u6aW1sgsMKYNags6O6x8/h6oHW1a1j5neYter93rqWV96w78ttnAXVX2Mbr6",
+	"rsFhR8bbw3gXEz/ypnRwzJlUAlMbKdTlqOJtPZ+jhU+s6JwWVG0cY7M2oMByVAoCz6TV7mJrWpgTJLGi",
+	"Ul+nMwbOrq3B0JwsuLDMcJOnsX6BwA9BBABVU/Ru5ahB3Pg4Y+Ra75asbbLN2QK34r404RYNQGCE5BYO",
+	"ahWgHaF2DZPjGXNE2bN5vkdzOuN6CiZiozmSBC9KDneG/7KGMqdO7+6Yv5hkZNfGgQshF4bluMIFhcAt",
+	"Z1MOepsxx88o4Eaz4PDt0ZSCZ4SAVdMHYrT3o4shbld+sJDapa/h+wBDPdEyu9iCJqJC43i4LWAcn7GX",
+	"OFsZk4bu62/nb98Yo60FC2CzoUsQoaQz5gJXsLXjH7hA1q1pjGYjY4w3BzvV6OdudPNCH4oxZE9r3bez",
+	"3Uu+JrDu2WgP+hnH86Z7Wgux67+8sT541Ed6OtPIqSwLvOlxC6hfmj1fVWus2RicA2PlPM4GjvU/fH4e",
+	"lfv+Zl64hXQkvV6hqGMvWOOYEH9sXrj+bTsNH6LqMeYPd2uk66gi/GQdqMGhzdBDicFCuU2I7ZNe70Vg",
+	"TZJqklSTpJok1SSpJkk1SaoNTkC6IPGXwDpGduW81cIb6e0WEfvYg2rzgrUDyC237EsfQI6kwnoz3V3t",
+	"Z1eLJHa4KTqjy5VG5A+Iqm8sWSqvM+OOU8p1Pp+iv/IPGh3GiPq4rlKOUbk0UdVsYwUeG/IcYwB387y1",
+	"K8iedrhdxnLT4ra2ciKSpfzhWsqNa0oylD8oQ3kYebtLPeXI4Xk3xEW38skzUpBLson/nmziAYp0zOI5",
+	"kSDXe3+03c4jmo39iUm8IMeh1jKCNj0trQDjtAPWSdYzLSBqaRbBhB23dKOoYguqALlLwfPKiLYVnM6M",
+	"vfBhqkeod3iQYe1J12yNlckWlT4cJEhBsDT8bteF2zihR3z+4bmjQ6ZVUx/V2U7CtOiWx1gxeGEwZVHg",
+	"pdkr/dD2LMP1TtEpzFhvBcrnRtdo2k01Pcm1jPfL+6kdT3cGQMoLRLRi1LVBkpRYYEW0aMnydlclVSLW",
+	"x+nJu7P4XukvIuqck3dntUItPB2XcQtwljLjpClIxq9MFqPm9s3DsOm4GvJ5u0lM59JopH1ChVHyuHna",
+	"JZsYiWZjp4G2UfIOkCRemyGMxsiqAiLoFYmQuAFI6IlG978qC47zE6aIuMLFeYxI/NRuEmTrMilIJJoT",
+	"9YFYT9k5ZQVfSmS6lqNoUq1QCHIrirpvO+CMyDvuVVMSdHjlP+wVZ+xB2YZtvHSPG/A3/UQgdnzmtJae",
+	"GM+YC8suuA8SeKjw5mIT9Q5G4S4emt63Od2u6vkJoswdecxLGtdzNBr4/j0Q2xPPzGuTeg5T1nJW/+5p",
+	"1FndT60XPj0hE5xtWUkLKbpwVR/F2AWI+952axD6jL3nPdGUL/y7wM9Uf+AiK/UdO+dcSSVwqbkyjBj5",
+	"4Lza+vCkZ7Tnwds2IpqHcCwaAwgwb58ID4EL0SvVI+tFmmHkp0G9/aJS7X4taEEOfGzp9EaABgO/74EY",
+	"Iw9v04c4Q3vLAdkomRki11ZUaZxwzOSWQrBTCPbDCMHWyRs1zzuXvKgUMX0Y20Vg3JmiVwRDJ2ACFpgW",
+	"+o9vDr6BVs6C0N3T1olbzwtjkf3ltzoiCnbJExrMWhPiItgY2NDxSMDlNJKkWEzXWGUrIh99898H//Ho",
+	"l/8+eP+vjw7gn8ffPj74j3/55vHo4/sUW55iy1Ns+Q1iywfjcDCPGpWNt5Ueq8ZZKn86e/VIY65FzBS7",
+	"nmLXf2+x65bK9ZGnJlp7GIzGtofd9rC4g+PP3+9g2vrRf4ujn94Wul5XSst5zbsb/fu/I17k56RYGFqQ",
+	"zxu5RXsYv+edRrF74cVzJ7c5KtcVt7rSyU7VHRzLhLJJQ0vXZNY7TEIeDZN+EURJ//TuWPMZViaETsG+",
+	"pS8Rjd+lMkLbGqsjNBs9PTz80+TwyeTw6bsnfzw6/P7o8I//ZRwoezO/eXQws2kjBFjA7WT0J8Ztwqxu",
+	"GmQbtR8bC00kd9ywuG1jSO+zxoesfGB336FX3iFa2T5j7sdxxqHXOHZ8Zl8h2jQpXDVrShyfuWvJ+QrP",
+	"WMVyIgog4s4xOUJbyBURRKpJ03fZ5JS0wrcby4reQWcz9ubtu5dH6Cdt0jG3hbkK9F5tUMnBsiYVLgpY",
+	"PYgTBcG5kST0wFh4q362RZYXBByxovop86armLL77z+NKKS21S4Y6P2DrTLbNUZQMsH4doDyvzkNcwS2",
+	"6sK485XzS9MygARdVQvyykr/g9nm7QIIY2fWHS+b9238Oz79yW2W/umnEHrsGy2GIkJ/8N+PZrN//d/J",
+	"4/949OiXw8lf3v/ro9lsCr++ffwfj//X//Wvjx8/evTL31//+O705Xv6+H9/YdX60vz1v49+IS/fD+/n",
+	"8eP/+Jf2naCpIRcTuy4nvq/JmovNrTflNXRT58aAv77orYn78Pjsue08GvCiRbps8x1XTlZgGY3fxdJj",
+	"pe8JHrZUJSURkkpFmEJXvKjW0IxGb01JfyW3Putz+qtfqe7Qm8V65/GlHHjIfMFW9Wu2f9tyK9vjh4b1",
+	"fVxeZ3oruFRLQeQ/C/2H9j+Lp/beg5kLwjmC+iC2MMgOPq6SRBh+VsZ5uJ+aDaL2kaiUbbySzZc9EkD8",
+	"0m5d2XYzXfNdCuU6fXNvalrT4w8Eq0qQXkdD9z50y+xYg4PIvIVr3/btsSuI6Bzh9Ls87PnrF8/DUbcN",
+	"Yhr3jSDLgqq/ckF/5ewFk4a/ip/zedj0zXndtH3iGEWbouMzp0mJvr5j88Qw5nXNGTWmk0g6J//O31r1",
+	"k+0Uu264bUdfR1p1N7PdV72P7e/v3sIziEFzho4mq2UdXhwY1quIJavAdB2/4OhaguW83hTZcAIfh4YN",
+	"oHXulfl4PGPG6doF9EAIEK3drA2XHSgpjKJdWjX7jL3YMLymmVuu9suxwVkW1dASK9LuJRSUp+jEeA2D",
+	"usZG+1lNjZnDNqfms3A9YZAkZwQRpjRPxdApz7V31LTROuKvu8WuDcADGvgGADaGKXk+jeyyD8M55bl3",
+	"Pwn3Qm89bMMaXzoXbw8u+ArTQm/UjFEmaU4QDo4nDpY9BWtsiYQGEmUrLomxAGBfnMNiRhBiAkBohAcI",
+	"hxiHARDeHw9aIbDb5MHMx8b/+wOVZMbgmE3vUmuUasdKGHs6rN7FTpN5zJt/jcuJ1keHvfT6/K9xqTs1",
+	"gtG24md78oJfiFzTLgEB4mEdhgdEy5b/w2teMThI7YNdqSCUzZvWou6V20oQNG6QA1NJzcceyUlNHA5i",
+	"9dMsMP3uz81ifOfkKNt5cg7lDNL7jqhEvkIe0Ax/EhD+YXVvIGNZoKELn+OSXGslBFXFJghjnDFPHfRX",
+	"mGntQwHCLhz+xN1hoHue1lOxvDq5zgjJ7WifFtCGcVEl1gQ+Zh3Xz5seWFLxMtRGxd0ueW7dkyhbmuDZ",
+	"OAt1Gm8YE0IiTTt+bAL89fSxByrnkucGze29jzPBpdypUSsFv45YhE71Yzc/aNPUhU5RqL7CtshVKShW",
+	"ZMYiH9RRrRAFV+f6MPUbLeePns2Y9vA27sYow1Y9IImqFYv+vg58Y4EJ8i4xPnC0lWuiz996mCLXrGqn",
+	"Hpdcl1zGNM3wvNmZabuDTafWpetMC8IR3uvkNHzfDlg7OXUuJMK8f3R88uIMueo9j2eQ0FBfD27bwPGj",
+	"cb4KmCUwjIVscz872JhSKAOenGoxUBApTeRzYy4QBU7VilcK/ODUGsvLAWFq45H2kX2OC8wyImopJZKI",
+	"N9qujYe6NzS3zezhaPJpQXeYzcMKLCenWw0fFgD052MXs+e/HKNwvmP0hufklAtljDT6G1lHrIBp0yOA",
+	"IKguJxVaU1x7/eja/wwnG445Go/coEMsL3sqfAAHpmYLpvEjDBVBBcHCVslDEoyZoVeOnolWC33jVvgN",
+	"+t//Rf/PCstHVlPUM8Rj3W57E+gX+nuk+5PbOptVh4dP/2T+i7a0RP+P7tO6JNzErmEoyOc2azRmkawa",
+	"yarx+awauxXaBlhb+uw1Z0uuF77C8H5kmSKr2l7OeQWk8P2gNDByhUUeVdSd2zduMq5lKzbCqELBaaaH",
+	"TzHReH3cinnbThcSHwxJ09iyV90qhsPpUijC1NPYmyy1dAx+/Lj+e0dMheOX6aK5B3WsUZSth3ay5wCb",
+	"+Xtqamw/ut1yG+cbRirY3nd62lgvh+0lHLZHL0KzxiJ9aYI9AhgzRa/IeZ+Z8Vn4um0bNMIY84LNI7Av",
+	"gFrycdRvgjOjWJBRlLDvmn63fkn1x96Lp7u2HibXd173nROFaWGuR84IwrIkWe3Z0C1MQCFU2ifX6O5k",
+	"gaV6JzCTMNI7GuNqu20apSXAb8j699sJK9/apa3hYOeFswfhH3QBzjHOhlHPg0oOgVtJ3a211ZnESU7Z",
+	"wLhC4HEPcoQW7JxprVkbQu+DEe1sN/pj44kE+unBNSJ6K1+s68oXNlEa8onS/DuWg8TKlv4w66yF9ba1",
+	"HeN9dhrljAdrfP2KsKV2x//u6f/5058jE+UDSod027RJ+9SFLE+D0iE+0rc+nA/Y+B1q4M5RVXJm8+qB",
+	"aw7LyFgTymhvVDrYLTboyVOTfQnGNiAzrdHol+v3Ux4tdfKXcWtCVCK9sXwBfmgzBj5LghiUsbJ7tJaH",
+	"m3C0Eoont4dxphfL2Dab52EixFLwpcDrNVY0QxR8JheUiBBADGMMHzpthl/dN9IiXwgypxBNbese+5iZ",
+	"AC1BpNMwZeivFg+h5LXNNWDiZwhm+rK2YzqFyNh4t35YEY25JnmC/UjAvCTNiSA5wmhZYYGZIiQHv1Zj",
+	"poPGAabjOijfQXXDdqRnaSUzAP0WzD85fPo9HIZ/0OAsf3k2+S88+fX9I/vjcPKXf4yP3n8b/PnesILR",
+	"EjCxi8w897TWberYZmBD70RFxugH8PBGP5kgoFAy1u9H4xE0GI1HtkW0WG2c03ROjAGEB5kNEGAaWnA+",
+	"tYkspxlfH/j3bZrx5E9NVvwXsy3vH/0ysb++dY8e/wew0NsaPP72ANhvv73vf5nUWz3VjHjw7vG/7LT+",
+	"RO6lmvJ6PPOntcWNoZNNeA8/SH+Pdx0h68y1revKOy5Gk22GRV12hYHZJsY+J7uxb38Lykq5TAw2yqqu",
+	"JRIqaC2CWQdxsNDB9bjD2Vn2+P3bCyyyBPPCeetLyJ6HmghUlVIJgtducsajvywgoIRcx0fczyXF8po7",
+	"XETMtD6VQ0pntOGeKdudUYLtbTy2I3e7zvlaX0W37rWHe224t8BQnulv9GSm4cZ5ZP+caAXXdwSdnOr7",
+	"qiwpWz7uW0IE/kwnLpdQZDiG16THXkGvsCInp5Hzda9qcR8eBErnGoZgmPgI1bygWXQA+8b3D3/v1f3H",
+	"AQRwxWW0mh5jBDKx2OAqe8vZhxBfZVjryH7KG7oexaarpxd30PirfeNm51oGuT4cMbGqbqF1iHGN+pD6",
+	"deRaCdyIoKx59Y7hbj++u79c35pLhQTJCFONYn32g5oti0iSA+r2xcPCTy2pB7DTvwds6YC8C1r82cSU",
+	"OzjfdDXO0BoMjUN717Y8wnKS+5s7Nli3leOyrQbCFrx0l3ydxqi+1Y/PAt7V5pYyKaf6YstonUcUGIag",
+	"9iNmWjIxfbhBNXNtGSAIbDRjWOZ5wbUBTX8qiIazzIbGQxLNiilaBKPUs4OHwS65wY5mbAI2Hh+OkQV5",
+	"s5YC5yR3TdohK26+jxpOtfbp46CjNc+pKQ3Q9AirmCSqFsvNnHFhDt/vkArTpkWWMN3mtt3vh624wkVo",
+	"5BgMbH1igWUyvJKpIST00YjhtSADBH/ek7Eq2mxYIj2bKCOl00vp9H6v6fRsdph9k+qZz6afOsPNJ81s",
+	"44NXd4Sthmvggi4hSXrbK6aP5R6Q6KY5j1sYH9x+7W+C6DtuX1J6S3nqeKliXZ5Yq0x9D8MV0PaAI0O6",
+	"k68HlAqvy47MbXb5G2lgxV6nwwbPiVSU4d6aJO6lmwSI/t0MSFGAW+JYoYUfcSlrDakztwkCikf9CcqJ",
+	"IlkA8hDeXPCljNrfKPtJDkjLcKKbhV57oGnxfCP1N5sJwPZkmcowI1EQoh040wEp7mxEMEdzwZ3Bl9p+",
+	"EDfMvIq0qk0z+p0zzmDVqLikSQlskp3bndbHdqjz3KXi0HzsTsSHs39/c76oP/13tOmN84A3aJojxykj",
+	"+MPLCN7lnFNq8AecGvx5VVye9UW0PGOuAI7idekISa6IiLAaMu4wYHDRh5nqGJ+R9doGi4KsgOYZnAav",
+	"yoIoEg871b1MrrBgpjLJL1tW8wyGPrXD7W5nprOr3Zmb7q6Gz91ydjV8YZf7cRxhwuLBUpY+NnMuBRFm",
+	"BF2Ydxf2+KLcjL7zqrLBy3bHO1l4V2F0YU7moi6KtOZXpM5HCebnIJthK9a16WMP59KtR6ZLy70mYkkQ",
+	"nJx3K1fc+CV2rwK7YOiwu96AVyUFyRQX+9Kwqrg8d5+2L08/mu/8/VCMkyVnhh1qYsztCK7pWjNXsRyn",
+	"4dRN98OnCwxbxwmvFWdGHGiY2JUBHvrgEhLQn22LPW401mYpIXaf50toFGTV7WCCF9Kib4VfuyNhUMKS",
+	"5EYZi6lx5bIJEp+V2viGi9uQLz2e+e95MNKOpj+4iexod9qeZzT+zy97IICcB8jVYlHhjYxGbEiNvbAX",
+	"YwR1TAs8JwVyyAS1PKG+1Yw9U6gg2NddQxfwmU1zB5+5KVyEdS2nMxZxvQpaR5gQ76HamQ6ZLqfogrCr",
+	"f8/J1VhpiY4y9AiP548vYiSWxetnvXGBxNE92asEogfdvmHgnbm0C8Nxx47BhIa8CILLdIUnFnQQFhcQ",
+	"BOe3LbHZKY9r8fbYxYN1KWMv/nprRyzpHuQgCmfZ1C0LK9BvcUcZYOrrW03kVGoKhQQpsKsKFW5nxznY",
+	"7MiNb4XI5vaA0qDtDd/c+e7WTjhDglKWHMKJJ2buvccQW267rc+f1z2y2mcd+bE7Z+SMuAI6sK5AoyOf",
+	"UOXo4EAj0JFJOfL/fXJ4OA3+d/TH70P7R5jlWsoPXOTNTgXnKtZaj+DOcVfrAXAMrKlmjgVXJOtTPZk2",
+	"qPSNTOaIzuWPnrk2JO+8DQxYhsvMEReorMQSQliZra5ouT0gRQyKixKGqA5SLanQ10htlAvmQyXKqQSn",
+	"a5/RspkH8sImSpuWRGScYfDhyu3SJnVX+sKpw9riFDx25wQ+7TsyOm6r99A+HoqXjEtFs+MVyS67pKPX",
+	"1v6u9nAE4Vd/jlZYojkhDMlLWpZx630XuEwqJbmPR98EXfDLi6PW0Pr0F7yyFe1KwecF0SkRJujC/iE7",
+	"35j27rVpbGffaJuBkkaPICpm7/FA9wPxEtToECsGlnZwTYaDdFwfvzSnCkNplt/u0vtBCRX8bux1rj+Y",
+	"OqORS8EdeL90aFYOGjazTRYp9PxH42EnGABQ3f4/ob0epiejFmBETsVkvZnk80l5nU0OwVP46f8H1NJx",
+	"3w3YjCikemWhn38Nq2Zpkehap2Gq533qZ2tWcNyXhawL5JEF7VSswgTGPek0dl9eNRC8DdIurElOvQ2g",
+	"nt8JyzQ6k0bARZj7rE2jouiqaSBVm1DUoWzBR+PRByyYkWEyQRXNYvJNe/0GRINua3DaCwfkELFzRXCh",
+	"VgbmZc/9ExE+deubclVt6hvhMRYGfXtW4GiJgeFx7YQEO0bQggqpRuNbTs6RkMj0zKZF/ITAVxoio9nG",
+	"7bBbCwQNOCDYmZ/YDTF2mx1sygAoeKnVoFG1JNFv3O2t6JoUlJHBB8+rWLdvvHsKz0yAQkYsMAVCIYwc",
+	"dVWh7IoXVyR/62nZTpLEB96yn5DwwJ7XNCd6BWil4YQvFi7HK2QpAgVEna9El7G1WZvQ1un3R2+cwXMH",
+	"gbDxU/SDdampIzAkmF1EbtSfLw0nB45gVKILY3Y2uqP8oi7K2/AYasPMjNlmojGFOgTA51SxTEJja94u",
+	"FvsUBvnZ+/wZqM74mhiZHBzGLmr54+IoAouG7XE4AE3qvXEzd8sVBp6DhcQ3ILalTX6onpVeqx09HlYR",
+	"NaKC0dSODiE1NdI1TnywjdQJjm6Cb/RXmkb9bG+vnVeW7dmek20dBD3UcRAtVB9Kypyx9A5VvtDv3Wl7",
+	"B5lz78yQmyy4D9yCm2y3D9l2exotMtBTWKClbWxiHcGioESqF9bNpL7Pnh4+/W7y5Onkuyfvnn539Me/",
+	"HP3xL/81mCTHHYtazjzOpaikSoD3UMu5CC+UO39r8dT+WwpfErbFh6dZ+KEzM9PoTpc74MDOrNvPLgJr",
+	"2w1zJra+RMmbOHkT/269iS3C7O1ObL+bxgqt3K76p8HK7XVx76rep4aWFTZ5+CRRyCrLg+AYyCnYqXYz",
+	"TYVCP0+h0E9ZnWgQcIQgN72/ekaa0mCfhZoyH6qrJx1bcGtqullJhL6NG36001QoaRfruFdQQUhCbZBe",
+	"NK7AyH2MkBwu9TlxB5L3uFr3YE9Abe8w7MBdCjeIO+i9FxqBB8OY4C/B7z3Q8g31PQ92t5EK1G9p6wa8",
+	"i1A8O+YgJUXQ9m6czh2fnXQWD1tn4YSspLp4wKoLV/8/gjDIFe43mAqO4hKyjlbgNyeQzHDhme4GjmJl",
+	"E09DDNYwf/SWHzp0HtVjZyKaDERhlmORI/1aO6IIIqXJjK1WaEGviGGzJHq0pqxSZIxWvBJjlGMwrq05",
+	"U6ux+8c+/EDI5eOGXeEQ/Rl9i75FTyZ/HBQzKAjOdVVuV7W080UjzWKjwGn3enAGqTDTUyct0W+H4z89",
+	"+VjnJvqXXpdI52u7c47mLPZDfwdZ5/Btg1u4SS/mY2vF+C8eqzV58uzNMwA49CtnNnlDCxaottXgorIS",
+	"TdPX8qd3x9PGWb+sNNAePCeioGw00L8EoHPsIPz9cBS8B6OEx+47s0u4Hs8q1p1rjdXbPFhu5tm9zaA1",
+	"UCsYMTH5wIHhbtZNuO7xiYC0qgjs29KmtrDXbNzKR6UhppBTA53ZifqgCnjngiqmEa+2JWXk+PSnpg71",
+	"SX8Kqdc+8XGgcv2xv/1ZkKh2zzzYkOx32PeH0Qyugw/E05fm7qyoVHax3aMKs82Qa5JV+p0cI0Y+EKlu",
+	"5fsRokospz6WyjWJh7e+c0HToHexTUHprr8NCBkebpxl5FqdVT7R6WDMiV4Q3SN52VMGuPl+hz7dgFzS",
+	"oyc9+u9Pj24QBPTnZuv1r1YUV1+yPFuEyqJAk2nYGcFi/OH/DmXDurFD7l1TXgcka7ivXGFBeSWR+UKC",
+	"5GCKwhlx4MVzSwFkVZZcKOlzMoZJxjIlUUEvCXIb6UmE9YBBP51opFtWNCfeEV3OGGVaYVxoyPR5yrgQ",
+	"GhbNjJCui+Z6o2KL/4PuMV7mFMmgK19S0BQ1sv5DLrWx3ZVK2iH6cgW6/Q3sGJKyZUGCaXen2OgkkorC",
+	"/RUkZJ74hMxBazfN5li93nARV+eIPnxrZ7vT9A0vQGAACpS4UouA/ngdjJG8jTpyis7ocqUQ4x8QVd9I",
+	"kwu0vM5Mkl9IcDlFf+UfyJUt+GWzR5RyjMolcHTgkwkqfNmnrm/znH0pWnfpUS1R2Ed/+rKPRrhihSGV",
+	"iBbWlUgqUTWoeF3q0N2p0qaXDncX1axRn2FrW726bhYZ6KumPCGpaHvNtWcwnTG3I+hl650709bH4/qB",
+	"qWehoYnzQiK6xktjpOquy7viRsPf4Mu/YrmKkmJ4e4pV/G0fcPid6aZ57fGp7GzOMMTsGVa+xqWhLGtc",
+	"7gaDnvLKCRISJPgaeX2AkADk9w0g3Qd6kxPEJIgZCDGxkV3u159MwtdIiuJmg6bo09wF15fLHts9Qqip",
+	"URSnBWZnZNEd7KTx3izd16V2CoagkROxnXeO43k7M9ElyX8mKOcm7DLIJAslRa982c+wc+NwU2xq6TwI",
+	"dnA1LUwm/TnJcCVJtw8t5+NCcjcTyyy7CUrnUBT4ErHcCowaeVb4iqCKUabMdDPOpFYDsIx4qXFOVviK",
+	"8kq4QjgYzStbqNvHn+hiKpihSmO2qhhWYW16fYJvX72ewibJarkkUgUldGwnes0HRuZcYZYX3X2WY/Rh",
+	"RbOVqcPqfGMwkkRQImeML1xQnF6lxAtSbNy3kOShf1+21W93ji2jcUwss9Bp4UhN26mIyWJBoFRUsfF1",
+	"kM1+5RUAnebWP0BVLo1vWNE5LajaICpnzGoboJmrUWIAwIVrAUhovDMmOF/Ex+iRnL+x7gm0sBkRGr90",
+	"UQbB2TKuxdlW4phfEXFFyYeDD1xcUrac6GEnBlHkAeznwR/gn9HetTZ1TXXbACu+ptkuo0a5wrEqtZaY",
+	"nOq37UpD8Mk2khIj30KR/Jka7gVj3Ih6VajvwtdOrveJwbkF8sYEw7zgMNV8IO13PQST6W6jSZPTosVN",
+	"3dYeZDueyz6R70S+E/n+3ZHvB0QKO9r4Hr681gTGff0sd0wZwujyz3JLafr9/P7MuNv9/eo2t/Pzczra",
+	"5N73MN37zDknt74H5db30qVgbNEL/RgJl+Wyo1jAiiytb8TO3I3HrjEUhc3jabDnBRmjNc5WlJHa2KSb",
+	"e4TXfbkcficMitjbXJIXY3TxhqsfeMXyi/GMXTwzZVFeahoh9Vtdg7mgGbT8gYs5zXPC9B+ngvhYepOC",
+	"8QJxoQcwKHkxnbGfGBgVTY1u4NxdycycoJwTw4OYVJhoTtQHQhgSpCBYAs8SOya4jP+T8gL3lMiF8jUU",
+	"/A79fQ6LdVnJjfXQbcx0qLPJD42BY9jYL530AtBxAA8tzYx90zhFswS/cznR3Dqyyawg90HJheZbLqg5",
+	"5wvzna2ZicNMuW5XgGZWUrnil4IoQW2pQV7Z49Ekg6rxjH1Y0YKgi4p5y5RNRelIsR9R0wvrVmayldmO",
+	"m8kU7DxH41HFcKVWhCnw+7f1ngy8jcYjZqF0NB5lFiS9q1oIiqYjNzd9tnZeUX+21pl2LXPuFdxXLmqo",
+	"AVXdWxNa9WT4hYArn1hGVzm1eBnWNnJFwi+mN8gZ4ntGYOSH3d1tKTVz9p3HbKa1RGPdrU7Ygm/NGeh9",
+	"7UwupRYlNC/fxZMeaoYMIsqOCyzlmzqdaCmIAQ/rGNWqT2D5HPsxyvTXRjIwqKPRoLapWsmhkb7Ou+f9",
+	"MlqW2lluWX43eh/QiN2OHcHMyfDb/jz4bKf7aLh7sb0adIBnnlUZcoohY9Nj4o6kayur17QoaLhzphhW",
+	"mJBndDSqTAE5raCm8vLc1tUa9sUafCufbxQZPMyQpJp+e5759emLGJc4s8nCvsK1HrvldSDOvRgH5x0D",
+	"s1d4TrbbimIlXlouP5M1ZnhJcpOJOLjJresHMqPU9XBLQRb02pDpIJXleMYaEjDiAhl+0hXmxDZBYBZE",
+	"AcKgQCsEMf4e/wY+VZsgWackSnfmSrvrbvQHfE2VclGAjg3UvMxbV2FvjGrvLLs6TfBBoVMUqBqw+hk7",
+	"e/7sGJW8oBm1BbaXAjOll76m0jqKsMZXdrNcrun5xqptvBgGShe4PrhYowsoiJp5rQr8SQ7g4yPz7pJs",
+	"zNN/N3+DmGGeXLh7LSdX9htF8Prf8UWDrQughuP8OS4wy3T6Wh05G6k+02nT49eqGyLXEtmmybk1Obf+",
+	"Xpxbu5iyO0FE95sIuvgcwLch8M/qXrS74sRAQompqTluyq1gGWQcrpFCY/bcznI0SKMWavas+vc3F5oM",
+	"8cid+UV2b4gn4JANvMOQaGIzDPhi7LqQANsE0c99lb6kejugYPCraLu9iwbHd2Vn3eBh2tJu53GNabzd",
+	"jbSmDQi0R5BUpw9Nddo98KQ+fVDq09ecUZMTx5nAbEDF28Xo6Jfth9v99jmW5GeqVhAD/PF9m5zWHyBq",
+	"vwgN06OI1/h4VInCJ5ONTvh51N9g91jRGJI3rXomw/QcQaGSwLzozcvr7lz2KrLSuu63nUlwp5tLxgmB",
+	"W7HUtIqkMC3X63ghISe36iT0E14a7mMCGESE2ayP5ux0GQDKXhG2VKswUnLvzq6IoIvNu1fnUc9988oW",
+	"Yde7T5isBEHvXp0fnJ+/QvC1vreb2UDCvNEDkKMB4LdElNHH8W+9BvLGPWXKTZg7KnfUMIw5cXo2q0h7",
+	"8ebcvDbgfndG6JzJCYDUxJmjgzoj6/UkgO67OfMtlbGGdtI92BvQpQGgYWrwnmKB1/LuaOh4389PX78e",
+	"uELjgnMHBFgP2dHCacrReYhL+nfSir7GJb0kmzuDmHi9Gv/0FrTMxsUFM8/XlN24xyHqwNPXr7vbrUXI",
+	"ofTqpzK/M6C8V2A0vFQDGKMLkk6wGMR+dr+PXa/+zu/0vfNm9p/+34obnqtlhrZ+WP/Ur41WtPaPQs/m",
+	"kjDlrKRYELD9gZOXcaCJsijGDaHXRwY8l2S39HPbPWsvDiQrq4iFlytcILzmFQMu6Pj0p8awlm22InFR",
+	"REvNdYbWuvjdY7kb7/bjrfG1yfAX2dHX+FonaEDMF2boqwQd295uSog1vm7lSrjRoENH87kutu+laXfr",
+	"rYxRpCZ+/OTM8l2up7/85T8dZm1D9BYeArm2Yw36zNldzAxjCW7cNle9BWR6Oussd15DW/fMLKJ1sSIC",
+	"Nt2vHe50gdyDwu48iI1hzIx8B3aIsV9EbCPenrw47rMdOIKo2yDw482JaObojJioKWHqJKIigF6gQq9h",
+	"7K3gfvIiqrmQsiLip7NXPf342RiGR3VzQfGSyJ6P7cu9alI2Dcl2jeE8/ZjRXS57VYa6arbcsGwlOOOV",
+	"dDVxP6y4Cb9aCiLBCTongl45K1nTSGWKl1iHYJKjWPodn4tyHz986/l9g0+ex4tA2tJ7+3RoPYQih3nq",
+	"dsc1adYV3rs26q3q/UaLTjk1F6mrYOv2cMOPEWf2JXfgAaXKwrxSUc18U+o3sQdaHKiYrbs1JDNVtMKw",
+	"h1Nw3j31XTefn/mBms/DAsTNN7be8Hvvz7y9Gl1s51oAPqSmSx4vUDnqUGiXBHI8sh7ROrigvzhzT061",
+	"zgXvF+uOLcSOELlCvNhKPu4i+5rv7Bb51k55brNCUbY85QXNIqxLpFGP7fmU56huimzbZHxOxuffi/E5",
+	"giu7rc+RjyIIs4D0R5s+Ju9Z47058AaL57HU9YQkUQqq+xlfWg0I1m+G1FJtdyauuPE/i9j64d35/33l",
+	"SIQfLT6Z4IPaeCv7sg72yt/DBnvx3IXOlzyPDMJ4Ttw+9iU5mhOJdLtgG2uKJ6qC1CmBSh5RKJQQYSVI",
+	"/qLScFYf/MmScf/4pcsOGGdJ7JBE2BAy6BMp7l/AAvUDPVWrmJBYUbnYmAxZfvZ1vlIJ+b/ogjo/aRf+",
+	"ZcK8qAKcz1acSzJj2OwC9HwFrsJEmjr9Aq25qD3w6/5Nmv36MypnDGzpfk/cOep+vHvbEu5XqcnI2qTJ",
+	"pcuVkmNEp5pG6N0mOFsFHa8JUdJEyi3CfIZwROZiXBOmJHrk6N2MWdo0dg065xPdsjEiKps+Hs+YvqEr",
+	"RTSZrdZ6/6iC+xmoq+DV0iyGFHZovgh22LjQ5RoFZ2w2MiucjdyNpHu0fg+wyDVW2YrIOuWYLLnBX3jz",
+	"sp7fv+k2M6a/eiQf13u6osuV21Js84g1j2JLBrFnLjivPrdggxURaz9DOANjzzCD07UWHKmyp4gOZ+yR",
+	"PkeTGUsD1YSXj3XZcFYVxYARGPcD2I6kCSX1ffWgIGFZ1O4DOyxJQTKl8ZiI9RhhKXlGIXjWb2Fz481y",
+	"umO1DyQ2ovO1aI7cANT5Bt5+I61b5LbT6e/HsgF+bQ2vD8PCjHUsH9kYnwjMfLShphpY2XpiBvIuyQZa",
+	"Wd6nW4yZ9KRBhSXA59AnQLibEygWCHAIsSvZTScWAlAnDtN9fyPNZPWmrygUSMHGcXVRc2v/qSMEgnBa",
+	"jQonbIzecKX/MaFAY/SCE/mGK/hzin5UZndeqegUTedxBYFmz40mtebE5BSdtKLwIToacWHnYSi2aWz7",
+	"cPVyGGcTF07b7cTMH+oABSvY1l9/Xz+Cy+8rNUb1xzMWfA0x2D6VoKVzjUjnOTFMdSmIxiTwckNWk+bi",
+	"jU2H1PsF5ygHOmzYV6zIkmZoTYRJX5OtpsO1A60oXY117TDddlFdsJF5mHu/K5Z2wAhjQxEg+Ob2xMBY",
+	"MRIxSMQgEYMvkBjcKJGA4TQiBaXheYdVAXLjZPwmz6JJw7nFtXfA51gTl4Cg1CeTJ4eHbYdVyKEecVgN",
+	"dyrgr/x074Z29vHmQ2UnC8qek2+Q1R7px9uI10QhrGYs5ETp2sa0lDw3cO1CZEwj0HFaLl5vt1Zx3GQO",
+	"GcGS2PQZa6JmDCsk+doWYHNooSfh88qjRxCFYrNzYBeC89jMV26kImuj0NISG97AzJXY6NZQg7vCRbFB",
+	"5Ipmyi8R1DxUGRE4LkCHECVjpNkcoWbx43ed0h8aWRF+wgG8PdsukhhxgQsrmXR7jAgMZozG/vMF0EMj",
+	"FD178wKUUrrVO17ygi834epMvhIt0divtew3t9eK3rE3re1I4kHiCBJHkDiCJB4kYpCIQSIG9yEe3HIZ",
+	"XQ7u/f6ziHmllTwfYlrRTGa/ZcWwtBmfFDzDylop9SeNetE8J2Ooyma08xp4gFc2SQVLnj+Sjx8ny0yy",
+	"zNy9ZWaFpTlgQ8r6DTUBOmg0uxc7jT5TeyR6UcGum3nlyOgMSH7anE3oHY3znOSoJGJiTpGjBWV5ZCLI",
+	"Tr6LV83Ot4uEDfy/rfEFmAdHzaLclG6A/lkRsUFQC9xf+w78pFWKUIkyLK3hGIR4MFhpqXNsXrf30J09",
+	"zJlx/V7eRABstzCMmeMDzQqijGBEvK2l2m08YX+ft2AKbbbWWzOF+iNLi+6FN/TzFffGJMKiG3ziPryh",
+	"eW7jt78YLnEwwzZjX774dus0QEEvjcIEv2nMgm3+aHJGaJJpuejwnWWHgm60pg+KHOgNuMIFYcqqBe29",
+	"p7tvk5qxdV/WKObzqs30xs1GY3NjhcAxG50w/cKlFWrAgycTUP1qZsB4NtpFpHaFUw/KnO63IV5x7nXj",
+	"vaNxsCP6OvJkBtg2Q2Hs/W6ueloUMzYnSOFLAkIK16uVNLcOmmaNnQpuBeeXVel2yTnQzRjVHItT58Lg",
+	"Um+2PQibMcQ8h/4AX+zdeNG48i4QlugCKCZDj+DDxxczVq/CMHG8AuDyaR4CBsYvEG1Zn+H0FGQ8r6f+",
+	"jeHMH2Gm6GN/p08R7LHN5ci+UWZYB7GugxmrF+/Hp4YPN9tpk4iY7QPABkJjtLUgB9ibwmdS1HvuB5tz",
+	"ZxupDx4zO6Tbv+mMPSskH7cbNjNhQYLHxneISr0ySdTdEjAdryl3QnO7yVcJ0IyrBNNRmKZyOFhT+WAg",
+	"23vd78WvG56vnQ/Cs4Ng+AlYQbOT8JRK+yJ3slzFgjpMQW8GrtqityneaEViCfx4JODTNp7OGNinavaU",
+	"5W2LVf2J7gutCWb6SnUqjm9k3WQ20kfovPB8p49++/i44XlX95kEjyR4JMEjCR5J8PiUggdrJTYKdzq8",
+	"YKxy18ToYEWz2sznWoWJnO/sZgsvrZ57Lbz8Ole0u9Z6LzF/zXU+3XW/3TF3oaz7xt/jdkYzhaCiijcx",
+	"aGbPsnmP9TohaX/4kik6qVvUOXk1k+l8r2bM3xo1I2UtFl6xX++dhn4iGpOg0qciwhLZCFHEGTLK/hkz",
+	"+GIYR3vQMJ6ZEVxV9RYEemmsTLycdZnhzDLJ+onpZ8Y8DMCiqB9/OmMv4djDrl1xJZM3Y0Cd6vrbKCXs",
+	"c3f7sLe7W0sPPYYK7nfh7tbsN/m8PRift0DaDZ3fZsx4v6FbOb/N2M8rAgBkalOhdVUoWtb2bDn2mTSl",
+	"c9mQLZjUw+FsNWMtIIIOwQAuAfWMSQ2YeuMT57gcYzqkWxnrF3Wdf68EkOiRJjjFxgriDbxpUCrLOtMr",
+	"X1rO5O/29EpbU93F1CakMxYQsb0pKdTc2I8SoiYhDChvTQlNxu6A8MADspsqatuqXp6zXQa7WVPFZIVK",
+	"wmASBpMwmITBJAwmK1SyQiUrVLJCJStUskIlK1QSPJLgkQSPJHgkwSNZoZIVKlmhviAr1K1Dt2wEFFN0",
+	"cBRUeKZ9oVD4itMclZWy4SxfYThUYxtSTNTgmKi+fUuBUSkwKpmkkmSYJMMkGSbJMJmkkkkqqe+TSSqZ",
+	"pJJJKpmkkkkqCR5J8EiCRxI8kuCRTFLJJJVMUikw6qsPjAoB9bNGR+0/kRQilUKkUohUskclsTCJhUks",
+	"TGJhskcle1SyRyV7VLJHJXtUskcle1QSPJLgkQSPJHgkwSPZo5I9KtmjHnaIVDRoSvDrCCSc6sfulnen",
+	"qinIgi4rIxggJxe8eI5M8zKq2NXbOSQmS7fbUprKjVbyPJWWSqWl7j6Cqj9kqn0p30vMlJdifONwgxsV",
+	"duEMAIOtUYWuy4JmVNlTRIcz9kifozHNaKCa8PKx5lTgDto9Ql3DF9mO9KiS1331oCAUpd5ZBvO24VWp",
+	"qm8q5JkKeaZCnqmqbyIGiRgkYnD7qr59zn4/7+3s1y7wO0Z35OxX81cpAfpDSYDOGk59yPj0zditnPqi",
+	"AnSzZPTWRAbxuw5c9oysCD/hAN6e7bBDtJRanR4jAkNEnWh94NaBXtFo6d5ZlUe4OqThEyQa+zVGsprb",
+	"a0Xv2JvWdiTxIHEEiSNIHEESDxIxSMQgEYP7EA9uuYwuB/d+/1n0pbwbmu5uR6Y7b2P7OrPcJcvMl2uZ",
+	"SbntUm67FEuUXPqSS19y6UsufSmWKMUSpViiFEuUYolSLFGKJUqxREnwSIJHEjyS4JFiiVIsUYolSrFE",
+	"Kbdd8nlLGe1SRruU0S5ZoZIwmITBJAwmYTBZoZIVKlmhkhUqWaGSFSpZoZIVKgkeSfBIgkcSPJLgkaxQ",
+	"yQqVrFBfakY7EwHFFB0cBRWeaV8oFL7iNEdlpWw4y1cYDtXYhhQTNTgmqm/fUmBUCoxKJqkkGSbJMEmG",
+	"STJMJqlkkkrq+2SSSiapZJJKJqlkkkqCRxI8kuCRBI8keCSTVDJJJZNUCoz66gOjQkD9rNFR+08khUil",
+	"EKkUIpXsUUksTGJhEguTWJjsUckelexRyR6V7FHJHpXsUckelQSPJHgkwSMJHknwSPaoZI9K9qiHHSI1",
+	"5Ml4VMp1Pu/Cxun56xfP3b3vzlnTlAVdVkZUQE5SMG1fPEdZUUlFRISzMB+eE3FFIizAcfB24JgvniPz",
+	"FbKflVE1sz7cIRFiut2WQllu1JLnqdBVKnR19/Fc/QFcbRbhXiK4vEzlG4cb3Kj3C2cA1MOaeOi6LGhG",
+	"lT1FdDhjj/Q5GkORBqoJLx9rvgluxN0j1BWFke1Ijyp53VcPChKWkd1FOW8b7JVqDKeyoqmsaCormmoM",
+	"J2KQiEEiBrevMdznevjz3q6H7XLDY3RHroc1f5XSsT+UdOys4WKIjIfhjN3KxTAqQDcLWG9NqxC/68CB",
+	"0MiK8BMO4O3ZDqtIS8XW6TEiMESUm9Yjbx1oOY3O8J1VwISrQxo+QaKxX2Mkq7m9VvSOvWltRxIPEkeQ",
+	"OILEESTxIBGDRAwSMbgP8eCWy+hycO/3n0VfAr6hyfd25N3zFr+vM+dessx8uZaZlGkvZdpLkU3JwTA5",
+	"GCYHw+RgmCKbUmRTimxKkU0psilFNqXIphTZlASPJHgkwSMJHimyKUU2pcimFNmUMu0ln7eUXy/l10v5",
+	"9ZIVKgmDSRhMwmASBpMVKlmhkhUqWaGSFSpZoZIVKlmhkuCRBI8keCTBIwkeyQqVrFDJCvWl5tczEVBM",
+	"0cFRUOGZ9oVC4StOc1RWyoazfIXhUI1tSDFRg2Oi+vYtBUalwKhkkkqSYZIMk2SYJMNkkkomqaS+Tyap",
+	"ZJJKJqlkkkomqSR4JMEjCR5J8EiCRzJJJZNUMkmlwKivPjAqBNTPGh21/0RSiFQKkUohUskelcTCJBYm",
+	"sTCJhckelexRyR6V7FHJHpXsUckelexRSfBIgkcSPJLgkQSPZI9K9qhkj3rYIVIfI70StqQsUqf/JTx3",
+	"97w7V01DFnRZGdEAOcngxXNk25dR3a7e0SFhWbrdlupUbriS56m6VKoudfdBVP1RU+17+V7Cprwg4xuH",
+	"G9wosgtnAEhs7Sp0XRY0o8qeIjqcsUf6HI11RgPVhJePNbMC19DuEeoyvsh2pEeVvO6rBwWhLvXOSpi3",
+	"jbBKhX1TLc9UyzPV8kyFfRMxSMQgEYPbF/bt8/f7eW9/v3aN3zG6I3+/mr9KOdAfSg501vDrQ8atb8Zu",
+	"5dcXFaCbVaO35jKI33XgtWdkRfgJB/D2bIcpoqXX6vQYERgiGkXrBrcOVItGUffOaj3C1SENnyDR2K8x",
+	"ktXcXit6x960tiOJB4kjSBxB4giSeJCIQSIGiRjch3hwy2V0Obj3+8+iL+vd0Ix3O5LdeTPb15noLllm",
+	"vlzLTEpvl9LbpXCi5NWXvPqSV1/y6kvhRCmcKIUTpXCiFE6UwolSOFEKJ0qCRxI8kuCRBI8UTpTCiVI4",
+	"UQonSuntks9bSmqXktqlpHbJCpWEwSQMJmEwCYPJCpWsUMkKlaxQyQqVrFDJCpWsUEnwSIJHEjyS4JEE",
+	"j2SFSlaoZIX6UpPamQgopujgKKjwTPtCofAVpzkqK2XDWb7CcKjGNqSYqMExUX37lgKjUmBUMkklyTBJ",
+	"hkkyTJJhMkklk1RS3yeTVDJJJZNUMkklk1QSPJLgkQSPJHgkwSOZpJJJKpmkUmDUVx8YFQLqZ42O2n8i",
+	"KUQqhUilEKlkj0piYRILk1iYxMJkj0r2qGSPSvaoZI9K9qhkj0r2qCR4JMEjCR5J8EiCR7JHJXtUskc9",
+	"7BCpaNCU4NcRSDjVj90t705VU5AFXVZGMEBOLnjxHJnmZVSxq7dzSEyWbrelNJUbreR5Ki2VSkvdfQRV",
+	"f8hU+1K+l5gpL8X4xuEGNyrswhkABlujCl2XBc2osqeIDmfskT5HY5rRQDXh5WPNqcAdtHuEuoYvsh3p",
+	"USWv++pBQShKvbMM5m3Dq1JV31TIMxXyTIU8U1XfRAwSMUjE4PZVffuc/X7e29mvXeB3jO7I2a/mr1IC",
+	"9IeSAJ01nPqQ8embsVs59UUF6GbJ6K2JDOJ3HbjsGVkRfsIBvD3bYYdoKbU6PUYEhog60frArQO9otHS",
+	"vbMqj3B1SMMnSDT2a4xkNbfXit6xN63tSOJB4ggSR5A4giQeJGKQiEEiBvchHtxyGV0O7v3+s+hLeTc0",
+	"3d2OTHfexvZ1ZrlLlpkv1zKTctul3HYplii59CWXvuTSl1z6UixRiiVKsUQplijFEqVYohRLlGKJkuCR",
+	"BI8keCTBI8USpViiFEuUYolSbrvk85Yy2qWMdimjXbJCJWEwCYNJGEzCYLJCJStUskIlK1SyQiUrVLJC",
+	"JStUEjyS4JEEjyR4JMEjWaGSFSpZob7UjHYmAoopOjgKKjzTvlAofMVpjspK2XCWrzAcqrENKSZqcExU",
+	"376lwKgUGJVMUkkyTJJhkgyTZJhMUskkldT3ySSVTFLJJJVMUskklQSPJHgkwSMJHknwSCapZJJKJqkU",
+	"GPXVB0aFgPpZo6P2n0gKkUohUilEKtmjkliYxMIkFiaxMNmjkj0q2aOSPSrZo5I9Ktmjkj0qCR5J8EiC",
+	"RxI8kuCR7FHJHpXsUQ87RGrIk/GovM66kHH6/zt2d747Y01PFnRZGTEBOSlBt3zxHGVFJRUREZ6CsCVl",
+	"pDvES3g+cJQXz5FtX0a1yfoMhwSC6XZb6mG54Uqep3pWqZ7V3Ydt9cdptTmBewnU8qKTbxxucKOsL5wB",
+	"EAlryaHrsqAZVfYU0eGMPdLnaOxBGqgmvHys2SO4+HaPUBcORrYjParkdV89KAiVsHfW3rxtTFcqJZyq",
+	"h6bqoal6aColnIhBIgaJGNy+lHCfh+HPe3sYtqsKj9EdeRjW/FXKuv5Qsq6zhichMo6EM3YrT8KoAN2s",
+	"U701e0L8rgM/QSMrwk84gLdnO4wfLU1ap8eIwBDRYVrHu3WgzDSqwXdWzxKuDmn4BInGfo2RrOb2WtE7",
+	"9qa1HUk8SBxB4ggSR5DEg0QMEjFIxOA+xINbLqPLwb3ffxZ9efaG5tjbkV7PG/a+ztR6yTLz5VpmUkK9",
+	"lFAvBTAlP8LkR5j8CJMfYQpgSgFMKYApBTClAKYUwJQCmFIAUxI8kuCRBI8keKQAphTAlAKYUgBTSqiX",
+	"fN5SGr2URi+l0UtWqCQMJmEwCYNJGExWqGSFSlaoZIVKVqhkhUpWqGSFSoJHEjyS4JEEjyR4JCtUskIl",
+	"K9SXmkbPREAxRQdHQYVn2hcKha84zVFZKRvO8hWGQzW2IcVEDY6J6tu3FBiVAqOSSSpJhkkyTJJhkgyT",
+	"SSqZpJL6PpmkkkkqmaSSSSqZpJLgkQSPJHgkwSMJHskklUxSySSVAqO++sCoEFA/a3TU/hNJIVIpRCqF",
+	"SCV7VBILk1iYxMIkFiZ7VLJHJXtUskcle1SyRyV7VLJHJcEjCR5J8EiCRxI8kj0q2aOSPephh0hFg6YE",
+	"v45Awql+7G55d6qagizosjKCAXJywYvnyDQvo4pdvZ1DYrJ0uy2lqdxoJc9TaalUWuruI6j6Q6bal/K9",
+	"xEx5KcY3Dje4UWEXzgAw2BpV6LosaEaVPUV0OGOP9Dka04wGqgkvH2tOBe6g3SPUNXyR7UiPKnndVw8K",
+	"QlHqnWUwbxtelar6pkKeqZBnKuSZqvomYpCIQSIGt6/q2+fs9/Pezn7tAr9jdEfOfjV/lRKgP5QE6Kzh",
+	"1IeMT9+M3cqpLypAN0tGb01kEL/rwGXPyIrwEw7g7dkOO0RLqdXpMSIwRNSJ1gduHegVjZbunVV5hKtD",
+	"Gj5BorFfYySrub1W9I69aW1HEg8SR5A4gsQRJPEgEYNEDBIxuA/x4JbL6HJw7/efRV/Ku6Hp7nZkuvM2",
+	"tq8zy12yzHy5lpmU2y7ltkuxRMmlL7n0JZe+5NKXYolSLFGKJUqxRCmWKMUSpViiFEuUBI8keCTBIwke",
+	"KZYoxRKlWKIUS5Ry2yWft5TRLmW0SxntkhUqCYNJGEzCYBIGkxUqWaGSFSpZoZIVKlmhkhUqWaGS4JEE",
+	"jyR4JMEjCR7JCpWsUMkK9aVmtDMRUEzRwVFQ4Zn2hULhK05zVFbKhrN8heFQjW1IMVGDY6L69i0FRqXA",
+	"qGSSSpJhkgyTZJgkw2SSSiappL5PJqlkkkomqWSSSiapJHgkwSMJHknwSIJHMkklk1QySaXAqK8+MKph",
+	"KPmc0VH7TySFSKUQqRQilexRSSxMYmESC5NYmOxRyR6V7FHJHpXsUckelexRyR6VBI8keCTBIwkeSfBI",
+	"9qhkj0r2qIcdInWzJ+MRYUvKyDt43AaZl/6dXrD+VO/Wi+fIfNRQyhc026AMMw1XNWLqnSGsWoNF6zrT",
+	"PAiXaimI/Geh/5DrfD56v2v3gjnGNk8qrCpLfEC00D8p+0mS0dECF5J0LoBTntcmr1OY+zl0YuHPhibN",
+	"JRFXJAdyBUuPfNflq+zIwWxgEu05nOhm5vpZFHhpNpOynGbAwdn4H7uxVBr5c74BmH3xHGVFJRURAejN",
+	"OS8IZnpHCizVWzv7Hwmz0l73gF9F2zkGECJxBMkIU2hZv/XbYmRHKvu2JTR5/un7uMlzAIRGen9FZcR4",
+	"29PQ8nKmwxZT7QxodQhbLUmHoWRwDDTGReOS/icRMrq9z05P7LsGXF2ZZ8SMsMY+NszzxHajF/W8p+hc",
+	"b7qQjnxnnF0RAefDl4z+6nuT7j4sTCgdWPkYLgzZNOyDtkgKAvtRsaAHx9++5mAeXPAjtFKqlEcHB0uq",
+	"ppd/llPKDzK+Xlf6JjjQ+yjovFJcyIOcXJHiQNLlBItsRRXJVCXIAS7pBCbLFEQGrvM/eLNTjDH3F6L/",
+	"8S+CLEZHoz/ogUvOCFPywK71IHLmHXr6cTy6pCzvns/fKcutzBXw9/UxOHvl2cvzd95WZo7KQpNvKusD",
+	"0ptLGYRqrmitIUKE5cayrP/ICkqY0iWP11RJZEMSgclBx149YazK+VRLF8d4TYpjLMm9H4/ePDnRWxY9",
+	"oDVROMcKB0zLNvQ9J5kgEWw1z9GKF7lE0vyhuwWwRxkRGkPh0rHlrLnCBZpvFJEOW52sZpiMF/pjw0c7",
+	"6aggEq5/hl7jazPgOf2VmF4SLt87Ljsw6ZPT/A2hDyTaQdPRQJ9wg3YHcDNFL3FmmEA4flB0GsqOi3KF",
+	"WbUmgmYoW2GBM0WEHKNvJt+M0Tf/+AZxgb6ZfmMATRJBcQF7qOdXW+NrEAWaMceS/Ol7RFjGc2AS9KTH",
+	"XeqBxZwqgcUGPSq5lHRebEANYD54bHo0lGdFBJkiF8oOMos7M8V5IaeUqMWUi+XBSq2LA7HIvv/T93/+",
+	"gySZ3qHJ96MI/tH1ulJ4XkT4uxP3aqzZDUlAZlVCQxZhshKOd4YZSsVFrfuz2Ju1SRV6BAKoGR45UuEY",
+	"wzXPQQx4DNoP/WVjUN2x9c1ptkdYAd+j6Br2B/gqI/kxWsR5oETy74fkt6i4wizHIre78430Z37vc/aT",
+	"iooEeuovdpCfHeSm7sQIek6HsdFAojF4TplG6wZlYA6wNO2YohNgP0vBr2huSzGjD4IqMgE8oayslIV5",
+	"zU6bJVLCMjJFzwprv6q1uKHliDpPuLy++DgzvY/BcKB/mnQGm5qzdfcCkLp6hV4BxYg2OfBKlZW1jQiC",
+	"wZnMg/Wz05PpqFeKbYPIT9ZwtsAZLSiIUqXgS4HXa9ACrTDLgcnmiyY9j8BPLRZrEMp5JjX0ZKRU8GNB",
+	"l5WRUg5MTwd/MP+C/CyjYnqEYYGEIBFt1ssrIohUaFnwOS6QdA3bfASneXYMs9nFvr49eXFsW7aF3qCT",
+	"mNB7XhZU/ZUL+itnL96c18O18DPWzAl45zAL5GyAUrddmbY5k2Y/pTvtz8Mqzdgd8koztoNZmrHPyS19",
+	"ghur3s7bXlkz1r2zZqxxad37bt5cUBmPNCmPoQvJGkCbE0lFqAKK410bPTRv+IKvMWVv8JqcV4sFve6O",
+	"9jzSyuGm7gHl8BKUpkia1xpZnTKGLcMWYDA3+XFOTRqjM1IWNMPnROPRiQo0v8Bw0jwygEZ1co3XpWYY",
+	"3a9pxrUH+pqyV4Qt1Wp09N14VGKlMWx0NPrvR7/gya/PJv91OPnL5P2/zmbTx/9qn7z/7en447/ETkcV",
+	"seQyr87dBuifDZLepFMTS6jQizetdl1ilemfC1CsdYc8rl82hg4e6/sXjDQ3ngCeZiIiAx8/06PrYfVx",
+	"54E0keFpSdZoQQuiO1eE2TO8KTfh3cm9/zuVSBI11l2Q+YrzS9OVNG2sd0aD22940l9M9Z9TVcipuWM1",
+	"DF8YwwpZl4oSGYwGpptwaGD+GyJFk6uoASXD06ip+vgZOhX0Sh+QVcl3N3FySTZpI2M6dQuSfnujinU/",
+	"nT71jX7nsAaISFNYtrK6u6LuAK9q0rTeTFQhJ2akncsNlvI+pnUO20aJtyFYd2N+GGRrGHbR3KmxIfPc",
+	"4QM2NkT35ebmhgaQlCQbzmzHjRC9TW9khmhiRM6kPaOkvHxohog4uiZTxIMyRcTO6CdY2CkWeL3FpyhK",
+	"VXf2t5+gbbY4Lm8ngWKnQJG4/K+Ty0/M/T0w91HyqLjAS3JcYCljmv76Lcp9tmU9p1ITO6KIMBQDowwa",
+	"gd8sfASPjcvVKRGSSn1S/8mLShMZa+vJNwyvaQZx0XB2hjWZztiMhWNbJbjWv3tnsvzfuhKIHdlMBWcZ",
+	"Fz4iWmWwuZSht7D410ThqT6YCFelFf9mpi+vS8zi/FWslSaOH3Q0BoFU0ZE56Y/QFXyFiP4sjzPYX5j1",
+	"JQZa5lJ8jrPLqrSHeaMb1/TgN7IGvO7BZRmR0npCdqiNddx703JdLQUBT8TRERgk2wJM211VOgdADVWV",
+	"tPzYvDHH4S6eelqMccNz7+Q3nwVNP45H8yq77BPV3wGTx6vc75tpfWDlDyJgSTvt75EFLLjIyClWq3O1",
+	"KUjQpCEfOnftbeuxTt1AyJZ9wxkS2neolSiiz6+IoIvNu1fnsfnFoXUpcE5MIvfGDV8JoSlXn5wFO23a",
+	"1H79VsqKbS+LntebgIy5XmJfKyyWZPtkGLlWbgLtLgFozUqNQn+YecxuzmmB2Z7I+9bHbbhhS91JG3NL",
+	"ArkrnmUeDwYJYHZe77C8jKGWHXLv/rp97diUZ6W+vXDR44HN+ISXTmZzmhbwgKDLpb0n/Am5faLgAu3I",
+	"TuOoOnOADehA7ppIqalRDD92Q6Em9CA/WEVQDBrtsbnhW66Z5iVSWF56BjvSq/MVFgTn2hGacXVmfwoi",
+	"FQamxu6K8U6Oew93N0cScSxITpiiuJDdDSqxlB+4yOOURRLhdmngYKdErGkddNYcjDA8L0gep5dl88uu",
+	"GmLnNdKB16YztRk7pufqpSXO8u1IieYrOoi7qIrimK/XVHVnqX3alxzM8BN5ScsJLw3VmIAigghz5X6E",
+	"PvV03kS3e3g3V/VSbtZFa9vCadW9j8NFx3aUcuC4cEnXOFtRRsRmWl4u9QM5XWu+8+rJVDMWmgeN6Ezt",
+	"m4Dh9j5VptzHhqkVUTSrc7kY97cVviJjRFlWVIB5hQ+Nu8KC8koio7e2pAhCnVwXoDfSHZhoIs6AEPxW",
+	"M8tj5Cb2MSIGc6YoqyIkxb2B/m30rVU9awyDvzEq6JoqxG2MabWeE6GHB/BHgqhKMJIb9WGtwQ5CFLXq",
+	"C0pmQG0S2Cp8hWmhwd64vfjIY17if1bEayLndZQ3lRJemDovVifmFJqB+gwrM2JueL+CmlaCKEHJlSmt",
+	"AZewDWX0M6n3/djsignUs16LhCnTl8sdNSfIOg8St2V2pU0bqV53tsJsSXJfngUcYDFakA9oTVmltwsO",
+	"V5M8F5Ttjt6piY0E6nbb+AFV0tfJ8SdpttLHeQN9zXDhdqohHy+oAB2/LDmTZIwqBv65G16Z+QiSEeq3",
+	"UvFLwozKEjNEhNDLMbdYVIEgyNqYmk4UWR/zikU0Md023njl4UxWc6mPmykLcnb2cBw2bMimMDPYFcSW",
+	"FTRYoI/wtE8NCDme2yUo4MLutYutNWm92tDvZ+4mJVHFLhn/wHw8oOnGHUVBFgpVDFCK5YivqVJ1RKjz",
+	"cbWJDsKJwulqHZ0i6BGhAP9zkuFKEkSVU0pkq4pd6p54/Ra2wAcPS9vocb0em8iMcQOX7TWZhVB5m5U4",
+	"zTcvcmCmMENXT6ZP/ohyXvub1voWgH3KFGH6GCvpOZ44pHxLpKJrUJR+C82k9iY3Duu8KIwb7hQdg0bd",
+	"W0j0uIIAIe3r22ShAxoh7B/kGmdqkF1rPGphb0xRIChzZj9A0gUlMiAj38jAPhPKC7WBAT62yhpnH8zs",
+	"ShVHOVGacWHEEAvzkaU0liJN0X8CPXDu+UoQ8BnGnhIHXeqzNhQKVcw7Amvh2hEXM/MpOuVlVWCfu4Ag",
+	"k35vijTrCDq/e9eGZJwZuS/bTKALXkwwyyeenGebGM2SpFi8oizCMLs3xib009mrtinIn8ug9Wsl2ouX",
+	"p2cvj5+9e/kC/d27URosk4qXSN/ieInr/q0WkqEn06eHGoIJlqRFbqgEIY6ZW3MOwM2viPvsiftsOky4",
+	"HMQuGfP5saY5UZWYe+lUwJYToMxgkgZtPOeVggj/ktr+0ALTohINpinDkkgDz3X2RX0TGR0kYZnGXmIL",
+	"ZrW4Yb0/cakcXtWUxhvzsDL3NzZciD4DGG2sMYThtTlhqiT62/nbN23S9xpv7NQJyrkhliWXSht5nK4I",
+	"ZC9GICAaKwPpRPN+WlQwi/qVCD6hLCfXGmHRD6Zol+ZDcFkSHPIUnGVGNg0yJcDkpUuRaUt+rfCV3s7W",
+	"Hk7RW8t6A3y+NKYheTRjCM1AKp2N0CQANv/QElKnaqlLu+kP4TL55fD9dEAPhiUxkydMCb2DrovZKG5y",
+	"9IJ0O7HHqlpjNhEE58DgBa/dWZt70v4BmzBFKND4WybUIjpQxgmwQgiDF3bDBSNkfbCMmv2RxaK9J3Wy",
+	"aNg3bI4ee4cDC9BEJ89f3zmavyAK00L+4+ppH67bFo0EULVWCtVYaTDs9bP/1921801wj+hdtgQj/DxC",
+	"NQIOT2PzGex+jdQYnYeSlfe4+KBHr5HO8zeSqJplgKvRpEtyyGMzLpmkuVhlK+uYagLlXVQ2mGl970Y8",
+	"svwHllKbGKAfzDZ1KwdvcLia7oENd4y4QBXLiXCDxEydlTS/utQNaK/PRmIIkhPG7FHFiu+ZTXObaWjx",
+	"VCdUgSQ/4VtDjdxZmT7BIKjHbeRU2Kbf2/uqiShaIANXfBfgVbDVbWof2wIrkYdrnQ53FNej6jd3MCh6",
+	"y2yZ09I6Ypk9z+liQUTtR2KFGpLXQ2hHls/tFsJ6zSD6ze33Bz36UEs0huyYJDHQvZERnVXTxfI97qHc",
+	"SmyeLRQR5yTjejmxTNveomxC5BRdw7UrzSdoThbcVvH05xW4ZhhdRD5F53xtCbzzDDLak9ALCOiPwpcE",
+	"LvUCJAJFEAbJBk2s7pZL35Fq3l6+zxX/gApuDK4fMFV+lvjSB0a2uh+UJn08qmgE+H86edE+zWnvMfnz",
+	"7juqNvzGI48qScRkWdGcHHiZSsg/VDSXd34Nbrn/zNKMqsZe2PqUtCW9ka7PtjAaLad9Sm6E9+1GmPE8",
+	"JqZUy6WhnH999+7UnY1uW3u6GsozRoda42eVFwNxxF60d3gHBnxYcmK8YyfGW0gUTonvVDWO/k93uUve",
+	"Giy80eJWAsiH1aY1c+uZoxc3G/1g+MDZyC70FpIJeuY49azAwmYiYwb97C4C+ukC6DknRs3Jr4gQmsuk",
+	"8SyCoe9/hDI3LO7UMFaa6zhCs9F5BR4qWhYV4UrvHRxlSTJQTtnJD7iqjOtFJajagCuruSqeEyyIeFap",
+	"lf4LgEd/NIfHdbd6DaOPug+9pu5e/QHpLozhwCSl1YHPAQYjZ318dnrictmhC/2R9s2Eb46QmYyvvXBJ",
+	"GPwkF2gFgrNh6JybKjTQYFYWmLKJItcKdBAm0Yh+Z5kCPrfa+vnG2j8uiJlNpgrbVBBJ1IVlJuAPcy+a",
+	"t6CGEZQpiai3IMlMEMKsIZ8qcI09JSLjDPvVGmwMjI1HoyfTw+mhTbDJcElHR6PvpodTfQeUWK3gVA6s",
+	"NX3idnsZy74CSge9n0s3W/uZESidkq/hsUZkjU4ORe1XZiUezk/y0dHoR6JqPeOxaXdi7MZOgIYJPz08",
+	"dGZDYow2kD/MAMPB/1jCYndjB+WKDwjA175/AfsWVVFjp97Y7+9wMi+F4CI2+E9M9gz/x08x/InjoKzi",
+	"g9iG45Gs1mssNtrt1kKDNfQrrOPhfxnV+zt6rz840NfJhK5LLhQRcje4WTN0Udh0Ce5LB081m70NtPTd",
+	"o7MWnPiBx6PAF/Dol/b4P9BCr6Y15nyDZFXCX3ntjeKS20HmoWcZJBcAA896jSeS6HF0+8JmlqW6f0jW",
+	"PHKS58j3anxU9PTqMxvuxyGNUx0wfKOP7+8Rb8LN1JubUGZ/lNH71oKwAHP0DiO3xaP3H7Ubir1JJo4V",
+	"nljwaSGVwzMNnROLFfJgXhXGz4vLbQjns6e65OpWlA9yh4Q+WJFcqGDi3SDcSlw9njGcCS6l8Q+xdoEg",
+	"GTd6twq6xYLUXeM1aAZAj+C9P2jDnVYQnI/rNK121rqNvfdtTCDoPe0oyGFnsRkjyfV9q4UHgBzbHLRa",
+	"9aR0Tgita4R3IBlL7w9RFT4erx5dEEsu2mN79qGmKOYrWPrRjE3QBSRdvjhqnAlYcl5DTuZT/VoTQtvQ",
+	"Ve8KhoBOKkku4Ia+0LNck4sjBA/hpMwjGfnQ+CVfHIF6x8QisklO1ron887rkT0vwG3oUMPrWs9wHrpy",
+	"Q0yDGSQnBVF6RuZHcx5jYx00Fn/nKG0lOt/5Al1kBcGsKhvu4hc2EmOqjTwvdOewt6DfcDwhtq6XKBME",
+	"1ErW8OyYydhV8rwqLl9YJLCXnvE8HRn/LyLVc55v7pTSBmPp4c/MMDG6864GPocJXYxVHCBqY/jLUei3",
+	"Zp3h7vXe6KzGjJWukP2vkGdwjJgFRFrqSwIX3WNv3S3wzh7DgOulcZfADVNwnE/muMAsI2JiYxL3Yeh0",
+	"B8h14OKU9+frXnGcP7e9+KD3ewPg7miJ/bkF+xOFgQBS9XYjt9/Ipbf6ON7FxRiCLhFGjHyIjhIDp2P4",
+	"qgeg7p60RwbqIemxBXg3K3CjMQvOPykxHzb/hAc7JGfDe8SOeAgi9JPtOIHuJd0Hv5kf8PlHg1sFUWQL",
+	"ljmeTfWBaKuULkEXzLJ+HdwDFi2Oe1sl9TDsJIrnWp2vMWTBK5ZbP4XXVrH9i/Pvee+66E7AGaCc6K4V",
+	"Z7XkHuxZB/dCIb6tMr1P4XxPM2HC2b1x1gDrjXF2oIb1tij1I1EJn9I990Bw5keibowwZbUNYYyVFsqw",
+	"3RJjTNj57wtpHjZfay3wia/94vDd4NIn5WublcW237LGgyas3Vh/jdaY4aUhGNa62qd9CDJC3CNE+lH2",
+	"UzY0zuO1XRMLZ+yOweTXM97LO7Y/+L655we/+d8fD4yudmK1tHvphZraY2mLuFkFuzWzL8A+V5N0Wz/W",
+	"0s9uD9Gza+iL5RAaD4uLjxKnzH5H9qLL4zg01NM7AMVXWDntfs19zZ1KGq9baLxasBngoNlkZHd5fy1X",
+	"s2ewL337rQuS+fZbCJO5uLjQ//ym/4PQzHt4zUZH7mEdS6O9juR3Dodno3GzgS1KqFtZWuGbfBy7AWRJ",
+	"slbnGtpd541O65w05rX5+0mjjU/TY5qYP/9hSmDWrXzeFzsO/NlpZRLH2BVUk4wwJXAxeTIbhav46Pft",
+	"RhuIf60Eucc9hP63bqPP2rN1J+0M/4EziFH7h1nBlj1ttQ83t7tx72rnfzCxZlgIMFxcnORkXXKIeJz8",
+	"nWyc+9XYuketwfZIFZJ4QVykvI5PfGZ+BX73rhK4u9mtbzdQRO9ZJ+iSajR1k3Gfz1g9EzXReQvxhuRH",
+	"UGjGzQlRJhXBELQDqOf8Uy3HipeYsjFYep9+j1a8EvrqOSPGDwyqFjuvMhMYYWLRzEQWEOgCr79/+tS4",
+	"KcMK9bcfVrQgjQXMmPsQHH9NaJBuWgquz5XkjR4P/9Kv724Q9wd0C96TdBJZtE0t1iOkNFf4+dXuzfNK",
+	"9/BNNe4dyN1yEfezw21GdzhPfPDbzTTtLXjsU2/0aNj3xvZ9EX1fTvfz0pcGjn4fi7tIuDRAE74PLg3U",
+	"fsfAPKMdOHcOA0t6RRi68KAQQYAfiUrQ/ykU5umGugNd+T4oBf5/AzTke1wf6C0rzIO6hQ0wd4HodRmn",
+	"HkV6wrZ75mX70+QO42XhQOQ+Z5043S9QB//JOV3jRjuxID9Y+wsBQg0PXOkkLONwXWcXiPvxUtaC4lYO",
+	"1K4O+BiGO3MT3YNGhYTgi7iVG0tNOtxb6HBbMBoglNlj5OFpO0a10WQ4RgWy4zAzVxe1+m5+EykQ8NFx",
+	"z5IGNH12vBlvG7G57jviJj4ZpiYsvRn/3Dn1z4SjB+Z2IsNir3RLiTCy2bHbOKtxk1yTrHLcfJ1RJwga",
+	"f9dF9rrwuh3EYz3EUn1YcX/T0qix26QpJwntE9o/5HAYDaMPB/VNjpkBmG8a9iN+DCPP4JuEkAkhHyxC",
+	"GhD9DPjYDlmb2NjRAajYdKpoh9E5WbojaTY55mTxfsgW73YUKhzpw5D97z+A2Cy2Rz/YB+6f3eg9eBV9",
+	"JPnp4ZNPP5ljy1JbQm3m8fTTz8NkJSF5ups6XgA9EN9Rku4ZI+0vnBtcUjd1DOhD3lsoeox592HSy/E+",
+	"FajsXuwZiBFd+PZYjNubpU4WpoKoyYzvDVMkR1UJ62okwOjJKBRLiTGKTKOua/dlRiTeJTndye6/a2TM",
+	"tUcsvflBpwxsqV1WWKI5IczdmdNEgTu+I3tR4IHOI/dACn8kKtHBe6SD7x8y95hQtlasPySOSffMBbkD",
+	"ud72lAT7L0Kwn7GTRWj/gFNwKdIuTgVZEHFktyyfYLlhWX0cmDWzBzvDh+JICZxdzpjupRR8KYiUzZxu",
+	"U3SiTG0d/aWvd2eh5uKt63dykvu91uunSkJVJspmrNXyFTd47NoP1lucGZD9nSgu3GqHai4cQj801cWW",
+	"dXwG3cWW2Xxa5cWWiSTtxXDthfA0wV3GbmP3vI39zXqT6/jONBgOie9ahfFQSOd+vLvdjdsx72cNuvgl",
+	"cO8pn9HnksS3U5ObyuJ3gNRdYTxh9Jcrj9+AJUqYu0Ug3462w5Ip3RfmGpf0hLyfAHm/DJHsc2R4+kpE",
+	"skVVJFrYiXZ5WDLR3jVOmrnat0a09OdFGiNpawpAVXStZ4M6Zj9DaWrQc5oS+oLUNXrGRjWlp4NcXhNk",
+	"s4dIhNGF/k2Z1iKaUkWgwzT6N/0hI9dKD0ZAU2fnR65LKjamBiVfIFKuyBpSTdVLjOjR7JFMS1PjaJrx",
+	"9QH0ROQEK33RuArV28q9BEglH8LdMiSpE11TNRrY+Niex+hmGaOGfXTOhXq+GXXvxjNbH9LFDnaBly+C",
+	"0OywTE6P0do0eac3LtxJwqq1xtryOtOnKNf5fGRyIy0Fkf8sRu/Hu2/y9mwN/DeCx23JuJ7J+epnD4Jl",
+	"TvFbtyy6s1dthNuZlrbScFeaBJWCK2LKOFhiTpimykFp4ShZzG0Hk7qDIzQbaXI0G4WUcjxjXASdRT68",
+	"qItkcpaRRp23jivDjMGFqy01XCAIQXdWJPdNKQichRUnIkutpwdXlQ1TsV4UEInyyqT202/rxrJeRynI",
+	"gl6bQurBvoxRowKvnqIpiIhyvsaUwdVnp5fPWDC4LfbOhZ1GPkbkOiOlspVWia+4F87HVwNGKyL0wb70",
+	"N12XMNoDtjsJdcWI8ja46DErVYSHOWNQ5D+vrBnrEZkup+ji/zxdXTxGXPT3E79F9VWOGTr74Rh99913",
+	"f4HrWiq8Lu0t/u7dK7CUmaK7xla2s3tXTblGhNrWxkWQPuAZ+oAF08snV4SBHZCsqYKtqWtVu17sEMau",
+	"CGBKjaONeZGPG62pnDGodgRjAghCqaWMC4izsCWL+hezmZS8oNmmsV1tRmE6Y+fBCZoPaw+hkog1lRIA",
+	"RXE7i3CaY1+ux4djSaL0woA70pMF7mjGdk1WEjWZNyY7RT9TteKVQpIv1MQMrgd0Gxaej92gGYOLki5M",
+	"oLWrt+Vn4mbrN6AugdoJyl64fTcFTdWKiA9UErM4dzhmA9oFmOBbqqT/vgFCGodWuFgg3pjmoh6aSjed",
+	"PNnuvyyn/N+JVXuw7uShmbEfiLJkmJak2Nyz9TqZrW9ltr7r2mRDdTMHv9lfExOvGUSJ3VRl46sU7nAk",
+	"e+i6myFKled2u74otf3t1PU7CjEE0JSUQ1+RusVAelK63KHSxRHKz+E33CH8oR/xjSm/6wRYb9x9P9xq",
+	"+jVcDmduS9PtkG6Hr/t2sKCeroe7vB5ETT8+h9n24Ld8/gav7StbXX/yP3y+9x1hy/sj/a1XId/icugl",
+	"vicwzt/4PNFcP31ziA/K8c0f07704uEhbAe08R2L9g28uxn6mrolewWImU9ujatDVZ3nZoZ74Gxkk+8G",
+	"9sefn1K8hR+4QCwY2p5IQ/s5RScLsDhoZT/NwYaABGY5X5tvXe7iJWFEmOjrHm4Cereb9ck1wvb4exTB",
+	"5u3nV//2zzKxN4N0nh2yYvjc/ejlfiTwjoJwhrMmNijz4mQxeY1VtqpNVtLktIj2T6WRBJxxli7A5nfx",
+	"8h1eXqC17giK+L3o2NHBqhRzK3CuE7VJ33Y+RpKQAf4PZjGBwVTP0vbavwxraNYbs4Ycm9a6rASWK2e3",
+	"m/7eglejEViJRU0ZY1LGmC8jY8z3T57e//BRq7f3KoFbIH65fAmRdLukoJuG0u2nUrY3qkNaR+JXvMhN",
+	"/1dEyMC9qUMFZ+y8vhHzznujdtbHBWQS7smN02ALogQl+lIEYuSvxWHhfem6+BIC+QbT4fHIwB5MSENl",
+	"30C22QG0+fjx89PCBx35t9OPeUedqBILRXFRbHwYIL6F9sM6mf3t/O0b9JqIJUGnQMUfaTfT//PdX/70",
+	"eIp+MGWGpBHuL1hVFBfWNRc46FsLFWYh/UJFh/bAHBP1+eSRiGsNIROA0H/topHt1sytj33oQJrimtUq",
+	"Nk4Oi+DLw3eg+3JpZeIbB1NzA6970/NBodz486l09qa+0fDwRH4feCD4zbyYH0DkdyLCiQjvDCD/fN7J",
+	"xjmtXuZu1wOvKrjCgvJKovrjPnp1t3l4juvJJqr9BYjswXkl297dpN/JQhR4IJTj4Df/+x/mXcGX+9AT",
+	"3dwBv+8qQjqaw1x8YqLzii8T3bnjEtmdU+8ZrXnytxv32DgnEwEnBJ4e3EQEG4FjQYVUzoW5DrEveQ6A",
+	"hahE2hLb5/DhPxztNatzJQheG1SwLtO8ksWmZ5QFLwr+oTFETha4KtToaIELScZdm1r3BKr1XJ/zAhWU",
+	"EVkrzwnL3cnAhBRHcsU/9MxFYVq80h00prPG13RdrUdHTw4PDw/HozVl9m8/NcoUWRIRm5r14oXRGflA",
+	"BFIrrA+CSrTGbIMkyTjLZc+UJGUZOfdNglntN4sfjpsh67ATCgtlZqY3bNsM3tGW28+CizVWhgaTiTKv",
+	"d9tgWVZUOamnAf7MBV+ac+s7Ft/6lmASnoUHkVKQK8sE1ogiFWZZnxHYfXHL2bw2cIXmG0WkDZeuBOsZ",
+	"tKBrqp7rpn3A+f2f//h//rQTQHdzTYpcq4OywBT4A3KN12VBZPBb/7zCRaU7fnr49I+TwyeTwyfvnhwe",
+	"Her//y90rgFLBzgbpmDGuq2e/BfSDpIEEhpwho7+fPjnwxkznEMvsUms152yXoAJn539EiQnTFtU9uG0",
+	"gq/uxV08wj4F80zM05cgtPkDS5TjrihHAwfuiGxMwl5vQkEiPop7UJKYZ+QnkcdcUqvTetZfFF358ihC",
+	"ZMe/HMrw/eH39z/8G67QD/qaePi0KIK3t7MEGsdlCRmwqDS/749AnKja01E3rLNfIcWt1afPv2yYQTDR",
+	"l09o3BtGWt7tB06f0+iXaOWXSSv78jvfgFzet+SXU7xkXCqaDZD8RMUkWhFcqBXKViS7lIizWxFhn5/P",
+	"rQ5y1wlSUKL7Hpt8l2GGulLweUHW0kpSLh8dFUjqnaJqA31itKJMGY3OmuTUUvK1z65n548FOZqxCboo",
+	"eT7Rh59XBWXLiyOtopUmW1+QbtM0MCkard/7GEFGyznJcGUS51Emq8WCZtTkq3ML48KofUlWmWnm9rKZ",
+	"wgSueFGtidQjEyFBL6OQeYiyAtO1nY3zXJ7r9YefTiqJl+TiyH4UNidYFBuk84uNEZZIkNLk5rBnoxOQ",
+	"FkTpqBvwlrmkZWl8YYK3SCqsZLAZ3k96PGOouQlh7lGLJ/ofmhFIaFmZ1Jm6n6XATM/kYknUhYOmC8Zz",
+	"Ig9Kwa83F34HXYpD3eKvpFijbIWFqo330JXZkExguZoUnJd6P71SMdgSaIF0C5sWdoWviIlnuKSFPuFg",
+	"LRskMEO8Uvp012TNIaPiBF0UWCqb1uXiyGi4sVS+Fn0Pg7LCEpINEnt8JVVissQwV2McoExNKAOVLqSy",
+	"vCJiY1SmME3d1ny65owqLgzE6m/rBwgvbYpSADOfFTFoUUeKmd5MWuuJda6/OGpO37z1rvdwgKjgbKlP",
+	"tyotSHUSlrr2FgAGymUBWUqKnju1ktXGUUMBNYmsWNNyhYsibAJuzhXT0yLXZcFz4saO2qz0Rw0FOWRN",
+	"iUzQq8axEHjzieXQAMISU9UYvh2+WgfKfAESaYNwfFbWClJE76NP1+S+oIzcVrIdIy5yImwzuiZG1AW/",
+	"dTPS333GcZPIutflagzesJoVGveyJWNH2k3u86qUxvpsAJcLf4HbQAsbUMUkNQnJ7dAmU5JnCLW1tdkg",
+	"knjHJnmebxy3AexdsLpLQkqzZLtOk/sDDHkkt8U+WLEZI7UpaQYRJZwRSEY8them7TroCy75J4eH7WUQ",
+	"HHGNHnbhvbxKnmh3e9dZQ3Nw9sD8rXBZEkZyhBcKHAGoRNZ03mt539/q/gkvMoCcLyyjV7rGdlxj5Ooh",
+	"eOXd0PPOc4+13NX2zLq1jy/CEmGkxZqCIHPh6FIWmgDDFUqlrbcRliXR4ndwvzhPE6XnZT2WrDR/8ct6",
+	"M8nnk/I6mxwelNfZezSdTi/q6gfGxwkLErlrIcWl8alyGe/11oxbH34QVCnC9EpAxsQQTJgRemWLAEA/",
+	"Fzn/wAqO84tGIAvstfnCJseADVFYTJe/IiyyFb0yWTDhPlvoi6wkIly2T/Ix4HpK7op3eznp0lExrFBc",
+	"o1OITQ4gy+vsAnGBLkArIv9ZXHSdDbsI6DsOQWWoHOe+vpkst0P4DHQynTUPWZn//EYri3lV3nxl7Syh",
+	"BsftKIYEaNw1/fW4YELa3LZb2SvClmqlHcuefj/ItU8RUQq7maZLQxcEWVYFhpI0goDSsWcegizJ9S2d",
+	"6h6096lj02tCmPxRf8/+qM8K6bw+O+ED3i3VOaPGqJcRKq7AhKE3z1sEjICBjar5s7ixgrTbhPZ79mzd",
+	"JoUZlmU/LqVnuo4n2m/b9hPKlr/SsikCePieU4ZhOh3g3s9xt8tkDnPltd+tMPACk8MD++s9GuDk+913",
+	"h39KTr6fRIp7CK692ri0hwx36mxP7xq2J8oW/BP5+J7qCSdR4wtw5YOTSrTiRrRiB659bqrhHB0G55DX",
+	"6/Ef3YfvbqRwx7mfZPLZvVdEdxud6kbcZd0IGYCvQ3a30/sVc3Y9GWEEcvvKMdTlWRu/I5nh4pZJkxFW",
+	"3kgpbUro+QbEG84CvYJOvei9pEw2X6OjucIFNTl2CnpJfM6fXsOjkaGWmDJb3OefFVe4g8bWRQxbjxo3",
+	"jPVQCSrShkZG24nbt8G1Pv3xJCfe+6Y1Btc+m+ttcxqJ3N1B7UyPbn30bo9wpZp23oq7OfjN/dw/+7z7",
+	"cksO2mEJxn/XRGXrmAHARMYK3t6Ga/q+e94JwW+UO3ongu/wLg+8vHchF1J8SdSKCKM81E1WVCouNvoL",
+	"qnSNfZJVquZBhikfEi5+VlxM1/mXotrcherDEn4OukfRu17GHRmDGV42nABBdKnjFMxg+eCYvUQDPjUN",
+	"SEJFokI3jan7bEKFCYe5WSFm++2u0vtblaAv7fifm0p9ilvcrDWpH+9C/Ug83HQsDGabh6KN62gPZDmo",
+	"yqXAOZmUBWZDMackDOLJfDyB7aRVh9kPC76Tz3ITPaA9+seIKoRrPw8JEQASovlc50ZKsPWdQY3KiClW",
+	"NgeXBG37JzmasTlZcEFMjCk4eJjZQB/1Jru5urkYDeTVk+mT6SFMx+om12vCcjOOiTW0K9em1s56bXEc",
+	"XuR+WKJbG/1qTkpBMuxKrruakrY8hR3+6fQwLgf9ZLo71efyNVOUcJ2JlNxIFnCQVxpYcVTkrQVX+ano",
+	"x4ErTjagZK4nGZFr2CNa5D7uEJUHhci/t/qMz+DAyYOjVXcvvwRLfOagPIKytqAfQFl9D8Witj2MD81n",
+	"kujiftKJQeJt2/5JCWVdUnffin125nfj02U5yi9Dk0LcZL8U9wy7u4mPuZ1O05/7NoFouELzDjGpqZ/8",
+	"nSPT/WkJ+/HoYdcGSvh/V9rEQSTgbq5q02SyIFhVgsgDWRZUTVZc0F85m+RMTjLOFnS5l2bxHDr5q+kE",
+	"vXhzjo6hEx+5ArIN7qhKohpG6Mz29eLN+bGdzgC6A506UrBzTtMvRWkQ3ZCkjbyFNnI3vE5DhX5s//dz",
+	"kWTkwwCA7PUDjM/gC8CIu78041vRc3fuXHHzMvWl5D/lbTp4QQmzB/n99Z651lKcnr9+8XwYbvdft+YK",
+	"HXCD3sU1HIjSe/kH7gb9HsFg2uM2eGMadBfk5/YSwoPiDb4cp79PkipnN6w+zNw51hFxEDTtJjgDNWV3",
+	"iNg/EpWw+ovh+FOCra+Damjl3x2RjBKrbDVQL3iHdMOoL7460tFey5cvF5mDOtUHIu9IRnLurElGSvTw",
+	"TpWhd0QS71dsq7OXT9y09lKU1t/vUo0aHw1BZFVACQA0dym1avpc4DkpvG9ErO8+L87Xvu2JX8a+6iSb",
+	"Zl4qLvDyrk08MWirp3eg1/BKr/6cFCRTGqbukx+LbFfSv95C/xoD1QC76+3eX8sa6do4T8XeuKvNl2G6",
+	"0KB4Ya86SXRC5+dYktyXhrDvDWqWJFM6g9Ql2ZhAMENBKrPt4MIpG32dV9kKYTnWtS2gqyNUrtcXkGSQ",
+	"oQv9GzoLv9TuNzR3eURxcwxfT8S5YK3xBtywdBkSdHGSk3XJFWHZZvJ3sqm9r0wpizW+NBVPJF4Qm7cL",
+	"aks8M7/q6Dap2TY9szBKzqGbIwhc0CXVx+8m4z6fsXomanJGygJvSH6ENB1wc3IJQXVncKLOlcgeEYTi",
+	"j0GJ9/R7SJCtidsZqaTxffVngFFOFwsiTPUT66CEaSHN6++fPjVpVGGFdV2KcAEz5j6EvInGA043LQXX",
+	"2EXyRo+Hf+nX3HcpxwOis/fEinbXbPZiOx/6uh89G9r5T8p4Ro4v0fybauYjBLif6PfzcVEebE+e7aZa",
+	"9dgdsqce/WYUYQuT98mE5Nf7jJ3U5Hc+fIxCPmjFeAtYGd6G8APV37fCwB+Juh36vf49oV+6RhNux9XX",
+	"e93k+yipb4XdRpGU7tfPze0P0Tqvd3H7n0XPnOjU10OnrFr5Mwkd/mT2SmFaf2WCgCESDkHk3Epwxivp",
+	"cxpujRV0KUuIrzfQiLXLidBVXuoqBXWV2DrITjesI49B1RRVJb+tV/o1R+76ZSbF7y0UvzwElmZEGjzc",
+	"joTB14NQb3AYWqjVrDFleORMA9+andwluv1Iamx72HE4PJjmQw9nq7c03fWN4f3GPGBJJAS0e6InkPh3",
+	"PxriMoHBp95iQ3JfwyBybbdyClIlUVYJMGNAZfUeguCliP8L0/yar+DmUn/Sm5Iu4v3RppY7/2lBxiHO",
+	"j4QRgQtTAmA76tS4sg11lMBy1U2Eu1dWf75QE6OCzzuxkNvYYMNBZ5h5C56+dxUX8Vx8kNvKDNNKm/b7",
+	"yHHlKi0m7vYWSa76wPQT1dPoQbd9jF0lEWus96XYeMMX3o6EcF/xSqEPmILVXl9y+voSRB8K5Uz3Sjnk",
+	"diEsin2nlVi2E2GmshqA6k/vDMCPV5gtiU3a0qeYqyUX7xTjEh1N0TOUQR/esWKFJZoTwurQuY/jlNT6",
+	"JgQEMKCXguwiIAOMZ7uweL/rUidZid6WCWvv94JOQuqAFBk5JxKEVnINWieBqPnbQv/DSwZzQ7z/JIzD",
+	"gSUEAxLd2ZY7qY3Jqm3/MEncZKUVXxUriASfxA9YmlpCObJJL+1D22eMKp2Z4RNJSiTpgV/3FlI/GeJr",
+	"vp5KOcwgFctT6z/3OqxKOq6BelVVsdFVR5eQjBF8k799aerOHn07Y8+kxnH41hTd1sLC2fNnx6jkBc02",
+	"xi9XdyvRBS5o5nTtcz6/OJqxi4uLGSvHSPCCHOXkalxjK9Qbw/kYfdtq0U6NM0bfjtG3B73N3KY12s35",
+	"fGuT5RjBdOse7WQ1kdMbCkk0gyrP9fLbG2vX7Vb724whNBsFrWajI/SLforcP/r/ZiP4bjYah8/q7Wm9",
+	"0HvVevTtbGT+fD8e2Ht7a7sdNv8+uMUQbs/3GEP/837GPtqdfMbyXVsfgtnwjZ/z+f3NOporWRJxWs9r",
+	"dJ/piltDJUJ/s5TFmlKWjSNzxP1ZpVaEKTsxNKsOD5/+CemnOjANHo7efwQKznNXIkB7IQDJpPtFn5U8",
+	"R3UXyHXhlKiX1ZwIBiqfLUXEtKbrlOfnvp9TIN67mKwXrbSEml8xt8cpz1HdGzLd6TvFnti8IEjxaU8t",
+	"dtPdO839hOwQYdVa7295nemZyXU+H5lIoqUg8p/F6P14N5tmq8e7SzA+UVuBXyKsUEGwVOgJErqqY8+E",
+	"V1ie2bKbHe7tpsXi94PnyOklte8t1L49aBVgeRRy9o9tiw206Y89imPpffgAxkbqkdWja/j8gT4DV5Dw",
+	"YVCkT/SQB+FDv1zTd/9tuRsPfjMjT24W7BMH1T535N56mze4LEP9QBzp96v5H5nC9rr/wb49GK0D5dPL",
+	"P8spLukaZyvKiNhMy8ulfiCna6Lw9OrJ9BwKtf3j6mnC3huH7dwcewfG8NwasX4kKmFVuvgemJh3c7wZ",
+	"lt0d3x5xbGjG7w13HjrH+zmyuCfEv8swk0/N8bq2co8aKxkucUbVxlSPu8K0AN2K78rh5t8H6YF+JKpu",
+	"aE0TZ35W9wi4W0ZN8Lu/xGZtsCI4Oge09U5bHaQkoMAcJElRdoULam4u5w+tn//t53dI8UvC+iWmczvM",
+	"rRICPP3LJ3A+4BytMdsgrBRZl0o+qKMNd/0VX/JK7a143qmgolJWXj/ljxbsKdoQaMLu6siXYEo2bMan",
+	"NwIl+boCp7IrYyW8KPiSsgsgXHNaULVF2RXCzD0URJNEHAuS6x3DRW9UK6whC9rd9YVeCr12ZfX+sNdR",
+	"hwP3xHAZX5LP0O8WbUlWCao2o6Nf3m9BYspuZDySRCnKlnK/MBb3lWMM3FwgArYoTAayaFZpN9x95gR1",
+	"YwwG7i27HEy4JxhC7+IVEe76G76J9qP2HupmBghiNO0/zUcneux73EM7zH5b6DfNfd2/Z80d/230nGBB",
+	"hAZQfQBaNjNbYCTOShSjo9HB1RNI5mj7bO+x3r+NWumLRZDClwxtsq1B5IblpeuXo4/j4X22fW+CHtuv",
+	"btZvXUa93a15c6vZIutlFHRvn9yu2+eQkS7o1TzYq9Pn7ax2ja7QuX0+tMs6Pr/uKgjuH9oNblJUEJQa",
+	"5NR3PoT2dkcNEUSs7SBzXqle+lqPGH57G2BDb4OqoLbv+tHQjr3zgGb1cFFA/Vy2RC+ee7fOkpsklozn",
+	"IQjGReF9FuQCEjRNzYlUojJ5OBvR5XY0E/SAbNTDfthvhW+S+6wLnG0jCXZVe2CXzu+gn8VSPLRPB559",
+	"fP/x/z8AvAmK3dyKBgA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
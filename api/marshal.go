@@ -12,9 +12,8 @@ func (e Error) MarshalJSON() ([]byte, error) {
 		r[0] = unicode.ToUpper(r[0])
 		*e.Message = string(r)
 	}
-	return json.Marshal(&struct {
-		Message *string `json:"message,omitempty"`
-	}{
-		Message: e.Message,
-	})
+	// The alias has the fields of Error but not its methods, so that it is
+	// marshaled without calling MarshalJSON again.
+	type plainError Error
+	return json.Marshal(plainError(e))
 }
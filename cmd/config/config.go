@@ -56,10 +56,19 @@ type EverestConfig struct {
 	// CreateSessionRateLimit allowed amount of API requests per second to the /session method
 	CreateSessionRateLimit int `default:"1" envconfig:"CREATE_SESSION_RATE_LIMIT"`
 	// VersionServiceURL contains the URL of the version service.
+	// Use configmap://<namespace>/<name> or file:///path to serve an imported snapshot in disconnected environments.
 	VersionServiceURL string `default:"https://check.percona.com" envconfig:"VERSION_SERVICE_URL"`
 	// TLSCertsPath contains the path to the directory with the TLS certificates.
 	// Setting this will enable HTTPS on ListenPort.
 	TLSCertsPath string `envconfig:"TLS_CERTS_PATH"`
+	// SoftDeleteRetention is the period deleted database clusters are retained for before being purged.
+	// Soft-delete is disabled if it is not set.
+	SoftDeleteRetention string `envconfig:"SOFT_DELETE_RETENTION"`
+	// TracingEndpoint is the URL of the OTLP/HTTP endpoint the traces are exported to,
+	// e.g. http://otel-collector:4318. Tracing is disabled if it is not set.
+	TracingEndpoint string `envconfig:"TRACING_ENDPOINT"`
+	// TracingSampleRatio is the ratio of the traces started by Everest that are sampled.
+	TracingSampleRatio float64 `default:"1" envconfig:"TRACING_SAMPLE_RATIO"`
 }
 
 // ParseConfig parses env vars and fills EverestConfig.
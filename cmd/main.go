@@ -30,6 +30,8 @@ import (
 	"github.com/percona/everest/cmd/config"
 	"github.com/percona/everest/internal/server"
 	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/tracing"
+	"github.com/percona/everest/pkg/version"
 )
 
 const (
@@ -58,6 +60,18 @@ func main() {
 
 	tCtx, tCancel := context.WithCancel(context.Background())
 
+	shutdownTracing, err := tracing.Init(tCtx, tracing.Config{
+		Endpoint:       c.TracingEndpoint,
+		SampleRatio:    c.TracingSampleRatio,
+		ServiceVersion: version.Version,
+	})
+	if err != nil {
+		l.Fatalf("Failed initializing tracing: %+v", err)
+	}
+	if c.TracingEndpoint != "" {
+		l.Infof("Tracing is exported to %s", c.TracingEndpoint)
+	}
+
 	server, err := server.NewEverestServer(tCtx, c, l)
 	if err != nil {
 		l.Fatalf("Error creating Everest Server\n: %s", err)
@@ -70,6 +84,16 @@ func main() {
 		}
 	}()
 
+	// The background jobs only run on the replica that holds the leadership.
+	go server.RunLeaderElection(tCtx)
+	go server.RunSoftDeletePurgeJob(tCtx)
+	go server.RunChangeRequestPurgeJob(tCtx)
+	go server.RunExpiryReaperJob(tCtx)
+	go server.RunScheduler(tCtx)
+	go server.RunIdempotencyKeyPurgeJob(tCtx)
+	go server.RunOperationPurgeJob(tCtx)
+	go server.RunTimelineRecorder(tCtx)
+
 	if !c.DisableTelemetry {
 		// To prevent leaking test data to prod,
 		// the prod TelemetryURL is set for the release builds during the build time.
@@ -94,6 +118,9 @@ func main() {
 	} else {
 		l.Info("Everest shut down")
 	}
+	if err := shutdownTracing(ctx); err != nil {
+		l.Error(errors.Join(err, errors.New("could not flush traces")))
+	}
 
 	l.Info("Exiting")
 }
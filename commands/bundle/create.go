@@ -0,0 +1,79 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bundle provides the offline bundles CLI commands.
+package bundle
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/percona/everest/pkg/cli"
+	"github.com/percona/everest/pkg/cli/bundle"
+	"github.com/percona/everest/pkg/cli/helm"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+)
+
+var (
+	bundleCreateCmd = &cobra.Command{
+		Use:   "create [flags]",
+		Args:  cobra.NoArgs,
+		Long:  "Download the Helm charts and the version metadata of an Everest version into an archive that can be used to install and upgrade Everest without internet access. The archive lists the images to mirror to a private registry. The --image-registry flag of the install and upgrade commands only rewrites the images of the Helm charts and of the Everest catalog: the database operators are installed by OLM and pull the operator and engine images from their original registries, so the nodes must be configured to pull them from the private registry, e.g. with the registry mirrors of the container runtime.",
+		Short: "Create an offline bundle",
+		Example: `everestctl bundle create --version 1.4.0 -o everest-bundle.tar.gz
+everestctl bundle create --image-registry registry.local:5000
+everestctl install --bundle everest-bundle.tar.gz --image-registry registry.local:5000`,
+		PreRun: bundleCreatePreRun,
+		Run:    bundleCreateRun,
+	}
+	bundleCreateCfg = &bundle.CreateConfig{}
+)
+
+func init() {
+	// local command flags
+	bundleCreateCmd.Flags().StringVar(&bundleCreateCfg.Version, cli.FlagVersion, "", "Everest version to bundle. By default the latest version is bundled")
+	bundleCreateCmd.Flags().StringVar(&bundleCreateCfg.VersionMetadataURL, cli.FlagVersionMetadataURL, common.DefaultVersionMetadataURL, "URL to retrieve version metadata information from")
+	bundleCreateCmd.Flags().StringVar(&bundleCreateCfg.RepoURL, helm.FlagRepository, helm.DefaultHelmRepoURL, "Helm chart repository to download the Everest charts from")
+	bundleCreateCmd.Flags().StringVar(&bundleCreateCfg.ImageRegistry, helm.FlagImageRegistry, "", "Registry the images will be mirrored to. If set, the bundle contains the mapping of the images to the registry")
+	bundleCreateCmd.Flags().StringVarP(&bundleCreateCfg.Output, "output", "o", "", "Path of the archive (default everest-bundle-<version>.tar.gz)")
+}
+
+func bundleCreatePreRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	// Copy global flags to config
+	bundleCreateCfg.Pretty = !(cmd.Flag(cli.FlagVerbose).Changed || cmd.Flag(cli.FlagJSON).Changed)
+}
+
+func bundleCreateRun(cmd *cobra.Command, _ []string) {
+	result, err := bundle.NewCreator(*bundleCreateCfg, logger.GetLogger()).Run(cmd.Context())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), bundleCreateCfg.Pretty)
+		os.Exit(1)
+	}
+	if cmd.Flag(cli.FlagJSON).Changed {
+		if raw, err := json.Marshal(result); err == nil {
+			_, _ = fmt.Fprintln(os.Stdout, string(raw))
+		}
+	}
+}
+
+// GetBundleCreateCmd returns the command to create an offline bundle.
+func GetBundleCreateCmd() *cobra.Command {
+	return bundleCreateCmd
+}
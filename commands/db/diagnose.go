@@ -0,0 +1,84 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package db provides the database clusters CLI commands.
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/percona/everest/pkg/cli"
+	"github.com/percona/everest/pkg/cli/diagnose"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+)
+
+var (
+	dbDiagnoseCmd = &cobra.Command{
+		Use:   "diagnose <name> [flags]",
+		Args:  cobra.ExactArgs(1),
+		Long:  "Run the health checks of a database cluster and print the problems found with hints to fix them.",
+		Short: "Run the health checks of a database cluster",
+		Example: `everestctl db diagnose my-db --namespace my-db-namespace
+everestctl db diagnose my-db -n my-db-namespace --check volumes --check crash-loops`,
+		PreRun: dbDiagnosePreRun,
+		Run:    dbDiagnoseRun,
+	}
+	dbDiagnoseCfg = &diagnose.Config{}
+)
+
+func init() {
+	// local command flags
+	dbDiagnoseCmd.Flags().StringVarP(&dbDiagnoseCfg.Namespace, "namespace", "n", "", "Namespace of the database cluster")
+	dbDiagnoseCmd.Flags().StringSliceVar(&dbDiagnoseCfg.Checks, "check", nil, "Name of a check to run, can be repeated. All the checks are run if not set")
+	dbDiagnoseCmd.Flags().StringVar(&dbDiagnoseCfg.VersionMetadataURL, cli.FlagVersionMetadataURL, common.DefaultVersionMetadataURL, "URL to retrieve version metadata information from")
+	_ = dbDiagnoseCmd.MarkFlagRequired("namespace")
+}
+
+func dbDiagnosePreRun(cmd *cobra.Command, args []string) { //nolint:revive
+	// Copy global flags to config
+	dbDiagnoseCfg.Pretty = !(cmd.Flag(cli.FlagVerbose).Changed || cmd.Flag(cli.FlagJSON).Changed)
+	dbDiagnoseCfg.KubeconfigPath = cmd.Flag(cli.FlagKubeconfig).Value.String()
+	dbDiagnoseCfg.Name = args[0]
+}
+
+func dbDiagnoseRun(cmd *cobra.Command, _ []string) {
+	op, err := diagnose.NewDiagnoser(*dbDiagnoseCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), dbDiagnoseCfg.Pretty)
+		os.Exit(1)
+	}
+
+	result, err := op.Run(cmd.Context())
+	if cmd.Flag(cli.FlagJSON).Changed && result != nil {
+		if raw, err := json.Marshal(result); err == nil {
+			_, _ = fmt.Fprintln(os.Stdout, string(raw))
+		}
+	}
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), dbDiagnoseCfg.Pretty)
+		os.Exit(1)
+	}
+}
+
+// GetDBDiagnoseCmd returns the command to diagnose a database cluster.
+func GetDBDiagnoseCmd() *cobra.Command {
+	return dbDiagnoseCmd
+}
@@ -0,0 +1,76 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/percona/everest/pkg/cli"
+	"github.com/percona/everest/pkg/cli/doctor"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+)
+
+var (
+	doctorCmd = &cobra.Command{
+		Use:   "doctor [flags]",
+		Args:  cobra.NoArgs,
+		Long:  "Run the pre-flight checks of the Kubernetes cluster before installing Everest, and the health checks of the Everest components once it is installed. The Kubernetes version, the permissions, the storage classes, the available resources, the Pod Security Standards, OLM and the Everest component versions are checked. The command exits with a non-zero code if any check fails.",
+		Short: "Check the cluster and the Everest installation",
+		Example: `everestctl doctor
+everestctl doctor --json`,
+		PreRun: doctorPreRun,
+		Run:    doctorRun,
+	}
+	doctorCfg = &doctor.Config{}
+)
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+
+	// local command flags
+	doctorCmd.Flags().StringVar(&doctorCfg.VersionMetadataURL, cli.FlagVersionMetadataURL, common.DefaultVersionMetadataURL, "URL to retrieve version metadata information from")
+}
+
+func doctorPreRun(_ *cobra.Command, _ []string) { //nolint:revive
+	// Copy global flags to config
+	doctorCfg.Pretty = rootCmdFlags.Pretty
+	doctorCfg.KubeconfigPath = rootCmdFlags.KubeconfigPath
+}
+
+func doctorRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	op, err := doctor.NewDoctor(*doctorCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), doctorCfg.Pretty)
+		os.Exit(1)
+	}
+
+	report, err := op.Run(cmd.Context())
+	if rootCmdFlags.JSON && report != nil {
+		if raw, err := json.Marshal(report); err == nil {
+			_, _ = fmt.Fprintln(os.Stdout, string(raw))
+		}
+	}
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), doctorCfg.Pretty)
+		os.Exit(1)
+	}
+}
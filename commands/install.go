@@ -25,6 +25,7 @@ import (
 	"github.com/percona/everest/pkg/cli"
 	"github.com/percona/everest/pkg/cli/helm"
 	"github.com/percona/everest/pkg/cli/install"
+	"github.com/percona/everest/pkg/cli/installconfig"
 	"github.com/percona/everest/pkg/cli/namespaces"
 	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/logger"
@@ -42,14 +43,18 @@ var (
 		Args:  cobra.NoArgs,
 		Short: "Install Percona Everest using Helm",
 		Long:  "Install Percona Everest using Helm",
-		Example: fmt.Sprintf("everestctl install --%s dev,staging,prod --%s=true --%s=false --%s=false --%s",
+		Example: fmt.Sprintf("everestctl install --%s dev,staging,prod --%s=true --%s=false --%s=false --%s\n"+
+			"everestctl install --%s everest.yaml",
 			cli.FlagNamespaces, cli.FlagOperatorMongoDB, cli.FlagOperatorPostgresql, cli.FlagOperatorMySQL, cli.FlagSkipWizard,
+			cli.FlagConfig,
 		),
 		PreRun: installPreRun,
 		Run:    installRun,
 	}
 	installCfg      = install.NewInstallConfig()
 	namespacesToAdd string
+	// installFile is the declarative configuration set with --config.
+	installFile *installconfig.Config
 )
 
 func init() {
@@ -58,12 +63,22 @@ func init() {
 	// local command flags
 	installCmd.Flags().StringVar(&namespacesToAdd, cli.FlagNamespaces, common.DefaultDBNamespaceName, "Comma-separated namespaces list Percona Everest can manage")
 	installCmd.Flags().BoolVar(&installCfg.NamespaceAddConfig.SkipWizard, cli.FlagSkipWizard, false, "Skip installation wizard")
-	installCmd.Flags().StringVar(&installCfg.VersionMetadataURL, cli.FlagVersionMetadataURL, "https://check.percona.com", "URL to retrieve version metadata information from")
+	installCmd.Flags().StringVar(&installCfg.VersionMetadataURL, cli.FlagVersionMetadataURL, common.DefaultVersionMetadataURL, "URL to retrieve version metadata information from. Use configmap://<namespace>/<name> or file:///path to read an imported snapshot")
 	installCmd.Flags().StringVar(&installCfg.Version, cli.FlagVersion, "", "Everest version to install. By default the latest version is installed")
 	installCmd.Flags().BoolVar(&installCfg.DisableTelemetry, cli.FlagDisableTelemetry, false, "Disable telemetry")
 	_ = installCmd.Flags().MarkHidden(cli.FlagDisableTelemetry)
 	installCmd.Flags().BoolVar(&installCfg.SkipEnvDetection, cli.FlagSkipEnvDetection, false, "Skip detecting Kubernetes environment where Everest is installed")
 	installCmd.Flags().BoolVar(&installCfg.SkipDBNamespace, cli.FlagInstallSkipDBNamespace, false, "Skip creating a database namespace with install")
+	installCmd.Flags().Bool(cli.FlagRender, false, "Write the manifests to the --output directory instead of installing them, e.g. to apply them with a GitOps tool. The cluster is not accessed")
+	installCmd.Flags().StringP(cli.FlagRenderOutput, "o", "", "Directory to write the rendered manifests to")
+	installCmd.MarkFlagsRequiredTogether(cli.FlagRender, cli.FlagRenderOutput)
+	installCmd.Flags().String(cli.FlagConfig, "", "Path to a declarative configuration file with the version, Helm values, namespaces, accounts, OIDC and RBAC settings. "+
+		"Re-running the command with the same file converges the installation")
+	installCmd.MarkFlagsMutuallyExclusive(cli.FlagConfig, cli.FlagNamespaces)
+	installCmd.MarkFlagsMutuallyExclusive(cli.FlagConfig, cli.FlagInstallSkipDBNamespace)
+	installCmd.MarkFlagsMutuallyExclusive(cli.FlagConfig, cli.FlagRender)
+	installCmd.MarkFlagsMutuallyExclusive(cli.FlagConfig, cli.FlagVersion)
+	installCmd.Flags().StringVar(&installCfg.Bundle, cli.FlagBundle, "", "Path to an offline bundle created with 'everestctl bundle create' to install Everest from without internet access")
 
 	// --namespaces and --skip-db-namespace flags are mutually exclusive
 	installCmd.MarkFlagsMutuallyExclusive(cli.FlagNamespaces, cli.FlagInstallSkipDBNamespace)
@@ -74,6 +89,7 @@ func init() {
 	installCmd.Flags().StringVar(&installCfg.HelmConfig.RepoURL, helm.FlagRepository, helm.DefaultHelmRepoURL, "Helm chart repository to download the Everest charts from")
 	installCmd.Flags().StringSliceVar(&installCfg.HelmConfig.Values.Values, helm.FlagHelmSet, []string{}, "Set helm values on the command line (can specify multiple values with commas: key1=val1,key2=val2)")
 	installCmd.Flags().StringSliceVarP(&installCfg.HelmConfig.Values.ValueFiles, helm.FlagHelmValues, "f", []string{}, "Specify values in a YAML file or a URL (can specify multiple)")
+	installCmd.Flags().StringVar(&installCfg.HelmConfig.ImageRegistry, helm.FlagImageRegistry, "", "Registry to pull the images of the Everest Helm charts and catalog from instead of their original registries, e.g. a mirror registry. The database operator and engine images require a registry mirror configured on the nodes")
 
 	// --operator.* flags
 	installCmd.Flags().BoolVar(&installCfg.NamespaceAddConfig.Operators.PSMDB, cli.FlagOperatorMongoDB, true, "Install MongoDB operator")
@@ -88,11 +104,28 @@ func installPreRun(cmd *cobra.Command, _ []string) { //nolint:revive
 	installCfg.Pretty = rootCmdFlags.Pretty
 	installCfg.KubeconfigPath = rootCmdFlags.KubeconfigPath
 	installCfg.NamespaceAddConfig.KubeconfigPath = rootCmdFlags.KubeconfigPath
+	if render, _ := cmd.Flags().GetBool(cli.FlagRender); render {
+		installCfg.RenderDir, _ = cmd.Flags().GetString(cli.FlagRenderOutput)
+		installCfg.NamespaceAddConfig.RenderDir = installCfg.RenderDir
+	}
+
+	if path, _ := cmd.Flags().GetString(cli.FlagConfig); path != "" {
+		// The configuration file is validated up front. It is applied to
+		// an existing installation as well, so the installation is not checked.
+		var err error
+		if installFile, err = installconfig.Load(path); err != nil {
+			output.PrintError(err, logger.GetLogger(), installCfg.Pretty)
+			os.Exit(1)
+		}
+		return
+	}
 
 	// Check if Everest is already installed.
-	if err := install.CheckEverestAlreadyinstalled(cmd.Context(), logger.GetLogger(), installCfg.KubeconfigPath); err != nil {
-		output.PrintError(err, logger.GetLogger(), installCfg.Pretty)
-		os.Exit(1)
+	if installCfg.RenderDir == "" {
+		if err := install.CheckEverestAlreadyinstalled(cmd.Context(), logger.GetLogger(), installCfg.KubeconfigPath); err != nil {
+			output.PrintError(err, logger.GetLogger(), installCfg.Pretty)
+			os.Exit(1)
+		}
 	}
 
 	if !installCfg.SkipDBNamespace {
@@ -148,6 +181,11 @@ func checkDBNamespaceParameters(cmd *cobra.Command) error {
 }
 
 func installRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	if installFile != nil {
+		applyInstallConfig(cmd)
+		return
+	}
+
 	op, err := install.NewInstall(installCfg, logger.GetLogger())
 	if err != nil {
 		output.PrintError(err, logger.GetLogger(), installCfg.Pretty)
@@ -159,3 +197,19 @@ func installRun(cmd *cobra.Command, _ []string) { //nolint:revive
 		os.Exit(1)
 	}
 }
+
+func applyInstallConfig(cmd *cobra.Command) {
+	op, err := installconfig.NewApplier(installconfig.ApplyConfig{
+		Config:  installFile,
+		Install: installCfg,
+	}, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), installCfg.Pretty)
+		os.Exit(1)
+	}
+
+	if err := op.Run(cmd.Context()); err != nil {
+		output.PrintError(err, logger.GetLogger(), installCfg.Pretty)
+		os.Exit(1)
+	}
+}
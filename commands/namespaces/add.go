@@ -25,6 +25,8 @@ import (
 
 	"github.com/percona/everest/pkg/cli"
 	"github.com/percona/everest/pkg/cli/helm"
+	"github.com/percona/everest/pkg/cli/install"
+	"github.com/percona/everest/pkg/cli/installconfig"
 	"github.com/percona/everest/pkg/cli/namespaces"
 	"github.com/percona/everest/pkg/logger"
 	"github.com/percona/everest/pkg/output"
@@ -35,17 +37,27 @@ var (
 	takeOwnershipHintMessage = fmt.Sprintf("HINT: set '--%s' flag to use existing namespaces", cli.FlagTakeNamespaceOwnership)
 	updateHintMessage        = "HINT: use 'everestctl namespaces update' to update the namespace"
 	namespacesAddCmd         = &cobra.Command{
-		Use:   "add <namespaces> [flags]",
-		Args:  cobra.ExactArgs(1),
+		Use: "add <namespaces> [flags]",
+		Args: func(cmd *cobra.Command, args []string) error {
+			if cmd.Flags().Changed(cli.FlagConfig) {
+				// The namespaces are read from the configuration file.
+				return cobra.NoArgs(cmd, args)
+			}
+			return cobra.ExactArgs(1)(cmd, args)
+		},
 		Long:  "Add a new namespace and make managed by Everest",
 		Short: "Add a new namespace and make managed by Everest",
-		Example: fmt.Sprintf("everestctl namespaces add ns-1,ns-2 --%s --%s=true --%s=false --%s=false",
+		Example: fmt.Sprintf("everestctl namespaces add ns-1,ns-2 --%s --%s=true --%s=false --%s=false\n"+
+			"everestctl namespaces add --%s everest.yaml",
 			cli.FlagSkipWizard, cli.FlagOperatorMySQL, cli.FlagOperatorPostgresql, cli.FlagOperatorMongoDB,
+			cli.FlagConfig,
 		),
 		PreRun: namespacesAddPreRun,
 		Run:    namespacesAddRun,
 	}
 	namespacesAddCfg = namespaces.NewNamespaceAddConfig()
+	// namespacesAddFile is the declarative configuration set with --config.
+	namespacesAddFile *installconfig.Config
 )
 
 func init() {
@@ -56,12 +68,22 @@ func init() {
 	namespacesAddCmd.Flags().BoolVar(&namespacesAddCfg.TakeOwnership, cli.FlagTakeNamespaceOwnership, false, "If the specified namespace already exists, take ownership of it")
 	namespacesAddCmd.Flags().BoolVar(&namespacesAddCfg.SkipEnvDetection, cli.FlagSkipEnvDetection, false, "Skip detecting Kubernetes environment where Everest is installed")
 
+	namespacesAddCmd.Flags().String(cli.FlagNamespaceQuota, "", "Path to a YAML file with the Everest quota for the namespaces")
+	namespacesAddCmd.Flags().Bool(cli.FlagRender, false, "Write the manifests to the --output directory instead of applying them, e.g. to apply them with a GitOps tool. The cluster is not accessed")
+	namespacesAddCmd.Flags().StringP(cli.FlagRenderOutput, "o", "", "Directory to write the rendered manifests to")
+	namespacesAddCmd.Flags().StringVar(&namespacesAddCfg.Version, cli.FlagVersion, "", "Everest version of the rendered manifests, required with --"+cli.FlagRender)
+	namespacesAddCmd.MarkFlagsRequiredTogether(cli.FlagRender, cli.FlagRenderOutput, cli.FlagVersion)
+	namespacesAddCmd.Flags().String(cli.FlagConfig, "", "Path to a declarative configuration file. The namespaces of the file are added or updated with their operators and quota")
+	namespacesAddCmd.MarkFlagsMutuallyExclusive(cli.FlagConfig, cli.FlagRender)
+	namespacesAddCmd.MarkFlagsMutuallyExclusive(cli.FlagConfig, cli.FlagNamespaceQuota)
+
 	// --helm.* flags
 	namespacesAddCmd.Flags().StringVar(&namespacesAddCfg.HelmConfig.ChartDir, helm.FlagChartDir, "", "Path to the chart directory. If not set, the chart will be downloaded from the repository")
 	_ = namespacesAddCmd.Flags().MarkHidden(helm.FlagChartDir) //nolint:errcheck,gosec
 	namespacesAddCmd.Flags().StringVar(&namespacesAddCfg.HelmConfig.RepoURL, helm.FlagRepository, helm.DefaultHelmRepoURL, "Helm chart repository to download the Everest charts from")
 	namespacesAddCmd.Flags().StringSliceVar(&namespacesAddCfg.HelmConfig.Values.Values, helm.FlagHelmSet, []string{}, "Set helm values on the command line (can specify multiple values with commas: key1=val1,key2=val2)")
 	namespacesAddCmd.Flags().StringSliceVarP(&namespacesAddCfg.HelmConfig.Values.ValueFiles, helm.FlagHelmValues, "f", []string{}, "Specify values in a YAML file or a URL (can specify multiple)")
+	namespacesAddCmd.Flags().StringVar(&namespacesAddCfg.HelmConfig.ImageRegistry, helm.FlagImageRegistry, "", "Registry to pull the images of the Everest Helm charts and catalog from instead of their original registries, e.g. a mirror registry. The database operator and engine images require a registry mirror configured on the nodes")
 
 	// --operator.* flags
 	namespacesAddCmd.Flags().BoolVar(&namespacesAddCfg.Operators.PSMDB, cli.FlagOperatorMongoDB, true, "Install MongoDB operator")
@@ -75,6 +97,23 @@ func namespacesAddPreRun(cmd *cobra.Command, args []string) { //nolint:revive
 	// Copy global flags to config
 	namespacesAddCfg.Pretty = !(cmd.Flag(cli.FlagVerbose).Changed || cmd.Flag(cli.FlagJSON).Changed)
 	namespacesAddCfg.KubeconfigPath = cmd.Flag(cli.FlagKubeconfig).Value.String()
+	if render, _ := cmd.Flags().GetBool(cli.FlagRender); render {
+		namespacesAddCfg.RenderDir, _ = cmd.Flags().GetString(cli.FlagRenderOutput)
+	}
+
+	if path, _ := cmd.Flags().GetString(cli.FlagConfig); path != "" {
+		// The namespaces already managed by Everest are updated, so their ownership is not checked.
+		var err error
+		if namespacesAddFile, err = installconfig.Load(path); err != nil {
+			output.PrintError(err, logger.GetLogger(), namespacesAddCfg.Pretty)
+			os.Exit(1)
+		}
+		if len(namespacesAddFile.Namespaces) == 0 {
+			output.PrintError(namespaces.ErrNamespaceListEmpty, logger.GetLogger(), namespacesAddCfg.Pretty)
+			os.Exit(1)
+		}
+		return
+	}
 
 	{
 		// Parse and validate provided namespaces
@@ -93,6 +132,13 @@ func namespacesAddPreRun(cmd *cobra.Command, args []string) { //nolint:revive
 		namespacesAddCfg.NamespaceList = nsList
 	}
 
+	if quotaFile, _ := cmd.Flags().GetString(cli.FlagNamespaceQuota); quotaFile != "" {
+		if err := namespacesAddCfg.PopulateQuota(quotaFile); err != nil {
+			output.PrintError(err, logger.GetLogger(), namespacesAddCfg.Pretty)
+			os.Exit(1)
+		}
+	}
+
 	// If user doesn't pass any --operator.* flags - need to ask explicitly.
 	askOperators := !(cmd.Flags().Lookup(cli.FlagOperatorMongoDB).Changed ||
 		cmd.Flags().Lookup(cli.FlagOperatorPostgresql).Changed ||
@@ -110,6 +156,11 @@ func namespacesAddPreRun(cmd *cobra.Command, args []string) { //nolint:revive
 }
 
 func namespacesAddRun(cmd *cobra.Command, _ []string) {
+	if namespacesAddFile != nil {
+		applyNamespacesConfig(cmd)
+		return
+	}
+
 	op, err := namespaces.NewNamespaceAdd(namespacesAddCfg, logger.GetLogger())
 	if err != nil {
 		output.PrintError(err, logger.GetLogger(), namespacesAddCfg.Pretty)
@@ -122,6 +173,27 @@ func namespacesAddRun(cmd *cobra.Command, _ []string) {
 	}
 }
 
+func applyNamespacesConfig(cmd *cobra.Command) {
+	installCfg := install.NewInstallConfig()
+	installCfg.KubeconfigPath = namespacesAddCfg.KubeconfigPath
+	installCfg.Pretty = namespacesAddCfg.Pretty
+	installCfg.NamespaceAddConfig = namespacesAddCfg
+	op, err := installconfig.NewApplier(installconfig.ApplyConfig{
+		Config:         namespacesAddFile,
+		Install:        installCfg,
+		NamespacesOnly: true,
+	}, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), namespacesAddCfg.Pretty)
+		os.Exit(1)
+	}
+
+	if err := op.Run(cmd.Context()); err != nil {
+		output.PrintError(err, logger.GetLogger(), namespacesAddCfg.Pretty)
+		os.Exit(1)
+	}
+}
+
 // GetNamespacesAddCmd returns the command to add namespaces.
 func GetNamespacesAddCmd() *cobra.Command {
 	return namespacesAddCmd
@@ -55,6 +55,8 @@ func init() {
 	namespacesUpdateCmd.Flags().BoolVar(&namespacesUpdateCfg.SkipWizard, cli.FlagSkipWizard, false, "Skip installation wizard")
 	namespacesUpdateCmd.Flags().BoolVar(&namespacesUpdateCfg.SkipEnvDetection, cli.FlagSkipEnvDetection, false, "Skip detecting Kubernetes environment where Everest is installed")
 
+	namespacesUpdateCmd.Flags().String(cli.FlagNamespaceQuota, "", "Path to a YAML file with the Everest quota for the namespaces")
+
 	// --helm.* flags
 	namespacesUpdateCmd.Flags().StringVar(&namespacesUpdateCfg.HelmConfig.ChartDir, helm.FlagChartDir, "", "Path to the chart directory. If not set, the chart will be downloaded from the repository")
 	_ = namespacesUpdateCmd.Flags().MarkHidden(helm.FlagChartDir) //nolint:errcheck,gosec
@@ -86,6 +88,13 @@ func namespacesUpdatePreRun(cmd *cobra.Command, args []string) { //nolint:revive
 		namespacesUpdateCfg.NamespaceList = nsList
 	}
 
+	if quotaFile, _ := cmd.Flags().GetString(cli.FlagNamespaceQuota); quotaFile != "" {
+		if err := namespacesUpdateCfg.PopulateQuota(quotaFile); err != nil {
+			output.PrintError(err, logger.GetLogger(), namespacesUpdateCfg.Pretty)
+			os.Exit(1)
+		}
+	}
+
 	// If user doesn't pass any --operator.* flags - need to ask explicitly.
 	askOperators := !(cmd.Flags().Lookup(cli.FlagOperatorMongoDB).Changed ||
 		cmd.Flags().Lookup(cli.FlagOperatorPostgresql).Changed ||
@@ -0,0 +1,62 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/percona/everest/pkg/cli/rollback"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+)
+
+var (
+	rollbackCmd = &cobra.Command{
+		Use:  "rollback [flags]",
+		Args: cobra.NoArgs,
+		Long: "Roll back Percona Everest and its DB namespaces to the previously installed version. " +
+			"The Custom Resource Definitions are kept, so the rollback is refused if they are not backward compatible with the previous version",
+		Short:  "Roll back Percona Everest to the previously installed version",
+		PreRun: rollbackPreRun,
+		Run:    rollbackRun,
+	}
+	rollbackCfg = &rollback.Config{}
+)
+
+func init() {
+	rootCmd.AddCommand(rollbackCmd)
+}
+
+func rollbackPreRun(_ *cobra.Command, _ []string) { //nolint:revive
+	// Copy global flags to config
+	rollbackCfg.Pretty = rootCmdFlags.Pretty
+	rollbackCfg.KubeconfigPath = rootCmdFlags.KubeconfigPath
+}
+
+func rollbackRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	op, err := rollback.NewRollback(*rollbackCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), rollbackCfg.Pretty)
+		os.Exit(1)
+	}
+
+	if err := op.Run(cmd.Context()); err != nil {
+		output.PrintError(err, logger.GetLogger(), rollbackCfg.Pretty)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,77 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/percona/everest/pkg/cli"
+	"github.com/percona/everest/pkg/cli/supportbundle"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+)
+
+var (
+	supportBundleCmd = &cobra.Command{
+		Use:   "support-bundle [flags]",
+		Args:  cobra.NoArgs,
+		Long:  "Collect the logs of the Everest and operator deployments, the Everest custom resources, the OLM resources, the events, the Helm release values and the versions into a single archive to attach to a support ticket. Secrets and sensitive values are redacted, the redactions are listed in the redaction-report.yaml file of the archive.",
+		Short: "Collect a support bundle",
+		Example: `everestctl support-bundle
+everestctl support-bundle --namespaces my-db-namespace -o bundle.tar.gz`,
+		PreRun: supportBundlePreRun,
+		Run:    supportBundleRun,
+	}
+	supportBundleCfg = &supportbundle.Config{}
+)
+
+func init() {
+	rootCmd.AddCommand(supportBundleCmd)
+
+	// local command flags
+	supportBundleCmd.Flags().StringSliceVar(&supportBundleCfg.Namespaces, cli.FlagNamespaces, nil, "Comma-separated DB namespaces to collect. All the DB namespaces managed by Everest are collected if not set")
+	supportBundleCmd.Flags().StringVarP(&supportBundleCfg.Output, "output", "o", "", "Path of the archive (default everest-support-bundle-<time>.tar.gz)")
+	supportBundleCmd.Flags().Int64Var(&supportBundleCfg.LogTailLines, "log-lines", supportbundle.DefaultLogTailLines, "Number of lines collected from the logs of each container")
+}
+
+func supportBundlePreRun(_ *cobra.Command, _ []string) { //nolint:revive
+	// Copy global flags to config
+	supportBundleCfg.Pretty = rootCmdFlags.Pretty
+	supportBundleCfg.KubeconfigPath = rootCmdFlags.KubeconfigPath
+}
+
+func supportBundleRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	op, err := supportbundle.NewSupportBundle(*supportBundleCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), supportBundleCfg.Pretty)
+		os.Exit(1)
+	}
+
+	result, err := op.Run(cmd.Context())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), supportBundleCfg.Pretty)
+		os.Exit(1)
+	}
+	if rootCmdFlags.JSON {
+		if raw, err := json.Marshal(result); err == nil {
+			_, _ = fmt.Fprintln(os.Stdout, string(raw))
+		}
+	}
+}
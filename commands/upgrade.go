@@ -24,6 +24,7 @@ import (
 	"github.com/percona/everest/pkg/cli"
 	"github.com/percona/everest/pkg/cli/helm"
 	"github.com/percona/everest/pkg/cli/upgrade"
+	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/logger"
 	"github.com/percona/everest/pkg/output"
 )
@@ -45,11 +46,12 @@ func init() {
 	rootCmd.AddCommand(upgradeCmd)
 
 	// local command flags
-	upgradeCmd.Flags().StringVar(&upgradeCfg.VersionMetadataURL, cli.FlagVersionMetadataURL, "https://check.percona.com", "URL to retrieve version metadata information from")
+	upgradeCmd.Flags().StringVar(&upgradeCfg.VersionMetadataURL, cli.FlagVersionMetadataURL, common.DefaultVersionMetadataURL, "URL to retrieve version metadata information from. Use configmap://<namespace>/<name> or file:///path to read an imported snapshot")
 	upgradeCmd.Flags().BoolVar(&upgradeCfg.SkipEnvDetection, cli.FlagSkipEnvDetection, false, "Skip detecting Kubernetes environment where Everest is installed")
-	upgradeCmd.Flags().BoolVar(&upgradeCfg.DryRun, cli.FlagUpgradeDryRun, false, "If set, only executes the pre-upgrade checks")
+	upgradeCmd.Flags().BoolVar(&upgradeCfg.DryRun, cli.FlagUpgradeDryRun, false, "If set, only executes the pre-upgrade checks and shows the CRD, Helm values and manifest changes of the upgrade, including the DB namespaces")
 	upgradeCmd.Flags().BoolVar(&upgradeCfg.InCluster, cli.FlagUpgradeInCluster, false, "If set, uses the in-cluster Kubernetes client configuration")
 	upgradeCmd.Flags().StringVar(&upgradeCfg.VersionToUpgrade, cli.FlagUpgradeVersionToUpgrade, "", "(Optional) Version to upgrade to. This version may be ahead by at most one minor version from the current version")
+	upgradeCmd.Flags().StringVar(&upgradeCfg.Bundle, cli.FlagBundle, "", "Path to an offline bundle created with 'everestctl bundle create' to upgrade Everest from without internet access")
 	_ = upgradeCmd.Flags().MarkHidden(cli.FlagUpgradeInCluster)
 
 	// --helm.* flags
@@ -58,6 +60,7 @@ func init() {
 	upgradeCmd.Flags().StringVar(&upgradeCfg.RepoURL, helm.FlagRepository, helm.DefaultHelmRepoURL, "Helm chart repository to download the Everest charts from")
 	upgradeCmd.Flags().StringSliceVar(&upgradeCfg.Values.Values, helm.FlagHelmSet, []string{}, "Set helm values on the command line (can specify multiple values with commas: key1=val1,key2=val2)")
 	upgradeCmd.Flags().StringSliceVarP(&upgradeCfg.Values.ValueFiles, helm.FlagHelmValues, "f", []string{}, "Specify values in a YAML file or a URL (can specify multiple)")
+	upgradeCmd.Flags().StringVar(&upgradeCfg.ImageRegistry, helm.FlagImageRegistry, "", "Registry to pull the images of the Everest Helm charts and catalog from instead of their original registries, e.g. a mirror registry. The database operator and engine images require a registry mirror configured on the nodes")
 	upgradeCmd.Flags().BoolVar(&upgradeCfg.ResetThenReuseValues, helm.FlagHelmReuseValues, false, "Reuse the last release's values and merge in any overrides from the command line via --helm.set and -f")
 	upgradeCmd.Flags().BoolVar(&upgradeCfg.ResetValues, helm.FlagHelmResetValues, false, "Reset the values to the ones built into the chart")
 	upgradeCmd.Flags().BoolVar(&upgradeCfg.ResetThenReuseValues, helm.FlagHelmResetThenReuseValues, false, "Reset the values to the ones built into the chart, apply the last release's values and merge in any overrides from the command line via --set and -f.")
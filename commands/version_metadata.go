@@ -0,0 +1,38 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package commands ...
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/percona/everest/commands/versionmetadata"
+)
+
+var versionMetadataCmd = &cobra.Command{
+	Use:   "version-metadata <command> [flags]",
+	Args:  cobra.ExactArgs(1),
+	Long:  "Manage the snapshots of the version metadata used by Everest in disconnected environments",
+	Short: "Manage version metadata snapshots",
+	Run:   func(_ *cobra.Command, _ []string) {},
+}
+
+func init() {
+	rootCmd.AddCommand(versionMetadataCmd)
+
+	versionMetadataCmd.AddCommand(versionmetadata.GetDownloadCmd())
+	versionMetadataCmd.AddCommand(versionmetadata.GetImportCmd())
+}
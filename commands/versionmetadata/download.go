@@ -0,0 +1,73 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package versionmetadata provides the version metadata CLI commands.
+package versionmetadata
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/percona/everest/pkg/cli"
+	"github.com/percona/everest/pkg/cli/versionmetadata"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+)
+
+var (
+	downloadCmd = &cobra.Command{
+		Use:     "download [flags]",
+		Args:    cobra.NoArgs,
+		Long:    "Download a snapshot of the version metadata to import it with 'everestctl version-metadata import' into a cluster without internet access.",
+		Short:   "Download a snapshot of the version metadata",
+		Example: `everestctl version-metadata download -o everest-version-metadata.json`,
+		PreRun:  downloadPreRun,
+		Run:     downloadRun,
+	}
+	downloadCfg = &versionmetadata.DownloadConfig{}
+)
+
+func init() {
+	// local command flags
+	downloadCmd.Flags().StringVar(&downloadCfg.VersionMetadataURL, cli.FlagVersionMetadataURL, common.DefaultVersionMetadataURL, "URL to retrieve version metadata information from")
+	downloadCmd.Flags().StringVarP(&downloadCfg.Output, "output", "o", versionmetadata.DefaultSnapshotFile, "Path of the snapshot file")
+}
+
+func downloadPreRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	// Copy global flags to config
+	downloadCfg.Pretty = !(cmd.Flag(cli.FlagVerbose).Changed || cmd.Flag(cli.FlagJSON).Changed)
+}
+
+func downloadRun(cmd *cobra.Command, _ []string) {
+	result, err := versionmetadata.NewDownloader(*downloadCfg, logger.GetLogger()).Run(cmd.Context())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), downloadCfg.Pretty)
+		os.Exit(1)
+	}
+	if cmd.Flag(cli.FlagJSON).Changed {
+		if raw, err := json.Marshal(result); err == nil {
+			_, _ = fmt.Fprintln(os.Stdout, string(raw))
+		}
+	}
+}
+
+// GetDownloadCmd returns the command to download a snapshot of the version metadata.
+func GetDownloadCmd() *cobra.Command {
+	return downloadCmd
+}
@@ -0,0 +1,76 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versionmetadata
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/percona/everest/pkg/cli"
+	"github.com/percona/everest/pkg/cli/versionmetadata"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+)
+
+var (
+	importCmd = &cobra.Command{
+		Use:  "import <file> [flags]",
+		Args: cobra.ExactArgs(1),
+		Long: "Import a snapshot of the version metadata into the " + versionmetadata.ConfigMap.String() + " ConfigMap. " +
+			"Everest serves the imported snapshot when its version metadata URL is " + versionmetadata.ConfigMapURL() + ". " +
+			"Importing a newer snapshot refreshes the version metadata without restarting Everest.",
+		Short: "Import a snapshot of the version metadata",
+		Example: `everestctl version-metadata import everest-version-metadata.json
+everestctl upgrade --version-metadata-url ` + versionmetadata.ConfigMapURL(),
+		PreRun: importPreRun,
+		Run:    importRun,
+	}
+	importCfg = &versionmetadata.ImportConfig{}
+)
+
+func importPreRun(cmd *cobra.Command, args []string) { //nolint:revive
+	// Copy global flags to config
+	importCfg.Pretty = !(cmd.Flag(cli.FlagVerbose).Changed || cmd.Flag(cli.FlagJSON).Changed)
+	importCfg.KubeconfigPath = cmd.Flag(cli.FlagKubeconfig).Value.String()
+	importCfg.File = args[0]
+}
+
+func importRun(cmd *cobra.Command, _ []string) {
+	op, err := versionmetadata.NewImporter(*importCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), importCfg.Pretty)
+		os.Exit(1)
+	}
+
+	result, err := op.Run(cmd.Context())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), importCfg.Pretty)
+		os.Exit(1)
+	}
+	if cmd.Flag(cli.FlagJSON).Changed {
+		if raw, err := json.Marshal(result); err == nil {
+			_, _ = fmt.Fprintln(os.Stdout, string(raw))
+		}
+	}
+}
+
+// GetImportCmd returns the command to import a snapshot of the version metadata.
+func GetImportCmd() *cobra.Command {
+	return importCmd
+}
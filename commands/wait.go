@@ -0,0 +1,78 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/percona/everest/pkg/cli/wait"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+)
+
+var (
+	waitCmd = &cobra.Command{
+		Use:   "wait <operation> [flags]",
+		Args:  cobra.ExactArgs(1),
+		Long:  "Wait for an asynchronous operation to complete. The operation is returned in the Operation-Id header of the request that started it.",
+		Short: "Wait for an asynchronous operation to complete",
+		Example: `everestctl wait operation-x7k2p --namespace my-db-namespace
+everestctl wait operation-x7k2p -n my-db-namespace --timeout 10m`,
+		PreRun: waitPreRun,
+		Run:    waitRun,
+	}
+	waitCfg = &wait.Config{}
+)
+
+func init() {
+	rootCmd.AddCommand(waitCmd)
+
+	// local command flags
+	waitCmd.Flags().StringVarP(&waitCfg.Namespace, "namespace", "n", "", "Namespace of the operation")
+	waitCmd.Flags().DurationVar(&waitCfg.Timeout, "timeout", wait.DefaultTimeout, "Maximum time to wait for the operation")
+	waitCmd.Flags().DurationVar(&waitCfg.Interval, "interval", wait.DefaultInterval, "Interval between two checks of the operation")
+	_ = waitCmd.MarkFlagRequired("namespace")
+}
+
+func waitPreRun(_ *cobra.Command, args []string) { //nolint:revive
+	// Copy global flags to config
+	waitCfg.Pretty = rootCmdFlags.Pretty
+	waitCfg.KubeconfigPath = rootCmdFlags.KubeconfigPath
+	waitCfg.Operation = args[0]
+}
+
+func waitRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	op, err := wait.NewWaiter(*waitCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), waitCfg.Pretty)
+		os.Exit(1)
+	}
+
+	result, err := op.Run(cmd.Context())
+	if rootCmdFlags.JSON && result != nil {
+		if raw, err := json.Marshal(result); err == nil {
+			_, _ = fmt.Fprintln(os.Stdout, string(raw))
+		}
+	}
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), waitCfg.Pretty)
+		os.Exit(1)
+	}
+}
@@ -22,3 +22,9 @@ import "embed"
 //
 //go:embed rbac/*
 var RBAC embed.FS
+
+// ServerRBAC stores the RBAC manifests of the everest API Server that are
+// not part of the Helm chart, as templates.
+//
+//go:embed server/*
+var ServerRBAC embed.FS
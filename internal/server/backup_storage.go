@@ -25,11 +25,11 @@ import (
 )
 
 // ListBackupStorages lists backup storages.
-func (e *EverestServer) ListBackupStorages(c echo.Context, namespace string) error {
+func (e *EverestServer) ListBackupStorages(c echo.Context, namespace string, params api.ListBackupStoragesParams) error {
 	ctx := c.Request().Context()
-	list, err := e.handler.ListBackupStorages(ctx, namespace)
+	list, err := e.handler.ListBackupStorages(ctx, namespace, &params)
 	if err != nil {
-		e.l.Errorf("ListBackupStorages failed: %v", err)
+		e.log(c).Errorf("ListBackupStorages failed: %v", err)
 		return err
 	}
 
@@ -51,11 +51,12 @@ func (e *EverestServer) CreateBackupStorage(c echo.Context, namespace string) er
 	}
 	result, err := e.handler.CreateBackupStorage(ctx, namespace, &req)
 	if err != nil {
-		e.l.Errorf("CreateBackupStorage failed: %v", err)
+		e.log(c).Errorf("CreateBackupStorage failed: %v", err)
 		return err
 	}
 	out := &api.BackupStorage{}
 	out.FromCR(result)
+	setETag(c, result)
 	return c.JSON(http.StatusCreated, out)
 }
 
@@ -63,7 +64,7 @@ func (e *EverestServer) CreateBackupStorage(c echo.Context, namespace string) er
 func (e *EverestServer) DeleteBackupStorage(c echo.Context, namespace, name string) error {
 	ctx := c.Request().Context()
 	if err := e.handler.DeleteBackupStorage(ctx, namespace, name); err != nil {
-		e.l.Errorf("DeleteBackupStorage failed: %v", err)
+		e.log(c).Errorf("DeleteBackupStorage failed: %v", err)
 		return err
 	}
 	return c.NoContent(http.StatusNoContent)
@@ -74,12 +75,13 @@ func (e *EverestServer) GetBackupStorage(c echo.Context, namespace, name string)
 	ctx := c.Request().Context()
 	result, err := e.handler.GetBackupStorage(ctx, namespace, name)
 	if err != nil {
-		e.l.Errorf("GetBackupStorage failed: %v", err)
+		e.log(c).Errorf("GetBackupStorage failed: %v", err)
 		return err
 	}
 
 	out := &api.BackupStorage{}
 	out.FromCR(result)
+	setETag(c, result)
 	return c.JSON(http.StatusOK, out)
 }
 
@@ -92,10 +94,11 @@ func (e *EverestServer) UpdateBackupStorage(c echo.Context, namespace, name stri
 	}
 	result, err := e.handler.UpdateBackupStorage(ctx, namespace, name, &req)
 	if err != nil {
-		e.l.Errorf("UpdateBackupStorage failed: %v", err)
+		e.log(c).Errorf("UpdateBackupStorage failed: %v", err)
 		return err
 	}
 	out := &api.BackupStorage{}
 	out.FromCR(result)
+	setETag(c, result)
 	return c.JSON(http.StatusOK, out)
 }
@@ -0,0 +1,113 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/percona/everest/api"
+	k8shandler "github.com/percona/everest/internal/server/handlers/k8s"
+	"github.com/percona/everest/pkg/approval"
+)
+
+// ListChangeRequests lists the change requests in the specified namespace.
+func (e *EverestServer) ListChangeRequests(c echo.Context, namespace string) error {
+	result, err := e.handler.ListChangeRequests(c.Request().Context(), namespace)
+	if err != nil {
+		e.log(c).Errorf("ListChangeRequests failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// GetChangeRequest returns the specified change request.
+func (e *EverestServer) GetChangeRequest(c echo.Context, namespace, name string) error {
+	result, err := e.handler.GetChangeRequest(c.Request().Context(), namespace, name)
+	if err != nil {
+		e.log(c).Errorf("GetChangeRequest failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// ApproveChangeRequest approves the specified change request and executes the requested operation.
+// The change request becomes executed, or failed if the operation could not be performed.
+// A failed change request can be approved again to retry the operation.
+func (e *EverestServer) ApproveChangeRequest(c echo.Context, namespace, name string) error {
+	ctx := c.Request().Context()
+	result, err := e.handler.ApproveChangeRequest(ctx, namespace, name)
+	if err != nil {
+		e.log(c).Errorf("ApproveChangeRequest failed: %v", err)
+		return err
+	}
+	// The operation goes through the whole handler chain again, so it is
+	// validated and authorized on behalf of the approver.
+	execErr := e.executeChangeRequest(approval.WithApproved(ctx, result), result)
+	if execErr != nil {
+		e.log(c).Errorf("ApproveChangeRequest failed to execute %s: %v", result.Operation, execErr)
+	}
+	result, err = e.handler.CompleteChangeRequest(ctx, namespace, name, execErr)
+	if err != nil {
+		e.log(c).Errorf("ApproveChangeRequest failed to record the result of %s: %v", name, err)
+	}
+	if execErr != nil {
+		return execErr
+	}
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// RejectChangeRequest rejects the specified change request.
+func (e *EverestServer) RejectChangeRequest(c echo.Context, namespace, name string) error {
+	result, err := e.handler.RejectChangeRequest(c.Request().Context(), namespace, name)
+	if err != nil {
+		e.log(c).Errorf("RejectChangeRequest failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+func (e *EverestServer) executeChangeRequest(ctx context.Context, cr *api.ChangeRequest) error {
+	var cleanupBackupStorage *bool
+	if cr.Parameters != nil {
+		cleanupBackupStorage = cr.Parameters.CleanupBackupStorage
+	}
+	switch cr.Operation {
+	case api.DeleteDatabaseCluster:
+		return e.handler.DeleteDatabaseCluster(ctx, cr.Namespace, cr.Target,
+			&api.DeleteDatabaseClusterParams{CleanupBackupStorage: cleanupBackupStorage})
+	case api.DeleteDatabaseClusterBackup:
+		return e.handler.DeleteDatabaseClusterBackup(ctx, cr.Namespace, cr.Target,
+			&api.DeleteDatabaseClusterBackupParams{CleanupBackupStorage: cleanupBackupStorage})
+	default:
+		return fmt.Errorf("unsupported operation '%s'", cr.Operation)
+	}
+}
+
+// pendingApproval returns the change request if err reports that the operation
+// has been turned into a change request.
+func pendingApproval(err error) (*api.ChangeRequest, bool) {
+	pending := &approval.PendingError{}
+	if errors.As(err, &pending) {
+		return pending.ChangeRequest, true
+	}
+	return nil, false
+}
+
+// changeRequestPurgeInterval is the interval between two runs of the change request purge job.
+const changeRequestPurgeInterval = time.Hour
+
+// RunChangeRequestPurgeJob runs the background job that deletes the change
+// requests whose retention period is over.
+func (e *EverestServer) RunChangeRequestPurgeJob(ctx context.Context) {
+	e.runPeriodicJob(ctx, changeRequestPurgeInterval, "purge change requests",
+		func(ctx context.Context, namespace string, now time.Time) error {
+			return k8shandler.PurgeChangeRequests(ctx, e.l, e.kubeConnector, namespace, now)
+		})
+}
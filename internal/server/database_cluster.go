@@ -18,6 +18,7 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -25,6 +26,7 @@ import (
 	"net/http"
 	"time"
 
+	jsonpatch "github.com/evanphx/json-patch/v5"
 	"github.com/labstack/echo/v4"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -33,6 +35,10 @@ import (
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
 	"github.com/percona/everest/api"
+	"github.com/percona/everest/internal/server/handlers"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/logstream"
+	"github.com/percona/everest/pkg/softdelete"
 )
 
 var (
@@ -50,7 +56,7 @@ func (e *EverestServer) CreateDatabaseCluster(c echo.Context, namespace string)
 
 	result, err := e.handler.CreateDatabaseCluster(c.Request().Context(), dbc)
 	if err != nil {
-		e.l.Errorf("CreateDatabaseCluster failed: %v", err)
+		e.log(c).Errorf("CreateDatabaseCluster failed: %v", err)
 		return err
 	}
 
@@ -60,17 +66,18 @@ func (e *EverestServer) CreateDatabaseCluster(c echo.Context, namespace string)
 		defer cancel()
 
 		if err := e.collectMetrics(ctx, *e.config); err != nil {
-			e.l.Errorf("Could not send metrics: %v", err)
+			e.log(c).Errorf("Could not send metrics: %v", err)
 		}
 	}()
+	setETag(c, result)
 	return c.JSON(http.StatusCreated, result)
 }
 
 // ListDatabaseClusters lists the created database clusters on the specified kubernetes cluster.
-func (e *EverestServer) ListDatabaseClusters(ctx echo.Context, namespace string) error {
-	list, err := e.handler.ListDatabaseClusters(ctx.Request().Context(), namespace)
+func (e *EverestServer) ListDatabaseClusters(ctx echo.Context, namespace string, params api.ListDatabaseClustersParams) error {
+	list, err := e.handler.ListDatabaseClusters(ctx.Request().Context(), namespace, &params)
 	if err != nil {
-		e.l.Errorf("ListDatabaseClusters failed: %v", err)
+		e.log(ctx).Errorf("ListDatabaseClusters failed: %v", err)
 		return err
 	}
 	return ctx.JSON(http.StatusOK, list)
@@ -83,27 +90,121 @@ func (e *EverestServer) DeleteDatabaseCluster(
 	params api.DeleteDatabaseClusterParams,
 ) error {
 	if err := e.handler.DeleteDatabaseCluster(c.Request().Context(), namespace, name, &params); err != nil {
-		e.l.Errorf("DeleteDatabaseCluster failed: %v", err)
+		if cr, ok := pendingApproval(err); ok {
+			return c.JSON(http.StatusAccepted, cr)
+		}
+		e.log(c).Errorf("DeleteDatabaseCluster failed: %v", err)
+		return err
+	}
+	e.startOperation(c, namespace, api.OperationTypeDatabaseClusterDeletion, name)
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ListDeletedDatabaseClusters lists the soft-deleted database clusters in the specified namespace.
+func (e *EverestServer) ListDeletedDatabaseClusters(c echo.Context, namespace string) error {
+	result, err := e.handler.ListDeletedDatabaseClusters(c.Request().Context(), namespace)
+	if err != nil {
+		e.log(c).Errorf("ListDeletedDatabaseClusters failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// GetDeletedDatabaseCluster retrieves the specified soft-deleted database cluster.
+func (e *EverestServer) GetDeletedDatabaseCluster(c echo.Context, namespace, name string) error {
+	result, err := e.handler.GetDeletedDatabaseCluster(c.Request().Context(), namespace, name)
+	if err != nil {
+		e.log(c).Errorf("GetDeletedDatabaseCluster failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// RestoreDatabaseCluster restores the specified soft-deleted database cluster.
+func (e *EverestServer) RestoreDatabaseCluster(c echo.Context, namespace, name string) error {
+	result, err := e.handler.RestoreDatabaseCluster(c.Request().Context(), namespace, name)
+	if err != nil {
+		e.log(c).Errorf("RestoreDatabaseCluster failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// PurgeDatabaseCluster permanently deletes the specified soft-deleted database cluster.
+func (e *EverestServer) PurgeDatabaseCluster(c echo.Context, namespace, name string) error {
+	if err := e.handler.PurgeDatabaseCluster(c.Request().Context(), namespace, name); err != nil {
+		if cr, ok := pendingApproval(err); ok {
+			return c.JSON(http.StatusAccepted, cr)
+		}
+		e.log(c).Errorf("PurgeDatabaseCluster failed: %v", err)
 		return err
 	}
 	return c.NoContent(http.StatusNoContent)
 }
 
 // GetDatabaseCluster retrieves the specified database cluster on the specified kubernetes cluster.
+// Soft-deleted database clusters are not found, they are retrieved with GetDeletedDatabaseCluster.
 func (e *EverestServer) GetDatabaseCluster(c echo.Context, namespace, name string) error {
 	result, err := e.handler.GetDatabaseCluster(c.Request().Context(), namespace, name)
 	if err != nil {
-		e.l.Errorf("GetDatabaseCluster failed: %v", err)
+		e.log(c).Errorf("GetDatabaseCluster failed: %v", err)
 		return err
 	}
+	setETag(c, result)
 	return c.JSON(http.StatusOK, result)
 }
 
+// GetDatabaseClusterDeletionProtection returns the deletion protection of the specified database cluster.
+func (e *EverestServer) GetDatabaseClusterDeletionProtection(c echo.Context, namespace, name string) error {
+	db, err := e.handler.GetDatabaseCluster(c.Request().Context(), namespace, name)
+	if err != nil {
+		e.log(c).Errorf("GetDatabaseClusterDeletionProtection failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, api.DatabaseClusterDeletionProtection{Enabled: softdelete.IsDeletionProtected(db)})
+}
+
+// UpdateDatabaseClusterDeletionProtection enables or disables the deletion protection of the specified database cluster.
+// The deletion protection annotation is reserved, so it is only changed with this endpoint,
+// which requires the permission to update the database cluster.
+func (e *EverestServer) UpdateDatabaseClusterDeletionProtection(c echo.Context, namespace, name string) error {
+	req := &api.DatabaseClusterDeletionProtection{}
+	if err := e.getBodyFromContext(c, req); err != nil {
+		return errors.Join(errFailedToReadRequestBody, err)
+	}
+	result, err := e.handler.UpdateDatabaseClusterDeletionProtection(c.Request().Context(), namespace, name, req.Enabled)
+	if err != nil {
+		e.log(c).Errorf("UpdateDatabaseClusterDeletionProtection failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, api.DatabaseClusterDeletionProtection{Enabled: softdelete.IsDeletionProtected(result)})
+}
+
 // GetDatabaseClusterComponents returns database cluster components.
 func (e *EverestServer) GetDatabaseClusterComponents(c echo.Context, namespace, name string) error {
 	result, err := e.handler.GetDatabaseClusterComponents(c.Request().Context(), namespace, name)
 	if err != nil {
-		e.l.Errorf("GetDatabaseClusterComponents failed: %v", err)
+		e.log(c).Errorf("GetDatabaseClusterComponents failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// GetDatabaseClusterEvents returns the timeline of the specified database cluster.
+func (e *EverestServer) GetDatabaseClusterEvents(c echo.Context, namespace, name string, params api.GetDatabaseClusterEventsParams) error {
+	result, err := e.handler.GetDatabaseClusterEvents(c.Request().Context(), namespace, name, &params)
+	if err != nil {
+		e.log(c).Errorf("GetDatabaseClusterEvents failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// GetDatabaseClusterDiagnostics runs the health checks of the specified database cluster.
+func (e *EverestServer) GetDatabaseClusterDiagnostics(c echo.Context, namespace, name string, params api.GetDatabaseClusterDiagnosticsParams) error {
+	result, err := e.handler.GetDatabaseClusterDiagnostics(c.Request().Context(), namespace, name, &params)
+	if err != nil {
+		e.log(c).Errorf("GetDatabaseClusterDiagnostics failed: %v", err)
 		return err
 	}
 	return c.JSON(http.StatusOK, result)
@@ -131,22 +232,132 @@ func (e *EverestServer) GetDatabaseClusterComponentLogs(c echo.Context, ns, cNam
 
 	err := e.handler.GetDatabaseClusterComponentLogs(ctx, ns, cName, componentName, params, stream)
 	if err != nil {
-		e.l.Errorf("GetDatabaseClusterComponents failed: %v", err)
+		e.log(c).Errorf("GetDatabaseClusterComponents failed: %v", err)
 		return err
 	}
 	return nil
 }
 
+// GetDatabaseClusterLogs returns the logs of all the containers of a database cluster as a single stream or a tar.gz archive.
+func (e *EverestServer) GetDatabaseClusterLogs(c echo.Context, namespace, name string, params api.GetDatabaseClusterLogsParams) error {
+	ctx := c.Request().Context()
+
+	stream := func(ctx context.Context, namespace string, sources []handlers.LogSource, params api.GetDatabaseClusterLogsParams) error {
+		filter, err := logstream.NewFilter(ptr.Deref(params.Filter, ""), ptr.Deref(params.Regex, false))
+		if err != nil {
+			return err
+		}
+		opts := logstream.Options{Filter: filter, Timestamps: ptr.Deref(params.Timestamps, false)}
+		if !ptr.Deref(params.Follow, false) {
+			// The logs are merged or archived once all the sources are read,
+			// each of them is bounded to keep the work of the server bounded.
+			opts.MaxBytes = maxLimitBytes
+		}
+
+		logSources := make([]logstream.Source, 0, len(sources))
+		var streams []io.Closer
+		defer func() {
+			for _, s := range streams {
+				s.Close() //nolint:errcheck
+			}
+		}()
+		for _, src := range sources {
+			logOpts, err := buildClusterLogOptions(src, params)
+			if err != nil {
+				return err
+			}
+			rc, err := e.kubeStreamer.CoreV1().Pods(namespace).GetLogs(src.Pod, logOpts).Stream(ctx)
+			if err != nil {
+				// e.g. the container is not started yet, the logs of the others are still useful.
+				e.log(c).Warnf("failed to open log stream of %s/%s: %v", src.Pod, src.Container, err)
+				continue
+			}
+			streams = append(streams, rc)
+
+			sourceName := src.Pod + "/" + src.Container
+			if src.Previous {
+				sourceName += ".previous"
+			}
+			logSources = append(logSources, logstream.Source{Name: sourceName, Reader: rc})
+		}
+
+		res := c.Response()
+		if ptr.Deref(params.Download, false) {
+			res.Header().Set(echo.HeaderContentType, "application/gzip")
+			res.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", name+"-logs.tar.gz"))
+			res.WriteHeader(http.StatusOK)
+			return logstream.WriteArchive(res, logSources, opts)
+		}
+
+		res.Header().Set(echo.HeaderContentType, "text/plain; charset=utf-8")
+		if !ptr.Deref(params.Follow, false) {
+			res.WriteHeader(http.StatusOK)
+			return logstream.Merge(res, logSources, opts)
+		}
+
+		res.Header().Set("Transfer-Encoding", "chunked")
+		res.WriteHeader(http.StatusOK)
+		return logstream.Follow(ctx, res, res.Flush, logSources, opts)
+	}
+
+	if err := e.handler.GetDatabaseClusterLogs(ctx, namespace, name, params, stream); err != nil {
+		e.log(c).Errorf("GetDatabaseClusterLogs failed: %v", err)
+		return err
+	}
+	return nil
+}
+
+// buildClusterLogOptions returns the options to read the logs of a container of a database cluster.
+// The logs are always requested with timestamps to order the lines of the containers.
+func buildClusterLogOptions(src handlers.LogSource, params api.GetDatabaseClusterLogsParams) (*corev1.PodLogOptions, error) {
+	// The previous instance of a container is terminated, there is nothing to follow.
+	follow := ptr.Deref(params.Follow, false) && !src.Previous
+
+	opts := &corev1.PodLogOptions{
+		Container:  src.Container,
+		Previous:   src.Previous,
+		Follow:     follow,
+		Timestamps: true,
+	}
+
+	if params.TailLines != nil {
+		if *params.TailLines > maxTailLines {
+			return nil, errors.New("tailLines too large")
+		}
+		opts.TailLines = intPtrToInt64Ptr(params.TailLines)
+	} else if !follow {
+		opts.TailLines = ptr.To(defaultTailLines)
+	}
+
+	if params.SinceSeconds != nil {
+		opts.SinceSeconds = intPtrToInt64Ptr(params.SinceSeconds)
+	}
+
+	if params.SinceTime != nil {
+		opts.SinceTime = &metav1.Time{Time: *params.SinceTime}
+	}
+
+	if params.LimitBytes != nil {
+		if *params.LimitBytes > maxLimitBytes {
+			return nil, errors.New("limitBytes too large")
+		}
+		opts.LimitBytes = intPtrToInt64Ptr(params.LimitBytes)
+	}
+
+	return opts, nil
+}
+
+const (
+	defaultTailLines int64 = 200
+	maxTailLines           = 10000
+	maxLimitBytes          = 100 * 1024 * 1024 // 100 MiB
+)
+
 func (e *EverestServer) buildPodLogOptions(
 	ctx context.Context,
 	namespace, componentName string,
 	params api.GetDatabaseClusterComponentLogsParams,
 ) (*corev1.PodLogOptions, error) {
-	const (
-		defaultTailLines int64 = 200
-		maxLimitBytes          = 100 * 1024 * 1024 // 100 MiB
-	)
-
 	follow := params.Follow != nil && *params.Follow
 
 	opts := &corev1.PodLogOptions{
@@ -239,15 +450,15 @@ func (e *EverestServer) getDefaultContainer(ctx context.Context, namespace, podN
 	}
 	pods, err := e.kubeConnector.ListPods(ctx, ctrlclient.InNamespace(namespace), ctrlclient.MatchingFields{"metadata.name": podName})
 	if err != nil {
-		e.l.Errorf("ListPods failed: %v", err)
+		logger.FromContext(ctx, e.l).Errorf("ListPods failed: %v", err)
 		return ""
 	}
 	if len(pods.Items) == 0 {
-		e.l.Errorf("Empty pod list")
+		logger.FromContext(ctx, e.l).Errorf("Empty pod list")
 		return ""
 	}
 	if len(pods.Items[0].Spec.Containers) == 0 {
-		e.l.Errorf("Empty container list")
+		logger.FromContext(ctx, e.l).Errorf("Empty container list")
 		return ""
 	}
 
@@ -275,17 +486,66 @@ func (e *EverestServer) UpdateDatabaseCluster(ctx echo.Context, namespace, name
 
 	result, err := e.handler.UpdateDatabaseCluster(ctx.Request().Context(), dbc)
 	if err != nil {
-		e.l.Errorf("UpdateDatabaseCluster failed: %v", err)
+		e.log(ctx).Errorf("UpdateDatabaseCluster failed: %v", err)
 		return err
 	}
+	setETag(ctx, result)
 	return ctx.JSON(http.StatusOK, result)
 }
 
+// PatchDatabaseCluster partially updates the specified database cluster with a JSON Merge Patch.
+func (e *EverestServer) PatchDatabaseCluster(c echo.Context, namespace, name string) error {
+	ctx := c.Request().Context()
+	reader, err := c.Request().GetBody()
+	if err != nil {
+		return errors.Join(errFailedToReadRequestBody, err)
+	}
+	patch, err := io.ReadAll(reader)
+	if err != nil {
+		return errors.Join(errFailedToReadRequestBody, err)
+	}
+
+	result, err := e.patchDatabaseCluster(ctx, namespace, name, patch)
+	if err != nil {
+		e.log(c).Errorf("PatchDatabaseCluster failed: %v", err)
+		return err
+	}
+	setETag(c, result)
+	return c.JSON(http.StatusOK, result)
+}
+
+// patchDatabaseCluster applies the JSON Merge Patch to the specified database cluster.
+func (e *EverestServer) patchDatabaseCluster(
+	ctx context.Context,
+	namespace, name string,
+	patch []byte,
+) (*everestv1alpha1.DatabaseCluster, error) {
+	current, err := e.handler.GetDatabaseCluster(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	original, err := json.Marshal(current)
+	if err != nil {
+		return nil, err
+	}
+	patched, err := jsonpatch.MergePatch(original, patch)
+	if err != nil {
+		return nil, errors.Join(errFailedToReadRequestBody, err)
+	}
+	dbc := &everestv1alpha1.DatabaseCluster{}
+	if err := json.Unmarshal(patched, dbc); err != nil {
+		return nil, errors.Join(errFailedToReadRequestBody, err)
+	}
+	dbc.SetNamespace(namespace)
+	dbc.SetName(name)
+	return e.handler.UpdateDatabaseCluster(ctx, dbc)
+}
+
 // GetDatabaseClusterCredentials returns credentials for the specified database cluster.
 func (e *EverestServer) GetDatabaseClusterCredentials(c echo.Context, namespace, name string) error {
 	result, err := e.handler.GetDatabaseClusterCredentials(c.Request().Context(), namespace, name)
 	if err != nil {
-		e.l.Errorf("GetDatabaseClusterCredentials failed: %v", err)
+		e.log(c).Errorf("GetDatabaseClusterCredentials failed: %v", err)
 		return err
 	}
 	return c.JSON(http.StatusOK, result)
@@ -295,7 +555,7 @@ func (e *EverestServer) GetDatabaseClusterCredentials(c echo.Context, namespace,
 func (e *EverestServer) GetDatabaseClusterPitr(c echo.Context, namespace, name string) error {
 	result, err := e.handler.GetDatabaseClusterPitr(c.Request().Context(), namespace, name)
 	if err != nil {
-		e.l.Errorf("GetDatabaseClusterPitr failed: %v", err)
+		e.log(c).Errorf("GetDatabaseClusterPitr failed: %v", err)
 		return err
 	}
 	return c.JSON(http.StatusOK, result)
@@ -323,7 +583,7 @@ func (e *EverestServer) CreateDatabaseClusterSecret(
 	}
 	result, err := e.handler.CreateDatabaseClusterSecret(c.Request().Context(), namespace, dbName, secret)
 	if err != nil {
-		e.l.Errorf("CreateDatabaseClusterSecret failed: %v", err)
+		e.log(c).Errorf("CreateDatabaseClusterSecret failed: %v", err)
 		return err
 	}
 	return c.JSON(http.StatusCreated, result)
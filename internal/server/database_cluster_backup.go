@@ -27,10 +27,14 @@ import (
 )
 
 // ListDatabaseClusterBackups returns list of the created database cluster backups on the specified kubernetes cluster.
-func (e *EverestServer) ListDatabaseClusterBackups(c echo.Context, namespace, name string) error {
-	result, err := e.handler.ListDatabaseClusterBackups(c.Request().Context(), namespace, name)
+func (e *EverestServer) ListDatabaseClusterBackups(
+	c echo.Context,
+	namespace, name string,
+	params api.ListDatabaseClusterBackupsParams,
+) error {
+	result, err := e.handler.ListDatabaseClusterBackups(c.Request().Context(), namespace, name, &params)
 	if err != nil {
-		e.l.Errorf("ListDatabaseClusterBackups failed: %w", err)
+		e.log(c).Errorf("ListDatabaseClusterBackups failed: %w", err)
 		return err
 	}
 	return c.JSON(http.StatusOK, result)
@@ -46,9 +50,10 @@ func (e *EverestServer) CreateDatabaseClusterBackup(ctx echo.Context, namespace
 
 	result, err := e.handler.CreateDatabaseClusterBackup(ctx.Request().Context(), dbb)
 	if err != nil {
-		e.l.Errorf("CreateDatabaseClusterBackup failed: %w", err)
+		e.log(ctx).Errorf("CreateDatabaseClusterBackup failed: %w", err)
 		return err
 	}
+	setETag(ctx, result)
 	return ctx.JSON(http.StatusOK, result)
 }
 
@@ -59,7 +64,10 @@ func (e *EverestServer) DeleteDatabaseClusterBackup(
 	params api.DeleteDatabaseClusterBackupParams,
 ) error {
 	if err := e.handler.DeleteDatabaseClusterBackup(ctx.Request().Context(), namespace, name, &params); err != nil {
-		e.l.Errorf("DeleteDatabaseClusterBackup failed: %w", err)
+		if cr, ok := pendingApproval(err); ok {
+			return ctx.JSON(http.StatusAccepted, cr)
+		}
+		e.log(ctx).Errorf("DeleteDatabaseClusterBackup failed: %w", err)
 		return err
 	}
 	return ctx.NoContent(http.StatusNoContent)
@@ -69,8 +77,9 @@ func (e *EverestServer) DeleteDatabaseClusterBackup(
 func (e *EverestServer) GetDatabaseClusterBackup(ctx echo.Context, namespace, name string) error {
 	result, err := e.handler.GetDatabaseClusterBackup(ctx.Request().Context(), namespace, name)
 	if err != nil {
-		e.l.Errorf("GetDatabaseClusterBackup failed: %w", err)
+		e.log(ctx).Errorf("GetDatabaseClusterBackup failed: %w", err)
 		return err
 	}
+	setETag(ctx, result)
 	return ctx.JSON(http.StatusOK, result)
 }
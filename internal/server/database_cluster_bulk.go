@@ -0,0 +1,203 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/api"
+	valhandler "github.com/percona/everest/internal/server/handlers/validation"
+	"github.com/percona/everest/pkg/logger"
+)
+
+// bulkBackupNameSuffixLength is the length of the random suffix of the backups taken by bulk actions.
+const bulkBackupNameSuffixLength = 5
+
+var (
+	errBulkNoSelector        = errors.New("selector must set names or labelSelector")
+	errBulkNoPatch           = errors.New("patch must be set for the patch action")
+	errBulkNoBackupStorage   = errors.New("backupStorageName must be set for the backup action")
+	errBulkUnsupportedAction = errors.New("unsupported action")
+)
+
+// BulkDatabaseClusterAction applies an action to the selected database clusters.
+func (e *EverestServer) BulkDatabaseClusterAction(c echo.Context) error {
+	req := &api.DatabaseClusterBulkRequest{}
+	if err := e.getBodyFromContext(c, req); err != nil {
+		return errors.Join(errFailedToReadRequestBody, err)
+	}
+	if err := validateBulkRequest(req); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	targets, missing, err := e.selectDatabaseClusters(ctx, req.Selector)
+	if err != nil {
+		e.log(c).Errorf("BulkDatabaseClusterAction failed to select database clusters: %v", err)
+		return err
+	}
+
+	result := api.DatabaseClusterBulkResponse{Items: make([]api.DatabaseClusterBulkResult, 0, len(targets)+len(missing))}
+	for _, target := range targets {
+		result.Items = append(result.Items, e.applyBulkAction(ctx, req, target.GetNamespace(), target.GetName()))
+	}
+	for _, name := range missing {
+		result.Items = append(result.Items, bulkResult("", name,
+			k8serrors.NewNotFound(schema.GroupResource{Resource: "databaseclusters"}, name)))
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+func validateBulkRequest(req *api.DatabaseClusterBulkRequest) error {
+	if len(pointer.Get(req.Selector.Names)) == 0 && pointer.Get(req.Selector.LabelSelector) == "" {
+		return errors.Join(valhandler.ErrInvalidRequest, errBulkNoSelector)
+	}
+	switch req.Action {
+	case api.DatabaseClusterBulkRequestActionPatch:
+		if req.Patch == nil {
+			return errors.Join(valhandler.ErrInvalidRequest, errBulkNoPatch)
+		}
+	case api.DatabaseClusterBulkRequestActionBackup:
+		if pointer.Get(req.BackupStorageName) == "" {
+			return errors.Join(valhandler.ErrInvalidRequest, errBulkNoBackupStorage)
+		}
+	case api.DatabaseClusterBulkRequestActionPause,
+		api.DatabaseClusterBulkRequestActionResume,
+		api.DatabaseClusterBulkRequestActionDelete:
+	default:
+		return errors.Join(valhandler.ErrInvalidRequest, fmt.Errorf("%w '%s'", errBulkUnsupportedAction, req.Action))
+	}
+	return nil
+}
+
+// selectDatabaseClusters returns the database clusters matched by the selector
+// among those the user is allowed to read, and the selected names that did
+// not match any database cluster.
+func (e *EverestServer) selectDatabaseClusters(
+	ctx context.Context,
+	selector api.DatabaseClusterBulkSelector,
+) ([]everestv1alpha1.DatabaseCluster, []string, error) {
+	namespaces := pointer.Get(selector.Namespaces)
+	if len(namespaces) == 0 {
+		var err error
+		if namespaces, err = e.handler.ListNamespaces(ctx); err != nil {
+			return nil, nil, err
+		}
+	}
+	names := pointer.Get(selector.Names)
+	params := &api.ListDatabaseClustersParams{LabelSelector: selector.LabelSelector}
+
+	var result []everestv1alpha1.DatabaseCluster
+	found := make(map[string]bool, len(names))
+	for _, namespace := range namespaces {
+		list, err := e.handler.ListDatabaseClusters(ctx, namespace, params)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, db := range list.Items {
+			if len(names) > 0 && !slices.Contains(names, db.GetName()) {
+				continue
+			}
+			found[db.GetName()] = true
+			result = append(result, db)
+		}
+	}
+	slices.SortFunc(result, func(a, b everestv1alpha1.DatabaseCluster) int {
+		if c := strings.Compare(a.GetNamespace(), b.GetNamespace()); c != 0 {
+			return c
+		}
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+
+	var missing []string
+	for _, name := range names {
+		if !found[name] && !slices.Contains(missing, name) {
+			missing = append(missing, name)
+		}
+	}
+	return result, missing, nil
+}
+
+// applyBulkAction applies the action of the request to a database cluster.
+// The action goes through the whole handler chain, so it is validated and
+// authorized for each database cluster.
+func (e *EverestServer) applyBulkAction(
+	ctx context.Context,
+	req *api.DatabaseClusterBulkRequest,
+	namespace, name string,
+) api.DatabaseClusterBulkResult {
+	var err error
+	switch req.Action {
+	case api.DatabaseClusterBulkRequestActionPatch:
+		var patch []byte
+		if patch, err = json.Marshal(req.Patch); err == nil {
+			_, err = e.patchDatabaseCluster(ctx, namespace, name, patch)
+		}
+	case api.DatabaseClusterBulkRequestActionPause:
+		_, err = e.patchDatabaseCluster(ctx, namespace, name, []byte(`{"spec":{"paused":true}}`))
+	case api.DatabaseClusterBulkRequestActionResume:
+		_, err = e.patchDatabaseCluster(ctx, namespace, name, []byte(`{"spec":{"paused":false}}`))
+	case api.DatabaseClusterBulkRequestActionBackup:
+		_, err = e.handler.CreateDatabaseClusterBackup(ctx, &everestv1alpha1.DatabaseClusterBackup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name + "-" + utilrand.String(bulkBackupNameSuffixLength),
+				Namespace: namespace,
+			},
+			Spec: everestv1alpha1.DatabaseClusterBackupSpec{
+				DBClusterName:     name,
+				BackupStorageName: pointer.Get(req.BackupStorageName),
+			},
+		})
+	case api.DatabaseClusterBulkRequestActionDelete:
+		err = e.handler.DeleteDatabaseCluster(ctx, namespace, name,
+			&api.DeleteDatabaseClusterParams{CleanupBackupStorage: req.CleanupBackupStorage})
+		if cr, ok := pendingApproval(err); ok {
+			return api.DatabaseClusterBulkResult{
+				Namespace:     pointer.ToString(namespace),
+				Name:          name,
+				Result:        api.DatabaseClusterBulkResultResultPendingApproval,
+				ChangeRequest: cr,
+			}
+		}
+	}
+	if err != nil {
+		logger.FromContext(ctx, e.l).Errorf("BulkDatabaseClusterAction failed to %s %s/%s: %v", req.Action, namespace, name, err)
+	}
+	return bulkResult(namespace, name, err)
+}
+
+// bulkResult returns the result of a bulk action that ended with err.
+func bulkResult(namespace, name string, err error) api.DatabaseClusterBulkResult {
+	result := api.DatabaseClusterBulkResult{
+		Name:   name,
+		Result: api.DatabaseClusterBulkResultResultSucceeded,
+	}
+	if namespace != "" {
+		result.Namespace = pointer.ToString(namespace)
+	}
+	if err == nil {
+		return result
+	}
+	result.Result = api.DatabaseClusterBulkResultResultFailed
+	apiErr := api.Error{Message: pointer.ToString(err.Error())}
+	var he *echo.HTTPError
+	if errors.As(toHTTPError(err), &he) {
+		if body, ok := he.Message.(api.Error); ok {
+			apiErr = body
+		}
+	}
+	result.Error = &apiErr
+	return result
+}
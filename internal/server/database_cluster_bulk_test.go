@@ -0,0 +1,133 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/api"
+	k8shandler "github.com/percona/everest/internal/server/handlers/k8s"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+func TestBulkDatabaseClusterAction(t *testing.T) {
+	t.Parallel()
+
+	db := func(namespace, name, env string) *everestv1alpha1.DatabaseCluster {
+		return &everestv1alpha1.DatabaseCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels:    map[string]string{"env": env},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		body       string
+		wantStatus int
+		want       []api.DatabaseClusterBulkResult
+		wantPaused []string
+	}{
+		{
+			name:       "pause by label selector across namespaces",
+			body:       `{"action":"pause","selector":{"namespaces":["ns1","ns2"],"labelSelector":"env=dev"}}`,
+			wantStatus: http.StatusOK,
+			want: []api.DatabaseClusterBulkResult{
+				{Namespace: pointer.ToString("ns1"), Name: "db1", Result: api.DatabaseClusterBulkResultResultSucceeded},
+				{Namespace: pointer.ToString("ns2"), Name: "db3", Result: api.DatabaseClusterBulkResultResultSucceeded},
+			},
+			wantPaused: []string{"ns1/db1", "ns2/db3"},
+		},
+		{
+			name:       "patch by names",
+			body:       `{"action":"patch","selector":{"namespaces":["ns1"],"names":["db2","unknown"]},"patch":{"spec":{"paused":true}}}`,
+			wantStatus: http.StatusOK,
+			want: []api.DatabaseClusterBulkResult{
+				{Namespace: pointer.ToString("ns1"), Name: "db2", Result: api.DatabaseClusterBulkResultResultSucceeded},
+				{Name: "unknown", Result: api.DatabaseClusterBulkResultResultFailed},
+			},
+			wantPaused: []string{"ns1/db2"},
+		},
+		{
+			name:       "no selector",
+			body:       `{"action":"pause","selector":{"namespaces":["ns1"]}}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "backup without storage",
+			body:       `{"action":"backup","selector":{"names":["db1"]}}`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.CreateScheme()).
+				WithObjects(db("ns1", "db1", "dev"), db("ns1", "db2", "prod"), db("ns2", "db3", "dev")).
+				Build()
+			kubeConnector := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(client)
+			e := &EverestServer{
+				kubeConnector: kubeConnector,
+				handler:       k8shandler.New(zap.NewNop().Sugar(), kubeConnector, ""),
+				l:             zap.NewNop().Sugar(),
+			}
+			router := echo.New()
+			router.HTTPErrorHandler = everestErrorHandler(router.DefaultHTTPErrorHandler)
+			router.POST("/v1/database-clusters/bulk", e.BulkDatabaseClusterAction)
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/database-clusters/bulk", bytes.NewBufferString(tc.body))
+			req.GetBody = func() (io.ReadCloser, error) {
+				return io.NopCloser(bytes.NewBufferString(tc.body)), nil
+			}
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+			require.Equal(t, tc.wantStatus, rec.Code, rec.Body.String())
+			if tc.wantStatus != http.StatusOK {
+				return
+			}
+
+			var got api.DatabaseClusterBulkResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+			require.Len(t, got.Items, len(tc.want))
+			for i, want := range tc.want {
+				assert.Equal(t, want.Namespace, got.Items[i].Namespace)
+				assert.Equal(t, want.Name, got.Items[i].Name)
+				assert.Equal(t, want.Result, got.Items[i].Result)
+				if want.Result == api.DatabaseClusterBulkResultResultFailed {
+					require.NotNil(t, got.Items[i].Error)
+					assert.Equal(t, errorCodeNotFound, pointer.Get(got.Items[i].Error.Code))
+				}
+			}
+
+			for _, key := range []types.NamespacedName{
+				{Namespace: "ns1", Name: "db1"},
+				{Namespace: "ns1", Name: "db2"},
+				{Namespace: "ns2", Name: "db3"},
+			} {
+				dbc, err := kubeConnector.GetDatabaseCluster(context.Background(), key)
+				require.NoError(t, err)
+				assert.Equal(t, slices.Contains(tc.wantPaused, key.String()), dbc.Spec.Paused, key.String())
+			}
+		})
+	}
+}
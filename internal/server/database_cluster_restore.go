@@ -23,13 +23,18 @@ import (
 	"github.com/labstack/echo/v4"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/api"
 )
 
 // ListDatabaseClusterRestores List of the created database cluster restores on the specified kubernetes cluster.
-func (e *EverestServer) ListDatabaseClusterRestores(ctx echo.Context, namespace, name string) error {
-	result, err := e.handler.ListDatabaseClusterRestores(ctx.Request().Context(), namespace, name)
+func (e *EverestServer) ListDatabaseClusterRestores(
+	ctx echo.Context,
+	namespace, name string,
+	params api.ListDatabaseClusterRestoresParams,
+) error {
+	result, err := e.handler.ListDatabaseClusterRestores(ctx.Request().Context(), namespace, name, &params)
 	if err != nil {
-		e.l.Errorf("ListDatabaseClusterRestores failed: %w", err)
+		e.log(ctx).Errorf("ListDatabaseClusterRestores failed: %w", err)
 		return err
 	}
 	return ctx.JSON(http.StatusOK, result)
@@ -45,16 +50,18 @@ func (e *EverestServer) CreateDatabaseClusterRestore(ctx echo.Context, namespace
 
 	result, err := e.handler.CreateDatabaseClusterRestore(ctx.Request().Context(), restore)
 	if err != nil {
-		e.l.Errorf("CreateDatabaseClusterRestore failed: %w", err)
+		e.log(ctx).Errorf("CreateDatabaseClusterRestore failed: %w", err)
 		return err
 	}
+	e.startOperation(ctx, namespace, api.OperationTypeDatabaseClusterRestore, result.GetName())
+	setETag(ctx, result)
 	return ctx.JSON(http.StatusCreated, result)
 }
 
 // DeleteDatabaseClusterRestore Delete the specified cluster restore on the specified kubernetes cluster.
 func (e *EverestServer) DeleteDatabaseClusterRestore(ctx echo.Context, namespace, name string) error {
 	if err := e.handler.DeleteDatabaseClusterRestore(ctx.Request().Context(), namespace, name); err != nil {
-		e.l.Errorf("DeleteDatabaseClusterRestore failed: %w", err)
+		e.log(ctx).Errorf("DeleteDatabaseClusterRestore failed: %w", err)
 		return err
 	}
 	return ctx.NoContent(http.StatusNoContent)
@@ -64,9 +71,10 @@ func (e *EverestServer) DeleteDatabaseClusterRestore(ctx echo.Context, namespace
 func (e *EverestServer) GetDatabaseClusterRestore(ctx echo.Context, namespace, name string) error {
 	rs, err := e.handler.GetDatabaseClusterRestore(ctx.Request().Context(), namespace, name)
 	if err != nil {
-		e.l.Errorf("GetDatabaseClusterRestore failed: %w", err)
+		e.log(ctx).Errorf("GetDatabaseClusterRestore failed: %w", err)
 		return err
 	}
+	setETag(ctx, rs)
 	return ctx.JSON(http.StatusOK, rs)
 }
 
@@ -81,8 +89,9 @@ func (e *EverestServer) UpdateDatabaseClusterRestore(ctx echo.Context, namespace
 
 	result, err := e.handler.UpdateDatabaseClusterRestore(ctx.Request().Context(), restore)
 	if err != nil {
-		e.l.Errorf("UpdateDatabaseClusterRestore failed: %w", err)
+		e.log(ctx).Errorf("UpdateDatabaseClusterRestore failed: %w", err)
 		return err
 	}
+	setETag(ctx, result)
 	return ctx.JSON(http.StatusOK, result)
 }
@@ -0,0 +1,68 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/percona/everest/api"
+)
+
+// ListDatabaseClusterSchedules lists the schedules of the specified database cluster.
+func (e *EverestServer) ListDatabaseClusterSchedules(ctx echo.Context, namespace, name string) error {
+	result, err := e.handler.ListDatabaseClusterSchedules(ctx.Request().Context(), namespace, name)
+	if err != nil {
+		e.log(ctx).Errorf("ListDatabaseClusterSchedules failed: %v", err)
+		return err
+	}
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// CreateDatabaseClusterSchedule creates a schedule for the specified database cluster.
+func (e *EverestServer) CreateDatabaseClusterSchedule(ctx echo.Context, namespace, name string) error {
+	req := &api.DatabaseClusterSchedule{}
+	if err := e.getBodyFromContext(ctx, req); err != nil {
+		return errors.Join(errFailedToReadRequestBody, err)
+	}
+	result, err := e.handler.CreateDatabaseClusterSchedule(ctx.Request().Context(), namespace, name, req)
+	if err != nil {
+		e.log(ctx).Errorf("CreateDatabaseClusterSchedule failed: %v", err)
+		return err
+	}
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// GetDatabaseClusterSchedule returns the specified schedule of the database cluster.
+func (e *EverestServer) GetDatabaseClusterSchedule(ctx echo.Context, namespace, name, schedule string) error {
+	result, err := e.handler.GetDatabaseClusterSchedule(ctx.Request().Context(), namespace, name, schedule)
+	if err != nil {
+		e.log(ctx).Errorf("GetDatabaseClusterSchedule failed: %v", err)
+		return err
+	}
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// UpdateDatabaseClusterSchedule updates the specified schedule of the database cluster.
+func (e *EverestServer) UpdateDatabaseClusterSchedule(ctx echo.Context, namespace, name, schedule string) error {
+	req := &api.DatabaseClusterSchedule{}
+	if err := e.getBodyFromContext(ctx, req); err != nil {
+		return errors.Join(errFailedToReadRequestBody, err)
+	}
+	req.Name = schedule
+	result, err := e.handler.UpdateDatabaseClusterSchedule(ctx.Request().Context(), namespace, name, req)
+	if err != nil {
+		e.log(ctx).Errorf("UpdateDatabaseClusterSchedule failed: %v", err)
+		return err
+	}
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// DeleteDatabaseClusterSchedule deletes the specified schedule of the database cluster.
+func (e *EverestServer) DeleteDatabaseClusterSchedule(ctx echo.Context, namespace, name, schedule string) error {
+	if err := e.handler.DeleteDatabaseClusterSchedule(ctx.Request().Context(), namespace, name, schedule); err != nil {
+		e.log(ctx).Errorf("DeleteDatabaseClusterSchedule failed: %v", err)
+		return err
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
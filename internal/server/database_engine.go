@@ -23,13 +23,15 @@ import (
 	"github.com/labstack/echo/v4"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/operations"
 )
 
 // ListDatabaseEngines List of the available database engines on the specified namespace.
 func (e *EverestServer) ListDatabaseEngines(ctx echo.Context, namespace string) error {
 	result, err := e.handler.ListDatabaseEngines(ctx.Request().Context(), namespace)
 	if err != nil {
-		e.l.Errorf("ListDatabaseEngines failed: %w", err)
+		e.log(ctx).Errorf("ListDatabaseEngines failed: %w", err)
 		return err
 	}
 	return ctx.JSON(http.StatusOK, result)
@@ -39,9 +41,10 @@ func (e *EverestServer) ListDatabaseEngines(ctx echo.Context, namespace string)
 func (e *EverestServer) GetDatabaseEngine(ctx echo.Context, namespace, name string) error {
 	result, err := e.handler.GetDatabaseEngine(ctx.Request().Context(), namespace, name)
 	if err != nil {
-		e.l.Errorf("GetDatabaseEngine failed: %w", err)
+		e.log(ctx).Errorf("GetDatabaseEngine failed: %w", err)
 		return err
 	}
+	setETag(ctx, result)
 	return ctx.JSON(http.StatusOK, result)
 }
 
@@ -58,9 +61,10 @@ func (e *EverestServer) UpdateDatabaseEngine(ctx echo.Context, namespace, name s
 
 	result, err := e.handler.UpdateDatabaseEngine(ctx.Request().Context(), dbe)
 	if err != nil {
-		e.l.Errorf("UpdateDatabaseEngine failed: %w", err)
+		e.log(ctx).Errorf("UpdateDatabaseEngine failed: %w", err)
 		return err
 	}
+	setETag(ctx, result)
 	return ctx.JSON(http.StatusOK, result)
 }
 
@@ -71,7 +75,7 @@ func (e *EverestServer) GetUpgradePlan(
 ) error {
 	result, err := e.handler.GetUpgradePlan(ctx.Request().Context(), namespace)
 	if err != nil {
-		e.l.Errorf("GetUpgradePlan failed: %w", err)
+		e.log(ctx).Errorf("GetUpgradePlan failed: %w", err)
 		return err
 	}
 	return ctx.JSON(http.StatusOK, result)
@@ -80,8 +84,9 @@ func (e *EverestServer) GetUpgradePlan(
 // ApproveUpgradePlan starts the upgrade of operators in the provided namespace.
 func (e *EverestServer) ApproveUpgradePlan(ctx echo.Context, namespace string) error {
 	if err := e.handler.ApproveUpgradePlan(ctx.Request().Context(), namespace); err != nil {
-		e.l.Errorf("ApproveUpgradePlan failed: %w", err)
+		e.log(ctx).Errorf("ApproveUpgradePlan failed: %w", err)
 		return err
 	}
+	e.startOperation(ctx, namespace, api.OperationTypeUpgradePlanApproval, operations.UpgradePlanTarget)
 	return nil
 }
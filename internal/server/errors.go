@@ -0,0 +1,148 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net/http"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/percona/everest/api"
+	valhandler "github.com/percona/everest/internal/server/handlers/validation"
+)
+
+// Stable codes of the errors returned by the API.
+const (
+	errorCodeInvalidRequest           = "InvalidRequest"
+	errorCodeInvalid                  = "Invalid"
+	errorCodeUnauthenticated          = "Unauthenticated"
+	errorCodeForbidden                = "Forbidden"
+	errorCodeNotFound                 = "NotFound"
+	errorCodeAlreadyExists            = "AlreadyExists"
+	errorCodeConflict                 = "Conflict"
+	errorCodePreconditionFailed       = "PreconditionFailed"
+	errorCodeTooManyRequests          = "TooManyRequests"
+	errorCodeUnavailable              = "Unavailable"
+	errorCodeInternal                 = "Internal"
+	errorCodeSelfApproval             = "SelfApproval"
+	errorCodeChangeRequestNotPending  = "ChangeRequestNotPending"
+	errorCodeChangeRequestExpired     = "ChangeRequestExpired"
+	errorCodeIdempotencyKeyReused     = "IdempotencyKeyReused"
+	errorCodeIdempotencyKeyInProgress = "IdempotencyKeyInProgress"
+)
+
+// newHTTPError returns an HTTP error with a structured body.
+func newHTTPError(
+	status int,
+	code string,
+	category api.ErrorCategory,
+	message string,
+	violations []api.FieldViolation,
+) *echo.HTTPError {
+	body := api.Error{
+		Message:  pointer.ToString(message),
+		Code:     pointer.ToString(code),
+		Category: pointer.To(category),
+	}
+	if len(violations) > 0 {
+		body.FieldViolations = &violations
+	}
+	return &echo.HTTPError{Code: status, Message: body}
+}
+
+// structuredHTTPError returns a copy of the HTTP error with a structured body.
+// The code and category of the error are derived from its status.
+func structuredHTTPError(he *echo.HTTPError) *echo.HTTPError {
+	var message string
+	switch m := he.Message.(type) {
+	case string:
+		message = m
+	case error:
+		message = m.Error()
+	case nil:
+		message = http.StatusText(he.Code)
+	default:
+		// Already structured.
+		return he
+	}
+	code, category := errorCodeOfStatus(he.Code)
+	result := newHTTPError(he.Code, code, category, message, requestViolations(he.Internal))
+	result.Internal = he.Internal
+	return result
+}
+
+func errorCodeOfStatus(status int) (string, api.ErrorCategory) {
+	switch status {
+	case http.StatusBadRequest:
+		return errorCodeInvalidRequest, api.ErrorCategoryInvalid
+	case http.StatusUnauthorized:
+		return errorCodeUnauthenticated, api.ErrorCategoryUnauthenticated
+	case http.StatusForbidden:
+		return errorCodeForbidden, api.ErrorCategoryForbidden
+	case http.StatusNotFound:
+		return errorCodeNotFound, api.ErrorCategoryNotFound
+	case http.StatusConflict:
+		return errorCodeConflict, api.ErrorCategoryConflict
+	case http.StatusPreconditionFailed:
+		return errorCodePreconditionFailed, api.ErrorCategoryFailedPrecondition
+	case http.StatusTooManyRequests:
+		return errorCodeTooManyRequests, api.ErrorCategoryUnavailable
+	case http.StatusServiceUnavailable:
+		return errorCodeUnavailable, api.ErrorCategoryUnavailable
+	}
+	if status < http.StatusInternalServerError {
+		return errorCodeInvalidRequest, api.ErrorCategoryInvalid
+	}
+	return errorCodeInternal, api.ErrorCategoryInternal
+}
+
+// fieldViolations returns the field violations reported by the validation handlers.
+func fieldViolations(err error) []api.FieldViolation {
+	fieldErrs := valhandler.FieldErrors(err)
+	if len(fieldErrs) == 0 {
+		return nil
+	}
+	result := make([]api.FieldViolation, 0, len(fieldErrs))
+	for _, fieldErr := range fieldErrs {
+		result = append(result, api.FieldViolation{
+			Field:  fieldErr.Field,
+			Reason: fieldErr.Err.Error(),
+		})
+	}
+	return result
+}
+
+// statusViolations returns the field violations reported by the Kubernetes API
+// server or an admission webhook.
+func statusViolations(statusError *k8serrors.StatusError) []api.FieldViolation {
+	details := statusError.Status().Details
+	if details == nil {
+		return nil
+	}
+	var result []api.FieldViolation
+	for _, cause := range details.Causes {
+		if cause.Field == "" {
+			continue
+		}
+		result = append(result, api.FieldViolation{
+			Field:  cause.Field,
+			Reason: trimWebhookErrorText(cause.Message),
+		})
+	}
+	return result
+}
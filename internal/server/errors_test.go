@@ -0,0 +1,113 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/percona/everest/api"
+	valhandler "github.com/percona/everest/internal/server/handlers/validation"
+	"github.com/percona/everest/pkg/accounts"
+)
+
+func TestEverestErrorHandler(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantBody   api.Error
+	}{
+		{
+			name:       "validation error",
+			err:        errors.Join(valhandler.ErrInvalidRequest, valhandler.ErrInvalidURL("url")),
+			wantStatus: http.StatusBadRequest,
+			wantBody: api.Error{
+				Message:         pointer.To("Invalid request\n'url' is an invalid URL"),
+				Code:            pointer.To(errorCodeInvalidRequest),
+				Category:        pointer.To(api.ErrorCategoryInvalid),
+				FieldViolations: &[]api.FieldViolation{{Field: "url", Reason: "'url' is an invalid URL"}},
+			},
+		},
+		{
+			name: "webhook error",
+			err: k8serrors.NewInvalid(schema.GroupKind{Kind: "DatabaseCluster"}, "db", field.ErrorList{
+				field.Invalid(field.NewPath("spec", "engine", "replicas"), 2, "must be odd"),
+			}),
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody: api.Error{
+				Message:         pointer.To(`DatabaseCluster "db" is invalid: spec.engine.replicas: Invalid value: 2: must be odd`),
+				Code:            pointer.To(errorCodeInvalid),
+				Category:        pointer.To(api.ErrorCategoryInvalid),
+				FieldViolations: &[]api.FieldViolation{{Field: "spec.engine.replicas", Reason: "Invalid value: 2: must be odd"}},
+			},
+		},
+		{
+			name:       "already exists",
+			err:        k8serrors.NewAlreadyExists(schema.GroupResource{Resource: "databaseclusters"}, "db"),
+			wantStatus: http.StatusConflict,
+			wantBody: api.Error{
+				Message:  pointer.To(http.StatusText(http.StatusConflict)),
+				Code:     pointer.To(errorCodeAlreadyExists),
+				Category: pointer.To(api.ErrorCategoryConflict),
+			},
+		},
+		{
+			name:       "echo error",
+			err:        echo.NewHTTPError(http.StatusUnauthorized, "missing token"),
+			wantStatus: http.StatusUnauthorized,
+			wantBody: api.Error{
+				Message:  pointer.To("Missing token"),
+				Code:     pointer.To(errorCodeUnauthenticated),
+				Category: pointer.To(api.ErrorCategoryUnauthenticated),
+			},
+		},
+		{
+			name:       "session error",
+			err:        sessionErrToHTTPRes(accounts.ErrAccountDisabled),
+			wantStatus: http.StatusForbidden,
+			wantBody: api.Error{
+				Message:  pointer.To("User account is disabled"),
+				Code:     pointer.To(errorCodeForbidden),
+				Category: pointer.To(api.ErrorCategoryForbidden),
+			},
+		},
+		{
+			name:       "internal error",
+			err:        errors.New("boom"),
+			wantStatus: http.StatusInternalServerError,
+			wantBody: api.Error{
+				Message:  pointer.To("Boom"),
+				Code:     pointer.To(errorCodeInternal),
+				Category: pointer.To(api.ErrorCategoryInternal),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+
+			everestErrorHandler(e.DefaultHTTPErrorHandler)(tc.err, c)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			var body api.Error
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			assert.Equal(t, tc.wantBody, body)
+		})
+	}
+}
@@ -28,15 +28,16 @@ import (
 	"path"
 	"slices"
 	"strings"
+	"sync/atomic"
 	"text/template"
+	"time"
 
-	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/golang-jwt/jwt/v5"
 	echojwt "github.com/labstack/echo-jwt/v4"
 	"github.com/labstack/echo/v4"
 	echomiddleware "github.com/labstack/echo/v4/middleware"
-	middleware "github.com/oapi-codegen/echo-middleware"
 	"github.com/unrolled/secure"
+	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
 	"golang.org/x/time/rate"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -49,11 +50,17 @@ import (
 	"github.com/percona/everest/internal/server/handlers"
 	k8shandler "github.com/percona/everest/internal/server/handlers/k8s"
 	rbachandler "github.com/percona/everest/internal/server/handlers/rbac"
+	tracinghandler "github.com/percona/everest/internal/server/handlers/tracing"
 	valhandler "github.com/percona/everest/internal/server/handlers/validation"
 	"github.com/percona/everest/pkg/accounts"
+	"github.com/percona/everest/pkg/approval"
 	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/etag"
+	"github.com/percona/everest/pkg/idempotency"
 	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/logger"
 	"github.com/percona/everest/pkg/oidc"
+	"github.com/percona/everest/pkg/rbac"
 	"github.com/percona/everest/pkg/session"
 	"github.com/percona/everest/public"
 )
@@ -69,6 +76,8 @@ type EverestServer struct {
 	attemptsStore *RateLimiterMemoryStore
 	handler       handlers.Handler
 	oidcProvider  *oidc.ProviderConfig
+	// leading is set while this replica is elected to run the background jobs.
+	leading atomic.Bool
 }
 
 func getOIDCProviderConfig(ctx context.Context, kubeClient kubernetes.KubernetesConnector) (*oidc.ProviderConfig, error) {
@@ -109,6 +118,9 @@ func NewEverestServer(ctx context.Context, c *config.EverestConfig, l *zap.Sugar
 	}
 
 	echoServer := echo.New()
+	echoServer.Use(tracingMiddleware(otel.GetTracerProvider(), otel.GetTextMapPropagator()))
+	echoServer.Use(requestID)
+	echoServer.Use(accessLog(l))
 	echoServer.Use(echomiddleware.RateLimiter(echomiddleware.NewRateLimiterMemoryStore(rate.Limit(c.APIRequestsRateLimit))))
 	middleware, store := sessionRateLimiter(c.CreateSessionRateLimit)
 	echoServer.Use(middleware)
@@ -142,7 +154,7 @@ func NewEverestServer(ctx context.Context, c *config.EverestConfig, l *zap.Sugar
 	}
 	e.echo.HTTPErrorHandler = e.errorHandlerChain()
 
-	if err := e.setupHandlers(ctx, l, kubeConnector, c.VersionServiceURL); err != nil {
+	if err := e.setupHandlers(ctx, l, kubeConnector, c); err != nil {
 		return nil, err
 	}
 
@@ -186,13 +198,6 @@ func (e *EverestServer) initHTTPServer(ctx context.Context) error {
 	e.echo.GET("/static/*", echo.WrapHandler(staticFilesHandler), e.securityHeaders())
 
 	// Middlewares
-	e.echo.Use(echomiddleware.LoggerWithConfig(echomiddleware.LoggerConfig{
-		Format:           echomiddleware.DefaultLoggerConfig.Format,
-		CustomTimeFormat: echomiddleware.DefaultLoggerConfig.CustomTimeFormat,
-		Skipper: func(c echo.Context) bool {
-			return c.Request().RequestURI == "/healthz"
-		},
-	}))
 	e.echo.Pre(echomiddleware.RemoveTrailingSlash())
 
 	// Setup the API handlers.
@@ -208,14 +213,7 @@ func (e *EverestServer) initHTTPServer(ctx context.Context) error {
 	apiGroup := e.echo.Group(basePath)
 
 	// Use our validation middleware to check all requests against the OpenAPI schema.
-	apiGroup.Use(middleware.OapiRequestValidatorWithOptions(swagger, &middleware.Options{
-		SilenceServersWarning: true,
-		// This field is required if a security scheme is specified.
-		// However, the actual authentication is handled by the JWT middleware, so we can use a noop function here.
-		Options: openapi3filter.Options{
-			AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
-		},
-	}))
+	apiGroup.Use(requestValidator(swagger))
 
 	// Setup and use JWT middleware.
 	jwtMW, err := e.jwtMiddleWare(ctx)
@@ -231,6 +229,8 @@ func (e *EverestServer) initHTTPServer(ctx context.Context) error {
 	apiGroup.Use(blocklistMW)
 
 	apiGroup.Use(e.checkOperatorUpgradeState)
+	apiGroup.Use(ifMatch)
+	apiGroup.Use(e.idempotency)
 	api.RegisterHandlers(apiGroup, e)
 
 	return nil
@@ -240,18 +240,40 @@ func (e *EverestServer) setupHandlers(
 	ctx context.Context,
 	log *zap.SugaredLogger,
 	kubeConnector kubernetes.KubernetesConnector,
-	vsURL string,
+	c *config.EverestConfig,
 ) error {
-	k8sH := k8shandler.New(log, kubeConnector, vsURL)
+	k8sOpts, err := k8sHandlerOptions(c)
+	if err != nil {
+		return err
+	}
+	k8sH := k8shandler.New(log, kubeConnector, c.VersionServiceURL, k8sOpts...)
 	valH := valhandler.New(log, kubeConnector)
 	rbacH, err := rbachandler.New(ctx, log, kubeConnector)
 	if err != nil {
 		return errors.Join(err, errors.New("could not create rbac handler"))
 	}
-	e.setHandlers(valH, rbacH, k8sH)
+	tp := otel.GetTracerProvider()
+	e.setHandlers(
+		tracinghandler.New("validation", valH, tp),
+		tracinghandler.New("rbac", rbacH, tp),
+		tracinghandler.New("k8s", k8sH, tp),
+	)
 	return nil
 }
 
+// k8sHandlerOptions returns the options of the k8s handler derived from the configuration.
+func k8sHandlerOptions(c *config.EverestConfig) ([]k8shandler.Option, error) {
+	var opts []k8shandler.Option
+	if c.SoftDeleteRetention != "" {
+		retention, err := time.ParseDuration(c.SoftDeleteRetention)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("could not parse soft-delete retention"))
+		}
+		opts = append(opts, k8shandler.WithSoftDeleteRetention(retention))
+	}
+	return opts, nil
+}
+
 func (e *EverestServer) setHandlers(hs ...handlers.Handler) {
 	e.handler = newHandlerChain(hs...)
 }
@@ -324,6 +346,9 @@ func (e *EverestServer) jwtMiddleWare(ctx context.Context) (echo.MiddlewareFunc,
 			// We will copy it to the context.Context as well.
 			ctx := c.Request().Context()
 			newCtx := context.WithValue(ctx, common.UserCtxKey, c.Get(common.UserCtxKey))
+			if user, err := rbac.GetUser(newCtx); err == nil {
+				newCtx = logger.WithFields(newCtx, "user", user.Subject)
+			}
 			newReq := c.Request().WithContext(newCtx)
 			c.SetRequest(newReq)
 		},
@@ -407,6 +432,11 @@ func (e *EverestServer) Shutdown(ctx context.Context) error {
 	return nil
 }
 
+// log returns the logger of the request being served.
+func (e *EverestServer) log(c echo.Context) *zap.SugaredLogger {
+	return logger.FromContext(c.Request().Context(), e.l)
+}
+
 func (e *EverestServer) getBodyFromContext(ctx echo.Context, into any) error {
 	// GetBody creates a copy of the body to avoid "spoiling" the request before proxing
 	reader, err := ctx.Request().GetBody()
@@ -442,52 +472,73 @@ func (e *EverestServer) errorHandlerChain() echo.HTTPErrorHandler {
 
 func everestErrorHandler(next echo.HTTPErrorHandler) echo.HTTPErrorHandler {
 	return func(err error, c echo.Context) {
-		echoErrTarget := &echo.HTTPError{}
-		switch {
-		case errors.As(err, &echoErrTarget):
-		case k8serrors.IsNotFound(err):
-			statusError := &k8serrors.StatusError{}
-			if errors.As(err, &statusError) {
-				err = &echo.HTTPError{
-					Code:    int(statusError.Status().Code),
-					Message: trimWebhookErrorText(statusError.Status().Message),
-				}
-			}
-		case k8serrors.IsForbidden(err),
-			k8serrors.IsInvalid(err):
-			statusError := &k8serrors.StatusError{}
-			if errors.As(err, &statusError) {
-				err = &echo.HTTPError{
-					Code:    int(statusError.Status().Code),
-					Message: trimWebhookErrorText(statusError.Status().Message),
-				}
-			}
-		case k8serrors.IsAlreadyExists(err),
-			k8serrors.IsConflict(err):
-			err = &echo.HTTPError{
-				Code: http.StatusConflict,
-			}
-		case errors.Is(err, rbachandler.ErrInsufficientPermissions):
-			err = &echo.HTTPError{
-				Code:    http.StatusForbidden,
-				Message: rbachandler.ErrInsufficientPermissions.Error(),
-			}
-		case errors.Is(err, valhandler.ErrInvalidRequest),
-			errors.Is(err, errFailedToReadRequestBody):
-			err = &echo.HTTPError{
-				Code:    http.StatusBadRequest,
-				Message: err.Error(),
-			}
-		default:
-			err = &echo.HTTPError{
-				Code:    http.StatusInternalServerError,
-				Message: err.Error(),
-			}
-		}
-		next(err, c)
+		next(toHTTPError(err), c)
 	}
 }
 
+// toHTTPError returns the HTTP error with a structured body that reports err.
+func toHTTPError(err error) error {
+	echoErrTarget := &echo.HTTPError{}
+	switch {
+	case errors.As(err, &echoErrTarget):
+		err = structuredHTTPError(echoErrTarget)
+	// Checked before the Kubernetes errors, since it may wrap a conflict.
+	case errors.Is(err, etag.ErrPreconditionFailed):
+		err = newHTTPError(http.StatusPreconditionFailed, errorCodePreconditionFailed,
+			api.ErrorCategoryFailedPrecondition, etag.ErrPreconditionFailed.Error(), nil)
+	case k8serrors.IsNotFound(err):
+		statusError := &k8serrors.StatusError{}
+		if errors.As(err, &statusError) {
+			err = newHTTPError(int(statusError.Status().Code), errorCodeNotFound,
+				api.ErrorCategoryNotFound, trimWebhookErrorText(statusError.Status().Message), nil)
+		}
+	case k8serrors.IsForbidden(err):
+		statusError := &k8serrors.StatusError{}
+		if errors.As(err, &statusError) {
+			err = newHTTPError(int(statusError.Status().Code), errorCodeForbidden,
+				api.ErrorCategoryForbidden, trimWebhookErrorText(statusError.Status().Message), statusViolations(statusError))
+		}
+	case k8serrors.IsInvalid(err):
+		statusError := &k8serrors.StatusError{}
+		if errors.As(err, &statusError) {
+			err = newHTTPError(int(statusError.Status().Code), errorCodeInvalid,
+				api.ErrorCategoryInvalid, trimWebhookErrorText(statusError.Status().Message), statusViolations(statusError))
+		}
+	case k8serrors.IsAlreadyExists(err):
+		err = newHTTPError(http.StatusConflict, errorCodeAlreadyExists,
+			api.ErrorCategoryConflict, http.StatusText(http.StatusConflict), nil)
+	case k8serrors.IsConflict(err):
+		err = newHTTPError(http.StatusConflict, errorCodeConflict,
+			api.ErrorCategoryConflict, http.StatusText(http.StatusConflict), nil)
+	case errors.Is(err, approval.ErrSelfApproval):
+		err = newHTTPError(http.StatusForbidden, errorCodeSelfApproval,
+			api.ErrorCategoryForbidden, approval.ErrSelfApproval.Error(), nil)
+	case errors.Is(err, idempotency.ErrKeyReused):
+		err = newHTTPError(http.StatusUnprocessableEntity, errorCodeIdempotencyKeyReused,
+			api.ErrorCategoryInvalid, idempotency.ErrKeyReused.Error(), nil)
+	case errors.Is(err, idempotency.ErrInProgress):
+		err = newHTTPError(http.StatusConflict, errorCodeIdempotencyKeyInProgress,
+			api.ErrorCategoryConflict, idempotency.ErrInProgress.Error(), nil)
+	case errors.Is(err, approval.ErrNotPending):
+		err = newHTTPError(http.StatusConflict, errorCodeChangeRequestNotPending,
+			api.ErrorCategoryConflict, err.Error(), nil)
+	case errors.Is(err, approval.ErrExpired):
+		err = newHTTPError(http.StatusConflict, errorCodeChangeRequestExpired,
+			api.ErrorCategoryConflict, err.Error(), nil)
+	case errors.Is(err, rbachandler.ErrInsufficientPermissions):
+		err = newHTTPError(http.StatusForbidden, errorCodeForbidden,
+			api.ErrorCategoryForbidden, rbachandler.ErrInsufficientPermissions.Error(), nil)
+	case errors.Is(err, valhandler.ErrInvalidRequest),
+		errors.Is(err, errFailedToReadRequestBody):
+		err = newHTTPError(http.StatusBadRequest, errorCodeInvalidRequest,
+			api.ErrorCategoryInvalid, err.Error(), fieldViolations(err))
+	default:
+		err = newHTTPError(http.StatusInternalServerError, errorCodeInternal,
+			api.ErrorCategoryInternal, err.Error(), nil)
+	}
+	return err
+}
+
 func trimWebhookErrorText(fullText string) string {
 	monitoringWebhookPrefix := `admission webhook "vmonitoringconfig-v1alpha1.everest.percona.com" denied the request: `
 	loadBalancerConfigWebhookPrefix := `admission webhook "vloadbalancerconfig-v1alpha1.everest.percona.com" denied the request: `
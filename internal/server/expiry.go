@@ -0,0 +1,27 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	k8shandler "github.com/percona/everest/internal/server/handlers/k8s"
+)
+
+// expiryReaperInterval is the interval between two runs of the expiry reaper job.
+const expiryReaperInterval = time.Minute
+
+// RunExpiryReaperJob runs the background job that warns about database clusters
+// that are about to expire and pauses or deletes the expired ones according
+// to the expiry policy of their namespace.
+func (e *EverestServer) RunExpiryReaperJob(ctx context.Context) {
+	opts, err := k8sHandlerOptions(e.config)
+	if err != nil {
+		e.l.Error(fmt.Errorf("failed to start the expiry reaper job: %w", err))
+		return
+	}
+	e.runPeriodicJob(ctx, expiryReaperInterval, "reap expired database clusters",
+		func(ctx context.Context, namespace string, now time.Time) error {
+			return k8shandler.ReapExpiredDatabaseClusters(ctx, e.l, e.kubeConnector, namespace, now, opts...)
+		})
+}
@@ -35,6 +35,9 @@ type Handler interface {
 	DataImporterHandler
 	DataImportJobHandler
 	EngineFeaturesHandler
+	ChangeRequestHandler
+	DatabaseClusterScheduleHandler
+	OperationHandler
 
 	GetKubernetesClusterResources(ctx context.Context) (*api.KubernetesClusterResources, error)
 	GetKubernetesClusterInfo(ctx context.Context) (*api.KubernetesClusterInfo, error)
@@ -44,29 +47,49 @@ type Handler interface {
 
 type StreamFunc func(ctx context.Context, namespace, clusterName, componentName string, params api.GetDatabaseClusterComponentLogsParams) error
 
+// LogSource is a container of a database cluster whose logs are read.
+type LogSource struct {
+	Pod       string
+	Container string
+	// Previous is set to read the logs of the previous instance of the container, e.g. after a crash.
+	Previous bool
+}
+
+// LogsStreamFunc streams the logs of the given containers of a database cluster.
+type LogsStreamFunc func(ctx context.Context, namespace string, sources []LogSource, params api.GetDatabaseClusterLogsParams) error
+
 // DatabaseClusterHandler provides methods for handling operations on database clusters.
 type DatabaseClusterHandler interface {
 	CreateDatabaseCluster(ctx context.Context, req *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error)
 	UpdateDatabaseCluster(ctx context.Context, req *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error)
-	ListDatabaseClusters(ctx context.Context, namespace string) (*everestv1alpha1.DatabaseClusterList, error)
+	ListDatabaseClusters(ctx context.Context, namespace string, params *api.ListDatabaseClustersParams) (*everestv1alpha1.DatabaseClusterList, error)
 	DeleteDatabaseCluster(ctx context.Context, namespace, name string, delReq *api.DeleteDatabaseClusterParams) error
 	GetDatabaseCluster(ctx context.Context, namespace, name string) (*everestv1alpha1.DatabaseCluster, error)
 	GetDatabaseClusterCredentials(ctx context.Context, namespace, name string) (*api.DatabaseClusterCredential, error)
 	GetDatabaseClusterComponents(ctx context.Context, namespace, name string) ([]api.DatabaseClusterComponent, error)
 	GetDatabaseClusterComponentLogs(ctx context.Context, namespace, clusterName, componentName string, params api.GetDatabaseClusterComponentLogsParams, stream StreamFunc) error
+	GetDatabaseClusterLogs(ctx context.Context, namespace, name string, params api.GetDatabaseClusterLogsParams, stream LogsStreamFunc) error
+	GetDatabaseClusterEvents(ctx context.Context, namespace, name string, params *api.GetDatabaseClusterEventsParams) (*api.DatabaseClusterEventList, error)
+	GetDatabaseClusterDiagnostics(ctx context.Context, namespace, name string, params *api.GetDatabaseClusterDiagnosticsParams) (*api.DatabaseClusterDiagnostics, error)
 	GetDatabaseClusterPitr(ctx context.Context, namespace, name string) (*api.DatabaseClusterPitr, error)
 	CreateDatabaseClusterSecret(ctx context.Context, namespace, dbName string, secret *corev1.Secret) (*corev1.Secret, error)
+	ListDeletedDatabaseClusters(ctx context.Context, namespace string) (*everestv1alpha1.DatabaseClusterList, error)
+	GetDeletedDatabaseCluster(ctx context.Context, namespace, name string) (*everestv1alpha1.DatabaseCluster, error)
+	RestoreDatabaseCluster(ctx context.Context, namespace, name string) (*everestv1alpha1.DatabaseCluster, error)
+	PurgeDatabaseCluster(ctx context.Context, namespace, name string) error
+	UpdateDatabaseClusterDeletionProtection(ctx context.Context, namespace, name string, enabled bool) (*everestv1alpha1.DatabaseCluster, error)
 }
 
 // NamespacesHandler provides methods for handling operations on namespaces.
 type NamespacesHandler interface {
 	ListNamespaces(ctx context.Context) ([]string, error)
+	GetNamespaceQuota(ctx context.Context, namespace string) (*api.NamespaceQuotaUsage, error)
 }
 
 // DatabaseClusterBackupHandler provides methods for handling operations on database cluster backups.
 type DatabaseClusterBackupHandler interface {
 	GetDatabaseClusterBackup(ctx context.Context, namespace, name string) (*everestv1alpha1.DatabaseClusterBackup, error)
-	ListDatabaseClusterBackups(ctx context.Context, namespace, clusterName string) (*everestv1alpha1.DatabaseClusterBackupList, error)
+	ListDatabaseClusterBackups(ctx context.Context, namespace, clusterName string, params *api.ListDatabaseClusterBackupsParams) (*everestv1alpha1.DatabaseClusterBackupList, error)
 	CreateDatabaseClusterBackup(ctx context.Context, req *everestv1alpha1.DatabaseClusterBackup) (*everestv1alpha1.DatabaseClusterBackup, error)
 	DeleteDatabaseClusterBackup(ctx context.Context, namespace, name string, req *api.DeleteDatabaseClusterBackupParams) error
 }
@@ -76,7 +99,7 @@ type DatabaseClusterRestoreHandler interface {
 	CreateDatabaseClusterRestore(ctx context.Context, req *everestv1alpha1.DatabaseClusterRestore) (*everestv1alpha1.DatabaseClusterRestore, error)
 	UpdateDatabaseClusterRestore(ctx context.Context, req *everestv1alpha1.DatabaseClusterRestore) (*everestv1alpha1.DatabaseClusterRestore, error)
 	GetDatabaseClusterRestore(ctx context.Context, namespace, name string) (*everestv1alpha1.DatabaseClusterRestore, error)
-	ListDatabaseClusterRestores(ctx context.Context, namespace, clusterName string) (*everestv1alpha1.DatabaseClusterRestoreList, error)
+	ListDatabaseClusterRestores(ctx context.Context, namespace, clusterName string, params *api.ListDatabaseClusterRestoresParams) (*everestv1alpha1.DatabaseClusterRestoreList, error)
 	DeleteDatabaseClusterRestore(ctx context.Context, namespace, name string) error
 }
 
@@ -93,7 +116,7 @@ type DatabaseEngineHandler interface {
 type BackupStorageHandler interface {
 	CreateBackupStorage(ctx context.Context, namespace string, req *api.CreateBackupStorageParams) (*everestv1alpha1.BackupStorage, error)
 	UpdateBackupStorage(ctx context.Context, name, namespace string, req *api.UpdateBackupStorageParams) (*everestv1alpha1.BackupStorage, error)
-	ListBackupStorages(ctx context.Context, namespace string) (*everestv1alpha1.BackupStorageList, error)
+	ListBackupStorages(ctx context.Context, namespace string, params *api.ListBackupStoragesParams) (*everestv1alpha1.BackupStorageList, error)
 	GetBackupStorage(ctx context.Context, namespace, name string) (*everestv1alpha1.BackupStorage, error)
 	DeleteBackupStorage(ctx context.Context, namespace, name string) error
 }
@@ -102,7 +125,7 @@ type BackupStorageHandler interface {
 type MonitoringInstanceHandler interface {
 	CreateMonitoringInstance(ctx context.Context, namespace string, req *api.CreateMonitoringInstanceJSONRequestBody) (*everestv1alpha1.MonitoringConfig, error)
 	UpdateMonitoringInstance(ctx context.Context, namespace, name string, req *api.UpdateMonitoringInstanceJSONRequestBody) (*everestv1alpha1.MonitoringConfig, error)
-	ListMonitoringInstances(ctx context.Context, namespaces string) (*everestv1alpha1.MonitoringConfigList, error)
+	ListMonitoringInstances(ctx context.Context, namespaces string, params *api.ListMonitoringInstancesParams) (*everestv1alpha1.MonitoringConfigList, error)
 	GetMonitoringInstance(ctx context.Context, namespace, name string) (*everestv1alpha1.MonitoringConfig, error)
 	DeleteMonitoringInstance(ctx context.Context, namespace, name string) error
 }
@@ -135,6 +158,25 @@ type DataImportJobHandler interface {
 	ListDataImportJobs(ctx context.Context, namespace, dbName string) (*everestv1alpha1.DataImportJobList, error)
 }
 
+// ChangeRequestHandler provides methods for handling operations on change requests.
+type ChangeRequestHandler interface {
+	ListChangeRequests(ctx context.Context, namespace string) (*api.ChangeRequestList, error)
+	GetChangeRequest(ctx context.Context, namespace, name string) (*api.ChangeRequest, error)
+	ApproveChangeRequest(ctx context.Context, namespace, name string) (*api.ChangeRequest, error)
+	RejectChangeRequest(ctx context.Context, namespace, name string) (*api.ChangeRequest, error)
+	// CompleteChangeRequest records the result of the execution of an approved change request.
+	CompleteChangeRequest(ctx context.Context, namespace, name string, execErr error) (*api.ChangeRequest, error)
+}
+
+// DatabaseClusterScheduleHandler provides methods for handling operations on database cluster schedules.
+type DatabaseClusterScheduleHandler interface {
+	ListDatabaseClusterSchedules(ctx context.Context, namespace, dbName string) (*api.DatabaseClusterScheduleList, error)
+	GetDatabaseClusterSchedule(ctx context.Context, namespace, dbName, name string) (*api.DatabaseClusterSchedule, error)
+	CreateDatabaseClusterSchedule(ctx context.Context, namespace, dbName string, req *api.DatabaseClusterSchedule) (*api.DatabaseClusterSchedule, error)
+	UpdateDatabaseClusterSchedule(ctx context.Context, namespace, dbName string, req *api.DatabaseClusterSchedule) (*api.DatabaseClusterSchedule, error)
+	DeleteDatabaseClusterSchedule(ctx context.Context, namespace, dbName, name string) error
+}
+
 //  ------ Engine Features interfaces ------
 
 // EngineFeaturesHandler provides methods for handling operations on engine features.
@@ -152,3 +194,10 @@ type SplitHorizonDNSConfigHandler interface {
 	DeleteSplitHorizonDNSConfig(ctx context.Context, namespace, name string) error
 	GetSplitHorizonDNSConfig(ctx context.Context, namespace, name string) (*enginefeaturesv1alpha1.SplitHorizonDNSConfig, error)
 }
+
+// OperationHandler provides methods for handling operations that track asynchronous actions.
+type OperationHandler interface {
+	CreateOperation(ctx context.Context, namespace string, opType api.OperationType, target string) (*api.Operation, error)
+	ListOperations(ctx context.Context, namespace string) (*api.OperationList, error)
+	GetOperation(ctx context.Context, namespace, name string) (*api.Operation, error)
+}
@@ -15,10 +15,21 @@ import (
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
 	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/etag"
+	"github.com/percona/everest/pkg/pagination"
+	"github.com/percona/everest/pkg/userlabels"
 )
 
-func (h *k8sHandler) ListBackupStorages(ctx context.Context, namespace string) (*everestv1alpha1.BackupStorageList, error) {
-	return h.kubeConnector.ListBackupStorages(ctx, ctrlclient.InNamespace(namespace))
+func (h *k8sHandler) ListBackupStorages(
+	ctx context.Context,
+	namespace string,
+	params *api.ListBackupStoragesParams,
+) (*everestv1alpha1.BackupStorageList, error) {
+	opts, err := pagination.BackupStorageOptions(params).ListOptions(namespace, nil)
+	if err != nil {
+		return nil, err
+	}
+	return h.kubeConnector.ListBackupStorages(ctx, opts...)
 }
 
 func (h *k8sHandler) GetBackupStorage(ctx context.Context, namespace, name string) (*everestv1alpha1.BackupStorage, error) {
@@ -56,8 +67,10 @@ func (h *k8sHandler) CreateBackupStorage(ctx context.Context, namespace string,
 	}
 	bs = &everestv1alpha1.BackupStorage{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      req.Name,
-			Namespace: namespace,
+			Name:        req.Name,
+			Namespace:   namespace,
+			Labels:      pointer.Get(req.Labels),
+			Annotations: pointer.Get(req.Annotations),
 		},
 		Spec: everestv1alpha1.BackupStorageSpec{
 			Type:                  everestv1alpha1.BackupStorageType(req.Type),
@@ -111,6 +124,9 @@ func (h *k8sHandler) UpdateBackupStorage(ctx context.Context, namespace, name st
 	if err != nil {
 		return nil, fmt.Errorf("failed to get backup storage: %w", err)
 	}
+	if err := etag.Check(ctx, bs); err != nil {
+		return nil, err
+	}
 	if req.BucketName != nil {
 		bs.Spec.Bucket = *req.BucketName
 	}
@@ -132,10 +148,22 @@ func (h *k8sHandler) UpdateBackupStorage(ctx context.Context, namespace, name st
 	if req.ForcePathStyle != nil {
 		bs.Spec.ForcePathStyle = req.ForcePathStyle
 	}
-	return h.kubeConnector.UpdateBackupStorage(ctx, bs)
+	if req.Labels != nil {
+		bs.SetLabels(userlabels.Merge(*req.Labels, bs.GetLabels()))
+	}
+	if req.Annotations != nil {
+		bs.SetAnnotations(userlabels.MergeAnnotations(*req.Annotations, bs.GetAnnotations()))
+	}
+	updated, err := h.kubeConnector.UpdateBackupStorage(ctx, bs)
+	return updated, etag.FromConflict(ctx, err)
 }
 
 func (h *k8sHandler) DeleteBackupStorage(ctx context.Context, namespace, name string) error {
+	if err := checkIfMatch(ctx, func() (*everestv1alpha1.BackupStorage, error) {
+		return h.kubeConnector.GetBackupStorage(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+	}); err != nil {
+		return err
+	}
 	delBSObj := &everestv1alpha1.BackupStorage{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      name,
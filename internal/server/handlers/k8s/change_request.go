@@ -0,0 +1,220 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/approval"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+func (h *k8sHandler) ListChangeRequests(ctx context.Context, namespace string) (*api.ChangeRequestList, error) {
+	cms, err := h.kubeConnector.ListConfigMaps(ctx,
+		ctrlclient.InNamespace(namespace),
+		ctrlclient.HasLabels{common.EverestChangeRequestLabel},
+	)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	result := &api.ChangeRequestList{Items: make([]api.ChangeRequest, 0, len(cms.Items))}
+	for _, cm := range cms.Items {
+		cr, err := approval.FromConfigMap(&cm)
+		if err != nil {
+			logger.FromContext(ctx, h.log).Warnf("Skipping change request %s/%s: %v", cm.GetNamespace(), cm.GetName(), err)
+			continue
+		}
+		approval.Refresh(cr, now)
+		result.Items = append(result.Items, *cr)
+	}
+	return result, nil
+}
+
+func (h *k8sHandler) GetChangeRequest(ctx context.Context, namespace, name string) (*api.ChangeRequest, error) {
+	cr, _, err := h.getChangeRequest(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	approval.Refresh(cr, time.Now())
+	return cr, nil
+}
+
+func (h *k8sHandler) ApproveChangeRequest(ctx context.Context, namespace, name string) (*api.ChangeRequest, error) {
+	return h.decideChangeRequest(ctx, namespace, name, api.Approved)
+}
+
+func (h *k8sHandler) RejectChangeRequest(ctx context.Context, namespace, name string) (*api.ChangeRequest, error) {
+	return h.decideChangeRequest(ctx, namespace, name, api.Rejected)
+}
+
+func (h *k8sHandler) CompleteChangeRequest(ctx context.Context, namespace, name string, execErr error) (*api.ChangeRequest, error) {
+	cr, cm, err := h.getChangeRequest(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	if cr.State != api.Approved {
+		return nil, approval.ErrNotApproved
+	}
+	approval.Complete(cr, execErr)
+	updated, err := approval.ToConfigMap(cr)
+	if err != nil {
+		return nil, err
+	}
+	cm.Data = updated.Data
+	if _, err := h.kubeConnector.UpdateConfigMap(ctx, cm); err != nil {
+		return nil, err
+	}
+	return cr, nil
+}
+
+// PurgeChangeRequests deletes the change requests in the namespace whose retention period is over.
+func PurgeChangeRequests(
+	ctx context.Context,
+	log *zap.SugaredLogger,
+	kubeConnector kubernetes.KubernetesConnector,
+	namespace string,
+	now time.Time,
+) error {
+	cms, err := kubeConnector.ListConfigMaps(ctx,
+		ctrlclient.InNamespace(namespace),
+		ctrlclient.HasLabels{common.EverestChangeRequestLabel},
+	)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, cm := range cms.Items {
+		cr, err := approval.FromConfigMap(&cm)
+		if err != nil || !approval.ShouldPurge(cr, now) {
+			continue
+		}
+		if err := kubeConnector.DeleteConfigMap(ctx, &cm); ctrlclient.IgnoreNotFound(err) != nil {
+			errs = append(errs, fmt.Errorf("could not delete change request %s/%s: %w", cm.GetNamespace(), cm.GetName(), err))
+			continue
+		}
+		logger.FromContext(ctx, log).Infof("Purged change request %s/%s", cm.GetNamespace(), cm.GetName())
+	}
+	return errors.Join(errs...)
+}
+
+func (h *k8sHandler) getChangeRequest(ctx context.Context, namespace, name string) (*api.ChangeRequest, *corev1.ConfigMap, error) {
+	cm, err := h.kubeConnector.GetConfigMap(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+	if err != nil {
+		return nil, nil, err
+	}
+	cr, err := approval.FromConfigMap(cm)
+	if errors.Is(err, approval.ErrNotChangeRequest) {
+		return nil, nil, k8serrors.NewNotFound(schema.GroupResource{Resource: "changerequests"}, name)
+	} else if err != nil {
+		return nil, nil, err
+	}
+	return cr, cm, nil
+}
+
+func (h *k8sHandler) decideChangeRequest(
+	ctx context.Context,
+	namespace, name string,
+	state api.ChangeRequestState,
+) (*api.ChangeRequest, error) {
+	user, err := rbac.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cr, cm, err := h.getChangeRequest(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := approval.Decide(cr, state, user.Subject, time.Now()); err != nil {
+		return nil, err
+	}
+	updated, err := approval.ToConfigMap(cr)
+	if err != nil {
+		return nil, err
+	}
+	// The update fails with a conflict if the change request has been
+	// decided concurrently, so an operation cannot be approved twice.
+	cm.Data = updated.Data
+	if _, err := h.kubeConnector.UpdateConfigMap(ctx, cm); err != nil {
+		return nil, err
+	}
+	return cr, nil
+}
+
+// requireApproval returns an *approval.PendingError if the operation requires
+// approval according to the policy of the namespace and has not been approved yet.
+// If a pending change request for the same operation already exists, it is reused.
+func (h *k8sHandler) requireApproval(
+	ctx context.Context,
+	namespace string,
+	op api.ChangeRequestOperation,
+	target string,
+	params *api.ChangeRequestParameters,
+) error {
+	if approval.IsApproved(ctx, namespace, op, target) {
+		return nil
+	}
+	ns, err := h.kubeConnector.GetNamespace(ctx, types.NamespacedName{Name: namespace})
+	if err != nil {
+		return err
+	}
+	policy, err := approval.PolicyFromNamespace(ns)
+	if err != nil {
+		return err
+	}
+	if !policy.Requires(op) {
+		return nil
+	}
+	user, err := rbac.GetUser(ctx)
+	if err != nil {
+		return err
+	}
+	return h.createChangeRequest(ctx, namespace, op, target, params, user.Subject, policy, time.Now())
+}
+
+// createChangeRequest creates a change request for the operation on behalf of the user
+// and returns it as an *approval.PendingError.
+// If a pending change request for the same operation already exists, it is reused.
+func (h *k8sHandler) createChangeRequest(
+	ctx context.Context,
+	namespace string,
+	op api.ChangeRequestOperation,
+	target string,
+	params *api.ChangeRequestParameters,
+	requestedBy string,
+	policy *approval.Policy,
+	now time.Time,
+) error {
+	existing, err := h.ListChangeRequests(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	for _, cr := range existing.Items {
+		if cr.State == api.Pending && approval.Matches(&cr, op, target, params) {
+			return &approval.PendingError{ChangeRequest: &cr}
+		}
+	}
+
+	cr := approval.NewChangeRequest(namespace, op, target, params, requestedBy, policy.ExpiryDuration(), now)
+	cm, err := approval.ToConfigMap(cr)
+	if err != nil {
+		return err
+	}
+	if _, err := h.kubeConnector.CreateConfigMap(ctx, cm); err != nil {
+		return err
+	}
+	logger.FromContext(ctx, h.log).Infof("Operation %s on %s/%s requires approval, created change request %s", op, namespace, target, cr.Name)
+	return &approval.PendingError{ChangeRequest: cr}
+}
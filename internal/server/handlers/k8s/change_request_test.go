@@ -0,0 +1,118 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/approval"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+func TestChangeRequests(t *testing.T) {
+	t.Parallel()
+
+	const testNamespace = "test-namespace"
+
+	userCtx := func(subject string) context.Context {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"sub": subject,
+			"iss": "test",
+		})
+		return context.WithValue(context.Background(), common.UserCtxKey, token)
+	}
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testNamespace,
+			Annotations: map[string]string{
+				common.EverestNamespaceApprovalPolicyAnnotation: "operations: [deleteDatabaseCluster]",
+			},
+		},
+	}
+	db := &everestv1alpha1.DatabaseCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-db",
+			Namespace: testNamespace,
+		},
+	}
+	mockClient := fakeclient.NewClientBuilder().
+		WithScheme(kubernetes.CreateScheme()).
+		WithObjects(ns, db).
+		Build()
+	h := &k8sHandler{
+		kubeConnector: kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient),
+		log:           zap.NewNop().Sugar(),
+	}
+
+	// Deleting the cluster creates a change request instead.
+	err := h.DeleteDatabaseCluster(userCtx("alice"), testNamespace, "test-db", &api.DeleteDatabaseClusterParams{})
+	pending := &approval.PendingError{}
+	require.ErrorAs(t, err, &pending)
+	assert.Equal(t, "alice", pending.ChangeRequest.RequestedBy)
+	assert.Equal(t, api.Pending, pending.ChangeRequest.State)
+
+	// Repeating the request reuses the pending change request.
+	err = h.DeleteDatabaseCluster(userCtx("bob"), testNamespace, "test-db", &api.DeleteDatabaseClusterParams{})
+	require.ErrorIs(t, err, approval.ErrApprovalRequired)
+	list, err := h.ListChangeRequests(context.Background(), testNamespace)
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+	name := list.Items[0].Name
+
+	// The requester cannot approve their own change request.
+	_, err = h.ApproveChangeRequest(userCtx("alice"), testNamespace, name)
+	require.ErrorIs(t, err, approval.ErrSelfApproval)
+
+	cr, err := h.ApproveChangeRequest(userCtx("bob"), testNamespace, name)
+	require.NoError(t, err)
+	assert.Equal(t, api.Approved, cr.State)
+
+	// An approved change request cannot be decided again.
+	_, err = h.RejectChangeRequest(userCtx("bob"), testNamespace, name)
+	require.ErrorIs(t, err, approval.ErrNotPending)
+
+	// A failed execution can be retried.
+	cr, err = h.CompleteChangeRequest(userCtx("bob"), testNamespace, name, errors.New("transient error"))
+	require.NoError(t, err)
+	assert.Equal(t, api.ExecutionFailed, cr.State)
+	assert.Equal(t, "transient error", pointer.Get(cr.Error))
+	_, err = h.CompleteChangeRequest(userCtx("bob"), testNamespace, name, nil)
+	require.ErrorIs(t, err, approval.ErrNotApproved)
+	cr, err = h.ApproveChangeRequest(userCtx("bob"), testNamespace, name)
+	require.NoError(t, err)
+
+	// The approved operation is executed.
+	ctx := approval.WithApproved(userCtx("bob"), cr)
+	require.NoError(t, h.DeleteDatabaseCluster(ctx, testNamespace, "test-db", &api.DeleteDatabaseClusterParams{}))
+	cr, err = h.CompleteChangeRequest(userCtx("bob"), testNamespace, name, nil)
+	require.NoError(t, err)
+	assert.Equal(t, api.Executed, cr.State)
+
+	// The change request is purged once its retention period is over.
+	require.NoError(t, PurgeChangeRequests(context.Background(), h.log, h.kubeConnector, testNamespace, cr.ExpiresAt))
+	_, err = h.GetChangeRequest(context.Background(), testNamespace, name)
+	require.NoError(t, err)
+	require.NoError(t, PurgeChangeRequests(context.Background(), h.log, h.kubeConnector, testNamespace,
+		cr.ExpiresAt.Add(approval.Retention+time.Second)))
+	_, err = h.GetChangeRequest(context.Background(), testNamespace, name)
+	require.True(t, k8serrors.IsNotFound(err))
+
+	// Operations not listed in the policy are not affected.
+	err = h.requireApproval(userCtx("alice"), testNamespace, api.DeleteDatabaseClusterBackup, "backup", nil)
+	require.NoError(t, err)
+}
@@ -1,6 +1,7 @@
 package k8s
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
@@ -13,7 +14,9 @@ import (
 	"github.com/cenkalti/backoff"
 	goversion "github.com/hashicorp/go-version"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -22,6 +25,11 @@ import (
 	"github.com/percona/everest/api"
 	"github.com/percona/everest/internal/server/handlers"
 	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/etag"
+	"github.com/percona/everest/pkg/expiry"
+	"github.com/percona/everest/pkg/pagination"
+	"github.com/percona/everest/pkg/softdelete"
+	"github.com/percona/everest/pkg/userlabels"
 )
 
 const (
@@ -37,16 +45,72 @@ const (
 )
 
 func (h *k8sHandler) CreateDatabaseCluster(ctx context.Context, db *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error) {
+	if err := expiry.ApplyTTL(db, time.Now()); err != nil {
+		return nil, err
+	}
+	recordExpirySetBy(ctx, db, nil)
 	return h.kubeConnector.CreateDatabaseCluster(ctx, db)
 }
 
-func (h *k8sHandler) ListDatabaseClusters(ctx context.Context, namespace string) (*everestv1alpha1.DatabaseClusterList, error) {
-	return h.kubeConnector.ListDatabaseClusters(ctx, ctrlclient.InNamespace(namespace))
+func (h *k8sHandler) ListDatabaseClusters(
+	ctx context.Context,
+	namespace string,
+	params *api.ListDatabaseClustersParams,
+) (*everestv1alpha1.DatabaseClusterList, error) {
+	o := pagination.DatabaseClusterOptions(params)
+	opts, err := o.ListOptions(namespace, nil)
+	if err != nil {
+		return nil, err
+	}
+	list, err := h.kubeConnector.ListDatabaseClusters(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	engineType := everestv1alpha1.EngineType(pointer.Get(pointer.Get(params).EngineType))
+	status := everestv1alpha1.AppState(pointer.Get(pointer.Get(params).Status))
+	list.Items = slices.DeleteFunc(list.Items, func(db everestv1alpha1.DatabaseCluster) bool {
+		// Soft-deleted clusters are listed in the trash only.
+		return softdelete.IsDeleted(&db) ||
+			(engineType != "" && db.Spec.Engine.Type != engineType) ||
+			(status != "" && db.Status.Status != status)
+	})
+	list.Items, list.Continue, err = pagination.Paginate(list.Items, list.Continue, o, pagination.DatabaseClusterSortKeys)
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
 }
 
 func (h *k8sHandler) DeleteDatabaseCluster(ctx context.Context, namespace, name string, req *api.DeleteDatabaseClusterParams) error {
+	if err := checkIfMatch(ctx, func() (*everestv1alpha1.DatabaseCluster, error) {
+		return h.kubeConnector.GetDatabaseCluster(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+	}); err != nil {
+		return err
+	}
+	if err := h.requireApproval(ctx, namespace, api.DeleteDatabaseCluster, name,
+		&api.ChangeRequestParameters{CleanupBackupStorage: req.CleanupBackupStorage},
+	); err != nil {
+		return err
+	}
+
 	cleanupStorage := pointer.Get(req.CleanupBackupStorage)
+	if h.softDeleteRetention > 0 {
+		db, err := h.kubeConnector.GetDatabaseCluster(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+		if err != nil {
+			return err
+		}
+		// Deleting a cluster that is already in the trash purges it.
+		if !softdelete.IsDeleted(db) {
+			softdelete.MarkDeleted(db, h.softDeleteRetention, cleanupStorage, time.Now())
+			_, err = h.kubeConnector.UpdateDatabaseCluster(ctx, db)
+			return err
+		}
+	}
+	return h.purgeDatabaseCluster(ctx, namespace, name, cleanupStorage)
+}
 
+// purgeDatabaseCluster permanently deletes the database cluster.
+func (h *k8sHandler) purgeDatabaseCluster(ctx context.Context, namespace, name string, cleanupStorage bool) error {
 	backups, err := h.kubeConnector.ListDatabaseClusterBackups(ctx, ctrlclient.InNamespace(namespace))
 	if err != nil {
 		return err
@@ -86,11 +150,43 @@ func (h *k8sHandler) DeleteDatabaseCluster(ctx context.Context, namespace, name
 }
 
 func (h *k8sHandler) UpdateDatabaseCluster(ctx context.Context, db *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error) {
-	return h.kubeConnector.UpdateDatabaseCluster(ctx, db)
+	current, err := h.kubeConnector.GetDatabaseCluster(ctx, types.NamespacedName{Namespace: db.GetNamespace(), Name: db.GetName()})
+	if err != nil {
+		return nil, err
+	}
+	if err := etag.Check(ctx, current); err != nil {
+		return nil, err
+	}
+	if _, ok := etag.IfMatch(ctx); ok {
+		db.SetResourceVersion(current.GetResourceVersion())
+	}
+	// Reserved labels and annotations are managed by Everest and the operators,
+	// so they are preserved when the user omits them.
+	db.SetLabels(userlabels.Merge(db.GetLabels(), current.GetLabels()))
+	db.SetAnnotations(userlabels.MergeAnnotations(db.GetAnnotations(), current.GetAnnotations()))
+	if err := expiry.ApplyTTL(db, time.Now()); err != nil {
+		return nil, err
+	}
+	recordExpirySetBy(ctx, db, current)
+	updated, err := h.kubeConnector.UpdateDatabaseCluster(ctx, db)
+	return updated, etag.FromConflict(ctx, err)
 }
 
+// GetDatabaseCluster returns the database cluster unless it is soft-deleted,
+// soft-deleted database clusters are returned by GetDeletedDatabaseCluster.
 func (h *k8sHandler) GetDatabaseCluster(ctx context.Context, namespace, name string) (*everestv1alpha1.DatabaseCluster, error) {
-	return h.kubeConnector.GetDatabaseCluster(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+	db, err := h.kubeConnector.GetDatabaseCluster(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+	if err != nil {
+		return nil, err
+	}
+	if softdelete.IsDeleted(db) {
+		return nil, databaseClusterNotFound(name)
+	}
+	return db, nil
+}
+
+func databaseClusterNotFound(name string) error {
+	return k8serrors.NewNotFound(schema.GroupResource{Resource: "databaseclusters"}, name)
 }
 
 func (h *k8sHandler) GetDatabaseClusterCredentials(ctx context.Context, namespace, name string) (*api.DatabaseClusterCredential, error) {
@@ -193,6 +289,42 @@ func (h *k8sHandler) GetDatabaseClusterComponentLogs(ctx context.Context, namesp
 	return stream(ctx, namespace, clusterName, componentName, params)
 }
 
+func (h *k8sHandler) GetDatabaseClusterLogs(ctx context.Context, namespace, name string, params api.GetDatabaseClusterLogsParams, stream handlers.LogsStreamFunc) error {
+	pods, err := h.kubeConnector.ListPods(ctx, ctrlclient.InNamespace(namespace), ctrlclient.MatchingLabels{"app.kubernetes.io/instance": name})
+	if err != nil {
+		return fmt.Errorf("failed to get pods for database cluster %s/%s: %w", namespace, name, err)
+	}
+	return stream(ctx, namespace, logSources(pods.Items, params), params)
+}
+
+// logSources returns the containers of the given pods selected by the params, sorted by pod and container name.
+func logSources(pods []corev1.Pod, params api.GetDatabaseClusterLogsParams) []handlers.LogSource {
+	components := pointer.Get(params.Component)
+	containers := pointer.Get(params.Container)
+	previous := pointer.GetBool(params.Previous)
+
+	var sources []handlers.LogSource
+	for _, pod := range pods {
+		component := pod.Labels["app.kubernetes.io/component"]
+		if component == "" || (len(components) > 0 && !slices.Contains(components, component)) {
+			continue
+		}
+		for _, c := range pod.Status.ContainerStatuses {
+			if len(containers) > 0 && !slices.Contains(containers, c.Name) {
+				continue
+			}
+			if previous && c.RestartCount > 0 {
+				sources = append(sources, handlers.LogSource{Pod: pod.Name, Container: c.Name, Previous: true})
+			}
+			sources = append(sources, handlers.LogSource{Pod: pod.Name, Container: c.Name})
+		}
+	}
+	slices.SortStableFunc(sources, func(a, b handlers.LogSource) int {
+		return cmp.Or(cmp.Compare(a.Pod, b.Pod), cmp.Compare(a.Container, b.Container))
+	})
+	return sources
+}
+
 func (h *k8sHandler) GetDatabaseClusterPitr(ctx context.Context, namespace, name string) (*api.DatabaseClusterPitr, error) {
 	databaseCluster, err := h.kubeConnector.GetDatabaseCluster(ctx, types.NamespacedName{Namespace: namespace, Name: name})
 	if err != nil {
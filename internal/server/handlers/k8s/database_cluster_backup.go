@@ -13,13 +13,33 @@ import (
 	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
 	"github.com/percona/everest/api"
 	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/pagination"
 )
 
-func (h *k8sHandler) ListDatabaseClusterBackups(ctx context.Context, namespace, clusterName string) (*everestv1alpha1.DatabaseClusterBackupList, error) {
-	return h.kubeConnector.ListDatabaseClusterBackups(ctx,
-		ctrlclient.InNamespace(namespace),
-		ctrlclient.MatchingLabels{common.DatabaseClusterNameLabel: clusterName},
-	)
+func (h *k8sHandler) ListDatabaseClusterBackups(
+	ctx context.Context,
+	namespace, clusterName string,
+	params *api.ListDatabaseClusterBackupsParams,
+) (*everestv1alpha1.DatabaseClusterBackupList, error) {
+	o := pagination.DatabaseClusterBackupOptions(params)
+	opts, err := o.ListOptions(namespace, map[string]string{common.DatabaseClusterNameLabel: clusterName})
+	if err != nil {
+		return nil, err
+	}
+	list, err := h.kubeConnector.ListDatabaseClusterBackups(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if status := everestv1alpha1.BackupState(pointer.Get(pointer.Get(params).Status)); status != "" {
+		list.Items = slices.DeleteFunc(list.Items, func(b everestv1alpha1.DatabaseClusterBackup) bool {
+			return b.Status.State != status
+		})
+	}
+	list.Items, list.Continue, err = pagination.Paginate(list.Items, list.Continue, o, pagination.DatabaseClusterBackupSortKeys)
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
 }
 
 func (h *k8sHandler) CreateDatabaseClusterBackup(ctx context.Context, req *everestv1alpha1.DatabaseClusterBackup) (*everestv1alpha1.DatabaseClusterBackup, error) {
@@ -32,6 +52,17 @@ func (h *k8sHandler) CreateDatabaseClusterBackup(ctx context.Context, req *evere
 }
 
 func (h *k8sHandler) DeleteDatabaseClusterBackup(ctx context.Context, namespace, name string, req *api.DeleteDatabaseClusterBackupParams) error {
+	if err := checkIfMatch(ctx, func() (*everestv1alpha1.DatabaseClusterBackup, error) {
+		return h.kubeConnector.GetDatabaseClusterBackup(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+	}); err != nil {
+		return err
+	}
+	if err := h.requireApproval(ctx, namespace, api.DeleteDatabaseClusterBackup, name,
+		&api.ChangeRequestParameters{CleanupBackupStorage: req.CleanupBackupStorage},
+	); err != nil {
+		return err
+	}
+
 	cleanupStorage := pointer.Get(req.CleanupBackupStorage)
 	backup, err := h.kubeConnector.GetDatabaseClusterBackup(ctx, types.NamespacedName{Namespace: namespace, Name: name})
 	if err != nil {
@@ -0,0 +1,141 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/AlekSi/pointer"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/diagnostics"
+	"github.com/percona/everest/pkg/kubernetes"
+	versionservice "github.com/percona/everest/pkg/version_service"
+)
+
+func (h *k8sHandler) GetDatabaseClusterDiagnostics(
+	ctx context.Context,
+	namespace, name string,
+	params *api.GetDatabaseClusterDiagnosticsParams,
+) (*api.DatabaseClusterDiagnostics, error) {
+	var names []string
+	if params != nil {
+		names = pointer.Get(params.Check)
+	}
+	checks, err := diagnostics.Select(names)
+	if err != nil {
+		return nil, err
+	}
+	db, err := h.kubeConnector.GetDatabaseCluster(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+	if err != nil {
+		return nil, err
+	}
+	in, err := h.diagnosticsInput(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return diagnostics.Run(in, checks), nil
+}
+
+// diagnosticsInput collects the objects inspected by the health checks of the database cluster.
+func (h *k8sHandler) diagnosticsInput(ctx context.Context, db *everestv1alpha1.DatabaseCluster) (*diagnostics.Input, error) {
+	namespace, name := db.GetNamespace(), db.GetName()
+	opts := []ctrlclient.ListOption{
+		ctrlclient.InNamespace(namespace),
+		ctrlclient.MatchingLabels{"app.kubernetes.io/instance": name},
+	}
+	pods, err := h.kubeConnector.ListPods(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+	pvcs, err := h.kubeConnector.ListPersistentVolumeClaims(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list persistent volume claims: %w", err)
+	}
+	events, err := h.kubeConnector.ListEvents(ctx, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+	backups, err := h.kubeConnector.ListDatabaseClusterBackups(ctx,
+		ctrlclient.InNamespace(namespace),
+		ctrlclient.MatchingLabels{common.DatabaseClusterNameLabel: name},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list database cluster backups: %w", err)
+	}
+	pitr, err := h.GetDatabaseClusterPitr(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	in := &diagnostics.Input{
+		Cluster: db,
+		Pods:    pods.Items,
+		PVCs:    pvcs.Items,
+		Events:  events.Items,
+		Backups: backups.Items,
+		Pitr:    pitr,
+	}
+	// The kubelet stats may not be reachable, e.g. without the permission to
+	// get nodes/proxy, in which case the volume usage check is skipped.
+	in.VolumeStats, in.VolumeStatsErr = h.volumeStats(ctx, in)
+	in.SupportedVersions, in.SupportedVersionsErr = h.supportedEngineVersions(ctx, db)
+	return in, nil
+}
+
+// volumeStats returns the usage of the volumes of the PVCs of the input, by PVC name.
+func (h *k8sHandler) volumeStats(ctx context.Context, in *diagnostics.Input) (map[string]kubernetes.VolumeStats, error) {
+	nodes := map[string]bool{}
+	for _, pod := range in.Pods {
+		if pod.Spec.NodeName != "" {
+			nodes[pod.Spec.NodeName] = true
+		}
+	}
+	result := map[string]kubernetes.VolumeStats{}
+	for node := range nodes {
+		stats, err := h.kubeConnector.GetVolumeStats(ctx, node)
+		if k8serrors.IsForbidden(err) {
+			// The nodes/proxy permission is not granted by the Everest Helm chart.
+			return nil, fmt.Errorf("failed to get the volume stats of node %s, "+
+				"the Everest server service account needs the get permission on the nodes/proxy resource: %w", node, err)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the volume stats of node %s: %w", node, err)
+		}
+		for _, pvc := range in.PVCs {
+			if s, ok := stats[types.NamespacedName{Namespace: pvc.GetNamespace(), Name: pvc.GetName()}]; ok {
+				result[pvc.GetName()] = s
+			}
+		}
+	}
+	return result, nil
+}
+
+// supportedEngineVersions returns the engine versions supported by the version
+// service for the operator installed for the engine of the database cluster.
+func (h *k8sHandler) supportedEngineVersions(ctx context.Context, db *everestv1alpha1.DatabaseCluster) ([]string, error) {
+	if h.versionServiceURL == "" {
+		return nil, errors.New("the version service is not configured")
+	}
+	operator, found := versionservice.EngineTypeToOperatorName[db.Spec.Engine.Type]
+	if !found {
+		return nil, fmt.Errorf("unsupported engine type %s", db.Spec.Engine.Type)
+	}
+	engines, err := h.kubeConnector.ListDatabaseEngines(ctx, ctrlclient.InNamespace(db.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	for _, engine := range engines.Items {
+		if engine.Spec.Type != db.Spec.Engine.Type || engine.Status.OperatorVersion == "" {
+			continue
+		}
+		vs := versionservice.New(h.versionServiceURL, versionservice.WithConfigMapGetter(h.kubeConnector))
+		return vs.GetSupportedEngineVersions(ctx, operator, engine.Status.OperatorVersion)
+	}
+	return nil, fmt.Errorf("no operator is installed for engine type %s", db.Spec.Engine.Type)
+}
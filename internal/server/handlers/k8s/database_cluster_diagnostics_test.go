@@ -0,0 +1,63 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+func TestGetDatabaseClusterDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	const ns = "ns"
+	instance := map[string]string{"app.kubernetes.io/instance": "db"}
+	db := &everestv1alpha1.DatabaseCluster{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: ns}}
+	db.Spec.Engine.Type = everestv1alpha1.DatabaseEnginePXC
+	db.Spec.Engine.Version = "8.0.36"
+	pvc := &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "datadir-db-pxc-0", Namespace: ns, Labels: instance}}
+	pvc.Status.Phase = corev1.ClaimPending
+
+	mockClient := fakeclient.NewClientBuilder().
+		WithScheme(kubernetes.CreateScheme()).
+		WithObjects(db, pvc).
+		WithStatusSubresource(pvc).
+		Build()
+	kubeConnector := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
+	h := &k8sHandler{kubeConnector: kubeConnector, log: zap.NewNop().Sugar()}
+	ctx := context.Background()
+
+	result, err := h.GetDatabaseClusterDiagnostics(ctx, ns, "db", &api.GetDatabaseClusterDiagnosticsParams{})
+	require.NoError(t, err)
+	assert.False(t, result.Healthy)
+	require.Len(t, result.Findings, 1)
+	assert.Equal(t, "volumes", result.Findings[0].Check)
+	assert.Equal(t, api.DatabaseClusterDiagnosticFindingSeverityCritical, result.Findings[0].Severity)
+	statuses := map[string]api.DatabaseClusterDiagnosticCheckStatus{}
+	for _, c := range result.Checks {
+		statuses[c.Name] = c.Status
+	}
+	assert.Equal(t, api.Problems, statuses["volumes"])
+	// The version service is not configured.
+	assert.Equal(t, api.Skipped, statuses["engine-version"])
+
+	result, err = h.GetDatabaseClusterDiagnostics(ctx, ns, "db", &api.GetDatabaseClusterDiagnosticsParams{
+		Check: pointer.To([]string{"crash-loops"}),
+	})
+	require.NoError(t, err)
+	assert.True(t, result.Healthy)
+	assert.Len(t, result.Checks, 1)
+
+	_, err = h.GetDatabaseClusterDiagnostics(ctx, ns, "missing", &api.GetDatabaseClusterDiagnosticsParams{})
+	require.Error(t, err)
+}
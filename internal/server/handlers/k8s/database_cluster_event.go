@@ -0,0 +1,238 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/timeline"
+)
+
+// upstreamClusterKinds are the kinds of the upstream operator resources that
+// back database clusters. They have the name of the database cluster.
+//
+//nolint:gochecknoglobals
+var upstreamClusterKinds = []string{"PerconaXtraDBCluster", "PerconaServerMongoDB", "PerconaPGCluster"}
+
+func (h *k8sHandler) GetDatabaseClusterEvents(
+	ctx context.Context,
+	namespace, name string,
+	params *api.GetDatabaseClusterEventsParams,
+) (*api.DatabaseClusterEventList, error) {
+	db, err := h.kubeConnector.GetDatabaseCluster(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+	if err != nil {
+		return nil, err
+	}
+	objects, err := h.databaseClusterObjects(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	// Events are listed in the namespace and filtered here, the field
+	// selectors of events do not support matching several objects.
+	events, err := h.kubeConnector.ListEvents(ctx, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+
+	result := &api.DatabaseClusterEventList{Items: []api.DatabaseClusterEvent{}}
+	for _, ev := range events.Items {
+		if !objects[api.DatabaseClusterEventObject{Kind: ev.InvolvedObject.Kind, Name: ev.InvolvedObject.Name}] {
+			continue
+		}
+		result.Items = append(result.Items, kubernetesEvent(&ev))
+	}
+
+	cm, err := h.kubeConnector.GetConfigMap(ctx, types.NamespacedName{Namespace: namespace, Name: timeline.ConfigMapName(name)})
+	switch {
+	case k8serrors.IsNotFound(err):
+		// The database cluster has not been observed yet.
+	case err != nil:
+		return nil, err
+	default:
+		t, err := timeline.FromConfigMap(cm)
+		if err != nil {
+			return nil, err
+		}
+		for _, tr := range t.Transitions {
+			result.Items = append(result.Items, timeline.Event(name, tr))
+		}
+	}
+
+	if params != nil && params.Since != nil {
+		result.Items = slices.DeleteFunc(result.Items, func(ev api.DatabaseClusterEvent) bool {
+			return !ev.Time.After(*params.Since)
+		})
+	}
+	slices.SortStableFunc(result.Items, func(a, b api.DatabaseClusterEvent) int {
+		return a.Time.Compare(b.Time)
+	})
+	return result, nil
+}
+
+// databaseClusterObjects returns the objects whose events are part of the timeline of the database cluster.
+func (h *k8sHandler) databaseClusterObjects(
+	ctx context.Context,
+	db *everestv1alpha1.DatabaseCluster,
+) (map[api.DatabaseClusterEventObject]bool, error) {
+	objects := map[api.DatabaseClusterEventObject]bool{
+		{Kind: "DatabaseCluster", Name: db.GetName()}: true,
+	}
+	for _, kind := range upstreamClusterKinds {
+		objects[api.DatabaseClusterEventObject{Kind: kind, Name: db.GetName()}] = true
+	}
+
+	opts := []ctrlclient.ListOption{
+		ctrlclient.InNamespace(db.GetNamespace()),
+		ctrlclient.MatchingLabels{"app.kubernetes.io/instance": db.GetName()},
+	}
+	pods, err := h.kubeConnector.ListPods(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+	for _, pod := range pods.Items {
+		objects[api.DatabaseClusterEventObject{Kind: "Pod", Name: pod.GetName()}] = true
+	}
+	pvcs, err := h.kubeConnector.ListPersistentVolumeClaims(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list persistent volume claims: %w", err)
+	}
+	for _, pvc := range pvcs.Items {
+		objects[api.DatabaseClusterEventObject{Kind: "PersistentVolumeClaim", Name: pvc.GetName()}] = true
+	}
+	services, err := h.kubeConnector.ListServices(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list services: %w", err)
+	}
+	for _, svc := range services.Items {
+		objects[api.DatabaseClusterEventObject{Kind: "Service", Name: svc.GetName()}] = true
+	}
+	return objects, nil
+}
+
+func kubernetesEvent(ev *corev1.Event) api.DatabaseClusterEvent {
+	event := api.DatabaseClusterEvent{
+		Time:   eventTime(ev),
+		Source: api.Kubernetes,
+		Type:   api.DatabaseClusterEventTypeNormal,
+		Reason: ev.Reason,
+		InvolvedObject: api.DatabaseClusterEventObject{
+			Kind: ev.InvolvedObject.Kind,
+			Name: ev.InvolvedObject.Name,
+		},
+		Message: ev.Message,
+	}
+	if ev.Type == corev1.EventTypeWarning {
+		event.Type = api.DatabaseClusterEventTypeWarning
+	}
+	if ev.Count > 0 {
+		event.Count = pointer.ToInt(int(ev.Count))
+	}
+	return event
+}
+
+// eventTime returns the time of the last occurrence of the event.
+func eventTime(ev *corev1.Event) time.Time {
+	switch {
+	case !ev.LastTimestamp.IsZero():
+		return ev.LastTimestamp.UTC()
+	case ev.Series != nil && !ev.Series.LastObservedTime.IsZero():
+		return ev.Series.LastObservedTime.UTC()
+	case !ev.EventTime.IsZero():
+		return ev.EventTime.UTC()
+	case !ev.FirstTimestamp.IsZero():
+		return ev.FirstTimestamp.UTC()
+	}
+	return ev.GetCreationTimestamp().UTC()
+}
+
+// RecordDatabaseClusterTimelines records the transitions of the status and the
+// conditions of the database clusters in the namespace in their timelines.
+func RecordDatabaseClusterTimelines(
+	ctx context.Context,
+	log *zap.SugaredLogger,
+	kubeConnector kubernetes.KubernetesConnector,
+	namespace string,
+	now time.Time,
+) error {
+	dbs, err := kubeConnector.ListDatabaseClusters(ctx, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		return err
+	}
+	cms, err := kubeConnector.ListConfigMaps(ctx,
+		ctrlclient.InNamespace(namespace),
+		ctrlclient.HasLabels{common.EverestDatabaseClusterTimelineLabel},
+	)
+	if err != nil {
+		return err
+	}
+	timelines := make(map[string]*corev1.ConfigMap, len(cms.Items))
+	for _, cm := range cms.Items {
+		timelines[cm.GetLabels()[common.EverestDatabaseClusterTimelineLabel]] = &cm
+	}
+
+	var errs []error
+	for _, db := range dbs.Items {
+		if err := recordDatabaseClusterTimeline(ctx, log, kubeConnector, &db, timelines[db.GetName()], now); err != nil {
+			errs = append(errs, fmt.Errorf("could not record the timeline of database cluster %s/%s: %w", namespace, db.GetName(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func recordDatabaseClusterTimeline(
+	ctx context.Context,
+	log *zap.SugaredLogger,
+	kubeConnector kubernetes.KubernetesConnector,
+	db *everestv1alpha1.DatabaseCluster,
+	cm *corev1.ConfigMap,
+	now time.Time,
+) error {
+	t := &timeline.Timeline{}
+	if cm != nil {
+		var err error
+		if t, err = timeline.FromConfigMap(cm); err != nil {
+			logger.FromContext(ctx, log).Warnf("Resetting the timeline of database cluster %s/%s: %v", db.GetNamespace(), db.GetName(), err)
+			t = &timeline.Timeline{}
+		}
+	}
+	if !t.Observe(db, now) {
+		return nil
+	}
+	updated, err := timeline.ToConfigMap(db.GetNamespace(), db.GetName(), t)
+	if err != nil {
+		return err
+	}
+	if cm == nil {
+		// Timelines are garbage collected together with the database cluster.
+		updated.SetOwnerReferences([]metav1.OwnerReference{{
+			APIVersion: everestv1alpha1.GroupVersion.String(),
+			Kind:       "DatabaseCluster",
+			Name:       db.GetName(),
+			UID:        db.GetUID(),
+		}})
+		_, err = kubeConnector.CreateConfigMap(ctx, updated)
+	} else {
+		cm.Data = updated.Data
+		_, err = kubeConnector.UpdateConfigMap(ctx, cm)
+	}
+	if k8serrors.IsAlreadyExists(err) || k8serrors.IsConflict(err) {
+		// Another API server replica has recorded the transitions.
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,98 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/timeline"
+)
+
+func TestGetDatabaseClusterEvents(t *testing.T) {
+	t.Parallel()
+
+	const ns = "ns"
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	instance := map[string]string{"app.kubernetes.io/instance": "db"}
+	newEvent := func(name, kind, object, reason string, at time.Time) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: ns},
+			InvolvedObject: corev1.ObjectReference{Kind: kind, Name: object, Namespace: ns},
+			Reason:         reason,
+			Message:        reason + " " + object,
+			Type:           corev1.EventTypeWarning,
+			LastTimestamp:  metav1.NewTime(at),
+			Count:          3,
+		}
+	}
+	db := &everestv1alpha1.DatabaseCluster{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: ns, UID: "db-uid"}}
+	db.Status.Status = everestv1alpha1.AppStateInit
+
+	mockClient := fakeclient.NewClientBuilder().
+		WithScheme(kubernetes.CreateScheme()).
+		WithObjects(
+			db,
+			&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "db-pxc-0", Namespace: ns, Labels: instance}},
+			&corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "datadir-db-pxc-0", Namespace: ns, Labels: instance}},
+			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "db-haproxy", Namespace: ns, Labels: instance}},
+			&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "other-pxc-0", Namespace: ns}},
+			newEvent("e1", "PersistentVolumeClaim", "datadir-db-pxc-0", "ProvisioningFailed", start.Add(3*time.Minute)),
+			newEvent("e2", "Pod", "db-pxc-0", "BackOff", start.Add(time.Minute)),
+			newEvent("e3", "PerconaXtraDBCluster", "db", "ReconcileError", start.Add(2*time.Minute)),
+			newEvent("e4", "Pod", "other-pxc-0", "BackOff", start),
+			newEvent("e5", "Service", "db-haproxy", "SyncLoadBalancerFailed", start.Add(4*time.Minute)),
+		).
+		Build()
+	kubeConnector := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
+	h := &k8sHandler{kubeConnector: kubeConnector, log: zap.NewNop().Sugar()}
+	ctx := context.Background()
+
+	require.NoError(t, RecordDatabaseClusterTimelines(ctx, zap.NewNop().Sugar(), kubeConnector, ns, start.Add(90*time.Second)))
+	cm, err := kubeConnector.GetConfigMap(ctx, types.NamespacedName{Namespace: ns, Name: timeline.ConfigMapName("db")})
+	require.NoError(t, err)
+	require.Len(t, cm.GetOwnerReferences(), 1)
+	assert.Equal(t, types.UID("db-uid"), cm.GetOwnerReferences()[0].UID)
+	// Unchanged database clusters are not recorded again.
+	require.NoError(t, RecordDatabaseClusterTimelines(ctx, zap.NewNop().Sugar(), kubeConnector, ns, start.Add(5*time.Minute)))
+
+	list, err := h.GetDatabaseClusterEvents(ctx, ns, "db", &api.GetDatabaseClusterEventsParams{})
+	require.NoError(t, err)
+	type event struct {
+		source api.DatabaseClusterEventSource
+		kind   string
+		reason string
+	}
+	events := make([]event, 0, len(list.Items))
+	for _, ev := range list.Items {
+		events = append(events, event{ev.Source, ev.InvolvedObject.Kind, ev.Reason})
+	}
+	assert.Equal(t, []event{
+		{api.Kubernetes, "Pod", "BackOff"},
+		{api.Timeline, "DatabaseCluster", timeline.ReasonStatusChanged},
+		{api.Kubernetes, "PerconaXtraDBCluster", "ReconcileError"},
+		{api.Kubernetes, "PersistentVolumeClaim", "ProvisioningFailed"},
+		{api.Kubernetes, "Service", "SyncLoadBalancerFailed"},
+	}, events)
+	assert.Equal(t, api.DatabaseClusterEventTypeWarning, list.Items[0].Type)
+	assert.Equal(t, 3, *list.Items[0].Count)
+	assert.Equal(t, "BackOff db-pxc-0", list.Items[0].Message)
+
+	since := start.Add(2 * time.Minute)
+	list, err = h.GetDatabaseClusterEvents(ctx, ns, "db", &api.GetDatabaseClusterEventsParams{Since: &since})
+	require.NoError(t, err)
+	assert.Len(t, list.Items, 2)
+
+	_, err = h.GetDatabaseClusterEvents(ctx, ns, "missing", &api.GetDatabaseClusterEventsParams{})
+	require.Error(t, err)
+}
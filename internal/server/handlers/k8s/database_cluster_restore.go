@@ -4,20 +4,43 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
+	"github.com/AlekSi/pointer"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
-	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/api"
 	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/etag"
+	"github.com/percona/everest/pkg/pagination"
 )
 
-func (h *k8sHandler) ListDatabaseClusterRestores(ctx context.Context, namespace, clusterName string) (*everestv1alpha1.DatabaseClusterRestoreList, error) {
-	return h.kubeConnector.ListDatabaseClusterRestores(ctx,
-		ctrlclient.InNamespace(namespace),
-		ctrlclient.MatchingLabels{common.DatabaseClusterNameLabel: clusterName},
-	)
+func (h *k8sHandler) ListDatabaseClusterRestores(
+	ctx context.Context,
+	namespace, clusterName string,
+	params *api.ListDatabaseClusterRestoresParams,
+) (*everestv1alpha1.DatabaseClusterRestoreList, error) {
+	o := pagination.DatabaseClusterRestoreOptions(params)
+	opts, err := o.ListOptions(namespace, map[string]string{common.DatabaseClusterNameLabel: clusterName})
+	if err != nil {
+		return nil, err
+	}
+	list, err := h.kubeConnector.ListDatabaseClusterRestores(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if status := everestv1alpha1.RestoreState(pointer.Get(pointer.Get(params).Status)); status != "" {
+		list.Items = slices.DeleteFunc(list.Items, func(r everestv1alpha1.DatabaseClusterRestore) bool {
+			return r.Status.State != status
+		})
+	}
+	list.Items, list.Continue, err = pagination.Paginate(list.Items, list.Continue, o, pagination.DatabaseClusterRestoreSortKeys)
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
 }
 
 func (h *k8sHandler) CreateDatabaseClusterRestore(ctx context.Context, req *everestv1alpha1.DatabaseClusterRestore) (*everestv1alpha1.DatabaseClusterRestore, error) {
@@ -32,6 +55,11 @@ func (h *k8sHandler) CreateDatabaseClusterRestore(ctx context.Context, req *ever
 }
 
 func (h *k8sHandler) DeleteDatabaseClusterRestore(ctx context.Context, namespace, name string) error {
+	if err := checkIfMatch(ctx, func() (*everestv1alpha1.DatabaseClusterRestore, error) {
+		return h.kubeConnector.GetDatabaseClusterRestore(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+	}); err != nil {
+		return err
+	}
 	delObj := &everestv1alpha1.DatabaseClusterRestore{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: namespace,
@@ -46,5 +74,11 @@ func (h *k8sHandler) GetDatabaseClusterRestore(ctx context.Context, namespace, n
 }
 
 func (h *k8sHandler) UpdateDatabaseClusterRestore(ctx context.Context, req *everestv1alpha1.DatabaseClusterRestore) (*everestv1alpha1.DatabaseClusterRestore, error) {
-	return h.kubeConnector.UpdateDatabaseClusterRestore(ctx, req)
+	if err := pinIfMatch(ctx, req, func() (*everestv1alpha1.DatabaseClusterRestore, error) {
+		return h.kubeConnector.GetDatabaseClusterRestore(ctx, types.NamespacedName{Namespace: req.GetNamespace(), Name: req.GetName()})
+	}); err != nil {
+		return nil, err
+	}
+	updated, err := h.kubeConnector.UpdateDatabaseClusterRestore(ctx, req)
+	return updated, etag.FromConflict(ctx, err)
 }
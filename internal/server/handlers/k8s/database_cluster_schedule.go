@@ -0,0 +1,266 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/internal/server/handlers"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/schedule"
+	"github.com/percona/everest/pkg/softdelete"
+)
+
+const (
+	// eventReasonScheduledActionSucceeded is the reason of the event emitted when a scheduled action succeeds.
+	eventReasonScheduledActionSucceeded = "ScheduledActionSucceeded"
+	// eventReasonScheduledActionFailed is the reason of the event emitted when a scheduled action fails.
+	eventReasonScheduledActionFailed = "ScheduledActionFailed"
+)
+
+func (h *k8sHandler) ListDatabaseClusterSchedules(ctx context.Context, namespace, dbName string) (*api.DatabaseClusterScheduleList, error) {
+	cms, err := h.kubeConnector.ListConfigMaps(ctx,
+		ctrlclient.InNamespace(namespace),
+		ctrlclient.MatchingLabels{common.EverestDatabaseClusterScheduleLabel: dbName},
+	)
+	if err != nil {
+		return nil, err
+	}
+	result := &api.DatabaseClusterScheduleList{Items: make([]api.DatabaseClusterSchedule, 0, len(cms.Items))}
+	for _, cm := range cms.Items {
+		s, err := schedule.FromConfigMap(&cm)
+		if err != nil {
+			logger.FromContext(ctx, h.log).Warnf("Skipping database cluster schedule %s/%s: %v", cm.GetNamespace(), cm.GetName(), err)
+			continue
+		}
+		result.Items = append(result.Items, *s)
+	}
+	return result, nil
+}
+
+func (h *k8sHandler) GetDatabaseClusterSchedule(ctx context.Context, namespace, dbName, name string) (*api.DatabaseClusterSchedule, error) {
+	s, _, err := h.getDatabaseClusterSchedule(ctx, namespace, dbName, name)
+	return s, err
+}
+
+func (h *k8sHandler) CreateDatabaseClusterSchedule(
+	ctx context.Context,
+	namespace, dbName string,
+	req *api.DatabaseClusterSchedule,
+) (*api.DatabaseClusterSchedule, error) {
+	db, err := h.kubeConnector.GetDatabaseCluster(ctx, types.NamespacedName{Namespace: namespace, Name: dbName})
+	if err != nil {
+		return nil, err
+	}
+	req.Namespace = pointer.ToString(namespace)
+	req.DbClusterName = pointer.ToString(dbName)
+	req.Status = nil
+	if err := schedule.Reset(req, time.Now()); err != nil {
+		return nil, err
+	}
+	cm, err := schedule.ToConfigMap(req)
+	if err != nil {
+		return nil, err
+	}
+	// Schedules are garbage collected together with the database cluster.
+	cm.SetOwnerReferences([]metav1.OwnerReference{{
+		APIVersion: everestv1alpha1.GroupVersion.String(),
+		Kind:       "DatabaseCluster",
+		Name:       db.GetName(),
+		UID:        db.GetUID(),
+	}})
+	if _, err := h.kubeConnector.CreateConfigMap(ctx, cm); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func (h *k8sHandler) UpdateDatabaseClusterSchedule(
+	ctx context.Context,
+	namespace, dbName string,
+	req *api.DatabaseClusterSchedule,
+) (*api.DatabaseClusterSchedule, error) {
+	current, cm, err := h.getDatabaseClusterSchedule(ctx, namespace, dbName, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	req.Namespace = current.Namespace
+	req.DbClusterName = current.DbClusterName
+	// The status is managed by the scheduler.
+	req.Status = current.Status
+	if req.Cron != current.Cron ||
+		pointer.Get(req.TimeZone) != pointer.Get(current.TimeZone) ||
+		schedule.IsEnabled(req) != schedule.IsEnabled(current) {
+		// Runs missed under the previous timing must not be executed.
+		if err := schedule.Reset(req, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+	if err := h.storeDatabaseClusterSchedule(ctx, cm, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func (h *k8sHandler) DeleteDatabaseClusterSchedule(ctx context.Context, namespace, dbName, name string) error {
+	_, cm, err := h.getDatabaseClusterSchedule(ctx, namespace, dbName, name)
+	if err != nil {
+		return err
+	}
+	return h.kubeConnector.DeleteConfigMap(ctx, cm)
+}
+
+func (h *k8sHandler) getDatabaseClusterSchedule(
+	ctx context.Context,
+	namespace, dbName, name string,
+) (*api.DatabaseClusterSchedule, *corev1.ConfigMap, error) {
+	cm, err := h.kubeConnector.GetConfigMap(ctx, types.NamespacedName{
+		Namespace: namespace,
+		Name:      schedule.ConfigMapName(dbName, name),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	s, err := schedule.FromConfigMap(cm)
+	if errors.Is(err, schedule.ErrNotSchedule) {
+		return nil, nil, k8serrors.NewNotFound(schema.GroupResource{Resource: "databaseclusterschedules"}, name)
+	} else if err != nil {
+		return nil, nil, err
+	}
+	return s, cm, nil
+}
+
+// storeDatabaseClusterSchedule stores the schedule in the given ConfigMap.
+// It fails with a conflict if the ConfigMap has been modified since it was read.
+func (h *k8sHandler) storeDatabaseClusterSchedule(ctx context.Context, cm *corev1.ConfigMap, s *api.DatabaseClusterSchedule) error {
+	updated, err := schedule.ToConfigMap(s)
+	if err != nil {
+		return err
+	}
+	cm.Data = updated.Data
+	_, err = h.kubeConnector.UpdateConfigMap(ctx, cm)
+	return err
+}
+
+// RunDatabaseClusterSchedules executes the due schedules of the database clusters
+// in the namespace and records the result in the status of each schedule.
+// The database clusters are updated through the given handler, so that the updates
+// are subject to the same validation as the requests of users.
+func RunDatabaseClusterSchedules(
+	ctx context.Context,
+	log *zap.SugaredLogger,
+	kubeConnector kubernetes.KubernetesConnector,
+	dbHandler handlers.DatabaseClusterHandler,
+	namespace string,
+	now time.Time,
+	opts ...Option,
+) error {
+	h := newK8sHandler(log, kubeConnector, opts...)
+	cms, err := kubeConnector.ListConfigMaps(ctx,
+		ctrlclient.InNamespace(namespace),
+		ctrlclient.HasLabels{common.EverestDatabaseClusterScheduleLabel},
+	)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, cm := range cms.Items {
+		s, err := schedule.FromConfigMap(&cm)
+		if err != nil {
+			logger.FromContext(ctx, h.log).Warnf("Skipping database cluster schedule %s/%s: %v", cm.GetNamespace(), cm.GetName(), err)
+			continue
+		}
+		if due, err := schedule.IsDue(s, now); err != nil || !due {
+			continue
+		}
+		if err := h.runDatabaseClusterSchedule(ctx, dbHandler, &cm, s, now); err != nil {
+			errs = append(errs, fmt.Errorf("could not run schedule %s of database cluster %s/%s: %w", s.Name, namespace, pointer.Get(s.DbClusterName), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (h *k8sHandler) runDatabaseClusterSchedule(
+	ctx context.Context,
+	dbHandler handlers.DatabaseClusterHandler,
+	cm *corev1.ConfigMap,
+	s *api.DatabaseClusterSchedule,
+	now time.Time,
+) error {
+	// Claim the run first, so that it is executed only once when several
+	// API server replicas run the scheduler.
+	if err := schedule.Reset(s, now); err != nil {
+		return err
+	}
+	if err := h.storeDatabaseClusterSchedule(ctx, cm, s); err != nil {
+		if k8serrors.IsConflict(err) {
+			return nil
+		}
+		return err
+	}
+
+	db, runErr := h.applyDatabaseClusterSchedule(ctx, dbHandler, s)
+	if err := schedule.RecordRun(s, now, runErr); err != nil {
+		return err
+	}
+	if err := h.storeDatabaseClusterSchedule(ctx, cm, s); err != nil {
+		return err
+	}
+	if db == nil {
+		return runErr
+	}
+
+	eventType, reason := corev1.EventTypeNormal, eventReasonScheduledActionSucceeded
+	message := fmt.Sprintf("Schedule '%s' executed action '%s'", s.Name, s.Action)
+	if runErr != nil {
+		eventType, reason = corev1.EventTypeWarning, eventReasonScheduledActionFailed
+		message = fmt.Sprintf("Schedule '%s' failed to execute action '%s': %v", s.Name, s.Action, runErr)
+	}
+	if err := h.recordEvent(ctx, db, eventType, reason, message, now); err != nil {
+		logger.FromContext(ctx, h.log).Warnf("Could not record event for database cluster %s/%s: %v", db.GetNamespace(), db.GetName(), err)
+	}
+	return runErr
+}
+
+// applyDatabaseClusterSchedule applies the action of the schedule to its database cluster.
+// The database cluster is returned whenever it exists, even if applying the action failed.
+func (h *k8sHandler) applyDatabaseClusterSchedule(
+	ctx context.Context,
+	dbHandler handlers.DatabaseClusterHandler,
+	s *api.DatabaseClusterSchedule,
+) (*everestv1alpha1.DatabaseCluster, error) {
+	key := types.NamespacedName{Namespace: pointer.Get(s.Namespace), Name: pointer.Get(s.DbClusterName)}
+	var db *everestv1alpha1.DatabaseCluster
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var err error
+		db, err = h.kubeConnector.GetDatabaseCluster(ctx, key)
+		if err != nil {
+			db = nil
+			return err
+		}
+		if softdelete.IsDeleted(db) {
+			return softdelete.ErrDeleted
+		}
+		if err := schedule.Apply(db, s); err != nil {
+			return err
+		}
+		_, err = dbHandler.UpdateDatabaseCluster(ctx, db)
+		return err
+	})
+	return db, err
+}
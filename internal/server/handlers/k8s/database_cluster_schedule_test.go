@@ -0,0 +1,99 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/schedule"
+)
+
+func TestDatabaseClusterSchedules(t *testing.T) {
+	t.Parallel()
+
+	const testNamespace = "test-namespace"
+
+	db := &everestv1alpha1.DatabaseCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-db",
+			Namespace: testNamespace,
+		},
+	}
+	mockClient := fakeclient.NewClientBuilder().
+		WithScheme(kubernetes.CreateScheme()).
+		WithObjects(db).
+		Build()
+	k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
+	h := newK8sHandler(zap.NewNop().Sugar(), k)
+	ctx := context.Background()
+	getDB := func() *everestv1alpha1.DatabaseCluster {
+		got, err := k.GetDatabaseCluster(ctx, types.NamespacedName{Namespace: testNamespace, Name: "test-db"})
+		require.NoError(t, err)
+		return got
+	}
+
+	created, err := h.CreateDatabaseClusterSchedule(ctx, testNamespace, "test-db", &api.DatabaseClusterSchedule{
+		Name:   "nightly-pause",
+		Cron:   "0 20 * * *",
+		Action: api.Pause,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, created.Status)
+	nextRun := pointer.Get(created.Status.NextRunTime)
+
+	list, err := h.ListDatabaseClusterSchedules(ctx, testNamespace, "test-db")
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, "test-db", pointer.Get(list.Items[0].DbClusterName))
+
+	// Schedules of other clusters are not listed.
+	list, err = h.ListDatabaseClusterSchedules(ctx, testNamespace, "other-db")
+	require.NoError(t, err)
+	assert.Empty(t, list.Items)
+
+	// Nothing is executed before the schedule fires.
+	log := zap.NewNop().Sugar()
+	require.NoError(t, RunDatabaseClusterSchedules(ctx, log, k, h, testNamespace, nextRun.Add(-time.Minute)))
+	assert.False(t, getDB().Spec.Paused)
+
+	// The due schedule is executed once and recorded in its history.
+	for range 2 {
+		require.NoError(t, RunDatabaseClusterSchedules(ctx, log, k, h, testNamespace, nextRun))
+	}
+	assert.True(t, getDB().Spec.Paused)
+	got, err := h.GetDatabaseClusterSchedule(ctx, testNamespace, "test-db", "nightly-pause")
+	require.NoError(t, err)
+	history := pointer.Get(got.Status.History)
+	require.Len(t, history, 1)
+	assert.Equal(t, api.Succeeded, history[0].Result)
+
+	// Failures are recorded as well.
+	_, err = h.UpdateDatabaseClusterSchedule(ctx, testNamespace, "test-db", &api.DatabaseClusterSchedule{
+		Name:   "nightly-pause",
+		Cron:   "0 20 * * *",
+		Action: api.Scale,
+	})
+	require.NoError(t, err)
+	err = RunDatabaseClusterSchedules(ctx, log, k, h, testNamespace, nextRun.Add(24*time.Hour))
+	require.ErrorIs(t, err, schedule.ErrScaleRequired)
+	got, err = h.GetDatabaseClusterSchedule(ctx, testNamespace, "test-db", "nightly-pause")
+	require.NoError(t, err)
+	history = pointer.Get(got.Status.History)
+	require.Len(t, history, 2)
+	assert.Equal(t, api.Failed, history[0].Result)
+
+	require.NoError(t, h.DeleteDatabaseClusterSchedule(ctx, testNamespace, "test-db", "nightly-pause"))
+	_, err = h.GetDatabaseClusterSchedule(ctx, testNamespace, "test-db", "nightly-pause")
+	require.Error(t, err)
+}
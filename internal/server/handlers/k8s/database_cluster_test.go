@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
@@ -13,8 +14,12 @@ import (
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/internal/server/handlers"
 	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/etag"
 	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/softdelete"
 )
 
 func TestLatestRestorableDate(t *testing.T) {
@@ -390,3 +395,269 @@ func TestCreateDatabaseClusterSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestListDatabaseClusters(t *testing.T) {
+	t.Parallel()
+
+	const testNamespace = "test-namespace"
+
+	newDB := func(name string, engineType everestv1alpha1.EngineType, labels map[string]string) *everestv1alpha1.DatabaseCluster {
+		return &everestv1alpha1.DatabaseCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+				Labels:    labels,
+			},
+			Spec: everestv1alpha1.DatabaseClusterSpec{
+				Engine: everestv1alpha1.Engine{Type: engineType},
+			},
+		}
+	}
+	mockClient := fakeclient.NewClientBuilder().
+		WithScheme(kubernetes.CreateScheme()).
+		WithObjects(
+			newDB("db-a", everestv1alpha1.DatabaseEnginePXC, map[string]string{"env": "dev"}),
+			newDB("db-b", everestv1alpha1.DatabaseEnginePSMDB, map[string]string{"env": "prod"}),
+			newDB("db-c", everestv1alpha1.DatabaseEnginePXC, map[string]string{"env": "dev"}),
+			newDB("db-d", everestv1alpha1.DatabaseEnginePostgresql, nil),
+		).
+		Build()
+	h := &k8sHandler{
+		kubeConnector: kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient),
+		log:           zap.NewNop().Sugar(),
+	}
+	names := func(list *everestv1alpha1.DatabaseClusterList) []string {
+		result := make([]string, 0, len(list.Items))
+		for _, db := range list.Items {
+			result = append(result, db.GetName())
+		}
+		return result
+	}
+	ctx := context.Background()
+
+	list, err := h.ListDatabaseClusters(ctx, testNamespace, nil)
+	require.NoError(t, err)
+	assert.Len(t, list.Items, 4)
+
+	list, err = h.ListDatabaseClusters(ctx, testNamespace, &api.ListDatabaseClustersParams{
+		LabelSelector: pointer.ToString("env=dev"),
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"db-a", "db-c"}, names(list))
+
+	list, err = h.ListDatabaseClusters(ctx, testNamespace, &api.ListDatabaseClustersParams{
+		EngineType: pointer.To(api.ListDatabaseClustersParamsEngineType(everestv1alpha1.DatabaseEnginePXC)),
+		SortBy:     pointer.ToString("-name"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"db-c", "db-a"}, names(list))
+
+	// Pages are requested with the continue token of the previous page.
+	params := &api.ListDatabaseClustersParams{
+		Limit:  pointer.ToInt64(3),
+		SortBy: pointer.ToString("name"),
+	}
+	list, err = h.ListDatabaseClusters(ctx, testNamespace, params)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"db-a", "db-b", "db-c"}, names(list))
+	require.NotEmpty(t, list.Continue)
+	params.Continue = pointer.ToString(list.Continue)
+	list, err = h.ListDatabaseClusters(ctx, testNamespace, params)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"db-d"}, names(list))
+	assert.Empty(t, list.Continue)
+}
+
+func TestListDatabaseClustersSoftDeletedPage(t *testing.T) {
+	t.Parallel()
+
+	const testNamespace = "test-namespace"
+
+	newDB := func(name string, deleted bool) *everestv1alpha1.DatabaseCluster {
+		db := &everestv1alpha1.DatabaseCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+		}
+		if deleted {
+			softdelete.MarkDeleted(db, time.Hour, false, time.Now())
+		}
+		return db
+	}
+	mockClient := fakeclient.NewClientBuilder().
+		WithScheme(kubernetes.CreateScheme()).
+		WithObjects(
+			newDB("db-a", true),
+			newDB("db-b", true),
+			newDB("db-c", false),
+			newDB("db-d", false),
+			newDB("db-e", false),
+		).
+		Build()
+	h := &k8sHandler{
+		kubeConnector: kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient),
+		log:           zap.NewNop().Sugar(),
+	}
+	ctx := context.Background()
+
+	// The soft-deleted clusters of the first page do not shorten it.
+	params := &api.ListDatabaseClustersParams{Limit: pointer.ToInt64(2)}
+	list, err := h.ListDatabaseClusters(ctx, testNamespace, params)
+	require.NoError(t, err)
+	require.Len(t, list.Items, 2)
+	assert.Equal(t, "db-c", list.Items[0].GetName())
+	assert.Equal(t, "db-d", list.Items[1].GetName())
+	require.NotEmpty(t, list.Continue)
+
+	params.Continue = pointer.ToString(list.Continue)
+	list, err = h.ListDatabaseClusters(ctx, testNamespace, params)
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, "db-e", list.Items[0].GetName())
+	assert.Empty(t, list.Continue)
+}
+
+func TestUpdateDatabaseClusterLabels(t *testing.T) {
+	t.Parallel()
+
+	const testNamespace = "test-namespace"
+
+	mockClient := fakeclient.NewClientBuilder().
+		WithScheme(kubernetes.CreateScheme()).
+		WithObjects(&everestv1alpha1.DatabaseCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "db",
+				Namespace: testNamespace,
+				Labels: map[string]string{
+					"team":                    "a",
+					"everest.percona.com/crd": "true",
+				},
+				Annotations: map[string]string{
+					"owner":                             "a",
+					common.DeletionProtectionAnnotation: "true",
+					common.ExpiryHandledAnnotation:      "2025-01-01T00:00:00Z",
+				},
+			},
+		}).
+		Build()
+	h := &k8sHandler{
+		kubeConnector: kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient),
+		log:           zap.NewNop().Sugar(),
+	}
+	ctx := context.Background()
+
+	db, err := h.GetDatabaseCluster(ctx, testNamespace, "db")
+	require.NoError(t, err)
+	db.SetLabels(map[string]string{"env": "dev"})
+	db.SetAnnotations(map[string]string{"owner": "b"})
+	updated, err := h.UpdateDatabaseCluster(ctx, db)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"env":                     "dev",
+		"everest.percona.com/crd": "true",
+	}, updated.GetLabels())
+	// Reserved annotations are preserved, while the user-managed ones are replaced.
+	assert.Equal(t, map[string]string{
+		"owner":                             "b",
+		common.DeletionProtectionAnnotation: "true",
+		common.ExpiryHandledAnnotation:      "2025-01-01T00:00:00Z",
+	}, updated.GetAnnotations())
+}
+
+func TestUpdateDatabaseClusterIfMatch(t *testing.T) {
+	t.Parallel()
+
+	const testNamespace = "test-namespace"
+
+	mockClient := fakeclient.NewClientBuilder().
+		WithScheme(kubernetes.CreateScheme()).
+		WithObjects(&everestv1alpha1.DatabaseCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: testNamespace},
+		}).
+		Build()
+	h := &k8sHandler{
+		kubeConnector: kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient),
+		log:           zap.NewNop().Sugar(),
+	}
+	ctx := context.Background()
+
+	db, err := h.GetDatabaseCluster(ctx, testNamespace, "db")
+	require.NoError(t, err)
+	tag := etag.Of(db)
+
+	db.Spec.Paused = true
+	_, err = h.UpdateDatabaseCluster(etag.WithIfMatch(ctx, tag), db.DeepCopy())
+	require.NoError(t, err)
+
+	// The tag is outdated after the update.
+	db.Spec.Paused = false
+	_, err = h.UpdateDatabaseCluster(etag.WithIfMatch(ctx, tag), db.DeepCopy())
+	require.ErrorIs(t, err, etag.ErrPreconditionFailed)
+
+	err = h.DeleteDatabaseCluster(etag.WithIfMatch(ctx, tag), testNamespace, "db", &api.DeleteDatabaseClusterParams{})
+	require.ErrorIs(t, err, etag.ErrPreconditionFailed)
+}
+
+func TestLogSources(t *testing.T) {
+	t.Parallel()
+
+	newPod := func(name, component string, containers ...corev1.ContainerStatus) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{"app.kubernetes.io/component": component},
+			},
+			Status: corev1.PodStatus{ContainerStatuses: containers},
+		}
+	}
+	pods := []corev1.Pod{
+		newPod("db-pxc-1", "pxc",
+			corev1.ContainerStatus{Name: "pxc", RestartCount: 2},
+			corev1.ContainerStatus{Name: "logs"},
+		),
+		newPod("db-pxc-0", "pxc",
+			corev1.ContainerStatus{Name: "pxc"},
+			corev1.ContainerStatus{Name: "logs"},
+		),
+		newPod("db-haproxy-0", "haproxy", corev1.ContainerStatus{Name: "haproxy"}),
+		newPod("unrelated", "", corev1.ContainerStatus{Name: "app"}),
+	}
+
+	testCases := []struct {
+		name     string
+		params   api.GetDatabaseClusterLogsParams
+		expected []handlers.LogSource
+	}{
+		{
+			name: "all containers",
+			expected: []handlers.LogSource{
+				{Pod: "db-haproxy-0", Container: "haproxy"},
+				{Pod: "db-pxc-0", Container: "logs"},
+				{Pod: "db-pxc-0", Container: "pxc"},
+				{Pod: "db-pxc-1", Container: "logs"},
+				{Pod: "db-pxc-1", Container: "pxc"},
+			},
+		},
+		{
+			name: "selected components and containers with previous logs",
+			params: api.GetDatabaseClusterLogsParams{
+				Component: &[]string{"pxc"},
+				Container: &[]string{"pxc"},
+				Previous:  pointer.ToBool(true),
+			},
+			expected: []handlers.LogSource{
+				{Pod: "db-pxc-0", Container: "pxc"},
+				{Pod: "db-pxc-1", Container: "pxc", Previous: true},
+				{Pod: "db-pxc-1", Container: "pxc"},
+			},
+		},
+		{
+			name:   "no matching component",
+			params: api.GetDatabaseClusterLogsParams{Component: &[]string{"proxysql"}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, logSources(pods, tc.params))
+		})
+	}
+}
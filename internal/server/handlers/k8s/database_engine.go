@@ -16,6 +16,7 @@ import (
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
 	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/etag"
 	versionservice "github.com/percona/everest/pkg/version_service"
 )
 
@@ -33,7 +34,13 @@ func (h *k8sHandler) GetDatabaseEngine(ctx context.Context, namespace, name stri
 }
 
 func (h *k8sHandler) UpdateDatabaseEngine(ctx context.Context, req *everestv1alpha1.DatabaseEngine) (*everestv1alpha1.DatabaseEngine, error) {
-	return h.kubeConnector.UpdateDatabaseEngine(ctx, req)
+	if err := pinIfMatch(ctx, req, func() (*everestv1alpha1.DatabaseEngine, error) {
+		return h.kubeConnector.GetDatabaseEngine(ctx, types.NamespacedName{Namespace: req.GetNamespace(), Name: req.GetName()})
+	}); err != nil {
+		return nil, err
+	}
+	updated, err := h.kubeConnector.UpdateDatabaseEngine(ctx, req)
+	return updated, etag.FromConflict(ctx, err)
 }
 
 func (h *k8sHandler) GetUpgradePlan(ctx context.Context, namespace string) (*api.UpgradePlan, error) {
@@ -181,7 +188,7 @@ func (h *k8sHandler) getOperatorUpgradePreflight(
 	args := upgradePreflightCheckArgs{
 		targetVersion:  targetVersion,
 		engine:         engine,
-		versionService: versionservice.New(h.versionServiceURL),
+		versionService: versionservice.New(h.versionServiceURL, versionservice.WithConfigMapGetter(h.kubeConnector)),
 	}
 	result, err := getUpgradePreflightChecksResult(ctx, databases.Items, args)
 	if err != nil {
@@ -0,0 +1,40 @@
+package k8s
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/percona/everest/pkg/etag"
+)
+
+// checkIfMatch checks the current version of an object against the If-Match
+// header of the request. The object is only read if the header is present.
+func checkIfMatch[T metav1.Object](ctx context.Context, get func() (T, error)) error {
+	if _, ok := etag.IfMatch(ctx); !ok {
+		return nil
+	}
+	current, err := get()
+	if err != nil {
+		return err
+	}
+	return etag.Check(ctx, current)
+}
+
+// pinIfMatch checks the current version of an object against the If-Match
+// header of the request and sets the version of the object to update to it,
+// so that the update fails if the object is modified in the meantime.
+func pinIfMatch[T metav1.Object](ctx context.Context, obj metav1.Object, get func() (T, error)) error {
+	if _, ok := etag.IfMatch(ctx); !ok {
+		return nil
+	}
+	current, err := get()
+	if err != nil {
+		return err
+	}
+	if err := etag.Check(ctx, current); err != nil {
+		return err
+	}
+	obj.SetResourceVersion(current.GetResourceVersion())
+	return nil
+}
@@ -0,0 +1,209 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/approval"
+	"github.com/percona/everest/pkg/expiry"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/rbac"
+	"github.com/percona/everest/pkg/softdelete"
+)
+
+const (
+	// eventReasonExpiring is the reason of the event emitted before a database cluster expires.
+	eventReasonExpiring = "DatabaseClusterExpiring"
+	// eventReasonExpired is the reason of the event emitted when a database cluster has expired.
+	eventReasonExpired = "DatabaseClusterExpired"
+	// eventSource is the component that reports the events.
+	eventSource = "everest-server"
+)
+
+// ReapExpiredDatabaseClusters warns about database clusters in the namespace that
+// are about to expire and pauses or deletes the expired ones according to the
+// expiry policy of the namespace.
+func ReapExpiredDatabaseClusters(
+	ctx context.Context,
+	log *zap.SugaredLogger,
+	kubeConnector kubernetes.KubernetesConnector,
+	namespace string,
+	now time.Time,
+	opts ...Option,
+) error {
+	h := newK8sHandler(log, kubeConnector, opts...)
+	ns, err := kubeConnector.GetNamespace(ctx, types.NamespacedName{Name: namespace})
+	if err != nil {
+		return err
+	}
+	policy, err := expiry.PolicyFromNamespace(ns)
+	if err != nil {
+		return err
+	}
+	approvalPolicy, err := approval.PolicyFromNamespace(ns)
+	if err != nil {
+		return err
+	}
+	list, err := kubeConnector.ListDatabaseClusters(ctx, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, db := range list.Items {
+		expiresAt, ok := expiry.ExpiresAt(&db)
+		if !ok || expiry.IsHandled(&db) || softdelete.IsDeleted(&db) || !db.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+		switch {
+		case !now.Before(expiresAt):
+			err = h.expireDatabaseCluster(ctx, &db, policy, approvalPolicy, now)
+		case !expiry.IsWarned(&db) && !now.Before(expiresAt.Add(-policy.WarnBeforeDuration())):
+			err = h.warnDatabaseClusterExpiring(ctx, &db, expiresAt, now)
+		default:
+			continue
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("could not reap database cluster %s/%s: %w", db.GetNamespace(), db.GetName(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// recordExpirySetBy records the user who set the expiry time of the database cluster.
+// The user is not recorded if the context has none, so the cluster is not purged when it expires.
+func recordExpirySetBy(ctx context.Context, db, current *everestv1alpha1.DatabaseCluster) {
+	user, _ := rbac.GetUser(ctx)
+	expiry.RecordSetBy(db, current, user.Subject)
+}
+
+func (h *k8sHandler) warnDatabaseClusterExpiring(
+	ctx context.Context,
+	db *everestv1alpha1.DatabaseCluster,
+	expiresAt, now time.Time,
+) error {
+	message := fmt.Sprintf("Database cluster expires at %s", expiresAt.UTC().Format(time.RFC3339))
+	if err := h.recordEvent(ctx, db, corev1.EventTypeWarning, eventReasonExpiring, message, now); err != nil {
+		return err
+	}
+	expiry.MarkWarned(db)
+	_, err := h.kubeConnector.UpdateDatabaseCluster(ctx, db)
+	return err
+}
+
+func (h *k8sHandler) expireDatabaseCluster(
+	ctx context.Context,
+	db *everestv1alpha1.DatabaseCluster,
+	policy *expiry.Policy,
+	approvalPolicy *approval.Policy,
+	now time.Time,
+) error {
+	action := policy.Action
+	// Deletion protection takes precedence over the expiry policy.
+	if action == expiry.ActionDelete && softdelete.IsDeletionProtected(db) {
+		action = expiry.ActionPause
+	}
+	// Without soft-delete the cluster would be purged, which cannot be undone.
+	if action == expiry.ActionDelete && h.softDeleteRetention <= 0 {
+		purge, err := h.canPurgeExpiredDatabaseCluster(ctx, db, approvalPolicy, now)
+		if err != nil {
+			return err
+		}
+		if !purge {
+			action = expiry.ActionPause
+		}
+	}
+
+	expiry.MarkHandled(db)
+	switch action {
+	case expiry.ActionDelete:
+		if h.softDeleteRetention > 0 {
+			softdelete.MarkDeleted(db, h.softDeleteRetention, false, now)
+			if _, err := h.kubeConnector.UpdateDatabaseCluster(ctx, db); err != nil {
+				return err
+			}
+		} else if err := h.purgeDatabaseCluster(ctx, db.GetNamespace(), db.GetName(), false); err != nil {
+			return err
+		}
+	default:
+		db.Spec.Paused = true
+		if _, err := h.kubeConnector.UpdateDatabaseCluster(ctx, db); err != nil {
+			return err
+		}
+	}
+
+	logger.FromContext(ctx, h.log).Infof("Applied expiry action '%s' to database cluster %s/%s", action, db.GetNamespace(), db.GetName())
+	message := fmt.Sprintf("Database cluster expired, applied action '%s'", action)
+	return h.recordEvent(ctx, db, corev1.EventTypeWarning, eventReasonExpired, message, now)
+}
+
+// canPurgeExpiredDatabaseCluster returns true if the expired database cluster can be purged.
+// The user who set the expiry time must be recorded, which means they were allowed to delete
+// the cluster. If the namespace requires approval for deletion, a change request is created
+// on behalf of that user instead.
+func (h *k8sHandler) canPurgeExpiredDatabaseCluster(
+	ctx context.Context,
+	db *everestv1alpha1.DatabaseCluster,
+	approvalPolicy *approval.Policy,
+	now time.Time,
+) (bool, error) {
+	setBy, ok := expiry.SetBy(db)
+	if !ok {
+		logger.FromContext(ctx, h.log).Warnf("Database cluster %s/%s has no recorded expiry setter, pausing it instead of deleting", db.GetNamespace(), db.GetName())
+		return false, nil
+	}
+	if !approvalPolicy.Requires(api.DeleteDatabaseCluster) {
+		return true, nil
+	}
+	err := h.createChangeRequest(ctx, db.GetNamespace(), api.DeleteDatabaseCluster, db.GetName(),
+		&api.ChangeRequestParameters{CleanupBackupStorage: pointer.ToBool(false)}, setBy, approvalPolicy, now,
+	)
+	var pending *approval.PendingError
+	if !errors.As(err, &pending) {
+		return false, err
+	}
+	return false, nil
+}
+
+// recordEvent records an event for the database cluster.
+func (h *k8sHandler) recordEvent(
+	ctx context.Context,
+	db *everestv1alpha1.DatabaseCluster,
+	eventType, reason, message string,
+	now time.Time,
+) error {
+	ts := metav1.NewTime(now)
+	_, err := h.kubeConnector.CreateEvent(ctx, &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s.%x", db.GetName(), now.UnixNano()),
+			Namespace: db.GetNamespace(),
+		},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion:      everestv1alpha1.GroupVersion.String(),
+			Kind:            "DatabaseCluster",
+			Name:            db.GetName(),
+			Namespace:       db.GetNamespace(),
+			UID:             db.GetUID(),
+			ResourceVersion: db.GetResourceVersion(),
+		},
+		Type:           eventType,
+		Reason:         reason,
+		Message:        message,
+		Source:         corev1.EventSource{Component: eventSource},
+		FirstTimestamp: ts,
+		LastTimestamp:  ts,
+		Count:          1,
+	})
+	return err
+}
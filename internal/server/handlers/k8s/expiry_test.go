@@ -0,0 +1,160 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/softdelete"
+)
+
+func TestReapExpiredDatabaseClusters(t *testing.T) {
+	t.Parallel()
+
+	const testNamespace = "test-namespace"
+	expiresAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	newDB := func(name string, annotations map[string]string) *everestv1alpha1.DatabaseCluster {
+		annotations[common.ExpiresAtAnnotation] = expiresAt.Format(time.RFC3339)
+		return &everestv1alpha1.DatabaseCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   testNamespace,
+				Annotations: annotations,
+			},
+		}
+	}
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testNamespace,
+			Annotations: map[string]string{
+				common.EverestNamespaceExpiryPolicyAnnotation: "action: delete\nwarnBefore: 1h",
+			},
+		},
+	}
+	mockClient := fakeclient.NewClientBuilder().
+		WithScheme(kubernetes.CreateScheme()).
+		WithObjects(
+			ns,
+			newDB("ephemeral", map[string]string{}),
+			newDB("protected", map[string]string{common.DeletionProtectionAnnotation: "true"}),
+		).
+		Build()
+	k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
+	log := zap.NewNop().Sugar()
+	ctx := context.Background()
+	get := func(name string) *everestv1alpha1.DatabaseCluster {
+		db, err := k.GetDatabaseCluster(ctx, types.NamespacedName{Namespace: testNamespace, Name: name})
+		require.NoError(t, err)
+		return db
+	}
+	countEvents := func(reason string) int {
+		events, err := k.ListEvents(ctx, ctrlclient.InNamespace(testNamespace))
+		require.NoError(t, err)
+		count := 0
+		for _, e := range events.Items {
+			if e.Reason == reason {
+				count++
+			}
+		}
+		return count
+	}
+
+	// Nothing happens before the warning period.
+	require.NoError(t, ReapExpiredDatabaseClusters(ctx, log, k, testNamespace, expiresAt.Add(-2*time.Hour)))
+	assert.Equal(t, 0, countEvents(eventReasonExpiring))
+
+	// A warning is emitted once per cluster within the warning period.
+	for range 2 {
+		require.NoError(t, ReapExpiredDatabaseClusters(ctx, log, k, testNamespace, expiresAt.Add(-30*time.Minute)))
+	}
+	assert.Equal(t, 2, countEvents(eventReasonExpiring))
+
+	// Expired clusters are deleted according to the policy, unless deletion protection is enabled.
+	require.NoError(t, ReapExpiredDatabaseClusters(ctx, log, k, testNamespace, expiresAt, WithSoftDeleteRetention(time.Hour)))
+	assert.True(t, softdelete.IsDeleted(get("ephemeral")))
+	protected := get("protected")
+	assert.False(t, softdelete.IsDeleted(protected))
+	assert.True(t, protected.Spec.Paused)
+	assert.Equal(t, 2, countEvents(eventReasonExpired))
+
+	// The policy is applied only once per expiry time.
+	protected.Spec.Paused = false
+	_, err := k.UpdateDatabaseCluster(ctx, protected)
+	require.NoError(t, err)
+	require.NoError(t, ReapExpiredDatabaseClusters(ctx, log, k, testNamespace, expiresAt.Add(time.Hour)))
+	assert.False(t, get("protected").Spec.Paused)
+}
+
+func TestReapExpiredDatabaseClustersWithoutSoftDelete(t *testing.T) {
+	t.Parallel()
+
+	const testNamespace = "test-namespace"
+	expiresAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	newDB := func(name string, annotations map[string]string) *everestv1alpha1.DatabaseCluster {
+		annotations[common.ExpiresAtAnnotation] = expiresAt.Format(time.RFC3339)
+		return &everestv1alpha1.DatabaseCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   testNamespace,
+				Annotations: annotations,
+			},
+		}
+	}
+	newClient := func(approvalPolicy string, objs ...ctrlclient.Object) kubernetes.KubernetesConnector {
+		annotations := map[string]string{common.EverestNamespaceExpiryPolicyAnnotation: "action: delete"}
+		if approvalPolicy != "" {
+			annotations[common.EverestNamespaceApprovalPolicyAnnotation] = approvalPolicy
+		}
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: testNamespace, Annotations: annotations}}
+		mockClient := fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.CreateScheme()).
+			WithObjects(append(objs, ns)...).
+			Build()
+		return kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
+	}
+	log := zap.NewNop().Sugar()
+	ctx := context.Background()
+
+	// Clusters are purged only if the user who set the expiry is recorded.
+	k := newClient("",
+		newDB("recorded", map[string]string{common.ExpirySetByAnnotation: "alice"}),
+		newDB("unrecorded", map[string]string{}),
+	)
+	require.NoError(t, ReapExpiredDatabaseClusters(ctx, log, k, testNamespace, expiresAt))
+	_, err := k.GetDatabaseCluster(ctx, types.NamespacedName{Namespace: testNamespace, Name: "recorded"})
+	assert.True(t, k8serrors.IsNotFound(err))
+	db, err := k.GetDatabaseCluster(ctx, types.NamespacedName{Namespace: testNamespace, Name: "unrecorded"})
+	require.NoError(t, err)
+	assert.True(t, db.Spec.Paused)
+
+	// If deletion requires approval, the cluster is paused and a change request is created.
+	k = newClient("operations: [deleteDatabaseCluster]",
+		newDB("recorded", map[string]string{common.ExpirySetByAnnotation: "alice"}),
+	)
+	require.NoError(t, ReapExpiredDatabaseClusters(ctx, log, k, testNamespace, expiresAt))
+	db, err = k.GetDatabaseCluster(ctx, types.NamespacedName{Namespace: testNamespace, Name: "recorded"})
+	require.NoError(t, err)
+	assert.True(t, db.Spec.Paused)
+	crs, err := newK8sHandler(log, k).ListChangeRequests(ctx, testNamespace)
+	require.NoError(t, err)
+	require.Len(t, crs.Items, 1)
+	assert.Equal(t, api.DeleteDatabaseCluster, crs.Items[0].Operation)
+	assert.Equal(t, "alice", crs.Items[0].RequestedBy)
+}
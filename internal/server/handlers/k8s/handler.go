@@ -1,6 +1,8 @@
 package k8s
 
 import (
+	"time"
+
 	"go.uber.org/zap"
 
 	"github.com/percona/everest/internal/server/handlers"
@@ -12,18 +14,39 @@ type k8sHandler struct {
 	kubeConnector     kubernetes.KubernetesConnector
 	log               *zap.SugaredLogger
 	versionServiceURL string
+	// softDeleteRetention is the period a deleted database cluster is retained for.
+	// Soft-delete is disabled if it is zero.
+	softDeleteRetention time.Duration
+}
+
+// Option configures the k8s handler.
+type Option func(h *k8sHandler)
+
+// WithSoftDeleteRetention enables soft-delete of database clusters with the given retention period.
+func WithSoftDeleteRetention(retention time.Duration) Option {
+	return func(h *k8sHandler) {
+		h.softDeleteRetention = retention
+	}
 }
 
 // New returns a new RBAC handler.
 //
 //nolint:ireturn
-func New(log *zap.SugaredLogger, kubeConnector kubernetes.KubernetesConnector, vsURL string) handlers.Handler {
-	l := log.With("handler", "k8s")
-	return &k8sHandler{
-		kubeConnector:     kubeConnector,
-		log:               l,
-		versionServiceURL: vsURL,
+func New(log *zap.SugaredLogger, kubeConnector kubernetes.KubernetesConnector, vsURL string, opts ...Option) handlers.Handler {
+	h := newK8sHandler(log, kubeConnector, opts...)
+	h.versionServiceURL = vsURL
+	return h
+}
+
+func newK8sHandler(log *zap.SugaredLogger, kubeConnector kubernetes.KubernetesConnector, opts ...Option) *k8sHandler {
+	h := &k8sHandler{
+		kubeConnector: kubeConnector,
+		log:           log.With("handler", "k8s"),
+	}
+	for _, opt := range opts {
+		opt(h)
 	}
+	return h
 }
 
 // SetNext sets the next handler to call in the chain.
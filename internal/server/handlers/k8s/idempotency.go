@@ -0,0 +1,48 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/idempotency"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+// PurgeExpiredIdempotencyRecords deletes the expired records of idempotency keys in the namespace.
+func PurgeExpiredIdempotencyRecords(
+	ctx context.Context,
+	log *zap.SugaredLogger,
+	kubeConnector kubernetes.KubernetesConnector,
+	namespace string,
+	now time.Time,
+) error {
+	list, err := kubeConnector.ListConfigMaps(ctx,
+		ctrlclient.InNamespace(namespace),
+		ctrlclient.HasLabels{common.EverestIdempotencyKeyLabel},
+	)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, cm := range list.Items {
+		record, err := idempotency.FromConfigMap(&cm)
+		if err != nil {
+			log.Warnf("Skipping invalid idempotency record %s/%s: %v", cm.GetNamespace(), cm.GetName(), err)
+			continue
+		}
+		if !record.IsExpired(now) {
+			continue
+		}
+		if err := kubeConnector.DeleteConfigMap(ctx, &cm); err != nil && !k8serrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("could not delete idempotency record %s/%s: %w", cm.GetNamespace(), cm.GetName(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
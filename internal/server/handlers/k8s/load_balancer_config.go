@@ -22,6 +22,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/pkg/etag"
 )
 
 func (h *k8sHandler) CreateLoadBalancerConfig(ctx context.Context, psp *everestv1alpha1.LoadBalancerConfig) (*everestv1alpha1.LoadBalancerConfig, error) {
@@ -29,7 +30,13 @@ func (h *k8sHandler) CreateLoadBalancerConfig(ctx context.Context, psp *everestv
 }
 
 func (h *k8sHandler) UpdateLoadBalancerConfig(ctx context.Context, psp *everestv1alpha1.LoadBalancerConfig) (*everestv1alpha1.LoadBalancerConfig, error) {
-	return h.kubeConnector.UpdateLoadBalancerConfig(ctx, psp)
+	if err := pinIfMatch(ctx, psp, func() (*everestv1alpha1.LoadBalancerConfig, error) {
+		return h.kubeConnector.GetLoadBalancerConfig(ctx, types.NamespacedName{Name: psp.GetName()})
+	}); err != nil {
+		return nil, err
+	}
+	updated, err := h.kubeConnector.UpdateLoadBalancerConfig(ctx, psp)
+	return updated, etag.FromConflict(ctx, err)
 }
 
 func (h *k8sHandler) ListLoadBalancerConfigs(ctx context.Context) (*everestv1alpha1.LoadBalancerConfigList, error) {
@@ -37,6 +44,11 @@ func (h *k8sHandler) ListLoadBalancerConfigs(ctx context.Context) (*everestv1alp
 }
 
 func (h *k8sHandler) DeleteLoadBalancerConfig(ctx context.Context, name string) error {
+	if err := checkIfMatch(ctx, func() (*everestv1alpha1.LoadBalancerConfig, error) {
+		return h.kubeConnector.GetLoadBalancerConfig(ctx, types.NamespacedName{Name: name})
+	}); err != nil {
+		return err
+	}
 	delObj := &everestv1alpha1.LoadBalancerConfig{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: name,
@@ -11,14 +11,25 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
-	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
 	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/etag"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/pagination"
+	"github.com/percona/everest/pkg/userlabels"
 )
 
-func (h *k8sHandler) ListMonitoringInstances(ctx context.Context, namespace string) (*everestv1alpha1.MonitoringConfigList, error) {
-	return h.kubeConnector.ListMonitoringConfigs(ctx, ctrlclient.InNamespace(namespace))
+func (h *k8sHandler) ListMonitoringInstances(
+	ctx context.Context,
+	namespace string,
+	params *api.ListMonitoringInstancesParams,
+) (*everestv1alpha1.MonitoringConfigList, error) {
+	opts, err := pagination.MonitoringInstanceOptions(params).ListOptions(namespace, nil)
+	if err != nil {
+		return nil, err
+	}
+	return h.kubeConnector.ListMonitoringConfigs(ctx, opts...)
 }
 
 func (h *k8sHandler) CreateMonitoringInstance(ctx context.Context, namespace string, req *api.CreateMonitoringInstanceJSONRequestBody) (*everestv1alpha1.MonitoringConfig, error) {
@@ -41,6 +52,11 @@ func (h *k8sHandler) CreateMonitoringInstance(ctx context.Context, namespace str
 }
 
 func (h *k8sHandler) DeleteMonitoringInstance(ctx context.Context, namespace, name string) error {
+	if err := checkIfMatch(ctx, func() (*everestv1alpha1.MonitoringConfig, error) {
+		return h.kubeConnector.GetMonitoringConfig(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+	}); err != nil {
+		return err
+	}
 	delMCObj := &everestv1alpha1.MonitoringConfig{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      name,
@@ -69,6 +85,9 @@ func (h *k8sHandler) UpdateMonitoringInstance(ctx context.Context, namespace, na
 	if err != nil {
 		return nil, err
 	}
+	if err := etag.Check(ctx, m); err != nil {
+		return nil, err
+	}
 	var apiKey string
 	if req.Pmm != nil && req.Pmm.ApiKey != "" {
 		apiKey = req.Pmm.ApiKey
@@ -106,7 +125,14 @@ func (h *k8sHandler) UpdateMonitoringInstance(ctx context.Context, namespace, na
 	if req.VerifyTLS != nil {
 		m.Spec.VerifyTLS = req.VerifyTLS
 	}
-	return h.kubeConnector.UpdateMonitoringConfig(ctx, m)
+	if req.Labels != nil {
+		m.SetLabels(userlabels.Merge(*req.Labels, m.GetLabels()))
+	}
+	if req.Annotations != nil {
+		m.SetAnnotations(userlabels.MergeAnnotations(*req.Annotations, m.GetAnnotations()))
+	}
+	updated, err := h.kubeConnector.UpdateMonitoringConfig(ctx, m)
+	return updated, etag.FromConflict(ctx, err)
 }
 
 func (h *k8sHandler) getPMMApiKey(ctx context.Context, params *api.CreateMonitoringInstanceJSONRequestBody) (string, error) {
@@ -114,7 +140,7 @@ func (h *k8sHandler) getPMMApiKey(ctx context.Context, params *api.CreateMonitor
 		return params.Pmm.ApiKey, nil
 	}
 
-	h.log.Debug("Getting PMM API key by username and password")
+	logger.FromContext(ctx, h.log).Debug("Getting PMM API key by username and password")
 	skipVerifyTLS := !pointer.Get(params.VerifyTLS)
 	config := everestv1alpha1.PMMConfig{URL: params.Url}
 	return config.CreatePMMApiKey(
@@ -147,8 +173,10 @@ func (h *k8sHandler) createMonitoringK8sResources(
 	}
 	created, err := h.kubeConnector.CreateMonitoringConfig(c, &everestv1alpha1.MonitoringConfig{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      params.Name,
-			Namespace: namespace,
+			Name:        params.Name,
+			Namespace:   namespace,
+			Labels:      pointer.Get(params.Labels),
+			Annotations: pointer.Get(params.Annotations),
 		},
 		Spec: everestv1alpha1.MonitoringConfigSpec{
 			Type: everestv1alpha1.MonitoringType(params.Type),
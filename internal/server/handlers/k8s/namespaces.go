@@ -3,6 +3,13 @@ package k8s
 import (
 	"context"
 	"fmt"
+
+	"github.com/AlekSi/pointer"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/quota"
 )
 
 func (h *k8sHandler) ListNamespaces(ctx context.Context) ([]string, error) {
@@ -17,3 +24,66 @@ func (h *k8sHandler) ListNamespaces(ctx context.Context) ([]string, error) {
 	}
 	return result, nil
 }
+
+func (h *k8sHandler) GetNamespaceQuota(ctx context.Context, namespace string) (*api.NamespaceQuotaUsage, error) {
+	ns, err := h.kubeConnector.GetNamespace(ctx, types.NamespacedName{Name: namespace})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get namespace %s: %w", namespace, err)
+	}
+	q, err := quota.FromNamespace(ns)
+	if err != nil {
+		return nil, fmt.Errorf("invalid quota in namespace %s: %w", namespace, err)
+	}
+
+	dbs, err := h.kubeConnector.ListDatabaseClusters(ctx, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list database clusters in namespace %s: %w", namespace, err)
+	}
+	usage, err := quota.DatabaseClustersUsage(dbs.Items...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate usage in namespace %s: %w", namespace, err)
+	}
+	backups, err := h.kubeConnector.ListDatabaseClusterBackups(ctx, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list database cluster backups in namespace %s: %w", namespace, err)
+	}
+
+	return &api.NamespaceQuotaUsage{
+		Namespace: namespace,
+		Quota:     quotaToAPI(q),
+		Usage: api.NamespaceResourceUsage{
+			DatabaseClusters: usage.DatabaseClusters,
+			Cpu:              quota.FormatMilliCPU(usage.MilliCPU),
+			Memory:           quota.FormatBytes(usage.MemoryBytes),
+			Disk:             quota.FormatBytes(usage.DiskBytes),
+			Backups:          len(backups.Items),
+		},
+	}, nil
+}
+
+func quotaToAPI(q *quota.Quota) *api.NamespaceQuota {
+	if q == nil {
+		return nil
+	}
+	out := &api.NamespaceQuota{
+		MaxDatabaseClusters: q.MaxDatabaseClusters,
+		MaxBackups:          q.MaxBackups,
+	}
+	if q.CPU != "" {
+		out.Cpu = pointer.ToString(q.CPU)
+	}
+	if q.Memory != "" {
+		out.Memory = pointer.ToString(q.Memory)
+	}
+	if q.Disk != "" {
+		out.Disk = pointer.ToString(q.Disk)
+	}
+	if len(q.AllowedEngines) > 0 {
+		engines := make([]string, 0, len(q.AllowedEngines))
+		for _, e := range q.AllowedEngines {
+			engines = append(engines, string(e))
+		}
+		out.AllowedEngines = &engines
+	}
+	return out
+}
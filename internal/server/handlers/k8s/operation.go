@@ -0,0 +1,136 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/operations"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+func (h *k8sHandler) CreateOperation(
+	ctx context.Context,
+	namespace string,
+	opType api.OperationType,
+	target string,
+) (*api.Operation, error) {
+	user, err := rbac.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	op := operations.New(namespace, opType, target, user.Subject, time.Now())
+	cm, err := operations.ToConfigMap(op)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := h.kubeConnector.CreateConfigMap(ctx, cm); err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+func (h *k8sHandler) ListOperations(ctx context.Context, namespace string) (*api.OperationList, error) {
+	cms, err := h.kubeConnector.ListConfigMaps(ctx,
+		ctrlclient.InNamespace(namespace),
+		ctrlclient.HasLabels{common.EverestOperationLabel},
+	)
+	if err != nil {
+		return nil, err
+	}
+	result := &api.OperationList{Items: make([]api.Operation, 0, len(cms.Items))}
+	for _, cm := range cms.Items {
+		op, err := h.observeOperation(ctx, &cm)
+		if err != nil {
+			logger.FromContext(ctx, h.log).Warnf("Skipping operation %s/%s: %v", cm.GetNamespace(), cm.GetName(), err)
+			continue
+		}
+		result.Items = append(result.Items, *op)
+	}
+	return result, nil
+}
+
+func (h *k8sHandler) GetOperation(ctx context.Context, namespace, name string) (*api.Operation, error) {
+	cm, err := h.kubeConnector.GetConfigMap(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+	if err != nil {
+		return nil, err
+	}
+	op, err := h.observeOperation(ctx, cm)
+	if errors.Is(err, operations.ErrNotOperation) {
+		return nil, k8serrors.NewNotFound(schema.GroupResource{Resource: "operations"}, name)
+	}
+	return op, err
+}
+
+// observeOperation returns the operation stored in the ConfigMap with its
+// current state. Once the operation is done, its final state is stored, so
+// that it does not change when the observed resources change afterwards.
+func (h *k8sHandler) observeOperation(ctx context.Context, cm *corev1.ConfigMap) (*api.Operation, error) {
+	op, err := operations.FromConfigMap(cm)
+	if err != nil {
+		return nil, err
+	}
+	if operations.IsDone(op) {
+		return op, nil
+	}
+	if err := operations.Observe(ctx, h.kubeConnector, op, time.Now()); err != nil {
+		return nil, err
+	}
+	if !operations.IsDone(op) {
+		return op, nil
+	}
+	updated, err := operations.ToConfigMap(op)
+	if err != nil {
+		return nil, err
+	}
+	cm.Data = updated.Data
+	if _, err := h.kubeConnector.UpdateConfigMap(ctx, cm); err != nil {
+		// The operation is observed again on the next request.
+		logger.FromContext(ctx, h.log).Warnf("Could not store the final state of operation %s/%s: %v", cm.GetNamespace(), cm.GetName(), err)
+	}
+	return op, nil
+}
+
+// PurgeExpiredOperations deletes the expired operations in the namespace.
+func PurgeExpiredOperations(
+	ctx context.Context,
+	log *zap.SugaredLogger,
+	kubeConnector kubernetes.KubernetesConnector,
+	namespace string,
+	now time.Time,
+) error {
+	list, err := kubeConnector.ListConfigMaps(ctx,
+		ctrlclient.InNamespace(namespace),
+		ctrlclient.HasLabels{common.EverestOperationLabel},
+	)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, cm := range list.Items {
+		op, err := operations.FromConfigMap(&cm)
+		if err != nil {
+			log.Warnf("Skipping invalid operation %s/%s: %v", cm.GetNamespace(), cm.GetName(), err)
+			continue
+		}
+		if !now.After(op.ExpiresAt) {
+			continue
+		}
+		if err := kubeConnector.DeleteConfigMap(ctx, &cm); err != nil && !k8serrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("could not delete operation %s/%s: %w", cm.GetNamespace(), cm.GetName(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,80 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/operations"
+)
+
+func TestOperations(t *testing.T) {
+	t.Parallel()
+
+	const testNamespace = "test-namespace"
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "alice", "iss": "test"})
+	ctx := context.WithValue(context.Background(), common.UserCtxKey, token)
+
+	restore := &everestv1alpha1.DatabaseClusterRestore{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-restore", Namespace: testNamespace},
+		Spec:       everestv1alpha1.DatabaseClusterRestoreSpec{DBClusterName: "test-db"},
+	}
+	mockClient := fakeclient.NewClientBuilder().
+		WithScheme(kubernetes.CreateScheme()).
+		WithObjects(restore).
+		WithStatusSubresource(restore).
+		Build()
+	h := &k8sHandler{
+		kubeConnector: kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient),
+		log:           zap.NewNop().Sugar(),
+	}
+
+	op, err := h.CreateOperation(ctx, testNamespace, api.OperationTypeDatabaseClusterRestore, "test-restore")
+	require.NoError(t, err)
+	assert.Equal(t, "alice", op.CreatedBy)
+
+	// The operation is pending until the restore starts.
+	got, err := h.GetOperation(ctx, testNamespace, op.Name)
+	require.NoError(t, err)
+	assert.Equal(t, api.OperationStatePending, got.State)
+
+	restore.Status.State = "Succeeded"
+	require.NoError(t, mockClient.Status().Update(ctx, restore))
+	got, err = h.GetOperation(ctx, testNamespace, op.Name)
+	require.NoError(t, err)
+	assert.Equal(t, api.OperationStateSucceeded, got.State)
+	assert.NotNil(t, got.Result)
+
+	// The final state is kept when the restore is deleted.
+	require.NoError(t, mockClient.Delete(ctx, restore))
+	list, err := h.ListOperations(ctx, testNamespace)
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, api.OperationStateSucceeded, list.Items[0].State)
+
+	// ConfigMaps that do not hold an operation are not found.
+	_, err = h.GetOperation(ctx, testNamespace, "unknown")
+	assert.True(t, k8serrors.IsNotFound(err))
+
+	// Expired operations are purged.
+	require.NoError(t, PurgeExpiredOperations(ctx, h.log, h.kubeConnector, testNamespace, time.Now()))
+	_, err = h.kubeConnector.GetConfigMap(ctx, types.NamespacedName{Namespace: testNamespace, Name: op.Name})
+	require.NoError(t, err)
+	require.NoError(t, PurgeExpiredOperations(ctx, h.log, h.kubeConnector, testNamespace, time.Now().Add(operations.TTL+time.Minute)))
+	_, err = h.kubeConnector.GetConfigMap(ctx, types.NamespacedName{Namespace: testNamespace, Name: op.Name})
+	assert.True(t, k8serrors.IsNotFound(err))
+}
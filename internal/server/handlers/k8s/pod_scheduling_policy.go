@@ -25,6 +25,7 @@ import (
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
 	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/etag"
 )
 
 func (h *k8sHandler) CreatePodSchedulingPolicy(ctx context.Context, psp *everestv1alpha1.PodSchedulingPolicy) (*everestv1alpha1.PodSchedulingPolicy, error) {
@@ -32,7 +33,13 @@ func (h *k8sHandler) CreatePodSchedulingPolicy(ctx context.Context, psp *everest
 }
 
 func (h *k8sHandler) UpdatePodSchedulingPolicy(ctx context.Context, psp *everestv1alpha1.PodSchedulingPolicy) (*everestv1alpha1.PodSchedulingPolicy, error) {
-	return h.kubeConnector.UpdatePodSchedulingPolicy(ctx, psp)
+	if err := pinIfMatch(ctx, psp, func() (*everestv1alpha1.PodSchedulingPolicy, error) {
+		return h.kubeConnector.GetPodSchedulingPolicy(ctx, types.NamespacedName{Name: psp.GetName()})
+	}); err != nil {
+		return nil, err
+	}
+	updated, err := h.kubeConnector.UpdatePodSchedulingPolicy(ctx, psp)
+	return updated, etag.FromConflict(ctx, err)
 }
 
 func (h *k8sHandler) ListPodSchedulingPolicies(ctx context.Context, params *api.ListPodSchedulingPolicyParams) (*everestv1alpha1.PodSchedulingPolicyList, error) {
@@ -60,6 +67,11 @@ func (h *k8sHandler) ListPodSchedulingPolicies(ctx context.Context, params *api.
 }
 
 func (h *k8sHandler) DeletePodSchedulingPolicy(ctx context.Context, name string) error {
+	if err := checkIfMatch(ctx, func() (*everestv1alpha1.PodSchedulingPolicy, error) {
+		return h.kubeConnector.GetPodSchedulingPolicy(ctx, types.NamespacedName{Name: name})
+	}); err != nil {
+		return err
+	}
 	delObj := &everestv1alpha1.PodSchedulingPolicy{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: name,
@@ -217,7 +217,7 @@ func TestValidate_ListPodSchedulingPolicy(t *testing.T) {
 				getDefaultPSMDBPolicy(),
 			},
 			listParams: &api.ListPodSchedulingPolicyParams{
-				EngineType: pointer.To(api.Pxc),
+				EngineType: pointer.To(api.ListPodSchedulingPolicyParamsEngineTypePxc),
 			},
 			assert: func(list *everestv1alpha1.PodSchedulingPolicyList) bool {
 				return len(list.Items) == 1 &&
@@ -235,7 +235,7 @@ func TestValidate_ListPodSchedulingPolicy(t *testing.T) {
 				getDefaultPSMDBPolicy(),
 			},
 			listParams: &api.ListPodSchedulingPolicyParams{
-				EngineType: pointer.To(api.Pxc),
+				EngineType: pointer.To(api.ListPodSchedulingPolicyParamsEngineTypePxc),
 				HasRules:   pointer.To(true),
 			},
 			assert: func(list *everestv1alpha1.PodSchedulingPolicyList) bool {
@@ -254,7 +254,7 @@ func TestValidate_ListPodSchedulingPolicy(t *testing.T) {
 				getDefaultPSMDBPolicy(),
 			},
 			listParams: &api.ListPodSchedulingPolicyParams{
-				EngineType: pointer.To(api.Psmdb),
+				EngineType: pointer.To(api.ListPodSchedulingPolicyParamsEngineTypePsmdb),
 			},
 			assert: func(list *everestv1alpha1.PodSchedulingPolicyList) bool {
 				return len(list.Items) == 1 &&
@@ -272,7 +272,7 @@ func TestValidate_ListPodSchedulingPolicy(t *testing.T) {
 				getDefaultPSMDBPolicy(),
 			},
 			listParams: &api.ListPodSchedulingPolicyParams{
-				EngineType: pointer.To(api.Psmdb),
+				EngineType: pointer.To(api.ListPodSchedulingPolicyParamsEngineTypePsmdb),
 				HasRules:   pointer.To(true),
 			},
 			assert: func(list *everestv1alpha1.PodSchedulingPolicyList) bool {
@@ -291,7 +291,7 @@ func TestValidate_ListPodSchedulingPolicy(t *testing.T) {
 				getDefaultPSMDBPolicy(),
 			},
 			listParams: &api.ListPodSchedulingPolicyParams{
-				EngineType: pointer.To(api.Postgresql),
+				EngineType: pointer.To(api.ListPodSchedulingPolicyParamsEngineTypePostgresql),
 			},
 			assert: func(list *everestv1alpha1.PodSchedulingPolicyList) bool {
 				return len(list.Items) == 1 &&
@@ -309,7 +309,7 @@ func TestValidate_ListPodSchedulingPolicy(t *testing.T) {
 				getDefaultPSMDBPolicy(),
 			},
 			listParams: &api.ListPodSchedulingPolicyParams{
-				EngineType: pointer.To(api.Postgresql),
+				EngineType: pointer.To(api.ListPodSchedulingPolicyParamsEngineTypePostgresql),
 				HasRules:   pointer.To(true),
 			},
 			assert: func(list *everestv1alpha1.PodSchedulingPolicyList) bool {
@@ -427,7 +427,7 @@ func TestValidate_ListPodSchedulingPolicy(t *testing.T) {
 				},
 			},
 			listParams: &api.ListPodSchedulingPolicyParams{
-				EngineType: pointer.To(api.Pxc),
+				EngineType: pointer.To(api.ListPodSchedulingPolicyParamsEngineTypePxc),
 			},
 			assert: func(list *everestv1alpha1.PodSchedulingPolicyList) bool {
 				return len(list.Items) == 1 &&
@@ -466,7 +466,7 @@ func TestValidate_ListPodSchedulingPolicy(t *testing.T) {
 				},
 			},
 			listParams: &api.ListPodSchedulingPolicyParams{
-				EngineType: pointer.To(api.Pxc),
+				EngineType: pointer.To(api.ListPodSchedulingPolicyParamsEngineTypePxc),
 				HasRules:   pointer.To(true),
 			},
 			assert: func(list *everestv1alpha1.PodSchedulingPolicyList) bool {
@@ -503,7 +503,7 @@ func TestValidate_ListPodSchedulingPolicy(t *testing.T) {
 				},
 			},
 			listParams: &api.ListPodSchedulingPolicyParams{
-				EngineType: pointer.To(api.Psmdb),
+				EngineType: pointer.To(api.ListPodSchedulingPolicyParamsEngineTypePsmdb),
 			},
 			assert: func(list *everestv1alpha1.PodSchedulingPolicyList) bool {
 				return len(list.Items) == 1 &&
@@ -542,7 +542,7 @@ func TestValidate_ListPodSchedulingPolicy(t *testing.T) {
 				},
 			},
 			listParams: &api.ListPodSchedulingPolicyParams{
-				EngineType: pointer.To(api.Psmdb),
+				EngineType: pointer.To(api.ListPodSchedulingPolicyParamsEngineTypePsmdb),
 				HasRules:   pointer.To(true),
 			},
 			assert: func(list *everestv1alpha1.PodSchedulingPolicyList) bool {
@@ -579,7 +579,7 @@ func TestValidate_ListPodSchedulingPolicy(t *testing.T) {
 				},
 			},
 			listParams: &api.ListPodSchedulingPolicyParams{
-				EngineType: pointer.To(api.Postgresql),
+				EngineType: pointer.To(api.ListPodSchedulingPolicyParamsEngineTypePostgresql),
 			},
 			assert: func(list *everestv1alpha1.PodSchedulingPolicyList) bool {
 				return len(list.Items) == 1 &&
@@ -618,7 +618,7 @@ func TestValidate_ListPodSchedulingPolicy(t *testing.T) {
 				},
 			},
 			listParams: &api.ListPodSchedulingPolicyParams{
-				EngineType: pointer.To(api.Postgresql),
+				EngineType: pointer.To(api.ListPodSchedulingPolicyParamsEngineTypePostgresql),
 				HasRules:   pointer.To(true),
 			},
 			assert: func(list *everestv1alpha1.PodSchedulingPolicyList) bool {
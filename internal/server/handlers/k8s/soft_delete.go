@@ -0,0 +1,111 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/softdelete"
+)
+
+func (h *k8sHandler) ListDeletedDatabaseClusters(ctx context.Context, namespace string) (*everestv1alpha1.DatabaseClusterList, error) {
+	list, err := h.kubeConnector.ListDatabaseClusters(ctx, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		return nil, err
+	}
+	list.Items = slices.DeleteFunc(list.Items, func(db everestv1alpha1.DatabaseCluster) bool {
+		return !softdelete.IsDeleted(&db) || !db.GetDeletionTimestamp().IsZero()
+	})
+	return list, nil
+}
+
+func (h *k8sHandler) GetDeletedDatabaseCluster(ctx context.Context, namespace, name string) (*everestv1alpha1.DatabaseCluster, error) {
+	db, err := h.kubeConnector.GetDatabaseCluster(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+	if err != nil {
+		return nil, err
+	}
+	if !softdelete.IsDeleted(db) {
+		return nil, databaseClusterNotFound(name)
+	}
+	return db, nil
+}
+
+func (h *k8sHandler) RestoreDatabaseCluster(ctx context.Context, namespace, name string) (*everestv1alpha1.DatabaseCluster, error) {
+	db, err := h.kubeConnector.GetDatabaseCluster(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+	if err != nil {
+		return nil, err
+	}
+	softdelete.Restore(db)
+	return h.kubeConnector.UpdateDatabaseCluster(ctx, db)
+}
+
+func (h *k8sHandler) UpdateDatabaseClusterDeletionProtection(ctx context.Context, namespace, name string, enabled bool) (*everestv1alpha1.DatabaseCluster, error) {
+	var updated *everestv1alpha1.DatabaseCluster
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		db, err := h.kubeConnector.GetDatabaseCluster(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+		if err != nil {
+			return err
+		}
+		softdelete.SetDeletionProtection(db, enabled)
+		updated, err = h.kubeConnector.UpdateDatabaseCluster(ctx, db)
+		return err
+	})
+	return updated, err
+}
+
+func (h *k8sHandler) PurgeDatabaseCluster(ctx context.Context, namespace, name string) error {
+	db, err := h.kubeConnector.GetDatabaseCluster(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+	if err != nil {
+		return err
+	}
+	cleanupStorage := softdelete.CleanupBackupStorage(db)
+	// Purging is as destructive as deleting, so it is subject to the same approval policy.
+	if err := h.requireApproval(ctx, namespace, api.DeleteDatabaseCluster, name,
+		&api.ChangeRequestParameters{CleanupBackupStorage: pointer.ToBool(cleanupStorage)},
+	); err != nil {
+		return err
+	}
+	return h.purgeDatabaseCluster(ctx, namespace, name, cleanupStorage)
+}
+
+// PurgeExpiredDatabaseClusters permanently deletes the soft-deleted database
+// clusters in the namespace whose retention period is over.
+func PurgeExpiredDatabaseClusters(
+	ctx context.Context,
+	log *zap.SugaredLogger,
+	kubeConnector kubernetes.KubernetesConnector,
+	namespace string,
+	now time.Time,
+) error {
+	h := newK8sHandler(log, kubeConnector)
+	list, err := kubeConnector.ListDatabaseClusters(ctx, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, db := range list.Items {
+		if !softdelete.ShouldPurge(&db, now) ||
+			softdelete.IsDeletionProtected(&db) ||
+			!db.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+		if err := h.purgeDatabaseCluster(ctx, db.GetNamespace(), db.GetName(), softdelete.CleanupBackupStorage(&db)); err != nil {
+			errs = append(errs, fmt.Errorf("could not purge database cluster %s/%s: %w", db.GetNamespace(), db.GetName(), err))
+			continue
+		}
+		logger.FromContext(ctx, h.log).Infof("Purged deleted database cluster %s/%s", db.GetNamespace(), db.GetName())
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,119 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/softdelete"
+)
+
+func TestSoftDeleteDatabaseCluster(t *testing.T) {
+	t.Parallel()
+
+	const testNamespace = "test-namespace"
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: testNamespace}}
+	db := &everestv1alpha1.DatabaseCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-db",
+			Namespace: testNamespace,
+		},
+	}
+	mockClient := fakeclient.NewClientBuilder().
+		WithScheme(kubernetes.CreateScheme()).
+		WithObjects(ns, db).
+		Build()
+	k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
+	h := New(zap.NewNop().Sugar(), k, "", WithSoftDeleteRetention(time.Hour))
+	ctx := context.Background()
+	key := types.NamespacedName{Namespace: testNamespace, Name: "test-db"}
+
+	// Deleting the cluster moves it to the trash.
+	require.NoError(t, h.DeleteDatabaseCluster(ctx, testNamespace, "test-db", &api.DeleteDatabaseClusterParams{}))
+	got, err := k.GetDatabaseCluster(ctx, key)
+	require.NoError(t, err)
+	assert.True(t, softdelete.IsDeleted(got))
+	assert.True(t, got.Spec.Paused)
+
+	list, err := h.ListDatabaseClusters(ctx, testNamespace, nil)
+	require.NoError(t, err)
+	assert.Empty(t, list.Items)
+	trash, err := h.ListDeletedDatabaseClusters(ctx, testNamespace)
+	require.NoError(t, err)
+	assert.Len(t, trash.Items, 1)
+
+	// The trashed cluster is only found by the soft-deleted getter.
+	_, err = h.GetDatabaseCluster(ctx, testNamespace, "test-db")
+	assert.True(t, k8serrors.IsNotFound(err))
+	_, err = h.GetDeletedDatabaseCluster(ctx, testNamespace, "test-db")
+	require.NoError(t, err)
+
+	// Restoring the cluster resumes it.
+	restored, err := h.RestoreDatabaseCluster(ctx, testNamespace, "test-db")
+	require.NoError(t, err)
+	assert.False(t, softdelete.IsDeleted(restored))
+	assert.False(t, restored.Spec.Paused)
+	_, err = h.GetDeletedDatabaseCluster(ctx, testNamespace, "test-db")
+	assert.True(t, k8serrors.IsNotFound(err))
+	list, err = h.ListDatabaseClusters(ctx, testNamespace, nil)
+	require.NoError(t, err)
+	assert.Len(t, list.Items, 1)
+
+	// The purge job deletes the cluster once the retention period is over.
+	require.NoError(t, h.DeleteDatabaseCluster(ctx, testNamespace, "test-db", &api.DeleteDatabaseClusterParams{}))
+	log := zap.NewNop().Sugar()
+	require.NoError(t, PurgeExpiredDatabaseClusters(ctx, log, k, testNamespace, time.Now()))
+	_, err = k.GetDatabaseCluster(ctx, key)
+	require.NoError(t, err)
+
+	require.NoError(t, PurgeExpiredDatabaseClusters(ctx, log, k, testNamespace, time.Now().Add(2*time.Hour)))
+	_, err = k.GetDatabaseCluster(ctx, key)
+	assert.True(t, k8serrors.IsNotFound(err))
+}
+
+func TestUpdateDatabaseClusterDeletionProtection(t *testing.T) {
+	t.Parallel()
+
+	const testNamespace = "test-namespace"
+
+	mockClient := fakeclient.NewClientBuilder().
+		WithScheme(kubernetes.CreateScheme()).
+		WithObjects(&everestv1alpha1.DatabaseCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "test-db",
+				Namespace:   testNamespace,
+				Annotations: map[string]string{"owner": "a"},
+			},
+		}).
+		Build()
+	h := New(zap.NewNop().Sugar(), kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient), "")
+	ctx := context.Background()
+
+	db, err := h.UpdateDatabaseClusterDeletionProtection(ctx, testNamespace, "test-db", true)
+	require.NoError(t, err)
+	assert.True(t, softdelete.IsDeletionProtected(db))
+
+	// Updating the cluster without the annotation does not disable the deletion protection.
+	db.SetAnnotations(map[string]string{"owner": "b"})
+	db, err = h.UpdateDatabaseCluster(ctx, db)
+	require.NoError(t, err)
+	assert.True(t, softdelete.IsDeletionProtected(db))
+	assert.Equal(t, "b", db.GetAnnotations()["owner"])
+
+	db, err = h.UpdateDatabaseClusterDeletionProtection(ctx, testNamespace, "test-db", false)
+	require.NoError(t, err)
+	assert.False(t, softdelete.IsDeletionProtected(db))
+}
@@ -24,6 +24,7 @@ import (
 
 	enginefeaturesv1alpha1 "github.com/percona/everest-operator/api/enginefeatures.everest/v1alpha1"
 	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/etag"
 )
 
 func (h *k8sHandler) CreateSplitHorizonDNSConfig(ctx context.Context, shdc *enginefeaturesv1alpha1.SplitHorizonDNSConfig) (*enginefeaturesv1alpha1.SplitHorizonDNSConfig, error) { //nolint:lll
@@ -35,6 +36,9 @@ func (h *k8sHandler) UpdateSplitHorizonDNSConfig(ctx context.Context, namespace,
 	if err != nil {
 		return nil, err
 	}
+	if err := etag.Check(ctx, shdc); err != nil {
+		return nil, err
+	}
 
 	if req.BaseDomainNameSuffix != nil {
 		shdc.Spec.BaseDomainNameSuffix = *req.BaseDomainNameSuffix
@@ -47,7 +51,8 @@ func (h *k8sHandler) UpdateSplitHorizonDNSConfig(ctx context.Context, namespace,
 		}
 	}
 
-	return h.kubeConnector.UpdateSplitHorizonDNSConfig(ctx, shdc)
+	updated, err := h.kubeConnector.UpdateSplitHorizonDNSConfig(ctx, shdc)
+	return updated, etag.FromConflict(ctx, err)
 }
 
 func (h *k8sHandler) ListSplitHorizonDNSConfigs(ctx context.Context, namespace string) (*enginefeaturesv1alpha1.SplitHorizonDNSConfigList, error) {
@@ -55,6 +60,11 @@ func (h *k8sHandler) ListSplitHorizonDNSConfigs(ctx context.Context, namespace s
 }
 
 func (h *k8sHandler) DeleteSplitHorizonDNSConfig(ctx context.Context, namespace, name string) error {
+	if err := checkIfMatch(ctx, func() (*enginefeaturesv1alpha1.SplitHorizonDNSConfig, error) {
+		return h.kubeConnector.GetSplitHorizonDNSConfig(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+	}); err != nil {
+		return err
+	}
 	delObj := &enginefeaturesv1alpha1.SplitHorizonDNSConfig{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: namespace,
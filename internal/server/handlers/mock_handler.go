@@ -13,6 +13,7 @@ import (
 
 	v1 "k8s.io/api/core/v1"
 
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
 	v1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
 )
 
@@ -21,6 +22,36 @@ type MockHandler struct {
 	mock.Mock
 }
 
+// ApproveChangeRequest provides a mock function with given fields: ctx, namespace, name
+func (_m *MockHandler) ApproveChangeRequest(ctx context.Context, namespace string, name string) (*api.ChangeRequest, error) {
+	ret := _m.Called(ctx, namespace, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveChangeRequest")
+	}
+
+	var r0 *api.ChangeRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*api.ChangeRequest, error)); ok {
+		return rf(ctx, namespace, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *api.ChangeRequest); ok {
+		r0 = rf(ctx, namespace, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.ChangeRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespace, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ApproveUpgradePlan provides a mock function with given fields: ctx, namespace
 func (_m *MockHandler) ApproveUpgradePlan(ctx context.Context, namespace string) error {
 	ret := _m.Called(ctx, namespace)
@@ -39,6 +70,36 @@ func (_m *MockHandler) ApproveUpgradePlan(ctx context.Context, namespace string)
 	return r0
 }
 
+// CompleteChangeRequest provides a mock function with given fields: ctx, namespace, name, execErr
+func (_m *MockHandler) CompleteChangeRequest(ctx context.Context, namespace string, name string, execErr error) (*api.ChangeRequest, error) {
+	ret := _m.Called(ctx, namespace, name, execErr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteChangeRequest")
+	}
+
+	var r0 *api.ChangeRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, error) (*api.ChangeRequest, error)); ok {
+		return rf(ctx, namespace, name, execErr)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, error) *api.ChangeRequest); ok {
+		r0 = rf(ctx, namespace, name, execErr)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.ChangeRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, error) error); ok {
+		r1 = rf(ctx, namespace, name, execErr)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateBackupStorage provides a mock function with given fields: ctx, namespace, req
 func (_m *MockHandler) CreateBackupStorage(ctx context.Context, namespace string, req *api.CreateBackupStorageParams) (*v1alpha1.BackupStorage, error) {
 	ret := _m.Called(ctx, namespace, req)
@@ -159,6 +220,36 @@ func (_m *MockHandler) CreateDatabaseClusterRestore(ctx context.Context, req *v1
 	return r0, r1
 }
 
+// CreateDatabaseClusterSchedule provides a mock function with given fields: ctx, namespace, dbName, req
+func (_m *MockHandler) CreateDatabaseClusterSchedule(ctx context.Context, namespace string, dbName string, req *api.DatabaseClusterSchedule) (*api.DatabaseClusterSchedule, error) {
+	ret := _m.Called(ctx, namespace, dbName, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDatabaseClusterSchedule")
+	}
+
+	var r0 *api.DatabaseClusterSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *api.DatabaseClusterSchedule) (*api.DatabaseClusterSchedule, error)); ok {
+		return rf(ctx, namespace, dbName, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *api.DatabaseClusterSchedule) *api.DatabaseClusterSchedule); ok {
+		r0 = rf(ctx, namespace, dbName, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.DatabaseClusterSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *api.DatabaseClusterSchedule) error); ok {
+		r1 = rf(ctx, namespace, dbName, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateDatabaseClusterSecret provides a mock function with given fields: ctx, namespace, dbName, secret
 func (_m *MockHandler) CreateDatabaseClusterSecret(ctx context.Context, namespace string, dbName string, secret *v1.Secret) (*v1.Secret, error) {
 	ret := _m.Called(ctx, namespace, dbName, secret)
@@ -249,6 +340,36 @@ func (_m *MockHandler) CreateMonitoringInstance(ctx context.Context, namespace s
 	return r0, r1
 }
 
+// CreateOperation provides a mock function with given fields: ctx, namespace, opType, target
+func (_m *MockHandler) CreateOperation(ctx context.Context, namespace string, opType api.OperationType, target string) (*api.Operation, error) {
+	ret := _m.Called(ctx, namespace, opType, target)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOperation")
+	}
+
+	var r0 *api.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, api.OperationType, string) (*api.Operation, error)); ok {
+		return rf(ctx, namespace, opType, target)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, api.OperationType, string) *api.Operation); ok {
+		r0 = rf(ctx, namespace, opType, target)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, api.OperationType, string) error); ok {
+		r1 = rf(ctx, namespace, opType, target)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreatePodSchedulingPolicy provides a mock function with given fields: ctx, psp
 func (_m *MockHandler) CreatePodSchedulingPolicy(ctx context.Context, psp *v1alpha1.PodSchedulingPolicy) (*v1alpha1.PodSchedulingPolicy, error) {
 	ret := _m.Called(ctx, psp)
@@ -381,6 +502,24 @@ func (_m *MockHandler) DeleteDatabaseClusterRestore(ctx context.Context, namespa
 	return r0
 }
 
+// DeleteDatabaseClusterSchedule provides a mock function with given fields: ctx, namespace, dbName, name
+func (_m *MockHandler) DeleteDatabaseClusterSchedule(ctx context.Context, namespace string, dbName string, name string) error {
+	ret := _m.Called(ctx, namespace, dbName, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatabaseClusterSchedule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, namespace, dbName, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteLoadBalancerConfig provides a mock function with given fields: ctx, name
 func (_m *MockHandler) DeleteLoadBalancerConfig(ctx context.Context, name string) error {
 	ret := _m.Called(ctx, name)
@@ -483,6 +622,36 @@ func (_m *MockHandler) GetBackupStorage(ctx context.Context, namespace string, n
 	return r0, r1
 }
 
+// GetChangeRequest provides a mock function with given fields: ctx, namespace, name
+func (_m *MockHandler) GetChangeRequest(ctx context.Context, namespace string, name string) (*api.ChangeRequest, error) {
+	ret := _m.Called(ctx, namespace, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChangeRequest")
+	}
+
+	var r0 *api.ChangeRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*api.ChangeRequest, error)); ok {
+		return rf(ctx, namespace, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *api.ChangeRequest); ok {
+		r0 = rf(ctx, namespace, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.ChangeRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespace, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDatabaseCluster provides a mock function with given fields: ctx, namespace, name
 func (_m *MockHandler) GetDatabaseCluster(ctx context.Context, namespace string, name string) (*v1alpha1.DatabaseCluster, error) {
 	ret := _m.Called(ctx, namespace, name)
@@ -621,6 +790,84 @@ func (_m *MockHandler) GetDatabaseClusterCredentials(ctx context.Context, namesp
 	return r0, r1
 }
 
+// GetDatabaseClusterDiagnostics provides a mock function with given fields: ctx, namespace, name, params
+func (_m *MockHandler) GetDatabaseClusterDiagnostics(ctx context.Context, namespace string, name string, params *api.GetDatabaseClusterDiagnosticsParams) (*api.DatabaseClusterDiagnostics, error) {
+	ret := _m.Called(ctx, namespace, name, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatabaseClusterDiagnostics")
+	}
+
+	var r0 *api.DatabaseClusterDiagnostics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *api.GetDatabaseClusterDiagnosticsParams) (*api.DatabaseClusterDiagnostics, error)); ok {
+		return rf(ctx, namespace, name, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *api.GetDatabaseClusterDiagnosticsParams) *api.DatabaseClusterDiagnostics); ok {
+		r0 = rf(ctx, namespace, name, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.DatabaseClusterDiagnostics)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *api.GetDatabaseClusterDiagnosticsParams) error); ok {
+		r1 = rf(ctx, namespace, name, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDatabaseClusterEvents provides a mock function with given fields: ctx, namespace, name, params
+func (_m *MockHandler) GetDatabaseClusterEvents(ctx context.Context, namespace string, name string, params *api.GetDatabaseClusterEventsParams) (*api.DatabaseClusterEventList, error) {
+	ret := _m.Called(ctx, namespace, name, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatabaseClusterEvents")
+	}
+
+	var r0 *api.DatabaseClusterEventList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *api.GetDatabaseClusterEventsParams) (*api.DatabaseClusterEventList, error)); ok {
+		return rf(ctx, namespace, name, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *api.GetDatabaseClusterEventsParams) *api.DatabaseClusterEventList); ok {
+		r0 = rf(ctx, namespace, name, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.DatabaseClusterEventList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *api.GetDatabaseClusterEventsParams) error); ok {
+		r1 = rf(ctx, namespace, name, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDatabaseClusterLogs provides a mock function with given fields: ctx, namespace, name, params, stream
+func (_m *MockHandler) GetDatabaseClusterLogs(ctx context.Context, namespace string, name string, params api.GetDatabaseClusterLogsParams, stream LogsStreamFunc) error {
+	ret := _m.Called(ctx, namespace, name, params, stream)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatabaseClusterLogs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, api.GetDatabaseClusterLogsParams, LogsStreamFunc) error); ok {
+		r0 = rf(ctx, namespace, name, params, stream)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetDatabaseClusterPitr provides a mock function with given fields: ctx, namespace, name
 func (_m *MockHandler) GetDatabaseClusterPitr(ctx context.Context, namespace string, name string) (*api.DatabaseClusterPitr, error) {
 	ret := _m.Called(ctx, namespace, name)
@@ -681,6 +928,36 @@ func (_m *MockHandler) GetDatabaseClusterRestore(ctx context.Context, namespace
 	return r0, r1
 }
 
+// GetDatabaseClusterSchedule provides a mock function with given fields: ctx, namespace, dbName, name
+func (_m *MockHandler) GetDatabaseClusterSchedule(ctx context.Context, namespace string, dbName string, name string) (*api.DatabaseClusterSchedule, error) {
+	ret := _m.Called(ctx, namespace, dbName, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatabaseClusterSchedule")
+	}
+
+	var r0 *api.DatabaseClusterSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*api.DatabaseClusterSchedule, error)); ok {
+		return rf(ctx, namespace, dbName, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *api.DatabaseClusterSchedule); ok {
+		r0 = rf(ctx, namespace, dbName, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.DatabaseClusterSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, namespace, dbName, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDatabaseEngine provides a mock function with given fields: ctx, namespace, name
 func (_m *MockHandler) GetDatabaseEngine(ctx context.Context, namespace string, name string) (*v1alpha1.DatabaseEngine, error) {
 	ret := _m.Called(ctx, namespace, name)
@@ -711,6 +988,36 @@ func (_m *MockHandler) GetDatabaseEngine(ctx context.Context, namespace string,
 	return r0, r1
 }
 
+// GetDeletedDatabaseCluster provides a mock function with given fields: ctx, namespace, name
+func (_m *MockHandler) GetDeletedDatabaseCluster(ctx context.Context, namespace string, name string) (*everestv1alpha1.DatabaseCluster, error) {
+	ret := _m.Called(ctx, namespace, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedDatabaseCluster")
+	}
+
+	var r0 *everestv1alpha1.DatabaseCluster
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*everestv1alpha1.DatabaseCluster, error)); ok {
+		return rf(ctx, namespace, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *everestv1alpha1.DatabaseCluster); ok {
+		r0 = rf(ctx, namespace, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*everestv1alpha1.DatabaseCluster)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespace, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetKubernetesClusterInfo provides a mock function with given fields: ctx
 func (_m *MockHandler) GetKubernetesClusterInfo(ctx context.Context) (*api.KubernetesClusterInfo, error) {
 	ret := _m.Called(ctx)
@@ -831,6 +1138,66 @@ func (_m *MockHandler) GetMonitoringInstance(ctx context.Context, namespace stri
 	return r0, r1
 }
 
+// GetNamespaceQuota provides a mock function with given fields: ctx, namespace
+func (_m *MockHandler) GetNamespaceQuota(ctx context.Context, namespace string) (*api.NamespaceQuotaUsage, error) {
+	ret := _m.Called(ctx, namespace)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNamespaceQuota")
+	}
+
+	var r0 *api.NamespaceQuotaUsage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*api.NamespaceQuotaUsage, error)); ok {
+		return rf(ctx, namespace)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *api.NamespaceQuotaUsage); ok {
+		r0 = rf(ctx, namespace)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.NamespaceQuotaUsage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, namespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOperation provides a mock function with given fields: ctx, namespace, name
+func (_m *MockHandler) GetOperation(ctx context.Context, namespace string, name string) (*api.Operation, error) {
+	ret := _m.Called(ctx, namespace, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOperation")
+	}
+
+	var r0 *api.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*api.Operation, error)); ok {
+		return rf(ctx, namespace, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *api.Operation); ok {
+		r0 = rf(ctx, namespace, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespace, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetPodSchedulingPolicy provides a mock function with given fields: ctx, name
 func (_m *MockHandler) GetPodSchedulingPolicy(ctx context.Context, name string) (*v1alpha1.PodSchedulingPolicy, error) {
 	ret := _m.Called(ctx, name)
@@ -981,9 +1348,9 @@ func (_m *MockHandler) GetUserPermissions(ctx context.Context) (*api.UserPermiss
 	return r0, r1
 }
 
-// ListBackupStorages provides a mock function with given fields: ctx, namespace
-func (_m *MockHandler) ListBackupStorages(ctx context.Context, namespace string) (*v1alpha1.BackupStorageList, error) {
-	ret := _m.Called(ctx, namespace)
+// ListBackupStorages provides a mock function with given fields: ctx, namespace, params
+func (_m *MockHandler) ListBackupStorages(ctx context.Context, namespace string, params *api.ListBackupStoragesParams) (*v1alpha1.BackupStorageList, error) {
+	ret := _m.Called(ctx, namespace, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListBackupStorages")
@@ -991,14 +1358,44 @@ func (_m *MockHandler) ListBackupStorages(ctx context.Context, namespace string)
 
 	var r0 *v1alpha1.BackupStorageList
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*v1alpha1.BackupStorageList, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.ListBackupStoragesParams) (*v1alpha1.BackupStorageList, error)); ok {
+		return rf(ctx, namespace, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.ListBackupStoragesParams) *v1alpha1.BackupStorageList); ok {
+		r0 = rf(ctx, namespace, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.BackupStorageList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *api.ListBackupStoragesParams) error); ok {
+		r1 = rf(ctx, namespace, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListChangeRequests provides a mock function with given fields: ctx, namespace
+func (_m *MockHandler) ListChangeRequests(ctx context.Context, namespace string) (*api.ChangeRequestList, error) {
+	ret := _m.Called(ctx, namespace)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListChangeRequests")
+	}
+
+	var r0 *api.ChangeRequestList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*api.ChangeRequestList, error)); ok {
 		return rf(ctx, namespace)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *v1alpha1.BackupStorageList); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) *api.ChangeRequestList); ok {
 		r0 = rf(ctx, namespace)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v1alpha1.BackupStorageList)
+			r0 = ret.Get(0).(*api.ChangeRequestList)
 		}
 	}
 
@@ -1078,9 +1475,9 @@ func (_m *MockHandler) ListDataImporters(ctx context.Context, supportedEngines .
 	return r0, r1
 }
 
-// ListDatabaseClusterBackups provides a mock function with given fields: ctx, namespace, clusterName
-func (_m *MockHandler) ListDatabaseClusterBackups(ctx context.Context, namespace string, clusterName string) (*v1alpha1.DatabaseClusterBackupList, error) {
-	ret := _m.Called(ctx, namespace, clusterName)
+// ListDatabaseClusterBackups provides a mock function with given fields: ctx, namespace, clusterName, params
+func (_m *MockHandler) ListDatabaseClusterBackups(ctx context.Context, namespace string, clusterName string, params *api.ListDatabaseClusterBackupsParams) (*v1alpha1.DatabaseClusterBackupList, error) {
+	ret := _m.Called(ctx, namespace, clusterName, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListDatabaseClusterBackups")
@@ -1088,19 +1485,19 @@ func (_m *MockHandler) ListDatabaseClusterBackups(ctx context.Context, namespace
 
 	var r0 *v1alpha1.DatabaseClusterBackupList
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*v1alpha1.DatabaseClusterBackupList, error)); ok {
-		return rf(ctx, namespace, clusterName)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *api.ListDatabaseClusterBackupsParams) (*v1alpha1.DatabaseClusterBackupList, error)); ok {
+		return rf(ctx, namespace, clusterName, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) *v1alpha1.DatabaseClusterBackupList); ok {
-		r0 = rf(ctx, namespace, clusterName)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *api.ListDatabaseClusterBackupsParams) *v1alpha1.DatabaseClusterBackupList); ok {
+		r0 = rf(ctx, namespace, clusterName, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*v1alpha1.DatabaseClusterBackupList)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, namespace, clusterName)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *api.ListDatabaseClusterBackupsParams) error); ok {
+		r1 = rf(ctx, namespace, clusterName, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1108,9 +1505,9 @@ func (_m *MockHandler) ListDatabaseClusterBackups(ctx context.Context, namespace
 	return r0, r1
 }
 
-// ListDatabaseClusterRestores provides a mock function with given fields: ctx, namespace, clusterName
-func (_m *MockHandler) ListDatabaseClusterRestores(ctx context.Context, namespace string, clusterName string) (*v1alpha1.DatabaseClusterRestoreList, error) {
-	ret := _m.Called(ctx, namespace, clusterName)
+// ListDatabaseClusterRestores provides a mock function with given fields: ctx, namespace, clusterName, params
+func (_m *MockHandler) ListDatabaseClusterRestores(ctx context.Context, namespace string, clusterName string, params *api.ListDatabaseClusterRestoresParams) (*v1alpha1.DatabaseClusterRestoreList, error) {
+	ret := _m.Called(ctx, namespace, clusterName, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListDatabaseClusterRestores")
@@ -1118,19 +1515,49 @@ func (_m *MockHandler) ListDatabaseClusterRestores(ctx context.Context, namespac
 
 	var r0 *v1alpha1.DatabaseClusterRestoreList
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*v1alpha1.DatabaseClusterRestoreList, error)); ok {
-		return rf(ctx, namespace, clusterName)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *api.ListDatabaseClusterRestoresParams) (*v1alpha1.DatabaseClusterRestoreList, error)); ok {
+		return rf(ctx, namespace, clusterName, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) *v1alpha1.DatabaseClusterRestoreList); ok {
-		r0 = rf(ctx, namespace, clusterName)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *api.ListDatabaseClusterRestoresParams) *v1alpha1.DatabaseClusterRestoreList); ok {
+		r0 = rf(ctx, namespace, clusterName, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*v1alpha1.DatabaseClusterRestoreList)
 		}
 	}
 
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *api.ListDatabaseClusterRestoresParams) error); ok {
+		r1 = rf(ctx, namespace, clusterName, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListDatabaseClusterSchedules provides a mock function with given fields: ctx, namespace, dbName
+func (_m *MockHandler) ListDatabaseClusterSchedules(ctx context.Context, namespace string, dbName string) (*api.DatabaseClusterScheduleList, error) {
+	ret := _m.Called(ctx, namespace, dbName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDatabaseClusterSchedules")
+	}
+
+	var r0 *api.DatabaseClusterScheduleList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*api.DatabaseClusterScheduleList, error)); ok {
+		return rf(ctx, namespace, dbName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *api.DatabaseClusterScheduleList); ok {
+		r0 = rf(ctx, namespace, dbName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.DatabaseClusterScheduleList)
+		}
+	}
+
 	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, namespace, clusterName)
+		r1 = rf(ctx, namespace, dbName)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1138,9 +1565,9 @@ func (_m *MockHandler) ListDatabaseClusterRestores(ctx context.Context, namespac
 	return r0, r1
 }
 
-// ListDatabaseClusters provides a mock function with given fields: ctx, namespace
-func (_m *MockHandler) ListDatabaseClusters(ctx context.Context, namespace string) (*v1alpha1.DatabaseClusterList, error) {
-	ret := _m.Called(ctx, namespace)
+// ListDatabaseClusters provides a mock function with given fields: ctx, namespace, params
+func (_m *MockHandler) ListDatabaseClusters(ctx context.Context, namespace string, params *api.ListDatabaseClustersParams) (*v1alpha1.DatabaseClusterList, error) {
+	ret := _m.Called(ctx, namespace, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListDatabaseClusters")
@@ -1148,19 +1575,19 @@ func (_m *MockHandler) ListDatabaseClusters(ctx context.Context, namespace strin
 
 	var r0 *v1alpha1.DatabaseClusterList
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*v1alpha1.DatabaseClusterList, error)); ok {
-		return rf(ctx, namespace)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.ListDatabaseClustersParams) (*v1alpha1.DatabaseClusterList, error)); ok {
+		return rf(ctx, namespace, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *v1alpha1.DatabaseClusterList); ok {
-		r0 = rf(ctx, namespace)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.ListDatabaseClustersParams) *v1alpha1.DatabaseClusterList); ok {
+		r0 = rf(ctx, namespace, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*v1alpha1.DatabaseClusterList)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, namespace)
+	if rf, ok := ret.Get(1).(func(context.Context, string, *api.ListDatabaseClustersParams) error); ok {
+		r1 = rf(ctx, namespace, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1198,6 +1625,36 @@ func (_m *MockHandler) ListDatabaseEngines(ctx context.Context, namespace string
 	return r0, r1
 }
 
+// ListDeletedDatabaseClusters provides a mock function with given fields: ctx, namespace
+func (_m *MockHandler) ListDeletedDatabaseClusters(ctx context.Context, namespace string) (*v1alpha1.DatabaseClusterList, error) {
+	ret := _m.Called(ctx, namespace)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeletedDatabaseClusters")
+	}
+
+	var r0 *v1alpha1.DatabaseClusterList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*v1alpha1.DatabaseClusterList, error)); ok {
+		return rf(ctx, namespace)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *v1alpha1.DatabaseClusterList); ok {
+		r0 = rf(ctx, namespace)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.DatabaseClusterList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, namespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListLoadBalancerConfigs provides a mock function with given fields: ctx
 func (_m *MockHandler) ListLoadBalancerConfigs(ctx context.Context) (*v1alpha1.LoadBalancerConfigList, error) {
 	ret := _m.Called(ctx)
@@ -1228,9 +1685,9 @@ func (_m *MockHandler) ListLoadBalancerConfigs(ctx context.Context) (*v1alpha1.L
 	return r0, r1
 }
 
-// ListMonitoringInstances provides a mock function with given fields: ctx, namespaces
-func (_m *MockHandler) ListMonitoringInstances(ctx context.Context, namespaces string) (*v1alpha1.MonitoringConfigList, error) {
-	ret := _m.Called(ctx, namespaces)
+// ListMonitoringInstances provides a mock function with given fields: ctx, namespaces, params
+func (_m *MockHandler) ListMonitoringInstances(ctx context.Context, namespaces string, params *api.ListMonitoringInstancesParams) (*v1alpha1.MonitoringConfigList, error) {
+	ret := _m.Called(ctx, namespaces, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListMonitoringInstances")
@@ -1238,19 +1695,19 @@ func (_m *MockHandler) ListMonitoringInstances(ctx context.Context, namespaces s
 
 	var r0 *v1alpha1.MonitoringConfigList
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*v1alpha1.MonitoringConfigList, error)); ok {
-		return rf(ctx, namespaces)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.ListMonitoringInstancesParams) (*v1alpha1.MonitoringConfigList, error)); ok {
+		return rf(ctx, namespaces, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *v1alpha1.MonitoringConfigList); ok {
-		r0 = rf(ctx, namespaces)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.ListMonitoringInstancesParams) *v1alpha1.MonitoringConfigList); ok {
+		r0 = rf(ctx, namespaces, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*v1alpha1.MonitoringConfigList)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, namespaces)
+	if rf, ok := ret.Get(1).(func(context.Context, string, *api.ListMonitoringInstancesParams) error); ok {
+		r1 = rf(ctx, namespaces, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1288,6 +1745,36 @@ func (_m *MockHandler) ListNamespaces(ctx context.Context) ([]string, error) {
 	return r0, r1
 }
 
+// ListOperations provides a mock function with given fields: ctx, namespace
+func (_m *MockHandler) ListOperations(ctx context.Context, namespace string) (*api.OperationList, error) {
+	ret := _m.Called(ctx, namespace)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOperations")
+	}
+
+	var r0 *api.OperationList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*api.OperationList, error)); ok {
+		return rf(ctx, namespace)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *api.OperationList); ok {
+		r0 = rf(ctx, namespace)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.OperationList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, namespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListPodSchedulingPolicies provides a mock function with given fields: ctx, params
 func (_m *MockHandler) ListPodSchedulingPolicies(ctx context.Context, params *api.ListPodSchedulingPolicyParams) (*v1alpha1.PodSchedulingPolicyList, error) {
 	ret := _m.Called(ctx, params)
@@ -1348,6 +1835,84 @@ func (_m *MockHandler) ListSplitHorizonDNSConfigs(ctx context.Context, namespace
 	return r0, r1
 }
 
+// PurgeDatabaseCluster provides a mock function with given fields: ctx, namespace, name
+func (_m *MockHandler) PurgeDatabaseCluster(ctx context.Context, namespace string, name string) error {
+	ret := _m.Called(ctx, namespace, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeDatabaseCluster")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, namespace, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RejectChangeRequest provides a mock function with given fields: ctx, namespace, name
+func (_m *MockHandler) RejectChangeRequest(ctx context.Context, namespace string, name string) (*api.ChangeRequest, error) {
+	ret := _m.Called(ctx, namespace, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectChangeRequest")
+	}
+
+	var r0 *api.ChangeRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*api.ChangeRequest, error)); ok {
+		return rf(ctx, namespace, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *api.ChangeRequest); ok {
+		r0 = rf(ctx, namespace, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.ChangeRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespace, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreDatabaseCluster provides a mock function with given fields: ctx, namespace, name
+func (_m *MockHandler) RestoreDatabaseCluster(ctx context.Context, namespace string, name string) (*v1alpha1.DatabaseCluster, error) {
+	ret := _m.Called(ctx, namespace, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreDatabaseCluster")
+	}
+
+	var r0 *v1alpha1.DatabaseCluster
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*v1alpha1.DatabaseCluster, error)); ok {
+		return rf(ctx, namespace, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *v1alpha1.DatabaseCluster); ok {
+		r0 = rf(ctx, namespace, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.DatabaseCluster)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespace, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetNext provides a mock function with given fields: h
 func (_m *MockHandler) SetNext(h Handler) {
 	_m.Called(h)
@@ -1413,6 +1978,36 @@ func (_m *MockHandler) UpdateDatabaseCluster(ctx context.Context, req *v1alpha1.
 	return r0, r1
 }
 
+// UpdateDatabaseClusterDeletionProtection provides a mock function with given fields: ctx, namespace, name, enabled
+func (_m *MockHandler) UpdateDatabaseClusterDeletionProtection(ctx context.Context, namespace string, name string, enabled bool) (*everestv1alpha1.DatabaseCluster, error) {
+	ret := _m.Called(ctx, namespace, name, enabled)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatabaseClusterDeletionProtection")
+	}
+
+	var r0 *everestv1alpha1.DatabaseCluster
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool) (*everestv1alpha1.DatabaseCluster, error)); ok {
+		return rf(ctx, namespace, name, enabled)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool) *everestv1alpha1.DatabaseCluster); ok {
+		r0 = rf(ctx, namespace, name, enabled)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*everestv1alpha1.DatabaseCluster)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, bool) error); ok {
+		r1 = rf(ctx, namespace, name, enabled)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateDatabaseClusterRestore provides a mock function with given fields: ctx, req
 func (_m *MockHandler) UpdateDatabaseClusterRestore(ctx context.Context, req *v1alpha1.DatabaseClusterRestore) (*v1alpha1.DatabaseClusterRestore, error) {
 	ret := _m.Called(ctx, req)
@@ -1443,6 +2038,36 @@ func (_m *MockHandler) UpdateDatabaseClusterRestore(ctx context.Context, req *v1
 	return r0, r1
 }
 
+// UpdateDatabaseClusterSchedule provides a mock function with given fields: ctx, namespace, dbName, req
+func (_m *MockHandler) UpdateDatabaseClusterSchedule(ctx context.Context, namespace string, dbName string, req *api.DatabaseClusterSchedule) (*api.DatabaseClusterSchedule, error) {
+	ret := _m.Called(ctx, namespace, dbName, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatabaseClusterSchedule")
+	}
+
+	var r0 *api.DatabaseClusterSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *api.DatabaseClusterSchedule) (*api.DatabaseClusterSchedule, error)); ok {
+		return rf(ctx, namespace, dbName, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *api.DatabaseClusterSchedule) *api.DatabaseClusterSchedule); ok {
+		r0 = rf(ctx, namespace, dbName, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.DatabaseClusterSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *api.DatabaseClusterSchedule) error); ok {
+		r1 = rf(ctx, namespace, dbName, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateDatabaseEngine provides a mock function with given fields: ctx, req
 func (_m *MockHandler) UpdateDatabaseEngine(ctx context.Context, req *v1alpha1.DatabaseEngine) (*v1alpha1.DatabaseEngine, error) {
 	ret := _m.Called(ctx, req)
@@ -1598,7 +2223,8 @@ func (_m *MockHandler) UpdateSplitHorizonDNSConfig(ctx context.Context, namespac
 func NewMockHandler(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockHandler {
+},
+) *MockHandler {
 	mock := &MockHandler{}
 	mock.Mock.Test(t)
 
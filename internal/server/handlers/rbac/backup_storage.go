@@ -5,20 +5,26 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/AlekSi/pointer"
+
 	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
 	"github.com/percona/everest/api"
 	"github.com/percona/everest/pkg/rbac"
 )
 
-func (h *rbacHandler) ListBackupStorages(ctx context.Context, namespace string) (*everestv1alpha1.BackupStorageList, error) {
-	list, err := h.next.ListBackupStorages(ctx, namespace)
+func (h *rbacHandler) ListBackupStorages(
+	ctx context.Context,
+	namespace string,
+	params *api.ListBackupStoragesParams,
+) (*everestv1alpha1.BackupStorageList, error) {
+	list, err := h.next.ListBackupStorages(ctx, namespace, params)
 	if err != nil {
 		return nil, fmt.Errorf("ListBackupStorages failed: %w", err)
 	}
 	filtered := []everestv1alpha1.BackupStorage{}
 	for _, bs := range list.Items {
-		if err := h.enforce(ctx, rbac.ResourceBackupStorages, rbac.ActionRead,
-			rbac.ObjectName(namespace, bs.GetName()),
+		if err := h.enforceLabeled(ctx, rbac.ResourceBackupStorages, rbac.ActionRead,
+			namespace, bs.GetName(), bs.GetLabels(),
 		); errors.Is(err, ErrInsufficientPermissions) {
 			continue
 		} else if err != nil {
@@ -31,28 +37,46 @@ func (h *rbacHandler) ListBackupStorages(ctx context.Context, namespace string)
 }
 
 func (h *rbacHandler) GetBackupStorage(ctx context.Context, namespace, name string) (*everestv1alpha1.BackupStorage, error) {
-	if err := h.enforce(ctx, rbac.ResourceBackupStorages, rbac.ActionRead, rbac.ObjectName(namespace, name)); err != nil {
+	bs, err := h.next.GetBackupStorage(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.enforceLabeled(ctx, rbac.ResourceBackupStorages, rbac.ActionRead, namespace, name, bs.GetLabels()); err != nil {
 		return nil, err
 	}
-	return h.next.GetBackupStorage(ctx, namespace, name)
+	return bs, nil
 }
 
 func (h *rbacHandler) CreateBackupStorage(ctx context.Context, namespace string, req *api.CreateBackupStorageParams) (*everestv1alpha1.BackupStorage, error) {
-	if err := h.enforce(ctx, rbac.ResourceBackupStorages, rbac.ActionCreate, rbac.ObjectName(namespace, req.Name)); err != nil {
+	if err := h.enforceLabeled(ctx, rbac.ResourceBackupStorages, rbac.ActionCreate, namespace, req.Name, pointer.Get(req.Labels)); err != nil {
 		return nil, err
 	}
 	return h.next.CreateBackupStorage(ctx, namespace, req)
 }
 
 func (h *rbacHandler) UpdateBackupStorage(ctx context.Context, namespace, name string, req *api.UpdateBackupStorageParams) (*everestv1alpha1.BackupStorage, error) {
-	if err := h.enforce(ctx, rbac.ResourceBackupStorages, rbac.ActionUpdate, rbac.ObjectName(namespace, name)); err != nil {
+	bs, err := h.next.GetBackupStorage(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	// The user needs permissions to update the backup storage both with its current and with its new labels.
+	if err := h.enforceLabeled(ctx, rbac.ResourceBackupStorages, rbac.ActionUpdate, namespace, name, bs.GetLabels()); err != nil {
 		return nil, err
 	}
+	if req.Labels != nil {
+		if err := h.enforceLabeled(ctx, rbac.ResourceBackupStorages, rbac.ActionUpdate, namespace, name, *req.Labels); err != nil {
+			return nil, err
+		}
+	}
 	return h.next.UpdateBackupStorage(ctx, namespace, name, req)
 }
 
 func (h *rbacHandler) DeleteBackupStorage(ctx context.Context, namespace, name string) error {
-	if err := h.enforce(ctx, rbac.ResourceBackupStorages, rbac.ActionDelete, rbac.ObjectName(namespace, name)); err != nil {
+	bs, err := h.next.GetBackupStorage(ctx, namespace, name)
+	if err != nil {
+		return err
+	}
+	if err := h.enforceLabeled(ctx, rbac.ResourceBackupStorages, rbac.ActionDelete, namespace, name, bs.GetLabels()); err != nil {
 		return err
 	}
 	return h.next.DeleteBackupStorage(ctx, namespace, name)
@@ -31,6 +31,7 @@ func TestRBAC_BackupStorage(t *testing.T) {
 		next.On("ListBackupStorages",
 			mock.Anything,
 			mock.Anything,
+			mock.Anything,
 		).Return(
 			&everestv1alpha1.BackupStorageList{
 				Items: []everestv1alpha1.BackupStorage{
@@ -159,7 +160,7 @@ func TestRBAC_BackupStorage(t *testing.T) {
 					userGetter: testUserGetter,
 				}
 
-				list, err := h.ListBackupStorages(ctx, "default")
+				list, err := h.ListBackupStorages(ctx, "default", nil)
 				require.NoError(t, err)
 				assert.Condition(t, func() bool {
 					return tc.assert(list)
@@ -423,6 +424,9 @@ func TestRBAC_BackupStorage(t *testing.T) {
 		t.Parallel()
 		next := func() *handlers.MockHandler {
 			next := handlers.MockHandler{}
+			next.On("GetBackupStorage", mock.Anything, mock.Anything, mock.Anything).Return(
+				&everestv1alpha1.BackupStorage{}, nil,
+			)
 			next.On("UpdateBackupStorage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
 				&everestv1alpha1.BackupStorage{}, nil,
 			)
@@ -589,6 +593,9 @@ func TestRBAC_BackupStorage(t *testing.T) {
 		t.Parallel()
 		next := func() *handlers.MockHandler {
 			next := handlers.MockHandler{}
+			next.On("GetBackupStorage", mock.Anything, mock.Anything, mock.Anything).Return(
+				&everestv1alpha1.BackupStorage{}, nil,
+			)
 			next.On("DeleteBackupStorage", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 			return &next
 		}
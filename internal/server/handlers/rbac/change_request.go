@@ -0,0 +1,56 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+func (h *rbacHandler) ListChangeRequests(ctx context.Context, namespace string) (*api.ChangeRequestList, error) {
+	list, err := h.next.ListChangeRequests(ctx, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("ListChangeRequests failed: %w", err)
+	}
+	result := make([]api.ChangeRequest, 0, len(list.Items))
+	for _, cr := range list.Items {
+		if err := h.enforce(ctx, rbac.ResourceChangeRequests, rbac.ActionRead, rbac.ObjectName(namespace, cr.Name)); errors.Is(err, ErrInsufficientPermissions) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("enforce failed: %w", err)
+		}
+		result = append(result, cr)
+	}
+	list.Items = result
+	return list, nil
+}
+
+func (h *rbacHandler) GetChangeRequest(ctx context.Context, namespace, name string) (*api.ChangeRequest, error) {
+	if err := h.enforce(ctx, rbac.ResourceChangeRequests, rbac.ActionRead, rbac.ObjectName(namespace, name)); err != nil {
+		return nil, err
+	}
+	return h.next.GetChangeRequest(ctx, namespace, name)
+}
+
+func (h *rbacHandler) ApproveChangeRequest(ctx context.Context, namespace, name string) (*api.ChangeRequest, error) {
+	if err := h.enforce(ctx, rbac.ResourceChangeRequests, rbac.ActionApprove, rbac.ObjectName(namespace, name)); err != nil {
+		return nil, err
+	}
+	return h.next.ApproveChangeRequest(ctx, namespace, name)
+}
+
+func (h *rbacHandler) RejectChangeRequest(ctx context.Context, namespace, name string) (*api.ChangeRequest, error) {
+	if err := h.enforce(ctx, rbac.ResourceChangeRequests, rbac.ActionApprove, rbac.ObjectName(namespace, name)); err != nil {
+		return nil, err
+	}
+	return h.next.RejectChangeRequest(ctx, namespace, name)
+}
+
+func (h *rbacHandler) CompleteChangeRequest(ctx context.Context, namespace, name string, execErr error) (*api.ChangeRequest, error) {
+	if err := h.enforce(ctx, rbac.ResourceChangeRequests, rbac.ActionApprove, rbac.ObjectName(namespace, name)); err != nil {
+		return nil, err
+	}
+	return h.next.CompleteChangeRequest(ctx, namespace, name, execErr)
+}
@@ -14,14 +14,15 @@ import (
 	"github.com/percona/everest/api"
 	"github.com/percona/everest/internal/server/handlers"
 	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/expiry"
+	"github.com/percona/everest/pkg/pagination"
 	"github.com/percona/everest/pkg/rbac"
 )
 
 func (h *rbacHandler) CreateDatabaseCluster(ctx context.Context, db *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error) {
 	name := db.GetName()
 	namespace := db.GetNamespace()
-	object := rbac.ObjectName(namespace, name)
-	if err := h.enforce(ctx, rbac.ResourceDatabaseClusters, rbac.ActionCreate, object); err != nil {
+	if err := h.enforceLabeled(ctx, rbac.ResourceDatabaseClusters, rbac.ActionCreate, namespace, name, db.GetLabels()); err != nil {
 		return nil, err
 	}
 
@@ -68,6 +69,14 @@ func (h *rbacHandler) CreateDatabaseCluster(ctx context.Context, db *everestv1al
 		}
 	}
 
+	// A cluster that expires may be deleted by the expiry policy, so setting the expiry
+	// requires permissions to delete the cluster.
+	if expiry.IsChanged(db, nil) {
+		if err := h.enforceLabeled(ctx, rbac.ResourceDatabaseClusters, rbac.ActionDelete, namespace, name, db.GetLabels()); err != nil {
+			return nil, err
+		}
+	}
+
 	// Check permissions for engine features used in the database cluster.
 	if err := h.enforceEngineFeaturesRead(ctx, db); err != nil {
 		return nil, err
@@ -75,8 +84,14 @@ func (h *rbacHandler) CreateDatabaseCluster(ctx context.Context, db *everestv1al
 	return h.next.CreateDatabaseCluster(ctx, db)
 }
 
-func (h *rbacHandler) ListDatabaseClusters(ctx context.Context, namespace string) (*everestv1alpha1.DatabaseClusterList, error) {
-	clusterList, err := h.next.ListDatabaseClusters(ctx, namespace)
+func (h *rbacHandler) ListDatabaseClusters(
+	ctx context.Context,
+	namespace string,
+	params *api.ListDatabaseClustersParams,
+) (*everestv1alpha1.DatabaseClusterList, error) {
+	// The clusters the user is not allowed to read are filtered out, so all
+	// the clusters are listed and the page is taken from the filtered ones.
+	clusterList, err := h.next.ListDatabaseClusters(ctx, namespace, pagination.DatabaseClusterParamsWithoutPage(params))
 	if err != nil {
 		return nil, fmt.Errorf("ListDatabaseClusters failed: %w", err)
 	}
@@ -90,7 +105,11 @@ func (h *rbacHandler) ListDatabaseClusters(ctx context.Context, namespace string
 		}
 		result = append(result, db)
 	}
-	clusterList.Items = result
+	clusterList.Items, clusterList.Continue, err = pagination.Paginate(result, "",
+		pagination.DatabaseClusterOptions(params), pagination.DatabaseClusterSortKeys)
+	if err != nil {
+		return nil, err
+	}
 	return clusterList, nil
 }
 
@@ -99,7 +118,7 @@ func (h *rbacHandler) DeleteDatabaseCluster(ctx context.Context, namespace, name
 	if err != nil {
 		return fmt.Errorf("GetDatabaseCluster failed: %w", err)
 	}
-	if err := h.enforce(ctx, rbac.ResourceDatabaseClusters, rbac.ActionDelete, rbac.ObjectName(namespace, name)); err != nil {
+	if err := h.enforceLabeled(ctx, rbac.ResourceDatabaseClusters, rbac.ActionDelete, namespace, name, db.GetLabels()); err != nil {
 		return err
 	}
 	engineName := common.OperatorTypeToName[db.Spec.Engine.Type]
@@ -109,21 +128,89 @@ func (h *rbacHandler) DeleteDatabaseCluster(ctx context.Context, namespace, name
 	return h.next.DeleteDatabaseCluster(ctx, namespace, name, req)
 }
 
-func (h *rbacHandler) UpdateDatabaseCluster(ctx context.Context, db *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error) {
-	name := db.GetName()
-	namespace := db.GetNamespace()
-	if err := h.enforce(ctx, rbac.ResourceDatabaseClusters, rbac.ActionUpdate, rbac.ObjectName(namespace, name)); err != nil {
+func (h *rbacHandler) ListDeletedDatabaseClusters(ctx context.Context, namespace string) (*everestv1alpha1.DatabaseClusterList, error) {
+	clusterList, err := h.next.ListDeletedDatabaseClusters(ctx, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("ListDeletedDatabaseClusters failed: %w", err)
+	}
+
+	result := make([]everestv1alpha1.DatabaseCluster, 0, len(clusterList.Items))
+	for _, db := range clusterList.Items {
+		if err := h.enforceDBClusterRead(ctx, &db); errors.Is(err, ErrInsufficientPermissions) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("enforce failed: %w", err)
+		}
+		result = append(result, db)
+	}
+	clusterList.Items = result
+	return clusterList, nil
+}
+
+func (h *rbacHandler) RestoreDatabaseCluster(ctx context.Context, namespace, name string) (*everestv1alpha1.DatabaseCluster, error) {
+	db, err := h.next.GetDeletedDatabaseCluster(ctx, namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("GetDeletedDatabaseCluster failed: %w", err)
+	}
+	if err := h.enforceLabeled(ctx, rbac.ResourceDatabaseClusters, rbac.ActionUpdate, namespace, name, db.GetLabels()); err != nil {
+		return nil, err
+	}
+	engineName := common.OperatorTypeToName[db.Spec.Engine.Type]
+	if err := h.enforce(ctx, rbac.ResourceDatabaseEngines, rbac.ActionRead, rbac.ObjectName(namespace, engineName)); err != nil {
+		return nil, err
+	}
+	return h.next.RestoreDatabaseCluster(ctx, namespace, name)
+}
+
+func (h *rbacHandler) UpdateDatabaseClusterDeletionProtection(ctx context.Context, namespace, name string, enabled bool) (*everestv1alpha1.DatabaseCluster, error) {
+	db, err := h.next.GetDatabaseCluster(ctx, namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("GetDatabaseCluster failed: %w", err)
+	}
+	if err := h.enforceLabeled(ctx, rbac.ResourceDatabaseClusters, rbac.ActionUpdate, namespace, name, db.GetLabels()); err != nil {
 		return nil, err
 	}
 	engineName := common.OperatorTypeToName[db.Spec.Engine.Type]
 	if err := h.enforce(ctx, rbac.ResourceDatabaseEngines, rbac.ActionRead, rbac.ObjectName(namespace, engineName)); err != nil {
 		return nil, err
 	}
+	return h.next.UpdateDatabaseClusterDeletionProtection(ctx, namespace, name, enabled)
+}
 
+func (h *rbacHandler) PurgeDatabaseCluster(ctx context.Context, namespace, name string) error {
+	db, err := h.next.GetDeletedDatabaseCluster(ctx, namespace, name)
+	if err != nil {
+		return fmt.Errorf("GetDeletedDatabaseCluster failed: %w", err)
+	}
+	if err := h.enforceLabeled(ctx, rbac.ResourceDatabaseClusters, rbac.ActionDelete, namespace, name, db.GetLabels()); err != nil {
+		return err
+	}
+	engineName := common.OperatorTypeToName[db.Spec.Engine.Type]
+	if err := h.enforce(ctx, rbac.ResourceDatabaseEngines, rbac.ActionRead, rbac.ObjectName(namespace, engineName)); err != nil {
+		return err
+	}
+	return h.next.PurgeDatabaseCluster(ctx, namespace, name)
+}
+
+func (h *rbacHandler) UpdateDatabaseCluster(ctx context.Context, db *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error) {
+	name := db.GetName()
+	namespace := db.GetNamespace()
 	oldDB, err := h.next.GetDatabaseCluster(ctx, namespace, name)
 	if err != nil {
 		return nil, err
 	}
+	// The user needs permissions to update the cluster both with its current and with its new labels.
+	if err := h.enforceLabeled(ctx, rbac.ResourceDatabaseClusters, rbac.ActionUpdate, namespace, name, oldDB.GetLabels()); err != nil {
+		return nil, err
+	}
+	if err := h.enforceLabeled(ctx, rbac.ResourceDatabaseClusters, rbac.ActionUpdate, namespace, name, db.GetLabels()); err != nil {
+		return nil, err
+	}
+	engineName := common.OperatorTypeToName[db.Spec.Engine.Type]
+	if err := h.enforce(ctx, rbac.ResourceDatabaseEngines, rbac.ActionRead, rbac.ObjectName(namespace, engineName)); err != nil {
+		return nil, err
+	}
+
 	oldSched := oldDB.Spec.Backup.Schedules
 	updatedSched := db.Spec.Backup.Schedules
 
@@ -163,6 +250,14 @@ func (h *rbacHandler) UpdateDatabaseCluster(ctx context.Context, db *everestv1al
 		}
 	}
 
+	// A cluster that expires may be deleted by the expiry policy, so setting the expiry
+	// requires permissions to delete the cluster.
+	if expiry.IsChanged(db, oldDB) {
+		if err := h.enforceLabeled(ctx, rbac.ResourceDatabaseClusters, rbac.ActionDelete, namespace, name, oldDB.GetLabels()); err != nil {
+			return nil, err
+		}
+	}
+
 	// Check permissions for engine features used in the database cluster.
 	if err := h.enforceEngineFeaturesRead(ctx, db); err != nil {
 		return nil, err
@@ -182,18 +277,30 @@ func (h *rbacHandler) GetDatabaseCluster(ctx context.Context, namespace, name st
 	return result, nil
 }
 
+func (h *rbacHandler) GetDeletedDatabaseCluster(ctx context.Context, namespace, name string) (*everestv1alpha1.DatabaseCluster, error) {
+	result, err := h.next.GetDeletedDatabaseCluster(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.enforceDBClusterRead(ctx, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (h *rbacHandler) GetDatabaseClusterCredentials(ctx context.Context, namespace, name string) (*api.DatabaseClusterCredential, error) {
-	if err := h.enforce(ctx, rbac.ResourceDatabaseClusters, rbac.ActionRead, rbac.ObjectName(namespace, name)); err != nil {
+	labels := h.databaseClusterLabels(ctx, namespace, name)
+	if err := h.enforceLabeled(ctx, rbac.ResourceDatabaseClusters, rbac.ActionRead, namespace, name, labels); err != nil {
 		return nil, err
 	}
-	if err := h.enforce(ctx, rbac.ResourceDatabaseClusterCredentials, rbac.ActionRead, rbac.ObjectName(namespace, name)); err != nil {
+	if err := h.enforceLabeled(ctx, rbac.ResourceDatabaseClusterCredentials, rbac.ActionRead, namespace, name, labels); err != nil {
 		return nil, err
 	}
 	return h.next.GetDatabaseClusterCredentials(ctx, namespace, name)
 }
 
 func (h *rbacHandler) GetDatabaseClusterComponents(ctx context.Context, namespace, name string) ([]api.DatabaseClusterComponent, error) {
-	if err := h.enforce(ctx, rbac.ResourceDatabaseClusters, rbac.ActionRead, rbac.ObjectName(namespace, name)); err != nil {
+	if err := h.enforceDatabaseCluster(ctx, rbac.ActionRead, namespace, name); err != nil {
 		return nil, err
 	}
 	return h.next.GetDatabaseClusterComponents(ctx, namespace, name)
@@ -201,14 +308,35 @@ func (h *rbacHandler) GetDatabaseClusterComponents(ctx context.Context, namespac
 
 func (h *rbacHandler) GetDatabaseClusterComponentLogs(ctx context.Context, namespace, clusterName, componentName string, params api.GetDatabaseClusterComponentLogsParams, stream handlers.StreamFunc) error {
 	// if users have access to the DB cluster let's give them access to read the logs
-	if err := h.enforce(ctx, rbac.ResourceDatabaseClusters, rbac.ActionRead, rbac.ObjectName(namespace, clusterName)); err != nil {
+	if err := h.enforceDatabaseCluster(ctx, rbac.ActionRead, namespace, clusterName); err != nil {
 		return err
 	}
 	return h.next.GetDatabaseClusterComponentLogs(ctx, namespace, clusterName, componentName, params, stream)
 }
 
+func (h *rbacHandler) GetDatabaseClusterLogs(ctx context.Context, namespace, name string, params api.GetDatabaseClusterLogsParams, stream handlers.LogsStreamFunc) error {
+	if err := h.enforceDatabaseCluster(ctx, rbac.ActionRead, namespace, name); err != nil {
+		return err
+	}
+	return h.next.GetDatabaseClusterLogs(ctx, namespace, name, params, stream)
+}
+
+func (h *rbacHandler) GetDatabaseClusterEvents(ctx context.Context, namespace, name string, params *api.GetDatabaseClusterEventsParams) (*api.DatabaseClusterEventList, error) {
+	if err := h.enforceDatabaseCluster(ctx, rbac.ActionRead, namespace, name); err != nil {
+		return nil, err
+	}
+	return h.next.GetDatabaseClusterEvents(ctx, namespace, name, params)
+}
+
+func (h *rbacHandler) GetDatabaseClusterDiagnostics(ctx context.Context, namespace, name string, params *api.GetDatabaseClusterDiagnosticsParams) (*api.DatabaseClusterDiagnostics, error) {
+	if err := h.enforceDatabaseCluster(ctx, rbac.ActionRead, namespace, name); err != nil {
+		return nil, err
+	}
+	return h.next.GetDatabaseClusterDiagnostics(ctx, namespace, name, params)
+}
+
 func (h *rbacHandler) GetDatabaseClusterPitr(ctx context.Context, namespace, name string) (*api.DatabaseClusterPitr, error) {
-	if err := h.enforce(ctx, rbac.ResourceDatabaseClusters, rbac.ActionRead, rbac.ObjectName(namespace, name)); err != nil {
+	if err := h.enforceDatabaseCluster(ctx, rbac.ActionRead, namespace, name); err != nil {
 		return nil, err
 	}
 	return h.next.GetDatabaseClusterPitr(ctx, namespace, name)
@@ -217,7 +345,7 @@ func (h *rbacHandler) GetDatabaseClusterPitr(ctx context.Context, namespace, nam
 func (h *rbacHandler) enforceDBClusterRead(ctx context.Context, db *everestv1alpha1.DatabaseCluster) error {
 	name := db.GetName()
 	namespace := db.GetNamespace()
-	if err := h.enforce(ctx, rbac.ResourceDatabaseClusters, rbac.ActionRead, rbac.ObjectName(namespace, name)); err != nil {
+	if err := h.enforceLabeled(ctx, rbac.ResourceDatabaseClusters, rbac.ActionRead, namespace, name, db.GetLabels()); err != nil {
 		return err
 	}
 
@@ -260,6 +388,24 @@ func (h *rbacHandler) enforceDBClusterRead(ctx context.Context, db *everestv1alp
 	return nil
 }
 
+// enforceDatabaseCluster checks that the user may perform the action on the database cluster,
+// either by its name or by its labels.
+func (h *rbacHandler) enforceDatabaseCluster(ctx context.Context, action, namespace, name string) error {
+	labels := h.databaseClusterLabels(ctx, namespace, name)
+	return h.enforceLabeled(ctx, rbac.ResourceDatabaseClusters, action, namespace, name, labels)
+}
+
+// databaseClusterLabels returns the labels of the database cluster.
+// If the cluster cannot be read, e.g. because it does not exist, the permissions
+// are checked by its name only, so no labels are returned.
+func (h *rbacHandler) databaseClusterLabels(ctx context.Context, namespace, name string) map[string]string {
+	db, err := h.next.GetDatabaseCluster(ctx, namespace, name)
+	if err != nil {
+		return nil
+	}
+	return db.GetLabels()
+}
+
 func (h *rbacHandler) enforceEngineFeaturesRead(ctx context.Context, db *everestv1alpha1.DatabaseCluster) error {
 	namespace := db.GetNamespace()
 
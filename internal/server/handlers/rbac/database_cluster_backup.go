@@ -10,8 +10,12 @@ import (
 	"github.com/percona/everest/pkg/rbac"
 )
 
-func (h *rbacHandler) ListDatabaseClusterBackups(ctx context.Context, namespace, clusterName string) (*everestv1alpha1.DatabaseClusterBackupList, error) {
-	list, err := h.next.ListDatabaseClusterBackups(ctx, namespace, clusterName)
+func (h *rbacHandler) ListDatabaseClusterBackups(
+	ctx context.Context,
+	namespace, clusterName string,
+	params *api.ListDatabaseClusterBackupsParams,
+) (*everestv1alpha1.DatabaseClusterBackupList, error) {
+	list, err := h.next.ListDatabaseClusterBackups(ctx, namespace, clusterName, params)
 	if err != nil {
 		return nil, err
 	}
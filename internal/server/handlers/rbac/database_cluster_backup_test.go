@@ -22,7 +22,7 @@ func TestRBAC_DatabaseClusterBackup(t *testing.T) {
 	t.Run("ListDatabaseClusterBackups", func(t *testing.T) {
 		next := func() *handlers.MockHandler {
 			h := &handlers.MockHandler{}
-			h.On("ListDatabaseClusterBackups", mock.Anything, mock.Anything, mock.Anything).Return(&everestv1alpha1.DatabaseClusterBackupList{
+			h.On("ListDatabaseClusterBackups", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&everestv1alpha1.DatabaseClusterBackupList{
 				Items: []everestv1alpha1.DatabaseClusterBackup{
 					{
 						ObjectMeta: metav1.ObjectMeta{
@@ -155,7 +155,7 @@ func TestRBAC_DatabaseClusterBackup(t *testing.T) {
 					userGetter: testUserGetter,
 				}
 
-				list, err := h.ListDatabaseClusterBackups(ctx, "default", "cluster1")
+				list, err := h.ListDatabaseClusterBackups(ctx, "default", "cluster1", nil)
 				require.NoError(t, err)
 				assert.Condition(t, func() bool {
 					return tc.assert(list)
@@ -6,11 +6,16 @@ import (
 	"fmt"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/api"
 	"github.com/percona/everest/pkg/rbac"
 )
 
-func (h *rbacHandler) ListDatabaseClusterRestores(ctx context.Context, namespace, clusterName string) (*everestv1alpha1.DatabaseClusterRestoreList, error) {
-	list, err := h.next.ListDatabaseClusterRestores(ctx, namespace, clusterName)
+func (h *rbacHandler) ListDatabaseClusterRestores(
+	ctx context.Context,
+	namespace, clusterName string,
+	params *api.ListDatabaseClusterRestoresParams,
+) (*everestv1alpha1.DatabaseClusterRestoreList, error) {
+	list, err := h.next.ListDatabaseClusterRestores(ctx, namespace, clusterName, params)
 	if err != nil {
 		return nil, err
 	}
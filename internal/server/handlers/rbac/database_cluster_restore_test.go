@@ -21,7 +21,7 @@ func TestRBAC_DatabaseClusterRestore(t *testing.T) {
 	t.Run("ListDatabaseClusterRestores", func(t *testing.T) {
 		next := func() *handlers.MockHandler {
 			h := &handlers.MockHandler{}
-			h.On("ListDatabaseClusterRestores", mock.Anything, mock.Anything, mock.Anything).Return(&everestv1alpha1.DatabaseClusterRestoreList{
+			h.On("ListDatabaseClusterRestores", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&everestv1alpha1.DatabaseClusterRestoreList{
 				Items: []everestv1alpha1.DatabaseClusterRestore{
 					{
 						ObjectMeta: metav1.ObjectMeta{
@@ -108,7 +108,7 @@ func TestRBAC_DatabaseClusterRestore(t *testing.T) {
 					userGetter: testUserGetter,
 				}
 
-				list, err := h.ListDatabaseClusterRestores(ctx, "default", "cluster1")
+				list, err := h.ListDatabaseClusterRestores(ctx, "default", "cluster1", nil)
 				require.NoError(t, err)
 				assert.Condition(t, func() bool {
 					return tc.assert(list)
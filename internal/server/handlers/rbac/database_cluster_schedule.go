@@ -0,0 +1,66 @@
+package rbac
+
+import (
+	"context"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+func (h *rbacHandler) ListDatabaseClusterSchedules(ctx context.Context, namespace, dbName string) (*api.DatabaseClusterScheduleList, error) {
+	if err := h.enforceDatabaseClusterSchedule(ctx, namespace, dbName, rbac.ActionRead); err != nil {
+		return nil, err
+	}
+	return h.next.ListDatabaseClusterSchedules(ctx, namespace, dbName)
+}
+
+func (h *rbacHandler) GetDatabaseClusterSchedule(ctx context.Context, namespace, dbName, name string) (*api.DatabaseClusterSchedule, error) {
+	if err := h.enforceDatabaseClusterSchedule(ctx, namespace, dbName, rbac.ActionRead); err != nil {
+		return nil, err
+	}
+	return h.next.GetDatabaseClusterSchedule(ctx, namespace, dbName, name)
+}
+
+func (h *rbacHandler) CreateDatabaseClusterSchedule(
+	ctx context.Context,
+	namespace, dbName string,
+	req *api.DatabaseClusterSchedule,
+) (*api.DatabaseClusterSchedule, error) {
+	if err := h.enforceDatabaseClusterSchedule(ctx, namespace, dbName, rbac.ActionCreate); err != nil {
+		return nil, err
+	}
+	return h.next.CreateDatabaseClusterSchedule(ctx, namespace, dbName, req)
+}
+
+func (h *rbacHandler) UpdateDatabaseClusterSchedule(
+	ctx context.Context,
+	namespace, dbName string,
+	req *api.DatabaseClusterSchedule,
+) (*api.DatabaseClusterSchedule, error) {
+	if err := h.enforceDatabaseClusterSchedule(ctx, namespace, dbName, rbac.ActionUpdate); err != nil {
+		return nil, err
+	}
+	return h.next.UpdateDatabaseClusterSchedule(ctx, namespace, dbName, req)
+}
+
+func (h *rbacHandler) DeleteDatabaseClusterSchedule(ctx context.Context, namespace, dbName, name string) error {
+	if err := h.enforceDatabaseClusterSchedule(ctx, namespace, dbName, rbac.ActionDelete); err != nil {
+		return err
+	}
+	return h.next.DeleteDatabaseClusterSchedule(ctx, namespace, dbName, name)
+}
+
+// enforceDatabaseClusterSchedule checks that the user may perform the action on the
+// schedules of the database cluster. Schedules modify the database cluster when they
+// are executed, so changing them also requires permission to update the cluster.
+func (h *rbacHandler) enforceDatabaseClusterSchedule(ctx context.Context, namespace, dbName, action string) error {
+	object := rbac.ObjectName(namespace, dbName)
+	clusterAction := rbac.ActionRead
+	if action != rbac.ActionRead {
+		clusterAction = rbac.ActionUpdate
+	}
+	if err := h.enforceDatabaseCluster(ctx, clusterAction, namespace, dbName); err != nil {
+		return err
+	}
+	return h.enforce(ctx, rbac.ResourceDatabaseClusterSchedules, action, object)
+}
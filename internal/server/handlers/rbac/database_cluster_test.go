@@ -1037,7 +1037,7 @@ func TestRBAC_DatabaseCluster(t *testing.T) {
 		// setup mocks.
 		next := func() *handlers.MockHandler {
 			h := &handlers.MockHandler{}
-			h.On("ListDatabaseClusters", mock.Anything, mock.Anything).Return(
+			h.On("ListDatabaseClusters", mock.Anything, mock.Anything, mock.Anything).Return(
 				&everestv1alpha1.DatabaseClusterList{
 					Items: []everestv1alpha1.DatabaseCluster{
 						{
@@ -1442,7 +1442,7 @@ func TestRBAC_DatabaseCluster(t *testing.T) {
 					log:        zap.NewNop().Sugar(),
 					userGetter: testUserGetter,
 				}
-				res, err := h.ListDatabaseClusters(ctx, "default")
+				res, err := h.ListDatabaseClusters(ctx, "default", nil)
 				assert.NoError(t, err)
 				assert.Condition(t, func() bool {
 					return tc.assert(res)
@@ -1451,6 +1451,59 @@ func TestRBAC_DatabaseCluster(t *testing.T) {
 		}
 	})
 
+	t.Run("ListDatabaseClusters - pagination", func(t *testing.T) {
+		t.Parallel()
+
+		newDB := func(name string) everestv1alpha1.DatabaseCluster {
+			return everestv1alpha1.DatabaseCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+				Spec: everestv1alpha1.DatabaseClusterSpec{
+					Engine: everestv1alpha1.Engine{Type: everestv1alpha1.DatabaseEnginePXC},
+				},
+			}
+		}
+		next := &handlers.MockHandler{}
+		// The whole list is requested, so that the page is taken from the readable clusters.
+		next.On("ListDatabaseClusters", mock.Anything, "default", &api.ListDatabaseClustersParams{}).Return(
+			&everestv1alpha1.DatabaseClusterList{
+				Items: []everestv1alpha1.DatabaseCluster{
+					newDB("db-a"), newDB("db-b"), newDB("db-c"), newDB("db-d"), newDB("db-e"),
+				},
+			}, nil,
+		)
+		ctx := context.WithValue(context.Background(), common.UserCtxKey, rbac.User{Subject: "bob"})
+		enf, err := rbac.NewEnforcer(ctx, newConfigMapMock(newPolicy(
+			"p, role:test, database-engines, read, default/*",
+			"p, role:test, database-clusters, read, default/db-c",
+			"p, role:test, database-clusters, read, default/db-d",
+			"p, role:test, database-clusters, read, default/db-e",
+			"g, bob, role:test",
+		)), zap.NewNop().Sugar())
+		require.NoError(t, err)
+		h := &rbacHandler{
+			next:       next,
+			enforcer:   enf,
+			log:        zap.NewNop().Sugar(),
+			userGetter: testUserGetter,
+		}
+
+		// The clusters of the first page the user cannot read do not shorten it.
+		params := &api.ListDatabaseClustersParams{Limit: pointer.ToInt64(2)}
+		res, err := h.ListDatabaseClusters(ctx, "default", params)
+		require.NoError(t, err)
+		require.Len(t, res.Items, 2)
+		assert.Equal(t, "db-c", res.Items[0].GetName())
+		assert.Equal(t, "db-d", res.Items[1].GetName())
+		require.NotEmpty(t, res.Continue)
+
+		params.Continue = pointer.ToString(res.Continue)
+		res, err = h.ListDatabaseClusters(ctx, "default", params)
+		require.NoError(t, err)
+		require.Len(t, res.Items, 1)
+		assert.Equal(t, "db-e", res.Items[0].GetName())
+		assert.Empty(t, res.Continue)
+	})
+
 	t.Run("DeleteDatabaseCluster", func(t *testing.T) {
 		testCases := []struct {
 			desc    string
@@ -1568,6 +1621,8 @@ func TestRBAC_DatabaseCluster(t *testing.T) {
 
 		next := func() *handlers.MockHandler {
 			h := &handlers.MockHandler{}
+			h.On("GetDatabaseCluster", mock.Anything, mock.Anything, mock.Anything).Return(
+				&everestv1alpha1.DatabaseCluster{}, nil)
 			h.On("GetDatabaseClusterCredentials", mock.Anything, mock.Anything, mock.Anything).Return(
 				&api.DatabaseClusterCredential{}, nil)
 			return h
@@ -1623,6 +1678,8 @@ func TestRBAC_DatabaseCluster(t *testing.T) {
 
 		next := func() *handlers.MockHandler {
 			h := &handlers.MockHandler{}
+			h.On("GetDatabaseCluster", mock.Anything, mock.Anything, mock.Anything).Return(
+				&everestv1alpha1.DatabaseCluster{}, nil)
 			h.On("GetDatabaseClusterComponents", mock.Anything, mock.Anything, mock.Anything).Return(
 				[]api.DatabaseClusterComponent{}, nil)
 			return h
@@ -1678,6 +1735,8 @@ func TestRBAC_DatabaseCluster(t *testing.T) {
 
 		next := func() *handlers.MockHandler {
 			h := &handlers.MockHandler{}
+			h.On("GetDatabaseCluster", mock.Anything, mock.Anything, mock.Anything).Return(
+				&everestv1alpha1.DatabaseCluster{}, nil)
 			h.On("GetDatabaseClusterPitr", mock.Anything, mock.Anything, mock.Anything).Return(
 				&api.DatabaseClusterPitr{}, nil)
 			return h
@@ -1702,6 +1761,56 @@ func TestRBAC_DatabaseCluster(t *testing.T) {
 		}
 	})
 
+	t.Run("label-based policies", func(t *testing.T) {
+		testCases := []struct {
+			desc    string
+			labels  map[string]string
+			wantErr error
+		}{
+			{
+				desc:   "matching label",
+				labels: map[string]string{"team": "a"},
+			},
+			{
+				desc:    "other label value",
+				labels:  map[string]string{"team": "b"},
+				wantErr: ErrInsufficientPermissions,
+			},
+			{
+				desc:    "no labels",
+				wantErr: ErrInsufficientPermissions,
+			},
+		}
+
+		policy := newPolicy(
+			"p, role:test, database-clusters, read, default/label:team=a",
+			"g, bob, role:test",
+		)
+		ctx := context.WithValue(context.Background(), common.UserCtxKey, rbac.User{Subject: "bob"})
+		for _, tc := range testCases {
+			t.Run(tc.desc, func(t *testing.T) {
+				t.Parallel()
+				k8sMock := newConfigMapMock(policy)
+				enf, err := rbac.NewEnforcer(ctx, k8sMock, zap.NewNop().Sugar())
+				require.NoError(t, err)
+
+				next := &handlers.MockHandler{}
+				next.On("GetDatabaseCluster", mock.Anything, mock.Anything, mock.Anything).Return(
+					&everestv1alpha1.DatabaseCluster{ObjectMeta: metav1.ObjectMeta{Labels: tc.labels}}, nil)
+				next.On("GetDatabaseClusterComponents", mock.Anything, mock.Anything, mock.Anything).Return(
+					[]api.DatabaseClusterComponent{}, nil)
+				h := &rbacHandler{
+					next:       next,
+					enforcer:   enf,
+					log:        zap.NewNop().Sugar(),
+					userGetter: testUserGetter,
+				}
+				_, err = h.GetDatabaseClusterComponents(ctx, "default", "test-cluster")
+				assert.ErrorIs(t, err, tc.wantErr)
+			})
+		}
+	})
+
 	t.Run("CreateDatabaseClusterSecret", func(t *testing.T) {
 		testCases := []struct {
 			desc    string
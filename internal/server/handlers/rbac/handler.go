@@ -11,6 +11,7 @@ import (
 
 	"github.com/percona/everest/internal/server/handlers"
 	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/logger"
 	"github.com/percona/everest/pkg/rbac"
 )
 
@@ -54,6 +55,31 @@ func (h *rbacHandler) enforce(
 	resource,
 	action,
 	object string,
+) error {
+	return h.enforceAny(ctx, resource, action, []string{object})
+}
+
+// enforceLabeled is like enforce, but the permission may also be granted by a policy
+// that matches one of the user-managed labels of the object, see rbac.LabelObjectNames.
+func (h *rbacHandler) enforceLabeled(
+	ctx context.Context,
+	resource,
+	action,
+	namespace,
+	name string,
+	labels map[string]string,
+) error {
+	objects := append([]string{rbac.ObjectName(namespace, name)}, rbac.LabelObjectNames(namespace, labels)...)
+	return h.enforceAny(ctx, resource, action, objects)
+}
+
+// enforceAny checks that the user has the permission for any of the objects.
+// The first object is the one reported when the permission is denied.
+func (h *rbacHandler) enforceAny(
+	ctx context.Context,
+	resource,
+	action string,
+	objects []string,
 ) error {
 	user, err := h.userGetter(ctx)
 	if err != nil {
@@ -63,15 +89,17 @@ func (h *rbacHandler) enforce(
 	// User is allowed to perform the operation if the user's subject or any
 	// of its groups have the required permission.
 	for _, sub := range append([]string{user.Subject}, user.Groups...) {
-		ok, err := h.enforcer.Enforce(sub, resource, action, object)
-		if err != nil {
-			return fmt.Errorf("enforce error: %w", err)
-		}
-		if ok {
-			return nil
+		for _, object := range objects {
+			ok, err := h.enforcer.Enforce(sub, resource, action, object)
+			if err != nil {
+				return fmt.Errorf("enforce error: %w", err)
+			}
+			if ok {
+				return nil
+			}
 		}
 	}
 
-	h.log.Warnf("Permission denied: [%s %s %s %s]", user.Subject, resource, action, object)
+	logger.FromContext(ctx, h.log).Warnf("Permission denied: [%s %s %s %s]", user.Subject, resource, action, objects[0])
 	return ErrInsufficientPermissions
 }
@@ -5,19 +5,27 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/AlekSi/pointer"
+
 	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
 	"github.com/percona/everest/api"
 	"github.com/percona/everest/pkg/rbac"
 )
 
-func (h *rbacHandler) ListMonitoringInstances(ctx context.Context, namespace string) (*everestv1alpha1.MonitoringConfigList, error) {
-	list, err := h.next.ListMonitoringInstances(ctx, namespace)
+func (h *rbacHandler) ListMonitoringInstances(
+	ctx context.Context,
+	namespace string,
+	params *api.ListMonitoringInstancesParams,
+) (*everestv1alpha1.MonitoringConfigList, error) {
+	list, err := h.next.ListMonitoringInstances(ctx, namespace, params)
 	if err != nil {
 		return nil, fmt.Errorf("ListMonitoringInstances failed: %w", err)
 	}
 	filtered := []everestv1alpha1.MonitoringConfig{}
 	for _, mon := range list.Items {
-		if err := h.enforce(ctx, rbac.ResourceMonitoringInstances, rbac.ActionRead, rbac.ObjectName(namespace, mon.GetName())); errors.Is(err, ErrInsufficientPermissions) {
+		if err := h.enforceLabeled(ctx, rbac.ResourceMonitoringInstances, rbac.ActionRead,
+			namespace, mon.GetName(), mon.GetLabels(),
+		); errors.Is(err, ErrInsufficientPermissions) {
 			continue
 		} else if err != nil {
 			return nil, fmt.Errorf("enforce failed: %w", err)
@@ -29,29 +37,47 @@ func (h *rbacHandler) ListMonitoringInstances(ctx context.Context, namespace str
 }
 
 func (h *rbacHandler) CreateMonitoringInstance(ctx context.Context, namespace string, req *api.CreateMonitoringInstanceJSONRequestBody) (*everestv1alpha1.MonitoringConfig, error) {
-	if err := h.enforce(ctx, rbac.ResourceMonitoringInstances, rbac.ActionCreate, rbac.ObjectName(namespace, req.Name)); err != nil {
+	if err := h.enforceLabeled(ctx, rbac.ResourceMonitoringInstances, rbac.ActionCreate, namespace, req.Name, pointer.Get(req.Labels)); err != nil {
 		return nil, err
 	}
 	return h.next.CreateMonitoringInstance(ctx, namespace, req)
 }
 
 func (h *rbacHandler) DeleteMonitoringInstance(ctx context.Context, namespace, name string) error {
-	if err := h.enforce(ctx, rbac.ResourceMonitoringInstances, rbac.ActionDelete, rbac.ObjectName(namespace, name)); err != nil {
+	m, err := h.next.GetMonitoringInstance(ctx, namespace, name)
+	if err != nil {
+		return err
+	}
+	if err := h.enforceLabeled(ctx, rbac.ResourceMonitoringInstances, rbac.ActionDelete, namespace, name, m.GetLabels()); err != nil {
 		return err
 	}
 	return h.next.DeleteMonitoringInstance(ctx, namespace, name)
 }
 
 func (h *rbacHandler) GetMonitoringInstance(ctx context.Context, namespace, name string) (*everestv1alpha1.MonitoringConfig, error) {
-	if err := h.enforce(ctx, rbac.ResourceMonitoringInstances, rbac.ActionRead, rbac.ObjectName(namespace, name)); err != nil {
+	m, err := h.next.GetMonitoringInstance(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.enforceLabeled(ctx, rbac.ResourceMonitoringInstances, rbac.ActionRead, namespace, name, m.GetLabels()); err != nil {
 		return nil, err
 	}
-	return h.next.GetMonitoringInstance(ctx, namespace, name)
+	return m, nil
 }
 
 func (h *rbacHandler) UpdateMonitoringInstance(ctx context.Context, namespace, name string, req *api.UpdateMonitoringInstanceJSONRequestBody) (*everestv1alpha1.MonitoringConfig, error) {
-	if err := h.enforce(ctx, rbac.ResourceMonitoringInstances, rbac.ActionUpdate, rbac.ObjectName(namespace, name)); err != nil {
+	m, err := h.next.GetMonitoringInstance(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	// The user needs permissions to update the monitoring instance both with its current and with its new labels.
+	if err := h.enforceLabeled(ctx, rbac.ResourceMonitoringInstances, rbac.ActionUpdate, namespace, name, m.GetLabels()); err != nil {
 		return nil, err
 	}
+	if req.Labels != nil {
+		if err := h.enforceLabeled(ctx, rbac.ResourceMonitoringInstances, rbac.ActionUpdate, namespace, name, *req.Labels); err != nil {
+			return nil, err
+		}
+	}
 	return h.next.UpdateMonitoringInstance(ctx, namespace, name, req)
 }
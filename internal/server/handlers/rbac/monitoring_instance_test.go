@@ -26,6 +26,7 @@ func TestRBAC_MonitoringInstance(t *testing.T) {
 		next.On("ListMonitoringInstances",
 			mock.Anything,
 			mock.Anything,
+			mock.Anything,
 		).Return(
 			&everestv1alpha1.MonitoringConfigList{
 				Items: []everestv1alpha1.MonitoringConfig{
@@ -175,7 +176,7 @@ func TestRBAC_MonitoringInstance(t *testing.T) {
 					userGetter: testUserGetter,
 				}
 
-				list, err := h.ListMonitoringInstances(ctx, "default")
+				list, err := h.ListMonitoringInstances(ctx, "default", nil)
 				require.NoError(t, err)
 				assert.Condition(t, func() bool {
 					return tc.assert(list)
@@ -439,6 +440,9 @@ func TestRBAC_MonitoringInstance(t *testing.T) {
 		t.Parallel()
 		next := func() *handlers.MockHandler {
 			next := handlers.MockHandler{}
+			next.On("GetMonitoringInstance", mock.Anything, mock.Anything, mock.Anything).Return(
+				&everestv1alpha1.MonitoringConfig{}, nil,
+			)
 			next.On("UpdateMonitoringInstance", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
 				&everestv1alpha1.MonitoringConfig{}, nil,
 			)
@@ -605,6 +609,9 @@ func TestRBAC_MonitoringInstance(t *testing.T) {
 		t.Parallel()
 		next := func() *handlers.MockHandler {
 			next := handlers.MockHandler{}
+			next.On("GetMonitoringInstance", mock.Anything, mock.Anything, mock.Anything).Return(
+				&everestv1alpha1.MonitoringConfig{}, nil,
+			)
 			next.On("DeleteMonitoringInstance", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 			return &next
 		}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/percona/everest/api"
 	"github.com/percona/everest/pkg/rbac"
 )
 
@@ -24,3 +25,10 @@ func (h *rbacHandler) ListNamespaces(ctx context.Context) ([]string, error) {
 	}
 	return result, nil
 }
+
+func (h *rbacHandler) GetNamespaceQuota(ctx context.Context, namespace string) (*api.NamespaceQuotaUsage, error) {
+	if err := h.enforce(ctx, rbac.ResourceNamespaces, rbac.ActionRead, namespace); err != nil {
+		return nil, err
+	}
+	return h.next.GetNamespaceQuota(ctx, namespace)
+}
@@ -0,0 +1,46 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+// CreateOperation does not check permissions, since operations are only
+// created for actions that the user has already been allowed to perform.
+func (h *rbacHandler) CreateOperation(
+	ctx context.Context,
+	namespace string,
+	opType api.OperationType,
+	target string,
+) (*api.Operation, error) {
+	return h.next.CreateOperation(ctx, namespace, opType, target)
+}
+
+func (h *rbacHandler) ListOperations(ctx context.Context, namespace string) (*api.OperationList, error) {
+	list, err := h.next.ListOperations(ctx, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("ListOperations failed: %w", err)
+	}
+	result := make([]api.Operation, 0, len(list.Items))
+	for _, op := range list.Items {
+		if err := h.enforce(ctx, rbac.ResourceOperations, rbac.ActionRead, rbac.ObjectName(namespace, op.Name)); errors.Is(err, ErrInsufficientPermissions) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("enforce failed: %w", err)
+		}
+		result = append(result, op)
+	}
+	list.Items = result
+	return list, nil
+}
+
+func (h *rbacHandler) GetOperation(ctx context.Context, namespace, name string) (*api.Operation, error) {
+	if err := h.enforce(ctx, rbac.ResourceOperations, rbac.ActionRead, rbac.ObjectName(namespace, name)); err != nil {
+		return nil, err
+	}
+	return h.next.GetOperation(ctx, namespace, name)
+}
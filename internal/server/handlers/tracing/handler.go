@@ -0,0 +1,602 @@
+// Package tracing provides the tracing handler.
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+	corev1 "k8s.io/api/core/v1"
+
+	enginefeaturesv1alpha1 "github.com/percona/everest-operator/api/enginefeatures.everest/v1alpha1"
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/internal/server/handlers"
+	"github.com/percona/everest/pkg/tracing"
+)
+
+const (
+	handlerAttr         = attribute.Key("everest.handler")
+	resourceNameAttr    = attribute.Key("k8s.resource.name")
+	databaseClusterAttr = attribute.Key("everest.database_cluster.name")
+)
+
+// tracingHandler records a span for each call to the handler it wraps.
+// Since the wrapped handler calls the next handler itself, each link of
+// the chain needs to be wrapped for its own spans to be recorded.
+type tracingHandler struct {
+	name    string
+	handler handlers.Handler
+	tracer  trace.Tracer
+}
+
+// New returns a handler that records the calls to the given handler as spans
+// named after the handler and the method, e.g. "rbac.GetDatabaseCluster".
+//
+//nolint:ireturn
+func New(name string, handler handlers.Handler, tp trace.TracerProvider) handlers.Handler {
+	return &tracingHandler{
+		name:    name,
+		handler: handler,
+		tracer:  tp.Tracer(tracing.InstrumentationName),
+	}
+}
+
+// SetNext sets the next handler to call in the chain.
+func (h *tracingHandler) SetNext(next handlers.Handler) {
+	h.handler.SetNext(next)
+}
+
+func (h *tracingHandler) start(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return h.tracer.Start(ctx, h.name+"."+method,
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(append(attrs, handlerAttr.String(h.name))...),
+	)
+}
+
+func (h *tracingHandler) ListNamespaces(ctx context.Context) ([]string, error) {
+	ctx, span := h.start(ctx, "ListNamespaces")
+	result, err := h.handler.ListNamespaces(ctx)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) GetNamespaceQuota(ctx context.Context, namespace string) (*api.NamespaceQuotaUsage, error) {
+	ctx, span := h.start(ctx, "GetNamespaceQuota", semconv.K8SNamespaceName(namespace))
+	result, err := h.handler.GetNamespaceQuota(ctx, namespace)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) CreateDatabaseCluster(ctx context.Context, req *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error) {
+	ctx, span := h.start(ctx, "CreateDatabaseCluster", semconv.K8SNamespaceName(req.GetNamespace()), resourceNameAttr.String(req.GetName()))
+	result, err := h.handler.CreateDatabaseCluster(ctx, req)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) UpdateDatabaseCluster(ctx context.Context, req *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error) {
+	ctx, span := h.start(ctx, "UpdateDatabaseCluster", semconv.K8SNamespaceName(req.GetNamespace()), resourceNameAttr.String(req.GetName()))
+	result, err := h.handler.UpdateDatabaseCluster(ctx, req)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) ListDatabaseClusters(ctx context.Context, namespace string, params *api.ListDatabaseClustersParams) (*everestv1alpha1.DatabaseClusterList, error) {
+	ctx, span := h.start(ctx, "ListDatabaseClusters", semconv.K8SNamespaceName(namespace))
+	result, err := h.handler.ListDatabaseClusters(ctx, namespace, params)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) DeleteDatabaseCluster(ctx context.Context, namespace, name string, delReq *api.DeleteDatabaseClusterParams) error {
+	ctx, span := h.start(ctx, "DeleteDatabaseCluster", semconv.K8SNamespaceName(namespace), resourceNameAttr.String(name))
+	err := h.handler.DeleteDatabaseCluster(ctx, namespace, name, delReq)
+	tracing.End(span, err)
+	return err
+}
+
+func (h *tracingHandler) GetDatabaseCluster(ctx context.Context, namespace, name string) (*everestv1alpha1.DatabaseCluster, error) {
+	ctx, span := h.start(ctx, "GetDatabaseCluster", semconv.K8SNamespaceName(namespace), resourceNameAttr.String(name))
+	result, err := h.handler.GetDatabaseCluster(ctx, namespace, name)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) GetDatabaseClusterCredentials(ctx context.Context, namespace, name string) (*api.DatabaseClusterCredential, error) {
+	ctx, span := h.start(ctx, "GetDatabaseClusterCredentials", semconv.K8SNamespaceName(namespace), resourceNameAttr.String(name))
+	result, err := h.handler.GetDatabaseClusterCredentials(ctx, namespace, name)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) GetDatabaseClusterComponents(ctx context.Context, namespace, name string) ([]api.DatabaseClusterComponent, error) {
+	ctx, span := h.start(ctx, "GetDatabaseClusterComponents", semconv.K8SNamespaceName(namespace), resourceNameAttr.String(name))
+	result, err := h.handler.GetDatabaseClusterComponents(ctx, namespace, name)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) GetDatabaseClusterComponentLogs(ctx context.Context, namespace, clusterName, componentName string, params api.GetDatabaseClusterComponentLogsParams, stream handlers.StreamFunc) error {
+	ctx, span := h.start(ctx, "GetDatabaseClusterComponentLogs", semconv.K8SNamespaceName(namespace), databaseClusterAttr.String(clusterName))
+	err := h.handler.GetDatabaseClusterComponentLogs(ctx, namespace, clusterName, componentName, params, stream)
+	tracing.End(span, err)
+	return err
+}
+
+func (h *tracingHandler) GetDatabaseClusterLogs(ctx context.Context, namespace, name string, params api.GetDatabaseClusterLogsParams, stream handlers.LogsStreamFunc) error {
+	ctx, span := h.start(ctx, "GetDatabaseClusterLogs", semconv.K8SNamespaceName(namespace), databaseClusterAttr.String(name))
+	err := h.handler.GetDatabaseClusterLogs(ctx, namespace, name, params, stream)
+	tracing.End(span, err)
+	return err
+}
+
+func (h *tracingHandler) GetDatabaseClusterEvents(ctx context.Context, namespace, name string, params *api.GetDatabaseClusterEventsParams) (*api.DatabaseClusterEventList, error) {
+	ctx, span := h.start(ctx, "GetDatabaseClusterEvents", semconv.K8SNamespaceName(namespace), resourceNameAttr.String(name))
+	result, err := h.handler.GetDatabaseClusterEvents(ctx, namespace, name, params)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) GetDatabaseClusterDiagnostics(ctx context.Context, namespace, name string, params *api.GetDatabaseClusterDiagnosticsParams) (*api.DatabaseClusterDiagnostics, error) {
+	ctx, span := h.start(ctx, "GetDatabaseClusterDiagnostics", semconv.K8SNamespaceName(namespace), resourceNameAttr.String(name))
+	result, err := h.handler.GetDatabaseClusterDiagnostics(ctx, namespace, name, params)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) GetDatabaseClusterPitr(ctx context.Context, namespace, name string) (*api.DatabaseClusterPitr, error) {
+	ctx, span := h.start(ctx, "GetDatabaseClusterPitr", semconv.K8SNamespaceName(namespace), resourceNameAttr.String(name))
+	result, err := h.handler.GetDatabaseClusterPitr(ctx, namespace, name)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) CreateDatabaseClusterSecret(ctx context.Context, namespace, dbName string, secret *corev1.Secret) (*corev1.Secret, error) {
+	ctx, span := h.start(ctx, "CreateDatabaseClusterSecret", semconv.K8SNamespaceName(namespace), databaseClusterAttr.String(dbName))
+	result, err := h.handler.CreateDatabaseClusterSecret(ctx, namespace, dbName, secret)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) ListDeletedDatabaseClusters(ctx context.Context, namespace string) (*everestv1alpha1.DatabaseClusterList, error) {
+	ctx, span := h.start(ctx, "ListDeletedDatabaseClusters", semconv.K8SNamespaceName(namespace))
+	result, err := h.handler.ListDeletedDatabaseClusters(ctx, namespace)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) RestoreDatabaseCluster(ctx context.Context, namespace, name string) (*everestv1alpha1.DatabaseCluster, error) {
+	ctx, span := h.start(ctx, "RestoreDatabaseCluster", semconv.K8SNamespaceName(namespace), resourceNameAttr.String(name))
+	result, err := h.handler.RestoreDatabaseCluster(ctx, namespace, name)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) GetDeletedDatabaseCluster(ctx context.Context, namespace, name string) (*everestv1alpha1.DatabaseCluster, error) {
+	ctx, span := h.start(ctx, "GetDeletedDatabaseCluster", semconv.K8SNamespaceName(namespace), resourceNameAttr.String(name))
+	result, err := h.handler.GetDeletedDatabaseCluster(ctx, namespace, name)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) PurgeDatabaseCluster(ctx context.Context, namespace, name string) error {
+	ctx, span := h.start(ctx, "PurgeDatabaseCluster", semconv.K8SNamespaceName(namespace), resourceNameAttr.String(name))
+	err := h.handler.PurgeDatabaseCluster(ctx, namespace, name)
+	tracing.End(span, err)
+	return err
+}
+
+func (h *tracingHandler) UpdateDatabaseClusterDeletionProtection(ctx context.Context, namespace, name string, enabled bool) (*everestv1alpha1.DatabaseCluster, error) {
+	ctx, span := h.start(ctx, "UpdateDatabaseClusterDeletionProtection", semconv.K8SNamespaceName(namespace), resourceNameAttr.String(name))
+	result, err := h.handler.UpdateDatabaseClusterDeletionProtection(ctx, namespace, name, enabled)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) GetDatabaseClusterBackup(ctx context.Context, namespace, name string) (*everestv1alpha1.DatabaseClusterBackup, error) {
+	ctx, span := h.start(ctx, "GetDatabaseClusterBackup", semconv.K8SNamespaceName(namespace), resourceNameAttr.String(name))
+	result, err := h.handler.GetDatabaseClusterBackup(ctx, namespace, name)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) ListDatabaseClusterBackups(ctx context.Context, namespace, clusterName string, params *api.ListDatabaseClusterBackupsParams) (*everestv1alpha1.DatabaseClusterBackupList, error) {
+	ctx, span := h.start(ctx, "ListDatabaseClusterBackups", semconv.K8SNamespaceName(namespace), databaseClusterAttr.String(clusterName))
+	result, err := h.handler.ListDatabaseClusterBackups(ctx, namespace, clusterName, params)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) CreateDatabaseClusterBackup(ctx context.Context, req *everestv1alpha1.DatabaseClusterBackup) (*everestv1alpha1.DatabaseClusterBackup, error) {
+	ctx, span := h.start(ctx, "CreateDatabaseClusterBackup", semconv.K8SNamespaceName(req.GetNamespace()), resourceNameAttr.String(req.GetName()))
+	result, err := h.handler.CreateDatabaseClusterBackup(ctx, req)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) DeleteDatabaseClusterBackup(ctx context.Context, namespace, name string, req *api.DeleteDatabaseClusterBackupParams) error {
+	ctx, span := h.start(ctx, "DeleteDatabaseClusterBackup", semconv.K8SNamespaceName(namespace), resourceNameAttr.String(name))
+	err := h.handler.DeleteDatabaseClusterBackup(ctx, namespace, name, req)
+	tracing.End(span, err)
+	return err
+}
+
+func (h *tracingHandler) CreateDatabaseClusterRestore(ctx context.Context, req *everestv1alpha1.DatabaseClusterRestore) (*everestv1alpha1.DatabaseClusterRestore, error) {
+	ctx, span := h.start(ctx, "CreateDatabaseClusterRestore", semconv.K8SNamespaceName(req.GetNamespace()), resourceNameAttr.String(req.GetName()))
+	result, err := h.handler.CreateDatabaseClusterRestore(ctx, req)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) UpdateDatabaseClusterRestore(ctx context.Context, req *everestv1alpha1.DatabaseClusterRestore) (*everestv1alpha1.DatabaseClusterRestore, error) {
+	ctx, span := h.start(ctx, "UpdateDatabaseClusterRestore", semconv.K8SNamespaceName(req.GetNamespace()), resourceNameAttr.String(req.GetName()))
+	result, err := h.handler.UpdateDatabaseClusterRestore(ctx, req)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) GetDatabaseClusterRestore(ctx context.Context, namespace, name string) (*everestv1alpha1.DatabaseClusterRestore, error) {
+	ctx, span := h.start(ctx, "GetDatabaseClusterRestore", semconv.K8SNamespaceName(namespace), resourceNameAttr.String(name))
+	result, err := h.handler.GetDatabaseClusterRestore(ctx, namespace, name)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) ListDatabaseClusterRestores(ctx context.Context, namespace, clusterName string, params *api.ListDatabaseClusterRestoresParams) (*everestv1alpha1.DatabaseClusterRestoreList, error) {
+	ctx, span := h.start(ctx, "ListDatabaseClusterRestores", semconv.K8SNamespaceName(namespace), databaseClusterAttr.String(clusterName))
+	result, err := h.handler.ListDatabaseClusterRestores(ctx, namespace, clusterName, params)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) DeleteDatabaseClusterRestore(ctx context.Context, namespace, name string) error {
+	ctx, span := h.start(ctx, "DeleteDatabaseClusterRestore", semconv.K8SNamespaceName(namespace), resourceNameAttr.String(name))
+	err := h.handler.DeleteDatabaseClusterRestore(ctx, namespace, name)
+	tracing.End(span, err)
+	return err
+}
+
+func (h *tracingHandler) UpdateDatabaseEngine(ctx context.Context, req *everestv1alpha1.DatabaseEngine) (*everestv1alpha1.DatabaseEngine, error) {
+	ctx, span := h.start(ctx, "UpdateDatabaseEngine", semconv.K8SNamespaceName(req.GetNamespace()), resourceNameAttr.String(req.GetName()))
+	result, err := h.handler.UpdateDatabaseEngine(ctx, req)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) ListDatabaseEngines(ctx context.Context, namespace string) (*everestv1alpha1.DatabaseEngineList, error) {
+	ctx, span := h.start(ctx, "ListDatabaseEngines", semconv.K8SNamespaceName(namespace))
+	result, err := h.handler.ListDatabaseEngines(ctx, namespace)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) GetDatabaseEngine(ctx context.Context, namespace, name string) (*everestv1alpha1.DatabaseEngine, error) {
+	ctx, span := h.start(ctx, "GetDatabaseEngine", semconv.K8SNamespaceName(namespace), resourceNameAttr.String(name))
+	result, err := h.handler.GetDatabaseEngine(ctx, namespace, name)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) GetUpgradePlan(ctx context.Context, namespace string) (*api.UpgradePlan, error) {
+	ctx, span := h.start(ctx, "GetUpgradePlan", semconv.K8SNamespaceName(namespace))
+	result, err := h.handler.GetUpgradePlan(ctx, namespace)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) ApproveUpgradePlan(ctx context.Context, namespace string) error {
+	ctx, span := h.start(ctx, "ApproveUpgradePlan", semconv.K8SNamespaceName(namespace))
+	err := h.handler.ApproveUpgradePlan(ctx, namespace)
+	tracing.End(span, err)
+	return err
+}
+
+func (h *tracingHandler) CreateBackupStorage(ctx context.Context, namespace string, req *api.CreateBackupStorageParams) (*everestv1alpha1.BackupStorage, error) {
+	ctx, span := h.start(ctx, "CreateBackupStorage", semconv.K8SNamespaceName(namespace))
+	result, err := h.handler.CreateBackupStorage(ctx, namespace, req)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) UpdateBackupStorage(ctx context.Context, name, namespace string, req *api.UpdateBackupStorageParams) (*everestv1alpha1.BackupStorage, error) {
+	ctx, span := h.start(ctx, "UpdateBackupStorage", resourceNameAttr.String(name), semconv.K8SNamespaceName(namespace))
+	result, err := h.handler.UpdateBackupStorage(ctx, name, namespace, req)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) ListBackupStorages(ctx context.Context, namespace string, params *api.ListBackupStoragesParams) (*everestv1alpha1.BackupStorageList, error) {
+	ctx, span := h.start(ctx, "ListBackupStorages", semconv.K8SNamespaceName(namespace))
+	result, err := h.handler.ListBackupStorages(ctx, namespace, params)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) GetBackupStorage(ctx context.Context, namespace, name string) (*everestv1alpha1.BackupStorage, error) {
+	ctx, span := h.start(ctx, "GetBackupStorage", semconv.K8SNamespaceName(namespace), resourceNameAttr.String(name))
+	result, err := h.handler.GetBackupStorage(ctx, namespace, name)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) DeleteBackupStorage(ctx context.Context, namespace, name string) error {
+	ctx, span := h.start(ctx, "DeleteBackupStorage", semconv.K8SNamespaceName(namespace), resourceNameAttr.String(name))
+	err := h.handler.DeleteBackupStorage(ctx, namespace, name)
+	tracing.End(span, err)
+	return err
+}
+
+func (h *tracingHandler) CreateMonitoringInstance(ctx context.Context, namespace string, req *api.CreateMonitoringInstanceJSONRequestBody) (*everestv1alpha1.MonitoringConfig, error) {
+	ctx, span := h.start(ctx, "CreateMonitoringInstance", semconv.K8SNamespaceName(namespace))
+	result, err := h.handler.CreateMonitoringInstance(ctx, namespace, req)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) UpdateMonitoringInstance(ctx context.Context, namespace, name string, req *api.UpdateMonitoringInstanceJSONRequestBody) (*everestv1alpha1.MonitoringConfig, error) {
+	ctx, span := h.start(ctx, "UpdateMonitoringInstance", semconv.K8SNamespaceName(namespace), resourceNameAttr.String(name))
+	result, err := h.handler.UpdateMonitoringInstance(ctx, namespace, name, req)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) ListMonitoringInstances(ctx context.Context, namespaces string, params *api.ListMonitoringInstancesParams) (*everestv1alpha1.MonitoringConfigList, error) {
+	ctx, span := h.start(ctx, "ListMonitoringInstances", semconv.K8SNamespaceName(namespaces))
+	result, err := h.handler.ListMonitoringInstances(ctx, namespaces, params)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) GetMonitoringInstance(ctx context.Context, namespace, name string) (*everestv1alpha1.MonitoringConfig, error) {
+	ctx, span := h.start(ctx, "GetMonitoringInstance", semconv.K8SNamespaceName(namespace), resourceNameAttr.String(name))
+	result, err := h.handler.GetMonitoringInstance(ctx, namespace, name)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) DeleteMonitoringInstance(ctx context.Context, namespace, name string) error {
+	ctx, span := h.start(ctx, "DeleteMonitoringInstance", semconv.K8SNamespaceName(namespace), resourceNameAttr.String(name))
+	err := h.handler.DeleteMonitoringInstance(ctx, namespace, name)
+	tracing.End(span, err)
+	return err
+}
+
+func (h *tracingHandler) CreatePodSchedulingPolicy(ctx context.Context, psp *everestv1alpha1.PodSchedulingPolicy) (*everestv1alpha1.PodSchedulingPolicy, error) {
+	ctx, span := h.start(ctx, "CreatePodSchedulingPolicy", semconv.K8SNamespaceName(psp.GetNamespace()), resourceNameAttr.String(psp.GetName()))
+	result, err := h.handler.CreatePodSchedulingPolicy(ctx, psp)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) UpdatePodSchedulingPolicy(ctx context.Context, psp *everestv1alpha1.PodSchedulingPolicy) (*everestv1alpha1.PodSchedulingPolicy, error) {
+	ctx, span := h.start(ctx, "UpdatePodSchedulingPolicy", semconv.K8SNamespaceName(psp.GetNamespace()), resourceNameAttr.String(psp.GetName()))
+	result, err := h.handler.UpdatePodSchedulingPolicy(ctx, psp)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) ListPodSchedulingPolicies(ctx context.Context, params *api.ListPodSchedulingPolicyParams) (*everestv1alpha1.PodSchedulingPolicyList, error) {
+	ctx, span := h.start(ctx, "ListPodSchedulingPolicies")
+	result, err := h.handler.ListPodSchedulingPolicies(ctx, params)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) DeletePodSchedulingPolicy(ctx context.Context, name string) error {
+	ctx, span := h.start(ctx, "DeletePodSchedulingPolicy", resourceNameAttr.String(name))
+	err := h.handler.DeletePodSchedulingPolicy(ctx, name)
+	tracing.End(span, err)
+	return err
+}
+
+func (h *tracingHandler) GetPodSchedulingPolicy(ctx context.Context, name string) (*everestv1alpha1.PodSchedulingPolicy, error) {
+	ctx, span := h.start(ctx, "GetPodSchedulingPolicy", resourceNameAttr.String(name))
+	result, err := h.handler.GetPodSchedulingPolicy(ctx, name)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) CreateLoadBalancerConfig(ctx context.Context, psp *everestv1alpha1.LoadBalancerConfig) (*everestv1alpha1.LoadBalancerConfig, error) {
+	ctx, span := h.start(ctx, "CreateLoadBalancerConfig", semconv.K8SNamespaceName(psp.GetNamespace()), resourceNameAttr.String(psp.GetName()))
+	result, err := h.handler.CreateLoadBalancerConfig(ctx, psp)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) UpdateLoadBalancerConfig(ctx context.Context, psp *everestv1alpha1.LoadBalancerConfig) (*everestv1alpha1.LoadBalancerConfig, error) {
+	ctx, span := h.start(ctx, "UpdateLoadBalancerConfig", semconv.K8SNamespaceName(psp.GetNamespace()), resourceNameAttr.String(psp.GetName()))
+	result, err := h.handler.UpdateLoadBalancerConfig(ctx, psp)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) ListLoadBalancerConfigs(ctx context.Context) (*everestv1alpha1.LoadBalancerConfigList, error) {
+	ctx, span := h.start(ctx, "ListLoadBalancerConfigs")
+	result, err := h.handler.ListLoadBalancerConfigs(ctx)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) DeleteLoadBalancerConfig(ctx context.Context, name string) error {
+	ctx, span := h.start(ctx, "DeleteLoadBalancerConfig", resourceNameAttr.String(name))
+	err := h.handler.DeleteLoadBalancerConfig(ctx, name)
+	tracing.End(span, err)
+	return err
+}
+
+func (h *tracingHandler) GetLoadBalancerConfig(ctx context.Context, name string) (*everestv1alpha1.LoadBalancerConfig, error) {
+	ctx, span := h.start(ctx, "GetLoadBalancerConfig", resourceNameAttr.String(name))
+	result, err := h.handler.GetLoadBalancerConfig(ctx, name)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) ListDataImporters(ctx context.Context, supportedEngines ...string) (*everestv1alpha1.DataImporterList, error) {
+	ctx, span := h.start(ctx, "ListDataImporters")
+	result, err := h.handler.ListDataImporters(ctx, supportedEngines...)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) ListDataImportJobs(ctx context.Context, namespace, dbName string) (*everestv1alpha1.DataImportJobList, error) {
+	ctx, span := h.start(ctx, "ListDataImportJobs", semconv.K8SNamespaceName(namespace), databaseClusterAttr.String(dbName))
+	result, err := h.handler.ListDataImportJobs(ctx, namespace, dbName)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) CreateSplitHorizonDNSConfig(ctx context.Context, shdc *enginefeaturesv1alpha1.SplitHorizonDNSConfig) (*enginefeaturesv1alpha1.SplitHorizonDNSConfig, error) {
+	ctx, span := h.start(ctx, "CreateSplitHorizonDNSConfig", semconv.K8SNamespaceName(shdc.GetNamespace()), resourceNameAttr.String(shdc.GetName()))
+	result, err := h.handler.CreateSplitHorizonDNSConfig(ctx, shdc)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) UpdateSplitHorizonDNSConfig(ctx context.Context, namespace, name string, req *api.SplitHorizonDNSConfigUpdateParams) (*enginefeaturesv1alpha1.SplitHorizonDNSConfig, error) {
+	ctx, span := h.start(ctx, "UpdateSplitHorizonDNSConfig", semconv.K8SNamespaceName(namespace), resourceNameAttr.String(name))
+	result, err := h.handler.UpdateSplitHorizonDNSConfig(ctx, namespace, name, req)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) ListSplitHorizonDNSConfigs(ctx context.Context, namespace string) (*enginefeaturesv1alpha1.SplitHorizonDNSConfigList, error) {
+	ctx, span := h.start(ctx, "ListSplitHorizonDNSConfigs", semconv.K8SNamespaceName(namespace))
+	result, err := h.handler.ListSplitHorizonDNSConfigs(ctx, namespace)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) DeleteSplitHorizonDNSConfig(ctx context.Context, namespace, name string) error {
+	ctx, span := h.start(ctx, "DeleteSplitHorizonDNSConfig", semconv.K8SNamespaceName(namespace), resourceNameAttr.String(name))
+	err := h.handler.DeleteSplitHorizonDNSConfig(ctx, namespace, name)
+	tracing.End(span, err)
+	return err
+}
+
+func (h *tracingHandler) GetSplitHorizonDNSConfig(ctx context.Context, namespace, name string) (*enginefeaturesv1alpha1.SplitHorizonDNSConfig, error) {
+	ctx, span := h.start(ctx, "GetSplitHorizonDNSConfig", semconv.K8SNamespaceName(namespace), resourceNameAttr.String(name))
+	result, err := h.handler.GetSplitHorizonDNSConfig(ctx, namespace, name)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) ListChangeRequests(ctx context.Context, namespace string) (*api.ChangeRequestList, error) {
+	ctx, span := h.start(ctx, "ListChangeRequests", semconv.K8SNamespaceName(namespace))
+	result, err := h.handler.ListChangeRequests(ctx, namespace)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) GetChangeRequest(ctx context.Context, namespace, name string) (*api.ChangeRequest, error) {
+	ctx, span := h.start(ctx, "GetChangeRequest", semconv.K8SNamespaceName(namespace), resourceNameAttr.String(name))
+	result, err := h.handler.GetChangeRequest(ctx, namespace, name)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) ApproveChangeRequest(ctx context.Context, namespace, name string) (*api.ChangeRequest, error) {
+	ctx, span := h.start(ctx, "ApproveChangeRequest", semconv.K8SNamespaceName(namespace), resourceNameAttr.String(name))
+	result, err := h.handler.ApproveChangeRequest(ctx, namespace, name)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) RejectChangeRequest(ctx context.Context, namespace, name string) (*api.ChangeRequest, error) {
+	ctx, span := h.start(ctx, "RejectChangeRequest", semconv.K8SNamespaceName(namespace), resourceNameAttr.String(name))
+	result, err := h.handler.RejectChangeRequest(ctx, namespace, name)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) CompleteChangeRequest(ctx context.Context, namespace, name string, execErr error) (*api.ChangeRequest, error) {
+	ctx, span := h.start(ctx, "CompleteChangeRequest", semconv.K8SNamespaceName(namespace), resourceNameAttr.String(name))
+	result, err := h.handler.CompleteChangeRequest(ctx, namespace, name, execErr)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) ListDatabaseClusterSchedules(ctx context.Context, namespace, dbName string) (*api.DatabaseClusterScheduleList, error) {
+	ctx, span := h.start(ctx, "ListDatabaseClusterSchedules", semconv.K8SNamespaceName(namespace), databaseClusterAttr.String(dbName))
+	result, err := h.handler.ListDatabaseClusterSchedules(ctx, namespace, dbName)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) GetDatabaseClusterSchedule(ctx context.Context, namespace, dbName, name string) (*api.DatabaseClusterSchedule, error) {
+	ctx, span := h.start(ctx, "GetDatabaseClusterSchedule", semconv.K8SNamespaceName(namespace), databaseClusterAttr.String(dbName), resourceNameAttr.String(name))
+	result, err := h.handler.GetDatabaseClusterSchedule(ctx, namespace, dbName, name)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) CreateDatabaseClusterSchedule(ctx context.Context, namespace, dbName string, req *api.DatabaseClusterSchedule) (*api.DatabaseClusterSchedule, error) {
+	ctx, span := h.start(ctx, "CreateDatabaseClusterSchedule", semconv.K8SNamespaceName(namespace), databaseClusterAttr.String(dbName))
+	result, err := h.handler.CreateDatabaseClusterSchedule(ctx, namespace, dbName, req)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) UpdateDatabaseClusterSchedule(ctx context.Context, namespace, dbName string, req *api.DatabaseClusterSchedule) (*api.DatabaseClusterSchedule, error) {
+	ctx, span := h.start(ctx, "UpdateDatabaseClusterSchedule", semconv.K8SNamespaceName(namespace), databaseClusterAttr.String(dbName))
+	result, err := h.handler.UpdateDatabaseClusterSchedule(ctx, namespace, dbName, req)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) DeleteDatabaseClusterSchedule(ctx context.Context, namespace, dbName, name string) error {
+	ctx, span := h.start(ctx, "DeleteDatabaseClusterSchedule", semconv.K8SNamespaceName(namespace), databaseClusterAttr.String(dbName), resourceNameAttr.String(name))
+	err := h.handler.DeleteDatabaseClusterSchedule(ctx, namespace, dbName, name)
+	tracing.End(span, err)
+	return err
+}
+
+func (h *tracingHandler) CreateOperation(ctx context.Context, namespace string, opType api.OperationType, target string) (*api.Operation, error) {
+	ctx, span := h.start(ctx, "CreateOperation", semconv.K8SNamespaceName(namespace))
+	result, err := h.handler.CreateOperation(ctx, namespace, opType, target)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) ListOperations(ctx context.Context, namespace string) (*api.OperationList, error) {
+	ctx, span := h.start(ctx, "ListOperations", semconv.K8SNamespaceName(namespace))
+	result, err := h.handler.ListOperations(ctx, namespace)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) GetOperation(ctx context.Context, namespace, name string) (*api.Operation, error) {
+	ctx, span := h.start(ctx, "GetOperation", semconv.K8SNamespaceName(namespace), resourceNameAttr.String(name))
+	result, err := h.handler.GetOperation(ctx, namespace, name)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) GetKubernetesClusterResources(ctx context.Context) (*api.KubernetesClusterResources, error) {
+	ctx, span := h.start(ctx, "GetKubernetesClusterResources")
+	result, err := h.handler.GetKubernetesClusterResources(ctx)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) GetKubernetesClusterInfo(ctx context.Context) (*api.KubernetesClusterInfo, error) {
+	ctx, span := h.start(ctx, "GetKubernetesClusterInfo")
+	result, err := h.handler.GetKubernetesClusterInfo(ctx)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) GetUserPermissions(ctx context.Context) (*api.UserPermissions, error) {
+	ctx, span := h.start(ctx, "GetUserPermissions")
+	result, err := h.handler.GetUserPermissions(ctx)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (h *tracingHandler) GetSettings(ctx context.Context) (*api.Settings, error) {
+	ctx, span := h.start(ctx, "GetSettings")
+	result, err := h.handler.GetSettings(ctx)
+	tracing.End(span, err)
+	return result, err
+}
@@ -0,0 +1,63 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/internal/server/handlers"
+)
+
+// passthroughHandler calls the next handler in the chain.
+type passthroughHandler struct {
+	handlers.Handler
+	next handlers.Handler
+}
+
+func (h *passthroughHandler) SetNext(next handlers.Handler) {
+	h.next = next
+}
+
+func (h *passthroughHandler) GetDatabaseCluster(ctx context.Context, namespace, name string) (*everestv1alpha1.DatabaseCluster, error) {
+	return h.next.GetDatabaseCluster(ctx, namespace, name)
+}
+
+func TestTracingHandler(t *testing.T) {
+	t.Parallel()
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	errNotFound := errors.New("not found")
+	last := handlers.NewMockHandler(t)
+	last.On("GetDatabaseCluster", mock.Anything, "ns", "db").Return(nil, errNotFound)
+
+	first := New("rbac", &passthroughHandler{}, tp)
+	first.SetNext(New("k8s", last, tp))
+
+	_, err := first.GetDatabaseCluster(context.Background(), "ns", "db")
+	require.ErrorIs(t, err, errNotFound)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	k8sSpan, rbacSpan := spans[0], spans[1]
+	assert.Equal(t, "k8s.GetDatabaseCluster", k8sSpan.Name())
+	assert.Equal(t, "rbac.GetDatabaseCluster", rbacSpan.Name())
+	assert.Equal(t, rbacSpan.SpanContext().SpanID(), k8sSpan.Parent().SpanID())
+	assert.Equal(t, codes.Error, k8sSpan.Status().Code)
+	assert.Equal(t, codes.Error, rbacSpan.Status().Code)
+	assert.ElementsMatch(t, []attribute.KeyValue{
+		attribute.String("k8s.namespace.name", "ns"),
+		attribute.String("k8s.resource.name", "db"),
+		attribute.String("everest.handler", "k8s"),
+	}, k8sSpan.Attributes())
+}
@@ -29,6 +29,8 @@ import (
 	operatorUtils "github.com/percona/everest-operator/utils"
 	"github.com/percona/everest/api"
 	"github.com/percona/everest/cmd/config"
+	"github.com/percona/everest/pkg/pagination"
+	"github.com/percona/everest/pkg/userlabels"
 )
 
 const (
@@ -48,8 +50,15 @@ var (
 	}
 )
 
-func (h *validateHandler) ListBackupStorages(ctx context.Context, namespace string) (*everestv1alpha1.BackupStorageList, error) {
-	return h.next.ListBackupStorages(ctx, namespace)
+func (h *validateHandler) ListBackupStorages(
+	ctx context.Context,
+	namespace string,
+	params *api.ListBackupStoragesParams,
+) (*everestv1alpha1.BackupStorageList, error) {
+	if err := pagination.Validate(pagination.BackupStorageOptions(params), map[string]pagination.SortKey[everestv1alpha1.BackupStorage]{}); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+	return h.next.ListBackupStorages(ctx, namespace, params)
 }
 
 func (h *validateHandler) GetBackupStorage(ctx context.Context, namespace, name string) (*everestv1alpha1.BackupStorage, error) {
@@ -62,7 +71,13 @@ func (h *validateHandler) CreateBackupStorage(ctx context.Context, namespace str
 		return nil, fmt.Errorf("failed to ListBackupStorages: %w", err)
 	}
 	if err := validateCreateBackupStorageRequest(ctx, h.log, req, bsList); err != nil {
-		return nil, errors.Join(ErrInvalidRequest, err)
+		return nil, errors.Join(ErrInvalidRequest, withField(err, ""))
+	}
+	if err := userlabels.Validate(pointer.Get(req.Labels), nil); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, withField(err, "labels"))
+	}
+	if err := userlabels.ValidateAnnotations(pointer.Get(req.Annotations), nil); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, withField(err, "annotations"))
 	}
 	return h.next.CreateBackupStorage(ctx, namespace, req)
 }
@@ -77,7 +92,13 @@ func (h *validateHandler) UpdateBackupStorage(ctx context.Context, namespace, na
 		return nil, fmt.Errorf("failed to GetSecret: %w", err)
 	}
 	if err := h.validateUpdateBackupStorageRequest(ctx, h.log, req, bs, secret); err != nil {
-		return nil, errors.Join(ErrInvalidRequest, err)
+		return nil, errors.Join(ErrInvalidRequest, withField(err, ""))
+	}
+	if err := userlabels.Validate(pointer.Get(req.Labels), bs.GetLabels()); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, withField(err, "labels"))
+	}
+	if err := userlabels.ValidateAnnotations(pointer.Get(req.Annotations), bs.GetAnnotations()); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, withField(err, "annotations"))
 	}
 	return h.next.UpdateBackupStorage(ctx, namespace, name, req)
 }
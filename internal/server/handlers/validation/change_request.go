@@ -0,0 +1,27 @@
+package validation
+
+import (
+	"context"
+
+	"github.com/percona/everest/api"
+)
+
+func (h *validateHandler) ListChangeRequests(ctx context.Context, namespace string) (*api.ChangeRequestList, error) {
+	return h.next.ListChangeRequests(ctx, namespace)
+}
+
+func (h *validateHandler) GetChangeRequest(ctx context.Context, namespace, name string) (*api.ChangeRequest, error) {
+	return h.next.GetChangeRequest(ctx, namespace, name)
+}
+
+func (h *validateHandler) ApproveChangeRequest(ctx context.Context, namespace, name string) (*api.ChangeRequest, error) {
+	return h.next.ApproveChangeRequest(ctx, namespace, name)
+}
+
+func (h *validateHandler) RejectChangeRequest(ctx context.Context, namespace, name string) (*api.ChangeRequest, error) {
+	return h.next.RejectChangeRequest(ctx, namespace, name)
+}
+
+func (h *validateHandler) CompleteChangeRequest(ctx context.Context, namespace, name string, execErr error) (*api.ChangeRequest, error) {
+	return h.next.CompleteChangeRequest(ctx, namespace, name, execErr)
+}
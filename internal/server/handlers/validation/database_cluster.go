@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/AlekSi/pointer"
 	goversion "github.com/hashicorp/go-version"
 	"golang.org/x/mod/semver"
 	corev1 "k8s.io/api/core/v1"
@@ -20,6 +21,13 @@ import (
 	"github.com/percona/everest/api"
 	"github.com/percona/everest/internal/server/handlers"
 	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/diagnostics"
+	"github.com/percona/everest/pkg/expiry"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/logstream"
+	"github.com/percona/everest/pkg/pagination"
+	"github.com/percona/everest/pkg/softdelete"
+	"github.com/percona/everest/pkg/userlabels"
 )
 
 const (
@@ -36,6 +44,12 @@ func (h *validateHandler) CreateDatabaseCluster(ctx context.Context, db *everest
 	if err := h.validateDatabaseClusterCR(ctx, db.GetNamespace(), db); err != nil {
 		return nil, errors.Join(ErrInvalidRequest, err)
 	}
+	if err := userlabels.Validate(db.GetLabels(), nil); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, withField(err, "metadata.labels"))
+	}
+	if err := userlabels.ValidateCreateAnnotations(db.GetAnnotations()); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, withField(err, "metadata.annotations"))
+	}
 
 	if currentDB, err := h.kubeConnector.GetDatabaseCluster(ctx, types.NamespacedName{Namespace: db.GetNamespace(), Name: db.GetName()}); err != nil {
 		if !k8serrors.IsNotFound(err) {
@@ -45,17 +59,79 @@ func (h *validateHandler) CreateDatabaseCluster(ctx context.Context, db *everest
 		return nil, fmt.Errorf("db cluster with name '%s' already exists in namespace '%s'", db.GetName(), db.GetNamespace())
 	}
 
+	if err := h.validateDatabaseClusterQuota(ctx, db, true); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
 	return h.next.CreateDatabaseCluster(ctx, db)
 }
 
-func (h *validateHandler) ListDatabaseClusters(ctx context.Context, namespace string) (*everestv1alpha1.DatabaseClusterList, error) {
-	return h.next.ListDatabaseClusters(ctx, namespace)
+func (h *validateHandler) ListDatabaseClusters(
+	ctx context.Context,
+	namespace string,
+	params *api.ListDatabaseClustersParams,
+) (*everestv1alpha1.DatabaseClusterList, error) {
+	if err := pagination.Validate(pagination.DatabaseClusterOptions(params), pagination.DatabaseClusterSortKeys); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+	return h.next.ListDatabaseClusters(ctx, namespace, params)
 }
 
 func (h *validateHandler) DeleteDatabaseCluster(ctx context.Context, namespace, name string, req *api.DeleteDatabaseClusterParams) error {
+	if _, err := h.validateDatabaseClusterOnDelete(ctx, namespace, name); err != nil {
+		return err
+	}
 	return h.next.DeleteDatabaseCluster(ctx, namespace, name, req)
 }
 
+func (h *validateHandler) ListDeletedDatabaseClusters(ctx context.Context, namespace string) (*everestv1alpha1.DatabaseClusterList, error) {
+	return h.next.ListDeletedDatabaseClusters(ctx, namespace)
+}
+
+func (h *validateHandler) RestoreDatabaseCluster(ctx context.Context, namespace, name string) (*everestv1alpha1.DatabaseCluster, error) {
+	db, err := h.kubeConnector.GetDatabaseCluster(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+	if err != nil {
+		return nil, fmt.Errorf("failed to GetDatabaseCluster: %w", err)
+	}
+	if !softdelete.IsDeleted(db) {
+		return nil, errors.Join(ErrInvalidRequest, softdelete.ErrNotDeleted)
+	}
+	return h.next.RestoreDatabaseCluster(ctx, namespace, name)
+}
+
+func (h *validateHandler) UpdateDatabaseClusterDeletionProtection(ctx context.Context, namespace, name string, enabled bool) (*everestv1alpha1.DatabaseCluster, error) {
+	db, err := h.kubeConnector.GetDatabaseCluster(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+	if err != nil {
+		return nil, fmt.Errorf("failed to GetDatabaseCluster: %w", err)
+	}
+	if softdelete.IsDeleted(db) {
+		return nil, errors.Join(ErrInvalidRequest, softdelete.ErrDeleted)
+	}
+	return h.next.UpdateDatabaseClusterDeletionProtection(ctx, namespace, name, enabled)
+}
+
+func (h *validateHandler) PurgeDatabaseCluster(ctx context.Context, namespace, name string) error {
+	db, err := h.validateDatabaseClusterOnDelete(ctx, namespace, name)
+	if err != nil {
+		return err
+	}
+	if !softdelete.IsDeleted(db) {
+		return errors.Join(ErrInvalidRequest, softdelete.ErrNotDeleted)
+	}
+	return h.next.PurgeDatabaseCluster(ctx, namespace, name)
+}
+
+// validateDatabaseClusterOnDelete checks that the database cluster can be deleted and returns it.
+func (h *validateHandler) validateDatabaseClusterOnDelete(ctx context.Context, namespace, name string) (*everestv1alpha1.DatabaseCluster, error) {
+	db, err := h.kubeConnector.GetDatabaseCluster(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+	if err != nil {
+		return nil, fmt.Errorf("failed to GetDatabaseCluster: %w", err)
+	}
+	if softdelete.IsDeletionProtected(db) {
+		return nil, errors.Join(ErrInvalidRequest, softdelete.ErrDeletionProtected)
+	}
+	return db, nil
+}
+
 func (h *validateHandler) UpdateDatabaseCluster(ctx context.Context, db *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error) {
 	if err := h.validateDatabaseClusterCR(ctx, db.GetNamespace(), db); err != nil {
 		return nil, errors.Join(ErrInvalidRequest, err)
@@ -65,7 +141,19 @@ func (h *validateHandler) UpdateDatabaseCluster(ctx context.Context, db *everest
 	if err != nil {
 		return nil, fmt.Errorf("failed to GetDatabaseCluster: %w", err)
 	}
+	if softdelete.IsDeleted(current) {
+		return nil, errors.Join(ErrInvalidRequest, softdelete.ErrDeleted)
+	}
+	if err := userlabels.Validate(db.GetLabels(), current.GetLabels()); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, withField(err, "metadata.labels"))
+	}
+	if err := userlabels.ValidateAnnotations(db.GetAnnotations(), current.GetAnnotations()); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, withField(err, "metadata.annotations"))
+	}
 	if err := h.validateDatabaseClusterOnUpdate(db, current); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, withField(err, ""))
+	}
+	if err := h.validateDatabaseClusterQuota(ctx, db, false); err != nil {
 		return nil, errors.Join(ErrInvalidRequest, err)
 	}
 	return h.next.UpdateDatabaseCluster(ctx, db)
@@ -75,6 +163,10 @@ func (h *validateHandler) GetDatabaseCluster(ctx context.Context, namespace, nam
 	return h.next.GetDatabaseCluster(ctx, namespace, name)
 }
 
+func (h *validateHandler) GetDeletedDatabaseCluster(ctx context.Context, namespace, name string) (*everestv1alpha1.DatabaseCluster, error) {
+	return h.next.GetDeletedDatabaseCluster(ctx, namespace, name)
+}
+
 func (h *validateHandler) GetDatabaseClusterCredentials(ctx context.Context, namespace, name string) (*api.DatabaseClusterCredential, error) {
 	return h.next.GetDatabaseClusterCredentials(ctx, namespace, name)
 }
@@ -91,6 +183,32 @@ func (h *validateHandler) GetDatabaseClusterComponentLogs(ctx context.Context, n
 	return h.next.GetDatabaseClusterComponentLogs(ctx, namespace, clusterName, componentName, params, stream)
 }
 
+func (h *validateHandler) GetDatabaseClusterLogs(ctx context.Context, namespace, name string, params api.GetDatabaseClusterLogsParams, stream handlers.LogsStreamFunc) error {
+	if params.SinceSeconds != nil && params.SinceTime != nil {
+		return errors.Join(ErrInvalidRequest, errors.New("at most one of sinceSeconds or sinceTime may be specified"))
+	}
+	if pointer.GetBool(params.Download) && pointer.GetBool(params.Follow) {
+		return errors.Join(ErrInvalidRequest, errors.New("follow cannot be used to download the logs"))
+	}
+	if _, err := logstream.NewFilter(pointer.GetString(params.Filter), pointer.GetBool(params.Regex)); err != nil {
+		return errors.Join(ErrInvalidRequest, err)
+	}
+	return h.next.GetDatabaseClusterLogs(ctx, namespace, name, params, stream)
+}
+
+func (h *validateHandler) GetDatabaseClusterEvents(ctx context.Context, namespace, name string, params *api.GetDatabaseClusterEventsParams) (*api.DatabaseClusterEventList, error) {
+	return h.next.GetDatabaseClusterEvents(ctx, namespace, name, params)
+}
+
+func (h *validateHandler) GetDatabaseClusterDiagnostics(ctx context.Context, namespace, name string, params *api.GetDatabaseClusterDiagnosticsParams) (*api.DatabaseClusterDiagnostics, error) {
+	if params != nil {
+		if _, err := diagnostics.Select(pointer.Get(params.Check)); err != nil {
+			return nil, errors.Join(ErrInvalidRequest, err)
+		}
+	}
+	return h.next.GetDatabaseClusterDiagnostics(ctx, namespace, name, params)
+}
+
 func (h *validateHandler) GetDatabaseClusterPitr(ctx context.Context, namespace, name string) (*api.DatabaseClusterPitr, error) {
 	return h.next.GetDatabaseClusterPitr(ctx, namespace, name)
 }
@@ -102,59 +220,65 @@ func (h *validateHandler) validateDatabaseClusterCR(
 	databaseCluster *everestv1alpha1.DatabaseCluster,
 ) error {
 	if err := validateMetadata(databaseCluster); err != nil {
-		return err
+		return withField(err, "metadata")
 	}
 	if err := validateCreateDatabaseClusterRequest(databaseCluster); err != nil {
-		return err
+		return withField(err, "metadata.name")
+	}
+	if err := expiry.Validate(databaseCluster); err != nil {
+		return withField(err, "metadata.annotations")
+	}
+	if err := softdelete.ValidateDeletionProtection(databaseCluster); err != nil {
+		return withField(err, "metadata.annotations")
 	}
 
 	engineName, ok := common.OperatorTypeToName[databaseCluster.Spec.Engine.Type]
 	if !ok {
-		return errors.New("unsupported database engine")
+		return withField(errors.New("unsupported database engine"), "spec.engine.type")
 	}
 	engine, err := h.kubeConnector.GetDatabaseEngine(ctx, types.NamespacedName{Namespace: namespace, Name: engineName})
 	if err != nil {
 		return err
 	}
 	if err := validateEngine(databaseCluster, engine); err != nil {
-		return err
+		return withField(err, "spec.engine.version")
 	}
 	if databaseCluster.Spec.Proxy.Type != "" {
 		if err := validateProxy(databaseCluster); err != nil {
-			return err
+			return withField(err, "spec.proxy.expose.ipSourceRanges")
 		}
 	}
 	if err := validateBackupSpec(databaseCluster); err != nil {
-		return err
+		return withField(err, "spec.backup")
 	}
 
 	if err = h.validateBackupStoragesFor(ctx, namespace, databaseCluster); err != nil {
-		return err
+		return withField(err, "spec.backup")
 	}
 
 	if databaseCluster.Spec.DataSource != nil {
 		if err := validateDataSource(databaseCluster.Spec.DataSource); err != nil {
-			return err
+			return withField(err, "spec.dataSource")
 		}
 	}
 
 	if databaseCluster.Spec.Engine.Type == everestv1alpha1.DatabaseEnginePostgresql {
 		if err = h.validatePGSchedulesRestrictions(ctx, databaseCluster); err != nil {
-			return err
+			return withField(err, "spec.backup.schedules")
 		}
 		if err = validatePGReposForAPIDB(ctx, databaseCluster, h.kubeConnector.ListDatabaseClusterBackups); err != nil {
-			return err
+			return withField(err, "spec.backup.schedules")
 		}
 	}
 	if err := validateSharding(databaseCluster); err != nil {
-		return err
+		return withField(err, "spec.sharding")
 	}
 
 	if err = h.validatePodSchedulingPolicy(ctx, databaseCluster); err != nil {
-		h.log.Errorf("failed to validate .spec.podSchedulingPolicyName='%s': %v", databaseCluster.Spec.PodSchedulingPolicyName, err)
-		return err
+		logger.FromContext(ctx, h.log).Errorf("failed to validate .spec.podSchedulingPolicyName='%s': %v", databaseCluster.Spec.PodSchedulingPolicyName, err)
+		return withField(err, "spec.podSchedulingPolicyName")
 	}
-	return validateResourceLimits(databaseCluster)
+	return withField(validateResourceLimits(databaseCluster), "spec.engine.resources")
 }
 
 func validateSharding(dbc *everestv1alpha1.DatabaseCluster) error {
@@ -2,16 +2,28 @@ package validation
 
 import (
 	"context"
+	"errors"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
 	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/pagination"
 )
 
-func (h *validateHandler) ListDatabaseClusterBackups(ctx context.Context, namespace, clusterName string) (*everestv1alpha1.DatabaseClusterBackupList, error) {
-	return h.next.ListDatabaseClusterBackups(ctx, namespace, clusterName)
+func (h *validateHandler) ListDatabaseClusterBackups(
+	ctx context.Context,
+	namespace, clusterName string,
+	params *api.ListDatabaseClusterBackupsParams,
+) (*everestv1alpha1.DatabaseClusterBackupList, error) {
+	if err := pagination.Validate(pagination.DatabaseClusterBackupOptions(params), pagination.DatabaseClusterBackupSortKeys); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+	return h.next.ListDatabaseClusterBackups(ctx, namespace, clusterName, params)
 }
 
 func (h *validateHandler) CreateDatabaseClusterBackup(ctx context.Context, req *everestv1alpha1.DatabaseClusterBackup) (*everestv1alpha1.DatabaseClusterBackup, error) {
+	if err := h.validateBackupQuota(ctx, req.GetNamespace()); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
 	return h.next.CreateDatabaseClusterBackup(ctx, req)
 }
 
@@ -2,12 +2,22 @@ package validation
 
 import (
 	"context"
+	"errors"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/pagination"
 )
 
-func (h *validateHandler) ListDatabaseClusterRestores(ctx context.Context, namespace, clusterName string) (*everestv1alpha1.DatabaseClusterRestoreList, error) {
-	return h.next.ListDatabaseClusterRestores(ctx, namespace, clusterName)
+func (h *validateHandler) ListDatabaseClusterRestores(
+	ctx context.Context,
+	namespace, clusterName string,
+	params *api.ListDatabaseClusterRestoresParams,
+) (*everestv1alpha1.DatabaseClusterRestoreList, error) {
+	if err := pagination.Validate(pagination.DatabaseClusterRestoreOptions(params), pagination.DatabaseClusterRestoreSortKeys); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+	return h.next.ListDatabaseClusterRestores(ctx, namespace, clusterName, params)
 }
 
 func (h *validateHandler) CreateDatabaseClusterRestore(ctx context.Context, req *everestv1alpha1.DatabaseClusterRestore) (*everestv1alpha1.DatabaseClusterRestore, error) {
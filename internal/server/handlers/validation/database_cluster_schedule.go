@@ -0,0 +1,51 @@
+package validation
+
+import (
+	"context"
+	"errors"
+
+	operatorUtils "github.com/percona/everest-operator/utils"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/schedule"
+)
+
+func (h *validateHandler) ListDatabaseClusterSchedules(ctx context.Context, namespace, dbName string) (*api.DatabaseClusterScheduleList, error) {
+	return h.next.ListDatabaseClusterSchedules(ctx, namespace, dbName)
+}
+
+func (h *validateHandler) GetDatabaseClusterSchedule(ctx context.Context, namespace, dbName, name string) (*api.DatabaseClusterSchedule, error) {
+	return h.next.GetDatabaseClusterSchedule(ctx, namespace, dbName, name)
+}
+
+func (h *validateHandler) CreateDatabaseClusterSchedule(
+	ctx context.Context,
+	namespace, dbName string,
+	req *api.DatabaseClusterSchedule,
+) (*api.DatabaseClusterSchedule, error) {
+	if err := validateDatabaseClusterSchedule(req); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+	return h.next.CreateDatabaseClusterSchedule(ctx, namespace, dbName, req)
+}
+
+func (h *validateHandler) UpdateDatabaseClusterSchedule(
+	ctx context.Context,
+	namespace, dbName string,
+	req *api.DatabaseClusterSchedule,
+) (*api.DatabaseClusterSchedule, error) {
+	if err := validateDatabaseClusterSchedule(req); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+	return h.next.UpdateDatabaseClusterSchedule(ctx, namespace, dbName, req)
+}
+
+func (h *validateHandler) DeleteDatabaseClusterSchedule(ctx context.Context, namespace, dbName, name string) error {
+	return h.next.DeleteDatabaseClusterSchedule(ctx, namespace, dbName, name)
+}
+
+func validateDatabaseClusterSchedule(s *api.DatabaseClusterSchedule) error {
+	if err := operatorUtils.ValidateRFC1035(s.Name, "name"); err != nil {
+		return err
+	}
+	return schedule.Validate(s)
+}
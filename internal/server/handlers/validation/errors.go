@@ -69,7 +69,7 @@ func ErrUpdateStorageNotSupported(storageType string) error {
 
 // ErrInvalidURL when the given fieldName contains invalid URL.
 func ErrInvalidURL(fieldName string) error {
-	return fmt.Errorf("'%s' is an invalid URL", fieldName)
+	return &FieldError{Field: fieldName, Err: fmt.Errorf("'%s' is an invalid URL", fieldName)}
 }
 
 // ErrCreateStorageNotSupported appears when trying to create a storage of a type that is not supported.
@@ -0,0 +1,121 @@
+package validation
+
+import (
+	"errors"
+)
+
+// FieldError is an error caused by an invalid field of a request.
+// It reports the same message as the underlying error.
+type FieldError struct {
+	// Field is the JSON path of the invalid field, e.g. spec.engine.replicas.
+	Field string
+	// Err is the reason the field is invalid.
+	Err error
+}
+
+// Error returns the message of the underlying error.
+func (e *FieldError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// fieldsOfErrors maps the known validation errors to the field they are caused by.
+//
+//nolint:gochecknoglobals
+var fieldsOfErrors = []struct {
+	err   error
+	field string
+}{
+	{errEmptyName, "metadata.name"},
+	{errEmptyNamespace, "metadata.namespace"},
+	{errNotEnoughMemory, "spec.engine.resources.memory"},
+	{errNotEnoughCPU, "spec.engine.resources.cpu"},
+	{errNotEnoughDiskSize, "spec.engine.storage.size"},
+	{errCannotShrinkStorageSize, "spec.engine.storage.size"},
+	{errNoResourceDefined, "spec.engine.resources"},
+	{errEvenEngineReplicas, "spec.engine.replicas"},
+	{errMaxPXCEngineReplicas, "spec.engine.replicas"},
+	{errInvalidVersion, "spec.engine.version"},
+	{errDBEngineMajorVersionUpgrade, "spec.engine.version"},
+	{errDBEngineMajorUpgradeNotSeq, "spec.engine.version"},
+	{errDBEngineDowngrade, "spec.engine.version"},
+	{errShardingVersion, "spec.engine.version"},
+	{errUnsupportedPXCProxy, "spec.proxy.type"},
+	{errUnsupportedPGProxy, "spec.proxy.type"},
+	{errUnsupportedPSMDBProxy, "spec.proxy.type"},
+	{errMinPXCProxyReplicas, "spec.proxy.replicas"},
+	{errNoNameInSchedule, "spec.backup.schedules"},
+	{errScheduleNoBackupStorageName, "spec.backup.schedules"},
+	{errDuplicatedSchedules, "spec.backup.schedules"},
+	{errDuplicatedStoragePG, "spec.backup.schedules"},
+	{errStorageChangePG, "spec.backup.schedules"},
+	{errTooManyPGStorages, "spec.backup.schedules"},
+	{errPSMDBMultipleStorages, "spec.backup.schedules"},
+	{errPSMDBViolateActiveStorage, "spec.backup.schedules"},
+	{errPitrNoBackupStorageName, "spec.backup.pitr.backupStorageName"},
+	{errPXCPitrS3Only, "spec.backup.pitr.backupStorageName"},
+	{errPitrUploadInterval, "spec.backup.pitr.uploadIntervalSec"},
+	{errDataSourceConfig, "spec.dataSource"},
+	{errDataSourceNoPitrDateSpecified, "spec.dataSource.pitr.date"},
+	{errDataSourceWrongDateFormat, "spec.dataSource.pitr.date"},
+	{errUnsupportedPitrType, "spec.dataSource.pitr.type"},
+	{errDataSourceNoBackupStorageName, "spec.dataSource.backupSource.backupStorageName"},
+	{errDataSourceNoPath, "spec.dataSource.backupSource.path"},
+	{errShardingIsNotSupported, "spec.sharding.enabled"},
+	{errDisableShardingNotSupported, "spec.sharding.enabled"},
+	{errShardingEnablingNotSupported, "spec.sharding.enabled"},
+	{errInsufficientShardsNumber, "spec.sharding.shards"},
+	{errInsufficientCfgSrvNumber, "spec.sharding.configServer.replicas"},
+	{errInsufficientCfgSrvNumber1Node, "spec.sharding.configServer.replicas"},
+	{errEvenServersNumber, "spec.sharding.configServer.replicas"},
+	{errInvalidBucketName, "bucketName"},
+}
+
+// withField attributes the error to the field that caused it. Known
+// validation errors are attributed to their own field, other errors to the
+// given field. Errors that are already attributed to a field, and errors
+// without a field, are returned unchanged.
+func withField(err error, field string) error {
+	if err == nil {
+		return nil
+	}
+	var fieldErr *FieldError
+	if errors.As(err, &fieldErr) {
+		return err
+	}
+	for _, f := range fieldsOfErrors {
+		if errors.Is(err, f.err) {
+			field = f.field
+			break
+		}
+	}
+	if field == "" {
+		return err
+	}
+	return &FieldError{Field: field, Err: err}
+}
+
+// FieldErrors returns all field errors found in the tree of the error.
+func FieldErrors(err error) []*FieldError {
+	if err == nil {
+		return nil
+	}
+	if fieldErr, ok := err.(*FieldError); ok { //nolint:errorlint
+		return []*FieldError{fieldErr}
+	}
+	switch e := err.(type) { //nolint:errorlint
+	case interface{ Unwrap() []error }:
+		var result []*FieldError
+		for _, inner := range e.Unwrap() {
+			result = append(result, FieldErrors(inner)...)
+		}
+		return result
+	case interface{ Unwrap() error }:
+		return FieldErrors(e.Unwrap())
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithField(t *testing.T) {
+	t.Parallel()
+
+	// Known errors are attributed to their own field.
+	err := withField(errNotEnoughCPU, "spec.engine.resources")
+	require.ErrorIs(t, err, errNotEnoughCPU)
+	assert.Equal(t, errNotEnoughCPU.Error(), err.Error())
+	assert.Equal(t, []*FieldError{{Field: "spec.engine.resources.cpu", Err: errNotEnoughCPU}}, FieldErrors(err))
+
+	// Other errors are attributed to the given field.
+	other := errors.New("other")
+	assert.Equal(t, []*FieldError{{Field: "spec.proxy", Err: other}}, FieldErrors(withField(other, "spec.proxy")))
+
+	// Errors without a field are returned unchanged.
+	assert.Equal(t, other, withField(other, ""))
+	require.NoError(t, withField(nil, "spec"))
+
+	// Errors already attributed to a field keep it.
+	urlErr := ErrInvalidURL("url")
+	assert.Equal(t, urlErr, withField(urlErr, "spec"))
+
+	// Field errors are found in joined errors.
+	joined := errors.Join(ErrInvalidRequest, withField(errEvenEngineReplicas, ""), urlErr)
+	fieldErrs := FieldErrors(joined)
+	require.Len(t, fieldErrs, 2)
+	assert.Equal(t, "spec.engine.replicas", fieldErrs[0].Field)
+	assert.Equal(t, "url", fieldErrs[1].Field)
+	assert.Empty(t, FieldErrors(errors.Join(ErrInvalidRequest, other)))
+}
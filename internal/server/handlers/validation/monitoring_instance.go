@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/AlekSi/pointer"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -12,6 +13,8 @@ import (
 	"github.com/percona/everest-operator/utils"
 	operatorUtils "github.com/percona/everest-operator/utils"
 	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/pagination"
+	"github.com/percona/everest/pkg/userlabels"
 )
 
 // Used monitoring config error
@@ -19,8 +22,15 @@ var errDeleteInUseMonitoringConfig = func(namespace, name string) error {
 	return fmt.Errorf("monitoring instance='%s' in namespace='%s' is used by some DB cluster and cannot be deleted", name, namespace)
 }
 
-func (h *validateHandler) ListMonitoringInstances(ctx context.Context, namespace string) (*everestv1alpha1.MonitoringConfigList, error) {
-	return h.next.ListMonitoringInstances(ctx, namespace)
+func (h *validateHandler) ListMonitoringInstances(
+	ctx context.Context,
+	namespace string,
+	params *api.ListMonitoringInstancesParams,
+) (*everestv1alpha1.MonitoringConfigList, error) {
+	if err := pagination.Validate(pagination.MonitoringInstanceOptions(params), map[string]pagination.SortKey[everestv1alpha1.MonitoringConfig]{}); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+	return h.next.ListMonitoringInstances(ctx, namespace, params)
 }
 
 func (h *validateHandler) CreateMonitoringInstance(ctx context.Context, namespace string, req *api.CreateMonitoringInstanceJSONRequestBody) (*everestv1alpha1.MonitoringConfig, error) {
@@ -42,6 +52,12 @@ func (h *validateHandler) CreateMonitoringInstance(ctx context.Context, namespac
 	default:
 		return nil, errors.Join(ErrInvalidRequest, fmt.Errorf("monitoring type %s is not supported", req.Type))
 	}
+	if err := userlabels.Validate(pointer.Get(req.Labels), nil); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, withField(err, "labels"))
+	}
+	if err := userlabels.ValidateAnnotations(pointer.Get(req.Annotations), nil); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, withField(err, "annotations"))
+	}
 	return h.next.CreateMonitoringInstance(ctx, namespace, req)
 }
 
@@ -80,5 +96,17 @@ func (h *validateHandler) UpdateMonitoringInstance(ctx context.Context, namespac
 	default:
 		return nil, errors.Join(ErrInvalidRequest, fmt.Errorf("monitoring type %s is not supported", req.Type))
 	}
+	if req.Labels != nil || req.Annotations != nil {
+		mc, err := h.kubeConnector.GetMonitoringConfig(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+		if err != nil {
+			return nil, err
+		}
+		if err := userlabels.Validate(pointer.Get(req.Labels), mc.GetLabels()); err != nil {
+			return nil, errors.Join(ErrInvalidRequest, withField(err, "labels"))
+		}
+		if err := userlabels.ValidateAnnotations(pointer.Get(req.Annotations), mc.GetAnnotations()); err != nil {
+			return nil, errors.Join(ErrInvalidRequest, withField(err, "annotations"))
+		}
+	}
 	return h.next.UpdateMonitoringInstance(ctx, namespace, name, req)
 }
@@ -0,0 +1,72 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/pkg/quota"
+)
+
+// getNamespaceQuota returns the quota of the given namespace, or nil if there is none.
+func (h *validateHandler) getNamespaceQuota(ctx context.Context, namespace string) (*quota.Quota, error) {
+	ns, err := h.kubeConnector.GetNamespace(ctx, types.NamespacedName{Name: namespace})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get namespace '%s': %w", namespace, err)
+	}
+	q, err := quota.FromNamespace(ns)
+	if err != nil {
+		return nil, fmt.Errorf("invalid quota in namespace '%s': %w", namespace, err)
+	}
+	return q, nil
+}
+
+// validateDatabaseClusterQuota checks that creating or updating the given
+// database cluster does not exceed the quota of its namespace.
+func (h *validateHandler) validateDatabaseClusterQuota(ctx context.Context, db *everestv1alpha1.DatabaseCluster, isCreate bool) error {
+	q, err := h.getNamespaceQuota(ctx, db.GetNamespace())
+	if err != nil || q == nil {
+		return err
+	}
+
+	if isCreate {
+		if err := q.CheckEngine(db.Spec.Engine.Type); err != nil {
+			return err
+		}
+	}
+
+	dbs, err := h.kubeConnector.ListDatabaseClusters(ctx, ctrlclient.InNamespace(db.GetNamespace()))
+	if err != nil {
+		return fmt.Errorf("failed to list database clusters: %w", err)
+	}
+	current, err := quota.DatabaseClustersUsage(dbs.Items...)
+	if err != nil {
+		return err
+	}
+
+	others := slices.DeleteFunc(dbs.Items, func(item everestv1alpha1.DatabaseCluster) bool {
+		return item.GetName() == db.GetName()
+	})
+	requested, err := quota.DatabaseClustersUsage(append(others, *db)...)
+	if err != nil {
+		return err
+	}
+	return q.Check(current, requested)
+}
+
+// validateBackupQuota checks that one more backup fits in the quota of the namespace.
+func (h *validateHandler) validateBackupQuota(ctx context.Context, namespace string) error {
+	q, err := h.getNamespaceQuota(ctx, namespace)
+	if err != nil || q == nil || q.MaxBackups == nil {
+		return err
+	}
+	backups, err := h.kubeConnector.ListDatabaseClusterBackups(ctx, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		return fmt.Errorf("failed to list database cluster backups: %w", err)
+	}
+	return q.CheckBackups(len(backups.Items))
+}
@@ -0,0 +1,117 @@
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/quota"
+)
+
+func TestValidateDatabaseClusterQuota(t *testing.T) {
+	t.Parallel()
+
+	newDB := func(name, cpu string, engine everestv1alpha1.EngineType) *everestv1alpha1.DatabaseCluster {
+		return &everestv1alpha1.DatabaseCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "ns",
+			},
+			Spec: everestv1alpha1.DatabaseClusterSpec{
+				Engine: everestv1alpha1.Engine{
+					Type:     engine,
+					Replicas: 1,
+					Storage:  everestv1alpha1.Storage{Size: resource.MustParse("1Gi")},
+					Resources: everestv1alpha1.Resources{
+						CPU:    resource.MustParse(cpu),
+						Memory: resource.MustParse("1Gi"),
+					},
+				},
+			},
+		}
+	}
+
+	cases := []struct {
+		name     string
+		quota    string
+		db       *everestv1alpha1.DatabaseCluster
+		isCreate bool
+		wantErr  bool
+	}{
+		{
+			name:     "no quota",
+			db:       newDB("new", "16", everestv1alpha1.DatabaseEnginePXC),
+			isCreate: true,
+		},
+		{
+			name:     "create within quota",
+			quota:    "maxDatabaseClusters: 2\ncpu: \"4\"",
+			db:       newDB("new", "2", everestv1alpha1.DatabaseEnginePXC),
+			isCreate: true,
+		},
+		{
+			name:     "create exceeds cpu",
+			quota:    "cpu: \"4\"",
+			db:       newDB("new", "3", everestv1alpha1.DatabaseEnginePXC),
+			isCreate: true,
+			wantErr:  true,
+		},
+		{
+			name:     "create exceeds clusters",
+			quota:    "maxDatabaseClusters: 1",
+			db:       newDB("new", "1", everestv1alpha1.DatabaseEnginePXC),
+			isCreate: true,
+			wantErr:  true,
+		},
+		{
+			name:     "engine not allowed",
+			quota:    "allowedEngines: [psmdb]",
+			db:       newDB("new", "1", everestv1alpha1.DatabaseEnginePXC),
+			isCreate: true,
+			wantErr:  true,
+		},
+		{
+			name:  "update existing within quota",
+			quota: "maxDatabaseClusters: 1\ncpu: \"4\"",
+			db:    newDB("existing", "4", everestv1alpha1.DatabaseEnginePXC),
+		},
+		{
+			name:    "update existing exceeds cpu",
+			quota:   "cpu: \"4\"",
+			db:      newDB("existing", "5", everestv1alpha1.DatabaseEnginePXC),
+			wantErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns"}}
+			if tc.quota != "" {
+				ns.SetAnnotations(map[string]string{common.EverestNamespaceQuotaAnnotation: tc.quota})
+			}
+			existing := newDB("existing", "2", everestv1alpha1.DatabaseEnginePXC)
+			mockClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.CreateScheme()).WithObjects(ns, existing)
+			k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient.Build())
+			h := validateHandler{
+				kubeConnector: k,
+			}
+
+			err := h.validateDatabaseClusterQuota(context.Background(), tc.db, tc.isCreate)
+			if tc.wantErr {
+				assert.ErrorIs(t, err, quota.ErrQuotaExceeded)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -1,7 +1,15 @@
 package validation
 
-import "context"
+import (
+	"context"
+
+	"github.com/percona/everest/api"
+)
 
 func (h *validateHandler) ListNamespaces(ctx context.Context) ([]string, error) {
 	return h.next.ListNamespaces(ctx)
 }
+
+func (h *validateHandler) GetNamespaceQuota(ctx context.Context, namespace string) (*api.NamespaceQuotaUsage, error) {
+	return h.next.GetNamespaceQuota(ctx, namespace)
+}
@@ -0,0 +1,24 @@
+package validation
+
+import (
+	"context"
+
+	"github.com/percona/everest/api"
+)
+
+func (h *validateHandler) CreateOperation(
+	ctx context.Context,
+	namespace string,
+	opType api.OperationType,
+	target string,
+) (*api.Operation, error) {
+	return h.next.CreateOperation(ctx, namespace, opType, target)
+}
+
+func (h *validateHandler) ListOperations(ctx context.Context, namespace string) (*api.OperationList, error) {
+	return h.next.ListOperations(ctx, namespace)
+}
+
+func (h *validateHandler) GetOperation(ctx context.Context, namespace, name string) (*api.Operation, error) {
+	return h.next.GetOperation(ctx, namespace, name)
+}
@@ -0,0 +1,72 @@
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/internal/server/handlers"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/softdelete"
+)
+
+func TestValidateDeleteDatabaseCluster(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		annotations map[string]string
+		wantErr     error
+	}{
+		{
+			name: "not protected",
+		},
+		{
+			name:        "protection disabled",
+			annotations: map[string]string{common.DeletionProtectionAnnotation: "false"},
+		},
+		{
+			name:        "protected",
+			annotations: map[string]string{common.DeletionProtectionAnnotation: "true"},
+			wantErr:     softdelete.ErrDeletionProtected,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db := &everestv1alpha1.DatabaseCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "test-db",
+					Namespace:   "ns",
+					Annotations: tc.annotations,
+				},
+			}
+			mockClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.CreateScheme()).WithObjects(db)
+			next := &handlers.MockHandler{}
+			next.On("DeleteDatabaseCluster", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			h := validateHandler{
+				kubeConnector: kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient.Build()),
+				next:          next,
+			}
+
+			err := h.DeleteDatabaseCluster(context.Background(), "ns", "test-db", &api.DeleteDatabaseClusterParams{})
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, ErrInvalidRequest)
+				assert.ErrorIs(t, err, tc.wantErr)
+				next.AssertNotCalled(t, "DeleteDatabaseCluster", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			next.AssertCalled(t, "DeleteDatabaseCluster", mock.Anything, "ns", "test-db", mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,213 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	k8shandler "github.com/percona/everest/internal/server/handlers/k8s"
+	valhandler "github.com/percona/everest/internal/server/handlers/validation"
+	"github.com/percona/everest/pkg/idempotency"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+const (
+	idempotencyKeyHeader      = "Idempotency-Key"
+	idempotentReplayedHeader  = "Idempotent-Replayed"
+	idempotencyPurgeInterval  = time.Hour
+	idempotencyMaxClaimRounds = 2
+)
+
+// idempotentRoutes are the create endpoints that accept an Idempotency-Key header.
+var idempotentRoutes = []string{
+	"/namespaces/:namespace/database-clusters",
+	"/namespaces/:namespace/database-cluster-backups",
+	"/namespaces/:namespace/database-cluster-restores",
+	"/namespaces/:namespace/backup-storages",
+	"/namespaces/:namespace/monitoring-instances",
+}
+
+// responseRecorder is a http.ResponseWriter that keeps a copy of the response body.
+type responseRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+func isIdempotentRoute(c echo.Context) bool {
+	if c.Request().Method != http.MethodPost {
+		return false
+	}
+	return slices.ContainsFunc(idempotentRoutes, func(route string) bool {
+		return strings.HasSuffix(c.Path(), route)
+	})
+}
+
+// idempotency is a middleware that makes create requests with an
+// Idempotency-Key header safe to retry. The response of the first successful
+// request is recorded and replayed for retries with the same key, while
+// reusing the key for a different request is rejected.
+func (e *EverestServer) idempotency(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		key := c.Request().Header.Get(idempotencyKeyHeader)
+		if key == "" || !isIdempotentRoute(c) {
+			return next(c)
+		}
+		if err := idempotency.ValidateKey(key); err != nil {
+			return errors.Join(valhandler.ErrInvalidRequest, err)
+		}
+
+		ctx := c.Request().Context()
+		user, err := rbac.GetUser(ctx)
+		if err != nil {
+			return err
+		}
+		body, err := e.readRequestBody(c)
+		if err != nil {
+			return err
+		}
+		fingerprint := idempotency.Fingerprint(c.Request().Method, c.Request().URL.Path, body)
+		namespace := c.Param("namespace")
+		name := idempotency.ConfigMapName(user.Subject, key)
+
+		record := idempotency.NewRecord(fingerprint, time.Now())
+		cm, replay, err := e.claimIdempotencyKey(ctx, namespace, name, record)
+		if err != nil {
+			return err
+		}
+		if replay != nil {
+			c.Response().Header().Set(idempotentReplayedHeader, "true")
+			return c.Blob(replay.StatusCode, replay.ContentType, replay.Body)
+		}
+
+		rec := &responseRecorder{ResponseWriter: c.Response().Writer}
+		c.Response().Writer = rec
+		err = next(c)
+		c.Response().Writer = rec.ResponseWriter
+
+		// The claim is released if the request failed, so that it can be retried.
+		// A detached context is used, since the request context may be canceled.
+		cleanupCtx := context.WithoutCancel(ctx)
+		status := c.Response().Status
+		if err != nil || status < http.StatusOK || status >= http.StatusMultipleChoices {
+			if delErr := e.kubeConnector.DeleteConfigMap(cleanupCtx, cm); delErr != nil && !k8serrors.IsNotFound(delErr) {
+				e.log(c).Error(fmt.Errorf("failed to release idempotency key: %w", delErr))
+			}
+			return err
+		}
+
+		record.Complete(status, c.Response().Header().Get(echo.HeaderContentType), rec.body.Bytes(), time.Now())
+		updated, err := idempotency.ToConfigMap(namespace, name, record)
+		if err != nil {
+			e.log(c).Error(fmt.Errorf("failed to record idempotent response: %w", err))
+			return nil
+		}
+		updated.SetResourceVersion(cm.GetResourceVersion())
+		if _, err := e.kubeConnector.UpdateConfigMap(cleanupCtx, updated); err != nil {
+			e.log(c).Error(fmt.Errorf("failed to record idempotent response: %w", err))
+		}
+		return nil
+	}
+}
+
+// claimIdempotencyKey stores the record of a new request under the key. If the
+// key has already been used, the record of the completed request to replay is
+// returned instead.
+func (e *EverestServer) claimIdempotencyKey(
+	ctx context.Context,
+	namespace, name string,
+	record *idempotency.Record,
+) (*corev1.ConfigMap, *idempotency.Record, error) {
+	cm, err := idempotency.ToConfigMap(namespace, name, record)
+	if err != nil {
+		return nil, nil, err
+	}
+	for range idempotencyMaxClaimRounds {
+		created, err := e.kubeConnector.CreateConfigMap(ctx, cm.DeepCopy())
+		if err == nil {
+			return created, nil, nil
+		}
+		if !k8serrors.IsAlreadyExists(err) {
+			return nil, nil, err
+		}
+
+		existing, err := e.kubeConnector.GetConfigMap(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+		if k8serrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		prev, err := idempotency.FromConfigMap(existing)
+		if err != nil {
+			return nil, nil, err
+		}
+		if prev.IsExpired(time.Now()) {
+			if err := e.kubeConnector.DeleteConfigMap(ctx, existing); err != nil && !k8serrors.IsNotFound(err) {
+				return nil, nil, err
+			}
+			continue
+		}
+		if err := prev.Check(record.Fingerprint); err != nil {
+			return nil, nil, err
+		}
+		return nil, prev, nil
+	}
+	return nil, nil, idempotency.ErrInProgress
+}
+
+// readRequestBody returns a copy of the body of the request.
+func (e *EverestServer) readRequestBody(c echo.Context) ([]byte, error) {
+	if c.Request().GetBody == nil {
+		return nil, nil
+	}
+	reader, err := c.Request().GetBody()
+	if err != nil {
+		return nil, errors.Join(errFailedToReadRequestBody, err)
+	}
+	defer reader.Close() //nolint:errcheck
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, errors.Join(errFailedToReadRequestBody, err)
+	}
+	return body, nil
+}
+
+// RunIdempotencyKeyPurgeJob runs the background job that deletes the expired
+// records of idempotency keys.
+func (e *EverestServer) RunIdempotencyKeyPurgeJob(ctx context.Context) {
+	e.runPeriodicJob(ctx, idempotencyPurgeInterval, "purge expired idempotency keys",
+		func(ctx context.Context, namespace string, now time.Time) error {
+			return k8shandler.PurgeExpiredIdempotencyRecords(ctx, e.l, e.kubeConnector, namespace, now)
+		})
+}
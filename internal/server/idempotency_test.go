@@ -0,0 +1,90 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/idempotency"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+func TestIdempotency(t *testing.T) {
+	t.Parallel()
+
+	client := fakeclient.NewClientBuilder().WithScheme(kubernetes.CreateScheme()).Build()
+	e := &EverestServer{
+		kubeConnector: kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(client),
+		l:             zap.NewNop().Sugar(),
+	}
+
+	calls := 0
+	status := http.StatusCreated
+	router := echo.New()
+	router.HTTPErrorHandler = everestErrorHandler(router.DefaultHTTPErrorHandler)
+	router.POST("/v1/namespaces/:namespace/database-clusters", func(c echo.Context) error {
+		calls++
+		return c.JSON(status, map[string]int{"call": calls})
+	}, e.idempotency)
+
+	do := func(user, key, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/v1/namespaces/ns/database-clusters", bytes.NewBufferString(body))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewBufferString(body)), nil
+		}
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		if key != "" {
+			req.Header.Set(idempotencyKeyHeader, key)
+		}
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": user, "iss": "test"})
+		req = req.WithContext(context.WithValue(req.Context(), common.UserCtxKey, token))
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// Requests without a key are not deduplicated.
+	assert.JSONEq(t, `{"call":1}`, do("alice", "", `{}`).Body.String())
+	assert.JSONEq(t, `{"call":2}`, do("alice", "", `{}`).Body.String())
+
+	// A retry replays the original response.
+	rec := do("alice", "k1", `{"a":1}`)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.JSONEq(t, `{"call":3}`, rec.Body.String())
+	rec = do("alice", "k1", `{"a":1}`)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.JSONEq(t, `{"call":3}`, rec.Body.String())
+	assert.Equal(t, "true", rec.Header().Get(idempotentReplayedHeader))
+
+	// Keys are scoped to users.
+	assert.JSONEq(t, `{"call":4}`, do("bob", "k1", `{"a":1}`).Body.String())
+
+	// Reusing a key with a different request is rejected.
+	assert.Equal(t, http.StatusUnprocessableEntity, do("alice", "k1", `{"a":2}`).Code)
+
+	// Failed requests release the key.
+	status = http.StatusBadRequest
+	assert.Equal(t, http.StatusBadRequest, do("alice", "k2", `{}`).Code)
+	status = http.StatusCreated
+	assert.JSONEq(t, `{"call":7}`, do("alice", "k2", `{}`).Body.String())
+
+	cm, err := e.kubeConnector.ListConfigMaps(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, cm.Items, 3)
+	for _, item := range cm.Items {
+		r, err := idempotency.FromConfigMap(&item)
+		require.NoError(t, err)
+		assert.True(t, r.Completed)
+	}
+}
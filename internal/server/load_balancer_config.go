@@ -28,7 +28,7 @@ import (
 func (e *EverestServer) ListLoadBalancerConfig(ctx echo.Context) error {
 	list, err := e.handler.ListLoadBalancerConfigs(ctx.Request().Context())
 	if err != nil {
-		e.l.Errorf("ListLoadBalancerConfig failed: %v", err)
+		e.log(ctx).Errorf("ListLoadBalancerConfig failed: %v", err)
 		return err
 	}
 	return ctx.JSON(http.StatusOK, list)
@@ -43,17 +43,18 @@ func (e *EverestServer) CreateLoadBalancerConfig(c echo.Context) error {
 
 	result, err := e.handler.CreateLoadBalancerConfig(c.Request().Context(), lbc)
 	if err != nil {
-		e.l.Errorf("CreateLoadBalancerConfig failed: %v", err)
+		e.log(c).Errorf("CreateLoadBalancerConfig failed: %v", err)
 		return err
 	}
 
+	setETag(c, result)
 	return c.JSON(http.StatusOK, result)
 }
 
 // DeleteLoadBalancerConfig deletes a load balancer confi.
 func (e *EverestServer) DeleteLoadBalancerConfig(c echo.Context, configName string) error {
 	if err := e.handler.DeleteLoadBalancerConfig(c.Request().Context(), configName); err != nil {
-		e.l.Errorf("DeleteLoadBalancerConfig failed: %v", err)
+		e.log(c).Errorf("DeleteLoadBalancerConfig failed: %v", err)
 		return err
 	}
 	return c.NoContent(http.StatusNoContent)
@@ -63,9 +64,10 @@ func (e *EverestServer) DeleteLoadBalancerConfig(c echo.Context, configName stri
 func (e *EverestServer) GetLoadBalancerConfig(c echo.Context, configName string) error {
 	result, err := e.handler.GetLoadBalancerConfig(c.Request().Context(), configName)
 	if err != nil {
-		e.l.Errorf("GetLoadBalancerConfig failed: %v", err)
+		e.log(c).Errorf("GetLoadBalancerConfig failed: %v", err)
 		return err
 	}
+	setETag(c, result)
 	return c.JSON(http.StatusOK, result)
 }
 
@@ -79,8 +81,9 @@ func (e *EverestServer) UpdateLoadBalancerConfig(c echo.Context, configName stri
 
 	result, err := e.handler.UpdateLoadBalancerConfig(c.Request().Context(), lbc)
 	if err != nil {
-		e.l.Errorf("UpdateLoadBalancerConfig failed: %v", err)
+		e.log(c).Errorf("UpdateLoadBalancerConfig failed: %v", err)
 		return err
 	}
+	setETag(c, result)
 	return c.JSON(http.StatusOK, result)
 }
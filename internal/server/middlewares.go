@@ -20,16 +20,26 @@ import (
 	"net/url"
 	"slices"
 	"strings"
+	"time"
 
-	"github.com/AlekSi/pointer"
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/unrolled/secure"
 	"github.com/unrolled/secure/cspbuilder"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
 	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/etag"
+	"github.com/percona/everest/pkg/logger"
 	"github.com/percona/everest/pkg/oidc"
+	"github.com/percona/everest/pkg/tracing"
 )
 
 const (
@@ -69,7 +79,7 @@ func (e *EverestServer) shouldAllowRequestDuringEngineUpgrade(c echo.Context) (b
 	// Check if there's an engine in this namespace that is upgrading the operator?
 	engines, err := e.kubeConnector.ListDatabaseEngines(c.Request().Context(), ctrlclient.InNamespace(namespace))
 	if err != nil {
-		e.l.Error(err)
+		e.log(c).Error(err)
 		return false, err
 	}
 	locked := slices.ContainsFunc(engines.Items, func(engine everestv1alpha1.DatabaseEngine) bool {
@@ -85,17 +95,148 @@ func (e *EverestServer) shouldAllowRequestDuringEngineUpgrade(c echo.Context) (b
 func (e *EverestServer) checkOperatorUpgradeState(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		if allow, err := e.shouldAllowRequestDuringEngineUpgrade(c); err != nil {
-			e.l.Error(err)
+			e.log(c).Error(err)
 			return err
 		} else if !allow {
-			return c.JSON(http.StatusPreconditionFailed, api.Error{
-				Message: pointer.ToString("Cannot perform this operation while the operator is upgrading"),
-			})
+			return newHTTPError(http.StatusPreconditionFailed, errorCodePreconditionFailed, api.ErrorCategoryFailedPrecondition,
+				"Cannot perform this operation while the operator is upgrading", nil)
 		}
 		return next(c)
 	}
 }
 
+// ifMatch is a middleware that passes the If-Match header of modifying requests
+// to the handlers, which fail with 412 Precondition Failed if the modified
+// resource does not match it.
+func ifMatch(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := c.Request()
+		if header := req.Header.Get("If-Match"); header != "" && req.Method != http.MethodGet {
+			c.SetRequest(req.WithContext(etag.WithIfMatch(req.Context(), header)))
+		}
+		return next(c)
+	}
+}
+
+// tracingMiddleware records a span for each request. The span continues the
+// trace of the W3C trace context of the request, if any.
+func tracingMiddleware(tp trace.TracerProvider, propagator propagation.TextMapPropagator) echo.MiddlewareFunc {
+	tracer := tp.Tracer(tracing.InstrumentationName)
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			if req.RequestURI == "/healthz" {
+				return next(c)
+			}
+
+			ctx := propagator.Extract(req.Context(), propagation.HeaderCarrier(req.Header))
+			route := c.Path()
+			ctx, span := tracer.Start(ctx, strings.TrimSpace(req.Method+" "+route),
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					semconv.HTTPRequestMethodKey.String(req.Method),
+					semconv.HTTPRoute(route),
+					semconv.URLPath(req.URL.Path),
+				),
+			)
+			defer span.End()
+			c.SetRequest(req.WithContext(ctx))
+
+			err := next(c)
+			if err != nil {
+				// The status of the response is only known once the error handler has written it.
+				span.RecordError(err)
+				c.Error(err)
+			}
+			status := c.Response().Status
+			span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+			if status >= http.StatusInternalServerError {
+				span.SetStatus(codes.Error, http.StatusText(status))
+			}
+			return nil
+		}
+	}
+}
+
+// maxRequestIDLength is the maximum length of the request IDs provided by the clients.
+const maxRequestIDLength = 128
+
+// requestID is a middleware that identifies each request by the ID in its
+// X-Request-ID header, or by a generated one, and adds it to the loggers of the request.
+// The ID is returned in the X-Request-ID header of the response.
+func requestID(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := c.Request()
+		id := req.Header.Get(echo.HeaderXRequestID)
+		if !validRequestID(id) {
+			id = uuid.NewString()
+		}
+		c.Response().Header().Set(echo.HeaderXRequestID, id)
+
+		fields := []interface{}{"request_id", id}
+		if sc := trace.SpanContextFromContext(req.Context()); sc.IsValid() {
+			fields = append(fields, "trace_id", sc.TraceID().String())
+		}
+		c.SetRequest(req.WithContext(logger.WithFields(req.Context(), fields...)))
+		return next(c)
+	}
+}
+
+// validRequestID checks that a request ID provided by a client is short and printable.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		if r <= ' ' || r > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// accessLog is a middleware that logs a structured line for each served request.
+func accessLog(l *zap.SugaredLogger) echo.MiddlewareFunc {
+	l = l.Named("access")
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if c.Request().RequestURI == "/healthz" {
+				return next(c)
+			}
+
+			start := time.Now()
+			if err := next(c); err != nil {
+				// The status of the response is only known once the error handler has written it.
+				c.Error(err)
+			}
+
+			// The request is read after it is served, since the next handlers
+			// add the user to its logging fields.
+			req := c.Request()
+			res := c.Response()
+			logger.FromContext(req.Context(), l).Infow("request",
+				"method", req.Method,
+				"route", c.Path(),
+				"uri", req.RequestURI,
+				"status", res.Status,
+				"latency", time.Since(start),
+				"bytes_in", req.ContentLength,
+				"bytes_out", res.Size,
+				"remote_ip", c.RealIP(),
+				"user_agent", req.UserAgent(),
+			)
+			return nil
+		}
+	}
+}
+
+// setETag sets the ETag header of the response to the entity tag of the object.
+func setETag(c echo.Context, obj metav1.Object) {
+	if tag := etag.Of(obj); tag != "" {
+		c.Response().Header().Set("ETag", tag)
+	}
+}
+
 func (e *EverestServer) securityHeaders() echo.MiddlewareFunc {
 	oidcProvider := e.oidcProvider
 	useTLS := e.config.TLSCertsPath != ""
@@ -9,21 +9,34 @@
 package server
 
 import (
+	"errors"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/pkg/etag"
 	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/logger"
 )
 
 func TestShouldAllowRequestDuringEngineUpgrade(t *testing.T) {
@@ -138,3 +151,224 @@ func TestShouldAllowRequestDuringEngineUpgrade(t *testing.T) {
 		})
 	}
 }
+
+func TestIfMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		description string
+		method      string
+		header      string
+		want        []string
+	}{
+		{
+			description: "no header",
+			method:      http.MethodPut,
+		},
+		{
+			description: "update",
+			method:      http.MethodPut,
+			header:      `"1", "2"`,
+			want:        []string{`"1"`, `"2"`},
+		},
+		{
+			description: "ignored on read",
+			method:      http.MethodGet,
+			header:      `"1"`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+			req := httptest.NewRequest(tc.method, "/", nil)
+			if tc.header != "" {
+				req.Header.Set("If-Match", tc.header)
+			}
+			c := echo.New().NewContext(req, httptest.NewRecorder())
+
+			var got []string
+			err := ifMatch(func(c echo.Context) error {
+				got, _ = etag.IfMatch(c.Request().Context())
+				return nil
+			})(c)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestSetETag(t *testing.T) {
+	t.Parallel()
+
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+	setETag(c, &everestv1alpha1.DatabaseCluster{ObjectMeta: metav1.ObjectMeta{ResourceVersion: "42"}})
+	assert.Equal(t, `"42"`, rec.Header().Get("ETag"))
+}
+
+func TestTracingMiddleware(t *testing.T) {
+	t.Parallel()
+
+	const (
+		traceID      = "4bf92f3577b34da6a3ce929d0e0e4736"
+		parentSpanID = "00f067aa0ba902b7"
+	)
+	testCases := []struct {
+		description string
+		handler     echo.HandlerFunc
+		traceparent string
+		wantStatus  int
+		wantCode    codes.Code
+	}{
+		{
+			description: "new trace",
+			handler:     func(c echo.Context) error { return c.NoContent(http.StatusOK) },
+			wantStatus:  http.StatusOK,
+		},
+		{
+			description: "continued trace",
+			handler:     func(c echo.Context) error { return c.NoContent(http.StatusOK) },
+			traceparent: "00-" + traceID + "-" + parentSpanID + "-01",
+			wantStatus:  http.StatusOK,
+		},
+		{
+			description: "client error",
+			handler:     func(echo.Context) error { return echo.NewHTTPError(http.StatusNotFound) },
+			wantStatus:  http.StatusNotFound,
+		},
+		{
+			description: "server error",
+			handler:     func(echo.Context) error { return errors.New("boom") },
+			wantStatus:  http.StatusInternalServerError,
+			wantCode:    codes.Error,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+			recorder := tracetest.NewSpanRecorder()
+			tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+			var handlerSpan trace.SpanContext
+			router := echo.New()
+			router.Use(tracingMiddleware(tp, propagation.TraceContext{}))
+			router.GET("/v1/namespaces/:namespace/database-clusters/:name", func(c echo.Context) error {
+				handlerSpan = trace.SpanContextFromContext(c.Request().Context())
+				return tc.handler(c)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/namespaces/ns/database-clusters/db", nil)
+			if tc.traceparent != "" {
+				req.Header.Set("traceparent", tc.traceparent)
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+			assert.Equal(t, tc.wantStatus, rec.Code)
+
+			spans := recorder.Ended()
+			require.Len(t, spans, 1)
+			span := spans[0]
+			assert.Equal(t, "GET /v1/namespaces/:namespace/database-clusters/:name", span.Name())
+			assert.Equal(t, trace.SpanKindServer, span.SpanKind())
+			assert.Equal(t, span.SpanContext(), handlerSpan)
+			assert.Equal(t, tc.wantCode, span.Status().Code)
+			assert.Contains(t, span.Attributes(), semconv.HTTPResponseStatusCode(tc.wantStatus))
+			if tc.traceparent != "" {
+				assert.Equal(t, traceID, span.SpanContext().TraceID().String())
+				assert.Equal(t, parentSpanID, span.Parent().SpanID().String())
+			} else {
+				assert.False(t, span.Parent().IsValid())
+			}
+		})
+	}
+}
+
+func TestRequestID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		description string
+		header      string
+		want        string
+	}{
+		{
+			description: "generated",
+		},
+		{
+			description: "provided",
+			header:      "3f2a-req",
+			want:        "3f2a-req",
+		},
+		{
+			description: "not printable",
+			header:      "bad id\n",
+		},
+		{
+			description: "too long",
+			header:      strings.Repeat("a", maxRequestIDLength+1),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+			core, logs := observer.New(zap.InfoLevel)
+			l := zap.New(core).Sugar()
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tc.header != "" {
+				req.Header.Set(echo.HeaderXRequestID, tc.header)
+			}
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+
+			err := requestID(func(c echo.Context) error {
+				logger.FromContext(c.Request().Context(), l).Info("served")
+				return nil
+			})(c)
+			require.NoError(t, err)
+
+			id := rec.Header().Get(echo.HeaderXRequestID)
+			if tc.want != "" {
+				assert.Equal(t, tc.want, id)
+			} else {
+				assert.NoError(t, uuid.Validate(id))
+			}
+			require.Equal(t, 1, logs.Len())
+			assert.Equal(t, id, logs.All()[0].ContextMap()["request_id"])
+		})
+	}
+}
+
+func TestAccessLog(t *testing.T) {
+	t.Parallel()
+
+	core, logs := observer.New(zap.InfoLevel)
+	router := echo.New()
+	router.Use(requestID, accessLog(zap.New(core).Sugar()))
+	router.GET("/healthz", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+	router.GET("/v1/namespaces/:namespace/database-clusters/:name", func(c echo.Context) error {
+		// Set by the authentication middleware.
+		c.SetRequest(c.Request().WithContext(logger.WithFields(c.Request().Context(), "user", "alice")))
+		return echo.NewHTTPError(http.StatusNotFound)
+	})
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	req := httptest.NewRequest(http.MethodGet, "/v1/namespaces/ns/database-clusters/db?x=1", nil)
+	req.Header.Set(echo.HeaderXRequestID, "req-1")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	require.Equal(t, 1, logs.Len())
+	entry := logs.All()[0]
+	assert.Equal(t, "access", entry.LoggerName)
+	fields := entry.ContextMap()
+	assert.Equal(t, "req-1", fields["request_id"])
+	assert.Equal(t, "alice", fields["user"])
+	assert.Equal(t, http.MethodGet, fields["method"])
+	assert.Equal(t, "/v1/namespaces/:namespace/database-clusters/:name", fields["route"])
+	assert.Equal(t, "/v1/namespaces/ns/database-clusters/db?x=1", fields["uri"])
+	assert.EqualValues(t, http.StatusNotFound, fields["status"])
+	assert.EqualValues(t, rec.Body.Len(), fields["bytes_out"])
+	assert.Contains(t, fields, "latency")
+}
@@ -37,12 +37,13 @@ func (e *EverestServer) CreateMonitoringInstance(ctx echo.Context, namespace str
 
 	out := &api.MonitoringInstance{}
 	out.FromCR(created)
+	setETag(ctx, created)
 	return ctx.JSON(http.StatusOK, out)
 }
 
 // ListMonitoringInstances lists all monitoring instances.
-func (e *EverestServer) ListMonitoringInstances(ctx echo.Context, namespace string) error {
-	mcList, err := e.handler.ListMonitoringInstances(ctx.Request().Context(), namespace)
+func (e *EverestServer) ListMonitoringInstances(ctx echo.Context, namespace string, params api.ListMonitoringInstancesParams) error {
+	mcList, err := e.handler.ListMonitoringInstances(ctx.Request().Context(), namespace, &params)
 	if err != nil {
 		return err
 	}
@@ -65,6 +66,7 @@ func (e *EverestServer) GetMonitoringInstance(ctx echo.Context, namespace, name
 
 	out := &api.MonitoringInstance{}
 	out.FromCR(m)
+	setETag(ctx, m)
 	return ctx.JSON(http.StatusOK, out)
 }
 
@@ -82,6 +84,7 @@ func (e *EverestServer) UpdateMonitoringInstance(ctx echo.Context, namespace, na
 
 	out := &api.MonitoringInstance{}
 	out.FromCR(updated)
+	setETag(ctx, updated)
 	return ctx.JSON(http.StatusOK, out)
 }
 
@@ -10,7 +10,17 @@ import (
 func (e *EverestServer) ListNamespaces(ctx echo.Context) error {
 	result, err := e.handler.ListNamespaces(ctx.Request().Context())
 	if err != nil {
-		e.l.Errorf("ListNamespaces failed: %w", err)
+		e.log(ctx).Errorf("ListNamespaces failed: %w", err)
+		return err
+	}
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// GetNamespaceQuota returns the quota of the given namespace and its current usage.
+func (e *EverestServer) GetNamespaceQuota(ctx echo.Context, namespace string) error {
+	result, err := e.handler.GetNamespaceQuota(ctx.Request().Context(), namespace)
+	if err != nil {
+		e.log(ctx).Errorf("GetNamespaceQuota failed: %v", err)
 		return err
 	}
 	return ctx.JSON(http.StatusOK, result)
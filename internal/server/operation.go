@@ -0,0 +1,89 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/percona/everest/api"
+	k8shandler "github.com/percona/everest/internal/server/handlers/k8s"
+)
+
+const (
+	// preferHeader is the request header used to ask for an operation that
+	// tracks the progress of an asynchronous action.
+	preferHeader       = "Prefer"
+	preferRespondAsync = "respond-async"
+	// operationIDHeader and operationLocationHeader are the response headers
+	// that identify the operation.
+	operationIDHeader       = "Operation-Id"
+	operationLocationHeader = "Operation-Location"
+
+	// operationPurgeInterval is the interval between two runs of the operation purge job.
+	operationPurgeInterval = time.Hour
+)
+
+// ListOperations lists the operations in the specified namespace.
+func (e *EverestServer) ListOperations(c echo.Context, namespace string) error {
+	result, err := e.handler.ListOperations(c.Request().Context(), namespace)
+	if err != nil {
+		e.log(c).Errorf("ListOperations failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// GetOperation returns the specified operation.
+func (e *EverestServer) GetOperation(c echo.Context, namespace, name string) error {
+	result, err := e.handler.GetOperation(c.Request().Context(), namespace, name)
+	if err != nil {
+		e.log(c).Errorf("GetOperation failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// startOperation creates an operation that tracks the asynchronous action of
+// the request if the client prefers an asynchronous response, and returns it
+// in the response headers. It must be called once the action is accepted.
+// Since the action has already been carried out, a failure to record the
+// operation is only logged and the response is sent without the operation
+// headers.
+func (e *EverestServer) startOperation(c echo.Context, namespace string, opType api.OperationType, target string) {
+	if !prefersRespondAsync(c.Request()) {
+		return
+	}
+	op, err := e.handler.CreateOperation(c.Request().Context(), namespace, opType, target)
+	if err != nil {
+		e.log(c).Errorf("CreateOperation failed: %v", err)
+		return
+	}
+	c.Response().Header().Set(operationIDHeader, op.Name)
+	c.Response().Header().Set(operationLocationHeader,
+		fmt.Sprintf("/v1/namespaces/%s/operations/%s", op.Namespace, op.Name))
+}
+
+// prefersRespondAsync returns true if the request carries the respond-async
+// preference (RFC 7240).
+func prefersRespondAsync(req *http.Request) bool {
+	for _, header := range req.Header.Values(preferHeader) {
+		for _, pref := range strings.Split(header, ",") {
+			if strings.EqualFold(strings.TrimSpace(pref), preferRespondAsync) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// RunOperationPurgeJob runs the background job that deletes the expired operations.
+func (e *EverestServer) RunOperationPurgeJob(ctx context.Context) {
+	e.runPeriodicJob(ctx, operationPurgeInterval, "purge expired operations",
+		func(ctx context.Context, namespace string, now time.Time) error {
+			return k8shandler.PurgeExpiredOperations(ctx, e.l, e.kubeConnector, namespace, now)
+		})
+}
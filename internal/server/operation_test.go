@@ -0,0 +1,97 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/internal/server/handlers"
+	k8shandler "github.com/percona/everest/internal/server/handlers/k8s"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+func TestStartOperation(t *testing.T) {
+	t.Parallel()
+
+	client := fakeclient.NewClientBuilder().WithScheme(kubernetes.CreateScheme()).Build()
+	kubeConnector := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(client)
+	e := &EverestServer{
+		kubeConnector: kubeConnector,
+		handler:       k8shandler.New(zap.NewNop().Sugar(), kubeConnector, ""),
+		l:             zap.NewNop().Sugar(),
+	}
+
+	testCases := []struct {
+		name   string
+		prefer []string
+		want   bool
+	}{
+		{name: "no preference"},
+		{name: "other preference", prefer: []string{"return=minimal"}},
+		{name: "respond-async", prefer: []string{"respond-async"}, want: true},
+		{name: "several preferences", prefer: []string{"return=minimal, Respond-Async"}, want: true},
+		{name: "several headers", prefer: []string{"return=minimal", "respond-async"}, want: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			req := httptest.NewRequest(http.MethodDelete, "/v1/namespaces/ns/database-clusters/db", nil)
+			for _, p := range tc.prefer {
+				req.Header.Add(preferHeader, p)
+			}
+			token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "alice", "iss": "test"})
+			req = req.WithContext(context.WithValue(req.Context(), common.UserCtxKey, token))
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+
+			e.startOperation(c, "ns", api.OperationTypeDatabaseClusterDeletion, "db")
+			id := rec.Header().Get(operationIDHeader)
+			if !tc.want {
+				assert.Empty(t, id)
+				return
+			}
+			require.NotEmpty(t, id)
+			assert.Equal(t, "/v1/namespaces/ns/operations/"+id, rec.Header().Get(operationLocationHeader))
+
+			op, err := e.handler.GetOperation(req.Context(), "ns", id)
+			require.NoError(t, err)
+			assert.Equal(t, "alice", op.CreatedBy)
+			assert.Equal(t, api.OperationStateSucceeded, op.State)
+		})
+	}
+}
+
+func TestStartOperationFailure(t *testing.T) {
+	t.Parallel()
+
+	h := handlers.NewMockHandler(t)
+	h.On("CreateOperation", mock.Anything, "ns", api.OperationTypeDatabaseClusterDeletion, "db").
+		Return(nil, errors.New("operation could not be created"))
+	e := &EverestServer{
+		handler: h,
+		l:       zap.NewNop().Sugar(),
+	}
+
+	req := httptest.NewRequest(http.MethodDelete, "/v1/namespaces/ns/database-clusters/db", nil)
+	req.Header.Add(preferHeader, preferRespondAsync)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	// The action was already carried out, so the failure is not returned and
+	// the response carries no operation headers.
+	e.startOperation(c, "ns", api.OperationTypeDatabaseClusterDeletion, "db")
+	assert.Empty(t, rec.Header().Get(operationIDHeader))
+	assert.Empty(t, rec.Header().Get(operationLocationHeader))
+}
@@ -0,0 +1,162 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	"github.com/percona/everest/pkg/common"
+)
+
+const (
+	backgroundJobsLeaseDuration = 15 * time.Second
+	backgroundJobsRenewDeadline = 10 * time.Second
+	backgroundJobsRetryPeriod   = 2 * time.Second
+
+	// backgroundJobsLeaseMinBackoff and backgroundJobsLeaseMaxBackoff bound
+	// the wait before checking again the access to the Lease.
+	backgroundJobsLeaseMinBackoff = 10 * time.Second
+	backgroundJobsLeaseMaxBackoff = 5 * time.Minute
+)
+
+// backgroundJobsLeaseVerbs are the verbs the leader election needs on the Lease.
+var backgroundJobsLeaseVerbs = []string{"get", "create", "update"} //nolint:gochecknoglobals
+
+// namespaceJobFunc runs a periodic job in the provided DB namespace.
+type namespaceJobFunc func(ctx context.Context, namespace string, now time.Time) error
+
+// RunLeaderElection elects the replica of the server that runs the background
+// jobs, so that they do not run concurrently when the server is scaled out.
+// While the server is not allowed to use the Lease, the jobs run on this
+// replica without leader election and the access is checked again with an
+// exponential backoff. It returns when ctx is done.
+func (e *EverestServer) RunLeaderElection(ctx context.Context) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "everest-server"
+	}
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Name:      common.PerconaEverestServerLeaseName,
+			Namespace: common.SystemNamespace,
+		},
+		Client: e.kubeStreamer.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity: hostname + "_" + uuid.NewString(),
+		},
+	}
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		LeaseDuration:   backgroundJobsLeaseDuration,
+		RenewDeadline:   backgroundJobsRenewDeadline,
+		RetryPeriod:     backgroundJobsRetryPeriod,
+		ReleaseOnCancel: true,
+		Name:            common.PerconaEverestServerLeaseName,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(context.Context) {
+				e.l.Info("Started leading, running the background jobs")
+				e.leading.Store(true)
+			},
+			OnStoppedLeading: func() {
+				e.l.Info("Stopped leading, the background jobs are run by another replica")
+				e.leading.Store(false)
+			},
+		},
+	})
+	if err != nil {
+		e.l.Error(fmt.Errorf("failed to create leader elector, running the background jobs without leader election: %w", err))
+		e.leading.Store(true)
+		return
+	}
+
+	backoff := backgroundJobsLeaseMinBackoff
+	for ctx.Err() == nil {
+		if err := e.checkLeaseAccess(ctx); err != nil {
+			e.l.Errorf("Cannot use the %s/%s Lease, running the background jobs without leader election, retrying in %s: %v",
+				common.SystemNamespace, common.PerconaEverestServerLeaseName, backoff, err)
+			e.leading.Store(true)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+			backoff = min(2*backoff, backgroundJobsLeaseMaxBackoff)
+			continue
+		}
+		backoff = backgroundJobsLeaseMinBackoff
+		e.leading.Store(false)
+		// The elector returns once the leadership is lost, try to acquire it again.
+		elector.Run(ctx)
+	}
+}
+
+// checkLeaseAccess returns an error if the server is not allowed to use the
+// Lease of the leader election.
+func (e *EverestServer) checkLeaseAccess(ctx context.Context) error {
+	for _, verb := range backgroundJobsLeaseVerbs {
+		review, err := e.kubeStreamer.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+				ResourceAttributes: &authorizationv1.ResourceAttributes{
+					Namespace: common.SystemNamespace,
+					Verb:      verb,
+					Group:     coordinationv1.GroupName,
+					Resource:  "leases",
+				},
+			},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to check access to leases: %w", err)
+		}
+		if !review.Status.Allowed {
+			return fmt.Errorf("%s on %s leases is not allowed", verb, coordinationv1.GroupName)
+		}
+	}
+	return nil
+}
+
+// runPeriodicJob calls fn for each DB namespace every interval until ctx is
+// done. The ticks are skipped while this replica is not the leader.
+// description completes the error message logged when a run fails,
+// e.g. "failed to <description>".
+func (e *EverestServer) runPeriodicJob(ctx context.Context, interval time.Duration, description string, fn namespaceJobFunc) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !e.leading.Load() {
+				continue
+			}
+			if err := e.runNamespaceJob(ctx, fn); err != nil {
+				e.l.Error(fmt.Errorf("failed to %s: %w", description, err))
+			}
+		}
+	}
+}
+
+func (e *EverestServer) runNamespaceJob(ctx context.Context, fn namespaceJobFunc) error {
+	namespaces, err := e.kubeConnector.GetDBNamespaces(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get watched namespaces: %w", err)
+	}
+	now := time.Now()
+	var errs []error
+	for _, ns := range namespaces.Items {
+		if err := fn(ctx, ns.GetName(), now); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
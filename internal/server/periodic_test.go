@@ -0,0 +1,82 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+func TestRunNamespaceJob(t *testing.T) {
+	t.Parallel()
+
+	namespace := func(name string, managed bool) *corev1.Namespace {
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if managed {
+			ns.SetLabels(map[string]string{common.KubernetesManagedByLabel: common.Everest})
+		}
+		return ns
+	}
+	client := fakeclient.NewClientBuilder().
+		WithScheme(kubernetes.CreateScheme()).
+		WithObjects(
+			namespace("a", true),
+			namespace("b", true),
+			namespace("unmanaged", false),
+			namespace(common.SystemNamespace, true),
+		).
+		Build()
+	e := &EverestServer{
+		kubeConnector: kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(client),
+		l:             zap.NewNop().Sugar(),
+	}
+
+	// The job runs in every DB namespace with the same time, even if it fails in one of them.
+	var namespaces []string
+	var times []time.Time
+	err := e.runNamespaceJob(context.Background(), func(_ context.Context, namespace string, now time.Time) error {
+		namespaces = append(namespaces, namespace)
+		times = append(times, now)
+		if namespace == "a" {
+			return errors.New("failed")
+		}
+		return nil
+	})
+	require.Error(t, err)
+	assert.ElementsMatch(t, []string{"a", "b"}, namespaces)
+	require.Len(t, times, 2)
+	assert.Equal(t, times[0], times[1])
+}
+
+func TestCheckLeaseAccess(t *testing.T) {
+	t.Parallel()
+
+	newServer := func(allowed ...string) *EverestServer {
+		clientset := fakeclientset.NewClientset()
+		clientset.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			review, ok := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+			require.True(t, ok)
+			review.Status.Allowed = slices.Contains(allowed, review.Spec.ResourceAttributes.Verb)
+			return true, review, nil
+		})
+		return &EverestServer{kubeStreamer: clientset}
+	}
+
+	require.NoError(t, newServer("get", "create", "update").checkLeaseAccess(context.Background()))
+	assert.ErrorContains(t, newServer("get", "update").checkLeaseAccess(context.Background()), "create on coordination.k8s.io leases is not allowed")
+}
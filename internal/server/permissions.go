@@ -10,7 +10,7 @@ import (
 func (e *EverestServer) GetUserPermissions(c echo.Context) error {
 	permissions, err := e.handler.GetUserPermissions(c.Request().Context())
 	if err != nil {
-		e.l.Errorf("GetUserPermissions failed: %w", err)
+		e.log(c).Errorf("GetUserPermissions failed: %w", err)
 		return err
 	}
 	return c.JSON(http.StatusOK, permissions)
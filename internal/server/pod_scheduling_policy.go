@@ -29,7 +29,7 @@ import (
 func (e *EverestServer) ListPodSchedulingPolicy(ctx echo.Context, params api.ListPodSchedulingPolicyParams) error {
 	list, err := e.handler.ListPodSchedulingPolicies(ctx.Request().Context(), &params)
 	if err != nil {
-		e.l.Errorf("ListPodSchedulingPolicies failed: %v", err)
+		e.log(ctx).Errorf("ListPodSchedulingPolicies failed: %v", err)
 		return err
 	}
 	return ctx.JSON(http.StatusOK, list)
@@ -44,17 +44,18 @@ func (e *EverestServer) CreatePodSchedulingPolicy(c echo.Context) error {
 
 	result, err := e.handler.CreatePodSchedulingPolicy(c.Request().Context(), psp)
 	if err != nil {
-		e.l.Errorf("CreatePodSchedulingPolicy failed: %v", err)
+		e.log(c).Errorf("CreatePodSchedulingPolicy failed: %v", err)
 		return err
 	}
 
+	setETag(c, result)
 	return c.JSON(http.StatusOK, result)
 }
 
 // DeletePodSchedulingPolicy deletes a pod scheduling policy.
 func (e *EverestServer) DeletePodSchedulingPolicy(c echo.Context, policyName string) error {
 	if err := e.handler.DeletePodSchedulingPolicy(c.Request().Context(), policyName); err != nil {
-		e.l.Errorf("DeletePodSchedulingPolicy failed: %v", err)
+		e.log(c).Errorf("DeletePodSchedulingPolicy failed: %v", err)
 		return err
 	}
 	return c.NoContent(http.StatusNoContent)
@@ -64,9 +65,10 @@ func (e *EverestServer) DeletePodSchedulingPolicy(c echo.Context, policyName str
 func (e *EverestServer) GetPodSchedulingPolicy(c echo.Context, policyName string) error {
 	result, err := e.handler.GetPodSchedulingPolicy(c.Request().Context(), policyName)
 	if err != nil {
-		e.l.Errorf("GetPodSchedulingPolicy failed: %v", err)
+		e.log(c).Errorf("GetPodSchedulingPolicy failed: %v", err)
 		return err
 	}
+	setETag(c, result)
 	return c.JSON(http.StatusOK, result)
 }
 
@@ -80,8 +82,9 @@ func (e *EverestServer) UpdatePodSchedulingPolicy(c echo.Context, policyName str
 
 	result, err := e.handler.UpdatePodSchedulingPolicy(c.Request().Context(), psp)
 	if err != nil {
-		e.l.Errorf("UpdatePodSchedulingPolicy failed: %v", err)
+		e.log(c).Errorf("UpdatePodSchedulingPolicy failed: %v", err)
 		return err
 	}
+	setETag(c, result)
 	return c.JSON(http.StatusOK, result)
 }
@@ -0,0 +1,144 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/labstack/echo/v4"
+	middleware "github.com/oapi-codegen/echo-middleware"
+
+	"github.com/percona/everest/api"
+)
+
+// errRequestDoesNotMatchSpec is the message of requests rejected by the request validator.
+const errRequestDoesNotMatchSpec = "request does not match the API specification"
+
+// registerBodyDecoders registers the decoders of the request bodies that
+// openapi3filter does not know. The registry is global, so it is done once.
+var registerBodyDecoders = sync.OnceFunc(func() { //nolint:gochecknoglobals
+	// Partial updates are validated as JSON documents.
+	openapi3filter.RegisterBodyDecoder("application/merge-patch+json", openapi3filter.JSONBodyDecoder)
+})
+
+// requestValidator returns a middleware that validates every request against
+// the OpenAPI specification before it reaches the handlers: the path and
+// query parameters, the headers and the body, including enum values and
+// required fields. All violations are reported at once.
+func requestValidator(swagger *openapi3.T) echo.MiddlewareFunc {
+	registerBodyDecoders()
+	return middleware.OapiRequestValidatorWithOptions(swagger, &middleware.Options{
+		SilenceServersWarning: true,
+		Options: openapi3filter.Options{
+			// This field is required if a security scheme is specified.
+			// However, the actual authentication is handled by the JWT middleware, so we can use a noop function here.
+			AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+			MultiError:         true,
+		},
+		MultiErrorHandler: requestValidationError,
+	})
+}
+
+// requestValidationError returns the error of a request that does not match the specification.
+func requestValidationError(me openapi3.MultiError) *echo.HTTPError {
+	message := errRequestDoesNotMatchSpec
+	if len(me) > 0 {
+		// The errors of openapi3filter are multi-line with a decent message on the first line.
+		message = strings.Split(me[0].Error(), "\n")[0]
+	}
+	result := newHTTPError(http.StatusBadRequest, errorCodeInvalidRequest,
+		api.ErrorCategoryInvalid, message, requestViolations(me))
+	result.Internal = me
+	return result
+}
+
+// requestViolations returns the field violations of a request that does not
+// match the OpenAPI specification.
+func requestViolations(err error) []api.FieldViolation {
+	// The errors are matched by type, since errors.As would find the
+	// MultiError of the schema errors wrapped in a request error.
+	if me, ok := err.(openapi3.MultiError); ok { //nolint:errorlint
+		var result []api.FieldViolation
+		for _, e := range me {
+			result = append(result, requestViolations(e)...)
+		}
+		return result
+	}
+	var reqErr *openapi3filter.RequestError
+	if !errors.As(err, &reqErr) {
+		return nil
+	}
+	var field string
+	switch {
+	case reqErr.Parameter != nil:
+		field = reqErr.Parameter.Name
+	case reqErr.RequestBody != nil:
+		field = "body"
+	}
+	if violations := schemaViolations(reqErr.Err, field); len(violations) > 0 {
+		return violations
+	}
+	if field == "" {
+		return nil
+	}
+	reason := reqErr.Reason
+	if reason == "" && reqErr.Err != nil {
+		reason = reqErr.Err.Error()
+	}
+	return []api.FieldViolation{{Field: field, Reason: reason}}
+}
+
+// schemaViolations returns the violations of the schema errors in err. Errors
+// without a path are attributed to the given field.
+func schemaViolations(err error, field string) []api.FieldViolation {
+	return schemaViolationsAt(err, nil, field)
+}
+
+// schemaViolationsAt returns the violations of the schema errors in err, whose
+// paths are relative to the given path.
+func schemaViolationsAt(err error, path []string, field string) []api.FieldViolation {
+	if me, ok := err.(openapi3.MultiError); ok { //nolint:errorlint
+		var result []api.FieldViolation
+		for _, e := range me {
+			result = append(result, schemaViolationsAt(e, path, field)...)
+		}
+		return result
+	}
+	var schemaErr *openapi3.SchemaError
+	if !errors.As(err, &schemaErr) {
+		return nil
+	}
+	path = append(slices.Clone(path), schemaErr.JSONPointer()...)
+	// Errors of composed schemas (allOf, anyOf, ...) are reported by their origin.
+	if schemaErr.Origin != nil {
+		if violations := schemaViolationsAt(schemaErr.Origin, path, field); len(violations) > 0 {
+			return violations
+		}
+	}
+	if len(path) > 0 {
+		field = strings.Join(path, ".")
+	}
+	if field == "" {
+		return nil
+	}
+	return []api.FieldViolation{{Field: field, Reason: schemaErr.Reason}}
+}
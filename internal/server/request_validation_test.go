@@ -0,0 +1,200 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/everest/api"
+)
+
+func TestRequestValidator(t *testing.T) {
+	t.Parallel()
+
+	swagger, err := api.GetSwagger()
+	require.NoError(t, err)
+	basePath, err := swagger.Servers.BasePath()
+	require.NoError(t, err)
+
+	router := echo.New()
+	router.HTTPErrorHandler = everestErrorHandler(router.DefaultHTTPErrorHandler)
+	group := router.Group(basePath)
+	group.Use(requestValidator(swagger))
+	group.Any("/*", func(c echo.Context) error {
+		return c.NoContent(http.StatusNoContent)
+	})
+
+	do := func(method, target, contentType, body string) (int, api.Error) {
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		if contentType != "" {
+			req.Header.Set(echo.HeaderContentType, contentType)
+		}
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		var apiErr api.Error
+		if rec.Code != http.StatusNoContent {
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &apiErr), rec.Body.String())
+		}
+		return rec.Code, apiErr
+	}
+
+	for path, item := range swagger.Paths.Map() {
+		for method, op := range item.Operations() {
+			params := append(openapi3.Parameters{}, item.Parameters...)
+			params = append(params, op.Parameters...)
+
+			target := basePath + path
+			var required []string
+			var enumParam *openapi3.Parameter
+			for _, ref := range params {
+				p := ref.Value
+				switch p.In {
+				case openapi3.ParameterInPath:
+					target = strings.ReplaceAll(target, "{"+p.Name+"}", url.PathEscape(validValue(p)))
+				case openapi3.ParameterInQuery, openapi3.ParameterInHeader:
+					if p.Required {
+						required = append(required, p.Name)
+					}
+					if p.In == openapi3.ParameterInQuery && p.Schema != nil && p.Schema.Value != nil &&
+						len(p.Schema.Value.Enum) > 0 && enumParam == nil {
+						enumParam = p
+					}
+				}
+			}
+			var contentType string
+			var bodySchema *openapi3.Schema
+			if op.RequestBody != nil && op.RequestBody.Value != nil {
+				if op.RequestBody.Value.Required {
+					required = append(required, "body")
+				}
+				for ct, mt := range op.RequestBody.Value.Content {
+					contentType = ct
+					if mt.Schema != nil {
+						bodySchema = mt.Schema.Value
+					}
+					break
+				}
+			}
+
+			t.Run(op.OperationID, func(t *testing.T) {
+				t.Parallel()
+
+				// A request without a body and optional parameters reaches the
+				// handler, unless a body or a parameter is required.
+				status, apiErr := do(method, target, "", "")
+				if len(required) == 0 {
+					assert.Equal(t, http.StatusNoContent, status, pointer.Get(apiErr.Message))
+				} else {
+					assert.Equal(t, http.StatusBadRequest, status)
+					assert.Equal(t, errorCodeInvalidRequest, pointer.Get(apiErr.Code))
+					require.NotNil(t, apiErr.FieldViolations)
+					for _, v := range *apiErr.FieldViolations {
+						assert.Contains(t, required, v.Field)
+					}
+				}
+
+				// A body of the wrong type is rejected.
+				if bodySchema != nil && bodySchema.Type.Is(openapi3.TypeObject) {
+					status, apiErr := do(method, target, contentType, `[]`)
+					assert.Equal(t, http.StatusBadRequest, status)
+					assert.Equal(t, api.ErrorCategoryInvalid, pointer.Get(apiErr.Category))
+					assert.NotNil(t, apiErr.FieldViolations)
+				}
+
+				// A value that is not one of the enum values is rejected.
+				if enumParam != nil {
+					sep := "?"
+					if strings.Contains(target, "?") {
+						sep = "&"
+					}
+					status, apiErr := do(method, target+sep+url.QueryEscape(enumParam.Name)+"=not-an-enum-value", "", "")
+					assert.Equal(t, http.StatusBadRequest, status)
+					require.NotNil(t, apiErr.FieldViolations)
+					assert.Equal(t, enumParam.Name, (*apiErr.FieldViolations)[0].Field)
+				}
+			})
+		}
+	}
+}
+
+func TestRequestValidatorBodyViolations(t *testing.T) {
+	t.Parallel()
+
+	swagger, err := api.GetSwagger()
+	require.NoError(t, err)
+
+	router := echo.New()
+	router.HTTPErrorHandler = everestErrorHandler(router.DefaultHTTPErrorHandler)
+	group := router.Group("/v1")
+	group.Use(requestValidator(swagger))
+	group.Any("/*", func(c echo.Context) error {
+		return c.NoContent(http.StatusNoContent)
+	})
+
+	testCases := []struct {
+		name       string
+		target     string
+		body       string
+		wantFields []string
+	}{
+		{
+			name:       "monitoring instance",
+			target:     "/v1/namespaces/ns/monitoring-instances",
+			body:       `{"name":"pmm","type":"unknown","url":42}`,
+			wantFields: []string{"type", "url"},
+		},
+		{
+			name:   "database cluster",
+			target: "/v1/namespaces/ns/database-clusters",
+			body: `{"apiVersion":"everest.percona.com/v1alpha1","kind":"DatabaseCluster","metadata":{"name":"db"},` +
+				`"spec":{"engine":{"type":"unknown","replicas":"three"}}}`,
+			wantFields: []string{"spec.engine.type", "spec.engine.replicas", "spec.engine.storage"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			req := httptest.NewRequest(http.MethodPost, tc.target, strings.NewReader(tc.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, http.StatusBadRequest, rec.Code)
+			var apiErr api.Error
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &apiErr))
+			require.NotNil(t, apiErr.FieldViolations)
+			fields := make([]string, 0, len(*apiErr.FieldViolations))
+			for _, v := range *apiErr.FieldViolations {
+				fields = append(fields, v.Field)
+			}
+			assert.ElementsMatch(t, tc.wantFields, fields)
+		})
+	}
+}
+
+// validValue returns a value that matches the schema of the parameter.
+func validValue(p *openapi3.Parameter) string {
+	if p.Schema == nil || p.Schema.Value == nil {
+		return "test"
+	}
+	s := p.Schema.Value
+	switch {
+	case len(s.Enum) > 0:
+		return fmt.Sprint(s.Enum[0])
+	case s.Type.Is(openapi3.TypeInteger), s.Type.Is(openapi3.TypeNumber):
+		return "1"
+	case s.Type.Is(openapi3.TypeBoolean):
+		return "true"
+	}
+	return "test"
+}
@@ -0,0 +1,32 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	k8shandler "github.com/percona/everest/internal/server/handlers/k8s"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+const (
+	// schedulerInterval is the interval between two runs of the database cluster scheduler.
+	schedulerInterval = time.Minute
+	// schedulerUser is the name of the system user the scheduler acts as.
+	schedulerUser = "scheduler"
+)
+
+// RunScheduler runs the background job that executes the due database cluster schedules.
+func (e *EverestServer) RunScheduler(ctx context.Context) {
+	opts, err := k8sHandlerOptions(e.config)
+	if err != nil {
+		e.l.Error(fmt.Errorf("failed to start the scheduler: %w", err))
+		return
+	}
+	// The schedules update the database clusters through the handler chain as the system user.
+	ctx = rbac.WithSystemUser(ctx, schedulerUser)
+	e.runPeriodicJob(ctx, schedulerInterval, "run database cluster schedules",
+		func(ctx context.Context, namespace string, now time.Time) error {
+			return k8shandler.RunDatabaseClusterSchedules(ctx, e.l, e.kubeConnector, e.handler, namespace, now, opts...)
+		})
+}
@@ -22,7 +22,6 @@ import (
 	"net/http"
 	"time"
 
-	"github.com/AlekSi/pointer"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 
@@ -47,7 +46,7 @@ func (e *EverestServer) CreateSession(ctx echo.Context) error {
 	err := e.sessionMgr.Authenticate(c, *params.Username, *params.Password)
 	if err != nil {
 		e.attemptsStore.IncreaseTimeout(ctx.RealIP())
-		return sessionErrToHTTPRes(ctx, err)
+		return sessionErrToHTTPRes(err)
 	}
 
 	uniqueID, err := uuid.NewRandom()
@@ -77,33 +76,29 @@ func (e *EverestServer) DeleteSession(ctx echo.Context) error {
 	}
 	err = e.sessionMgr.Block(c, token)
 	if err != nil {
-		e.l.Errorf("blocklist error: %v", err)
-		return ctx.JSON(http.StatusInternalServerError, api.Error{
-			Message: pointer.To("Failed to logout user"),
-		})
+		e.log(ctx).Errorf("blocklist error: %v", err)
+		return newHTTPError(http.StatusInternalServerError, errorCodeInternal, api.ErrorCategoryInternal,
+			"Failed to logout user", nil)
 	}
 
 	return ctx.NoContent(http.StatusNoContent)
 }
 
-func sessionErrToHTTPRes(ctx echo.Context, err error) error {
+func sessionErrToHTTPRes(err error) error {
 	if errors.Is(err, accounts.ErrAccountNotFound) ||
 		errors.Is(err, accounts.ErrIncorrectPassword) {
-		return ctx.JSON(http.StatusUnauthorized, api.Error{
-			Message: pointer.To("Incorrect username or password provided"),
-		})
+		return newHTTPError(http.StatusUnauthorized, errorCodeUnauthenticated, api.ErrorCategoryUnauthenticated,
+			"Incorrect username or password provided", nil)
 	}
 
 	if errors.Is(err, accounts.ErrAccountDisabled) {
-		return ctx.JSON(http.StatusForbidden, api.Error{
-			Message: pointer.To("User account is disabled"),
-		})
+		return newHTTPError(http.StatusForbidden, errorCodeForbidden, api.ErrorCategoryForbidden,
+			"User account is disabled", nil)
 	}
 
 	if errors.Is(err, accounts.ErrInsufficientCapabilities) {
-		return ctx.JSON(http.StatusForbidden, api.Error{
-			Message: pointer.To("User account lacks required capabilities"),
-		})
+		return newHTTPError(http.StatusForbidden, errorCodeForbidden, api.ErrorCategoryForbidden,
+			"User account lacks required capabilities", nil)
 	}
 	return err
 }
@@ -3,7 +3,6 @@ package server
 import (
 	"net/http"
 
-	"github.com/AlekSi/pointer"
 	"github.com/labstack/echo/v4"
 
 	"github.com/percona/everest/api"
@@ -13,9 +12,8 @@ import (
 func (e *EverestServer) GetSettings(ctx echo.Context) error {
 	result, err := e.handler.GetSettings(ctx.Request().Context())
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, api.Error{
-			Message: pointer.To("Failed to get Everest settings"),
-		})
+		return newHTTPError(http.StatusInternalServerError, errorCodeInternal, api.ErrorCategoryInternal,
+			"Failed to get Everest settings", nil)
 	}
 	return ctx.JSON(http.StatusOK, result)
 }
@@ -0,0 +1,22 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	k8shandler "github.com/percona/everest/internal/server/handlers/k8s"
+)
+
+// softDeletePurgeInterval is the interval between two runs of the soft-delete purge job.
+const softDeletePurgeInterval = 10 * time.Minute
+
+// RunSoftDeletePurgeJob runs the background job that permanently deletes
+// soft-deleted database clusters once their retention period is over.
+// It also runs when soft-delete is disabled, so that clusters deleted
+// before it was disabled are eventually purged.
+func (e *EverestServer) RunSoftDeletePurgeJob(ctx context.Context) {
+	e.runPeriodicJob(ctx, softDeletePurgeInterval, "purge deleted database clusters",
+		func(ctx context.Context, namespace string, now time.Time) error {
+			return k8shandler.PurgeExpiredDatabaseClusters(ctx, e.l, e.kubeConnector, namespace, now)
+		})
+}
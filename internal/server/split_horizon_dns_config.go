@@ -37,10 +37,11 @@ func (e *EverestServer) CreateSplitHorizonDNSConfig(ctx echo.Context, namespace
 
 	result, err := e.handler.CreateSplitHorizonDNSConfig(ctx.Request().Context(), shdc)
 	if err != nil {
-		e.l.Errorf("CreateSplitHorizonDNSConfig failed: %v", err)
+		e.log(ctx).Errorf("CreateSplitHorizonDNSConfig failed: %v", err)
 		return err
 	}
 
+	setETag(ctx, result)
 	return ctx.JSON(http.StatusOK, result)
 }
 
@@ -48,7 +49,7 @@ func (e *EverestServer) CreateSplitHorizonDNSConfig(ctx echo.Context, namespace
 func (e *EverestServer) ListSplitHorizonDNSConfigs(ctx echo.Context, namespace string) error {
 	list, err := e.handler.ListSplitHorizonDNSConfigs(ctx.Request().Context(), namespace)
 	if err != nil {
-		e.l.Errorf("ListSplitHorizonDNSConfigs failed: %v", err)
+		e.log(ctx).Errorf("ListSplitHorizonDNSConfigs failed: %v", err)
 		return err
 	}
 	return ctx.JSON(http.StatusOK, list)
@@ -58,9 +59,10 @@ func (e *EverestServer) ListSplitHorizonDNSConfigs(ctx echo.Context, namespace s
 func (e *EverestServer) GetSplitHorizonDNSConfig(ctx echo.Context, namespace string, name string) error {
 	result, err := e.handler.GetSplitHorizonDNSConfig(ctx.Request().Context(), namespace, name)
 	if err != nil {
-		e.l.Errorf("GetSplitHorizonDNSConfig failed: %v", err)
+		e.log(ctx).Errorf("GetSplitHorizonDNSConfig failed: %v", err)
 		return err
 	}
+	setETag(ctx, result)
 	return ctx.JSON(http.StatusOK, result)
 }
 
@@ -73,16 +75,17 @@ func (e *EverestServer) UpdateSplitHorizonDNSConfig(ctx echo.Context, namespace,
 
 	result, err := e.handler.UpdateSplitHorizonDNSConfig(ctx.Request().Context(), namespace, name, req)
 	if err != nil {
-		e.l.Errorf("UpdateSplitHorizonDNSConfig failed: %v", err)
+		e.log(ctx).Errorf("UpdateSplitHorizonDNSConfig failed: %v", err)
 		return err
 	}
+	setETag(ctx, result)
 	return ctx.JSON(http.StatusOK, result)
 }
 
 // DeleteSplitHorizonDNSConfig handles deleting a specific SplitHorizonDNSConfig resource by name.
 func (e *EverestServer) DeleteSplitHorizonDNSConfig(ctx echo.Context, namespace, name string) error {
 	if err := e.handler.DeleteSplitHorizonDNSConfig(ctx.Request().Context(), namespace, name); err != nil {
-		e.l.Errorf("DeleteSplitHorizonDNSConfig failed: %v", err)
+		e.log(ctx).Errorf("DeleteSplitHorizonDNSConfig failed: %v", err)
 		return err
 	}
 	return ctx.NoContent(http.StatusNoContent)
@@ -0,0 +1,21 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	k8shandler "github.com/percona/everest/internal/server/handlers/k8s"
+)
+
+// timelineRecorderInterval is the interval between two observations of the database clusters
+// by the timeline recorder. Transitions that are shorter than it may not be recorded.
+const timelineRecorderInterval = 30 * time.Second
+
+// RunTimelineRecorder runs the background job that records the transitions of the status
+// and the conditions of the database clusters in their timelines.
+func (e *EverestServer) RunTimelineRecorder(ctx context.Context) {
+	e.runPeriodicJob(ctx, timelineRecorderInterval, "record database cluster timelines",
+		func(ctx context.Context, namespace string, now time.Time) error {
+			return k8shandler.RecordDatabaseClusterTimelines(ctx, e.l, e.kubeConnector, namespace, now)
+		})
+}
@@ -0,0 +1,286 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package approval provides the two-person approval workflow for destructive operations.
+//
+// Operations listed in the approval policy of a DB namespace are not executed
+// right away. Instead, a change request is created, which has to be approved
+// by a second user before the operation is executed.
+package approval
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/common"
+)
+
+const (
+	// DefaultExpiry is the time after which a pending change request expires
+	// if the policy does not specify it.
+	DefaultExpiry = 24 * time.Hour
+	// Retention is the time a change request is kept after its expiry time
+	// before it is garbage-collected.
+	Retention = 7 * 24 * time.Hour
+
+	namePrefix       = "change-request-"
+	nameSuffixLength = 5
+	configMapDataKey = "changeRequest"
+)
+
+var (
+	// ErrApprovalRequired is returned when an operation cannot be executed without approval.
+	ErrApprovalRequired = errors.New("operation requires approval")
+	// ErrSelfApproval is returned when a user tries to approve their own change request.
+	ErrSelfApproval = errors.New("change request cannot be approved by the user who created it")
+	// ErrNotPending is returned when a change request has already been decided.
+	ErrNotPending = errors.New("change request is not pending")
+	// ErrNotApproved is returned when the result of a change request that is not approved is recorded.
+	ErrNotApproved = errors.New("change request is not approved")
+	// ErrExpired is returned when a change request has expired.
+	ErrExpired = errors.New("change request has expired")
+	// ErrNotChangeRequest is returned when a ConfigMap does not hold a change request.
+	ErrNotChangeRequest = errors.New("not a change request")
+)
+
+// PendingError is returned when an operation has been turned into a change request.
+type PendingError struct {
+	ChangeRequest *api.ChangeRequest
+}
+
+// Error implements the error interface.
+func (e *PendingError) Error() string {
+	return fmt.Sprintf("%s: change request '%s' is awaiting approval", ErrApprovalRequired, e.ChangeRequest.Name)
+}
+
+// Unwrap returns ErrApprovalRequired.
+func (e *PendingError) Unwrap() error {
+	return ErrApprovalRequired
+}
+
+// Policy defines which operations require approval in a DB namespace.
+type Policy struct {
+	// Operations is the list of operations that require approval.
+	Operations []api.ChangeRequestOperation `json:"operations,omitempty" yaml:"operations,omitempty"`
+	// Expiry is the duration after which a pending change request expires.
+	// Defaults to DefaultExpiry.
+	Expiry string `json:"expiry,omitempty" yaml:"expiry,omitempty"`
+}
+
+// ParsePolicy parses the raw YAML (or JSON) representation of an approval policy.
+func ParsePolicy(raw []byte) (*Policy, error) {
+	p := &Policy{}
+	if err := yaml.Unmarshal(raw, p); err != nil {
+		return nil, fmt.Errorf("failed to parse approval policy: %w", err)
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// PolicyFromNamespace returns the approval policy configured for the given namespace.
+// Returns nil if the namespace has no approval policy.
+func PolicyFromNamespace(ns *corev1.Namespace) (*Policy, error) {
+	raw, ok := ns.GetAnnotations()[common.EverestNamespaceApprovalPolicyAnnotation]
+	if !ok || raw == "" {
+		return nil, nil //nolint:nilnil
+	}
+	return ParsePolicy([]byte(raw))
+}
+
+// Validate validates the approval policy.
+func (p *Policy) Validate() error {
+	for _, op := range p.Operations {
+		if !slices.Contains(SupportedOperations, op) {
+			return fmt.Errorf("unsupported operation '%s'", op)
+		}
+	}
+	if p.Expiry != "" {
+		d, err := time.ParseDuration(p.Expiry)
+		if err != nil {
+			return fmt.Errorf("invalid expiry: %w", err)
+		}
+		if d <= 0 {
+			return errors.New("expiry must be positive")
+		}
+	}
+	return nil
+}
+
+// Requires returns true if the given operation requires approval.
+func (p *Policy) Requires(op api.ChangeRequestOperation) bool {
+	return p != nil && slices.Contains(p.Operations, op)
+}
+
+// ExpiryDuration returns the duration after which a pending change request expires.
+func (p *Policy) ExpiryDuration() time.Duration {
+	if p == nil || p.Expiry == "" {
+		return DefaultExpiry
+	}
+	d, err := time.ParseDuration(p.Expiry)
+	if err != nil {
+		return DefaultExpiry
+	}
+	return d
+}
+
+// SupportedOperations is the list of operations that can require approval.
+var SupportedOperations = []api.ChangeRequestOperation{
+	api.DeleteDatabaseCluster,
+	api.DeleteDatabaseClusterBackup,
+}
+
+// NewChangeRequest returns a new pending change request.
+func NewChangeRequest(
+	namespace string,
+	op api.ChangeRequestOperation,
+	target string,
+	params *api.ChangeRequestParameters,
+	requestedBy string,
+	expiry time.Duration,
+	now time.Time,
+) *api.ChangeRequest {
+	now = now.UTC().Truncate(time.Second)
+	return &api.ChangeRequest{
+		Name:        namePrefix + utilrand.String(nameSuffixLength),
+		Namespace:   namespace,
+		Operation:   op,
+		Target:      target,
+		Parameters:  params,
+		RequestedBy: requestedBy,
+		State:       api.Pending,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(expiry),
+	}
+}
+
+// Matches returns true if the change request is for the given operation.
+func Matches(cr *api.ChangeRequest, op api.ChangeRequestOperation, target string, params *api.ChangeRequestParameters) bool {
+	return cr.Operation == op && cr.Target == target && cleanupBackupStorage(cr.Parameters) == cleanupBackupStorage(params)
+}
+
+func cleanupBackupStorage(params *api.ChangeRequestParameters) bool {
+	return params != nil && params.CleanupBackupStorage != nil && *params.CleanupBackupStorage
+}
+
+// Refresh marks the change request as expired if it is still pending, or its
+// operation failed, after its expiry time.
+func Refresh(cr *api.ChangeRequest, now time.Time) {
+	if (cr.State == api.Pending || cr.State == api.ExecutionFailed) &&
+		now.After(cr.ExpiresAt) {
+		cr.State = api.Expired
+	}
+}
+
+// Decide approves or rejects a pending change request on behalf of the given user.
+// A change request whose operation failed can be decided again, so that the
+// operation can be retried.
+func Decide(cr *api.ChangeRequest, state api.ChangeRequestState, user string, now time.Time) error {
+	Refresh(cr, now)
+	switch cr.State {
+	case api.Pending, api.ExecutionFailed:
+	case api.Expired:
+		return ErrExpired
+	default:
+		return ErrNotPending
+	}
+	if state == api.Approved && cr.RequestedBy == user {
+		return ErrSelfApproval
+	}
+	now = now.UTC().Truncate(time.Second)
+	cr.State = state
+	cr.DecidedBy = &user
+	cr.DecidedAt = &now
+	return nil
+}
+
+// Complete records the result of the execution of an approved change request.
+func Complete(cr *api.ChangeRequest, execErr error) {
+	if execErr != nil {
+		cr.State = api.ExecutionFailed
+		msg := execErr.Error()
+		cr.Error = &msg
+		return
+	}
+	cr.State = api.Executed
+	cr.Error = nil
+}
+
+// ShouldPurge returns true if the change request is past its retention period.
+func ShouldPurge(cr *api.ChangeRequest, now time.Time) bool {
+	return now.After(cr.ExpiresAt.Add(Retention))
+}
+
+// ToConfigMap returns the ConfigMap that stores the change request.
+func ToConfigMap(cr *api.ChangeRequest) (*corev1.ConfigMap, error) {
+	raw, err := json.Marshal(cr)
+	if err != nil {
+		return nil, err
+	}
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cr.Name,
+			Namespace: cr.Namespace,
+			Labels: map[string]string{
+				common.EverestChangeRequestLabel: string(cr.Operation),
+			},
+		},
+		Data: map[string]string{
+			configMapDataKey: string(raw),
+		},
+	}, nil
+}
+
+// FromConfigMap returns the change request stored in the given ConfigMap.
+func FromConfigMap(cm *corev1.ConfigMap) (*api.ChangeRequest, error) {
+	raw, ok := cm.Data[configMapDataKey]
+	if _, labeled := cm.GetLabels()[common.EverestChangeRequestLabel]; !ok || !labeled {
+		return nil, ErrNotChangeRequest
+	}
+	cr := &api.ChangeRequest{}
+	if err := json.Unmarshal([]byte(raw), cr); err != nil {
+		return nil, fmt.Errorf("failed to parse change request: %w", err)
+	}
+	cr.Name = cm.GetName()
+	cr.Namespace = cm.GetNamespace()
+	return cr, nil
+}
+
+type approvedCtxKey struct{}
+
+// WithApproved returns a context that allows executing the operation of the
+// given approved change request without creating another change request.
+func WithApproved(ctx context.Context, cr *api.ChangeRequest) context.Context {
+	return context.WithValue(ctx, approvedCtxKey{}, cr)
+}
+
+// IsApproved returns true if the context carries an approved change request
+// for the given operation.
+func IsApproved(ctx context.Context, namespace string, op api.ChangeRequestOperation, target string) bool {
+	cr, ok := ctx.Value(approvedCtxKey{}).(*api.ChangeRequest)
+	return ok && cr.State == api.Approved &&
+		cr.Namespace == namespace && cr.Operation == op && cr.Target == target
+}
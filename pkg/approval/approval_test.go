@@ -0,0 +1,183 @@
+package approval
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/everest/api"
+)
+
+func TestParsePolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		raw     string
+		wantErr bool
+		expiry  time.Duration
+	}{
+		{
+			name:   "default expiry",
+			raw:    "operations: [deleteDatabaseCluster]",
+			expiry: DefaultExpiry,
+		},
+		{
+			name:   "custom expiry",
+			raw:    "operations: [deleteDatabaseCluster, deleteDatabaseClusterBackup]\nexpiry: 2h",
+			expiry: 2 * time.Hour,
+		},
+		{
+			name:    "unsupported operation",
+			raw:     "operations: [deleteNamespace]",
+			wantErr: true,
+		},
+		{
+			name:    "invalid expiry",
+			raw:     "operations: [deleteDatabaseCluster]\nexpiry: tomorrow",
+			wantErr: true,
+		},
+		{
+			name:    "negative expiry",
+			raw:     "operations: [deleteDatabaseCluster]\nexpiry: -1h",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			p, err := ParsePolicy([]byte(tc.raw))
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, p.Requires(api.DeleteDatabaseCluster))
+			assert.Equal(t, tc.expiry, p.ExpiryDuration())
+		})
+	}
+}
+
+func TestDecide(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	newCR := func() *api.ChangeRequest {
+		return NewChangeRequest("ns", api.DeleteDatabaseCluster, "db", nil, "alice", time.Hour, now)
+	}
+
+	t.Run("approve", func(t *testing.T) {
+		t.Parallel()
+		cr := newCR()
+		require.NoError(t, Decide(cr, api.Approved, "bob", now.Add(time.Minute)))
+		assert.Equal(t, api.Approved, cr.State)
+		assert.Equal(t, "bob", pointer.Get(cr.DecidedBy))
+	})
+
+	t.Run("self approval", func(t *testing.T) {
+		t.Parallel()
+		cr := newCR()
+		assert.ErrorIs(t, Decide(cr, api.Approved, "alice", now), ErrSelfApproval)
+		assert.Equal(t, api.Pending, cr.State)
+	})
+
+	t.Run("self rejection", func(t *testing.T) {
+		t.Parallel()
+		cr := newCR()
+		require.NoError(t, Decide(cr, api.Rejected, "alice", now))
+		assert.Equal(t, api.Rejected, cr.State)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		t.Parallel()
+		cr := newCR()
+		assert.ErrorIs(t, Decide(cr, api.Approved, "bob", now.Add(2*time.Hour)), ErrExpired)
+		assert.Equal(t, api.Expired, cr.State)
+	})
+
+	t.Run("already decided", func(t *testing.T) {
+		t.Parallel()
+		cr := newCR()
+		require.NoError(t, Decide(cr, api.Rejected, "bob", now))
+		assert.ErrorIs(t, Decide(cr, api.Approved, "bob", now), ErrNotPending)
+	})
+
+	t.Run("executed", func(t *testing.T) {
+		t.Parallel()
+		cr := newCR()
+		require.NoError(t, Decide(cr, api.Approved, "bob", now))
+		Complete(cr, nil)
+		assert.Equal(t, api.Executed, cr.State)
+		assert.Nil(t, cr.Error)
+		assert.ErrorIs(t, Decide(cr, api.Approved, "carol", now), ErrNotPending)
+	})
+
+	t.Run("retry after failure", func(t *testing.T) {
+		t.Parallel()
+		cr := newCR()
+		require.NoError(t, Decide(cr, api.Approved, "bob", now))
+		Complete(cr, errors.New("quota exceeded"))
+		assert.Equal(t, api.ExecutionFailed, cr.State)
+		assert.Equal(t, "quota exceeded", pointer.Get(cr.Error))
+
+		require.NoError(t, Decide(cr, api.Approved, "bob", now.Add(time.Minute)))
+		Complete(cr, nil)
+		assert.Equal(t, api.Executed, cr.State)
+		assert.Nil(t, cr.Error)
+	})
+
+	t.Run("failed and expired", func(t *testing.T) {
+		t.Parallel()
+		cr := newCR()
+		require.NoError(t, Decide(cr, api.Approved, "bob", now))
+		Complete(cr, errors.New("conflict"))
+		assert.ErrorIs(t, Decide(cr, api.Approved, "bob", now.Add(2*time.Hour)), ErrExpired)
+	})
+}
+
+func TestShouldPurge(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	cr := NewChangeRequest("ns", api.DeleteDatabaseCluster, "db", nil, "alice", time.Hour, now)
+	assert.False(t, ShouldPurge(cr, now.Add(2*time.Hour)))
+	assert.True(t, ShouldPurge(cr, now.Add(time.Hour+Retention+time.Second)))
+}
+
+func TestConfigMap(t *testing.T) {
+	t.Parallel()
+
+	cr := NewChangeRequest("ns", api.DeleteDatabaseCluster, "db",
+		&api.ChangeRequestParameters{CleanupBackupStorage: pointer.ToBool(true)},
+		"alice", time.Hour, time.Now())
+	cm, err := ToConfigMap(cr)
+	require.NoError(t, err)
+
+	got, err := FromConfigMap(cm)
+	require.NoError(t, err)
+	assert.Equal(t, cr, got)
+	assert.True(t, Matches(got, api.DeleteDatabaseCluster, "db", cr.Parameters))
+	assert.False(t, Matches(got, api.DeleteDatabaseCluster, "db", nil))
+
+	cm.SetLabels(nil)
+	_, err = FromConfigMap(cm)
+	assert.ErrorIs(t, err, ErrNotChangeRequest)
+}
+
+func TestIsApproved(t *testing.T) {
+	t.Parallel()
+
+	cr := NewChangeRequest("ns", api.DeleteDatabaseCluster, "db", nil, "alice", time.Hour, time.Now())
+	ctx := WithApproved(context.Background(), cr)
+	assert.False(t, IsApproved(ctx, "ns", api.DeleteDatabaseCluster, "db"))
+
+	require.NoError(t, Decide(cr, api.Approved, "bob", time.Now()))
+	assert.True(t, IsApproved(ctx, "ns", api.DeleteDatabaseCluster, "db"))
+	assert.False(t, IsApproved(ctx, "ns", api.DeleteDatabaseCluster, "other"))
+	assert.False(t, IsApproved(context.Background(), "ns", api.DeleteDatabaseCluster, "db"))
+}
@@ -0,0 +1,231 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bundle provides the functionality to create and use the offline
+// bundles used to install and upgrade Everest without internet access.
+//
+// A bundle is a tar.gz archive with the following layout:
+//
+//	bundle.yaml                        the manifest of the bundle
+//	charts/<chart>-<version>.tgz       the Helm charts
+//	version-metadata.json              the snapshot of the version service metadata
+//	images.txt                         the images to mirror, one per line
+//	images-mapping.txt                 the source=target images, if a registry was set
+package bundle
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	versionservice "github.com/percona/everest/pkg/version_service"
+)
+
+const (
+	// FormatVersion is the version of the bundle layout.
+	// Version 2 stores the version metadata snapshot as a single file.
+	FormatVersion = 2
+
+	manifestFile        = "bundle.yaml"
+	chartsDir           = "charts"
+	versionMetadataFile = "version-metadata.json"
+	imagesFile          = "images.txt"
+	imagesMappingFile   = "images-mapping.txt"
+	maxExtractedFileLen = 1 << 30
+)
+
+// ErrUnsupportedFormat is returned when the bundle layout is not the one of this everestctl.
+var ErrUnsupportedFormat = errors.New("unsupported bundle format")
+
+type (
+	// Manifest describes the content of a bundle.
+	Manifest struct {
+		// FormatVersion is the version of the bundle layout.
+		FormatVersion int `json:"formatVersion"`
+		// EverestVersion is the Everest version that can be installed from the bundle.
+		EverestVersion string `json:"everestVersion"`
+		// CreatedAt is the time the bundle has been created.
+		CreatedAt time.Time `json:"createdAt"`
+		// Charts are the file names of the Helm chart archives.
+		Charts []string `json:"charts"`
+		// Images are the images referenced by the charts.
+		Images []string `json:"images"`
+		// ImageRegistry is the registry the images are meant to be mirrored to, if set.
+		ImageRegistry string `json:"imageRegistry,omitempty"`
+	}
+
+	// Bundle is an extracted bundle.
+	Bundle struct {
+		Manifest
+		dir string
+	}
+)
+
+// Open extracts the bundle archive into a temporary directory.
+// Close must be called to remove the directory.
+func Open(archivePath string) (*Bundle, error) {
+	dir, err := os.MkdirTemp("", "everest-bundle-")
+	if err != nil {
+		return nil, err
+	}
+	b := &Bundle{dir: dir}
+	if err := extract(archivePath, dir); err != nil {
+		_ = b.Close()
+		return nil, fmt.Errorf("could not extract bundle %s: %w", archivePath, err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, manifestFile))
+	if err != nil {
+		_ = b.Close()
+		return nil, fmt.Errorf("could not read bundle manifest: %w", err)
+	}
+	if err := yaml.Unmarshal(data, &b.Manifest); err != nil {
+		_ = b.Close()
+		return nil, fmt.Errorf("could not parse bundle manifest: %w", err)
+	}
+	if b.FormatVersion > FormatVersion {
+		_ = b.Close()
+		return nil, fmt.Errorf("%w: version %d, upgrade everestctl", ErrUnsupportedFormat, b.FormatVersion)
+	}
+	if b.FormatVersion != FormatVersion {
+		_ = b.Close()
+		return nil, fmt.Errorf("%w: version %d, create the bundle again with this everestctl", ErrUnsupportedFormat, b.FormatVersion)
+	}
+	return b, nil
+}
+
+// Close removes the extracted bundle.
+func (b *Bundle) Close() error {
+	return os.RemoveAll(b.dir)
+}
+
+// ChartRepoURL returns the URL of the local Helm repository with the charts of the bundle.
+func (b *Bundle) ChartRepoURL() string {
+	return "file://" + filepath.Join(b.dir, chartsDir)
+}
+
+// VersionMetadataURL returns the URL of the version metadata snapshot of the bundle.
+func (b *Bundle) VersionMetadataURL() string {
+	return "file://" + filepath.Join(b.dir, versionMetadataFile)
+}
+
+// VersionMetadata returns the version metadata snapshot of the bundle.
+func (b *Bundle) VersionMetadata() (*versionservice.Snapshot, error) {
+	data, err := os.ReadFile(filepath.Join(b.dir, versionMetadataFile))
+	if err != nil {
+		return nil, fmt.Errorf("could not read bundle version metadata: %w", err)
+	}
+	return versionservice.ParseSnapshot(data)
+}
+
+// CheckVersion returns an error if the bundle does not contain the requested Everest version.
+// An empty version matches the version of the bundle.
+func (b *Bundle) CheckVersion(version string) error {
+	if version != "" && strings.TrimPrefix(version, "v") != b.EverestVersion {
+		return fmt.Errorf("the bundle contains Everest %s, not %s", b.EverestVersion, version)
+	}
+	return nil
+}
+
+func extract(archivePath, dir string) error {
+	f, err := os.Open(archivePath) //nolint:gosec
+	if err != nil {
+		return err
+	}
+	defer f.Close() //nolint:errcheck
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return err
+	}
+	defer gz.Close() //nolint:errcheck
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		// Refuse the files that would be extracted outside of the directory.
+		target := filepath.Join(dir, filepath.FromSlash(hdr.Name))
+		if !strings.HasPrefix(target, filepath.Clean(dir)+string(os.PathSeparator)) {
+			return fmt.Errorf("invalid file path %q", hdr.Name)
+		}
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil { //nolint:mnd
+			return err
+		}
+		if err := extractFile(tr, target); err != nil {
+			return err
+		}
+	}
+}
+
+func extractFile(r io.Reader, target string) error {
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644) //nolint:gosec,mnd
+	if err != nil {
+		return err
+	}
+	defer f.Close() //nolint:errcheck
+	if _, err := io.Copy(f, io.LimitReader(r, maxExtractedFileLen)); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// archive writes files into a tar.gz archive.
+type archive struct {
+	gz  *gzip.Writer
+	tw  *tar.Writer
+	now time.Time
+}
+
+func newArchive(w io.Writer, now time.Time) *archive {
+	gz := gzip.NewWriter(w)
+	return &archive{gz: gz, tw: tar.NewWriter(gz), now: now}
+}
+
+func (a *archive) add(name string, data []byte) error {
+	if err := a.tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0o644, //nolint:mnd
+		Size:    int64(len(data)),
+		ModTime: a.now,
+	}); err != nil {
+		return err
+	}
+	_, err := a.tw.Write(data)
+	return err
+}
+
+func (a *archive) close() error {
+	if err := a.tw.Close(); err != nil {
+		return err
+	}
+	return a.gz.Close()
+}
@@ -0,0 +1,98 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeArchive(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	p := filepath.Join(t.TempDir(), "bundle.tar.gz")
+	f, err := os.Create(p)
+	require.NoError(t, err)
+	defer f.Close() //nolint:errcheck
+
+	a := newArchive(f, time.Now())
+	for name, content := range files {
+		require.NoError(t, a.add(name, []byte(content)))
+	}
+	require.NoError(t, a.close())
+	return p
+}
+
+func TestOpen(t *testing.T) {
+	t.Parallel()
+
+	p := writeArchive(t, map[string]string{
+		manifestFile:               "formatVersion: 2\neverestVersion: 1.4.0\ncharts:\n- everest-1.4.0.tgz\n",
+		"charts/everest-1.4.0.tgz": "chart",
+		versionMetadataFile:        `{"everest":{"versions":[{"version":"1.4.0"}]}}`,
+	})
+	b, err := Open(p)
+	require.NoError(t, err)
+
+	assert.Equal(t, "1.4.0", b.EverestVersion)
+	assert.Equal(t, []string{"everest-1.4.0.tgz"}, b.Charts)
+	chartsPath := strings.TrimPrefix(b.ChartRepoURL(), "file://")
+	data, err := os.ReadFile(filepath.Join(chartsPath, "everest-1.4.0.tgz"))
+	require.NoError(t, err)
+	assert.Equal(t, "chart", string(data))
+	assert.FileExists(t, strings.TrimPrefix(b.VersionMetadataURL(), "file://"))
+	snapshot, err := b.VersionMetadata()
+	require.NoError(t, err)
+	assert.Equal(t, "1.4.0", snapshot.Everest.GetVersions()[0].GetVersion())
+
+	require.NoError(t, b.CheckVersion(""))
+	require.NoError(t, b.CheckVersion("v1.4.0"))
+	require.Error(t, b.CheckVersion("1.5.0"))
+
+	require.NoError(t, b.Close())
+	assert.NoDirExists(t, chartsPath)
+}
+
+func TestOpenInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := Open(writeArchive(t, map[string]string{
+		manifestFile:       "formatVersion: 2\n",
+		"../../etc/passwd": "root",
+	}))
+	require.ErrorContains(t, err, `invalid file path "../../etc/passwd"`)
+
+	_, err = Open(writeArchive(t, map[string]string{
+		manifestFile: "formatVersion: 3\neverestVersion: 2.0.0\n",
+	}))
+	require.ErrorIs(t, err, ErrUnsupportedFormat)
+	require.ErrorContains(t, err, "upgrade everestctl")
+
+	// Bundles with the previous layout and without a version are rejected as well.
+	for _, manifest := range []string{"formatVersion: 1\neverestVersion: 1.4.0\n", "everestVersion: 1.4.0\n"} {
+		_, err = Open(writeArchive(t, map[string]string{manifestFile: manifest}))
+		require.ErrorIs(t, err, ErrUnsupportedFormat)
+	}
+
+	_, err = Open(writeArchive(t, map[string]string{"images.txt": ""}))
+	require.ErrorContains(t, err, "could not read bundle manifest")
+}
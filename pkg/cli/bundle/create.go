@@ -0,0 +1,274 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	versionpb "github.com/Percona-Lab/percona-version-service/versionpb"
+	goversion "github.com/hashicorp/go-version"
+	"go.uber.org/zap"
+	"helm.sh/helm/v3/pkg/cli/values"
+	"sigs.k8s.io/yaml"
+
+	"github.com/percona/everest/pkg/cli/helm"
+	helmutils "github.com/percona/everest/pkg/cli/helm/utils"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/output"
+	versionservice "github.com/percona/everest/pkg/version_service"
+)
+
+// crdChartMinVersion is the first Everest version with a separate CRD chart.
+const crdChartMinVersion = "1.9.0"
+
+type (
+	// CreateConfig is the configuration for creating a bundle.
+	CreateConfig struct {
+		// Pretty if set print the output in pretty mode.
+		Pretty bool
+		// Version is the Everest version to bundle. The latest version is bundled if empty.
+		Version string
+		// VersionMetadataURL is the URL of the version service to snapshot.
+		VersionMetadataURL string
+		// RepoURL is the URL of the Helm repository to download the charts from.
+		RepoURL string
+		// ImageRegistry is the registry the images will be mirrored to.
+		// If set, the bundle contains the mapping of the images to the registry.
+		ImageRegistry string
+		// Output is the path of the archive. It defaults to a file named
+		// after the Everest version in the current directory.
+		Output string
+	}
+
+	// CreateResult is the summary of a created bundle.
+	CreateResult struct {
+		Manifest
+		// Path is the path of the archive.
+		Path string `json:"path"`
+	}
+
+	// Creator is the CLI operation to create a bundle.
+	Creator struct {
+		cfg CreateConfig
+		l   *zap.SugaredLogger
+	}
+)
+
+// NewCreator returns a new CLI operation to create a bundle.
+func NewCreator(c CreateConfig, l *zap.SugaredLogger) *Creator {
+	cr := &Creator{
+		cfg: c,
+		l:   l.With("component", "bundle"),
+	}
+	if c.Pretty {
+		cr.l = zap.NewNop().Sugar()
+	}
+	return cr
+}
+
+// Run downloads the charts and the version metadata, and writes the bundle archive.
+func (c *Creator) Run(ctx context.Context) (*CreateResult, error) {
+	var out io.Writer = os.Stdout
+	if !c.cfg.Pretty {
+		out = io.Discard
+	}
+
+	snapshot, err := versionservice.TakeSnapshot(ctx, c.cfg.VersionMetadataURL)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch version metadata: %w", err)
+	}
+	ver, err := selectVersion(snapshot.Everest, c.cfg.Version)
+	if err != nil {
+		return nil, err
+	}
+	_, _ = fmt.Fprint(out, output.Info("Creating a bundle for Everest %s", ver))
+
+	tmp, err := os.MkdirTemp("", "everest-bundle-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmp) //nolint:errcheck
+
+	res := &CreateResult{
+		Manifest: Manifest{
+			FormatVersion:  FormatVersion,
+			EverestVersion: ver.String(),
+			CreatedAt:      time.Now().UTC(),
+			ImageRegistry:  c.cfg.ImageRegistry,
+		},
+		Path: c.cfg.Output,
+	}
+	if res.Path == "" {
+		res.Path = fmt.Sprintf("everest-bundle-%s.tar.gz", ver)
+	}
+
+	charts := []string{helm.EverestChartName, helm.EverestDBNamespaceChartName}
+	if ver.GreaterThanOrEqual(goversion.Must(goversion.NewVersion(crdChartMinVersion))) {
+		charts = append(charts, helm.EverestCRDChartName)
+	}
+	for _, name := range charts {
+		c.l.Infof("Downloading Helm chart %s %s", name, ver)
+		if _, err := helm.PullChart(name, ver.String(), c.cfg.RepoURL, tmp); err != nil {
+			return nil, fmt.Errorf("could not download Helm chart %s: %w", name, err)
+		}
+		res.Charts = append(res.Charts, helm.ChartArchiveName(name, ver.String()))
+	}
+
+	if res.Images, err = c.images(ctx, tmp, ver.String(), snapshot); err != nil {
+		return nil, err
+	}
+
+	if err := c.write(tmp, res, snapshot); err != nil {
+		_ = os.Remove(res.Path)
+		return nil, fmt.Errorf("could not write bundle %s: %w", res.Path, err)
+	}
+
+	_, _ = fmt.Fprint(out, output.Success("Bundle written to %s", res.Path))
+	_, _ = fmt.Fprintf(out, "   %d charts, %d images to mirror are listed in %s\n", len(res.Charts), len(res.Images), imagesFile)
+	return res, nil
+}
+
+// images returns the sorted images to mirror to install the Everest version:
+// the images referenced by the Everest chart, including the Everest catalog,
+// and the images of the database operators installed from the catalog, their
+// engines, backup and monitoring components, according to the version metadata.
+func (c *Creator) images(ctx context.Context, chartsPath, version string, snapshot *versionservice.Snapshot) ([]string, error) {
+	images, err := c.chartImages(ctx, chartsPath, version)
+	if err != nil {
+		return nil, err
+	}
+	operatorImages, err := snapshot.OperatorImages(version)
+	if err != nil {
+		return nil, fmt.Errorf("could not list the images of the database operators: %w", err)
+	}
+	images = append(images, operatorImages...)
+	slices.Sort(images)
+	return slices.Compact(images), nil
+}
+
+// chartImages returns the images referenced by the Everest chart rendered with its default values.
+func (c *Creator) chartImages(ctx context.Context, chartsPath, version string) ([]string, error) {
+	vals, err := helmutils.MergeVals(values.Options{}, helm.NewValues(helm.Values{}))
+	if err != nil {
+		return nil, err
+	}
+	installer := &helm.Installer{
+		ReleaseName:      common.SystemNamespace,
+		ReleaseNamespace: common.SystemNamespace,
+		Values:           vals,
+	}
+	if err := installer.Init("", helm.ChartOptions{
+		URL:     "file://" + chartsPath,
+		Name:    helm.EverestChartName,
+		Version: version,
+	}); err != nil {
+		return nil, fmt.Errorf("could not initialize Helm installer: %w", err)
+	}
+	manifests, err := installer.RenderTemplates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not render Helm chart: %w", err)
+	}
+	return helm.ListImages(manifests), nil
+}
+
+func (c *Creator) write(chartsPath string, res *CreateResult, snapshot *versionservice.Snapshot) error {
+	f, err := os.Create(res.Path)
+	if err != nil {
+		return err
+	}
+	defer f.Close() //nolint:errcheck
+
+	a := newArchive(f, res.CreatedAt)
+	manifest, err := yaml.Marshal(res.Manifest)
+	if err != nil {
+		return err
+	}
+	if err := a.add(manifestFile, manifest); err != nil {
+		return err
+	}
+	for _, name := range res.Charts {
+		data, err := os.ReadFile(path.Join(chartsPath, name))
+		if err != nil {
+			return err
+		}
+		if err := a.add(path.Join(chartsDir, name), data); err != nil {
+			return err
+		}
+	}
+
+	versionMetadata, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	if err := a.add(versionMetadataFile, versionMetadata); err != nil {
+		return err
+	}
+
+	if err := a.add(imagesFile, []byte(strings.Join(res.Images, "\n")+"\n")); err != nil {
+		return err
+	}
+	if c.cfg.ImageRegistry != "" {
+		mapping := make([]string, 0, len(res.Images))
+		for _, image := range res.Images {
+			mapping = append(mapping, image+"="+helm.RewriteImageRegistry(image, c.cfg.ImageRegistry))
+		}
+		if err := a.add(imagesMappingFile, []byte(strings.Join(mapping, "\n")+"\n")); err != nil {
+			return err
+		}
+	}
+
+	if err := a.close(); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// selectVersion returns the requested version if it exists in the metadata,
+// or the latest version if no version is requested.
+func selectVersion(meta *versionpb.MetadataResponse, version string) (*goversion.Version, error) {
+	var latest *goversion.Version
+	for _, v := range meta.GetVersions() {
+		ver, err := goversion.NewSemver(v.GetVersion())
+		if err != nil {
+			continue
+		}
+		if version != "" {
+			if ver.String() == strings.TrimPrefix(version, "v") {
+				return ver, nil
+			}
+			continue
+		}
+		if latest == nil || ver.GreaterThan(latest) {
+			latest = ver
+		}
+	}
+	if version != "" {
+		return nil, fmt.Errorf("version %s not found in version metadata", version)
+	}
+	if latest == nil {
+		return nil, errors.New("could not determine the latest Everest version")
+	}
+	return latest, nil
+}
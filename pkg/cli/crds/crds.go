@@ -0,0 +1,245 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package crds compares the Custom Resource Definitions of two Everest versions
+// to find the schema changes that are not backward compatible.
+package crds
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const crdKind = "CustomResourceDefinition"
+
+// Change is a change of a CRD.
+type Change struct {
+	// CRD is the name of the CRD.
+	CRD string `json:"crd"`
+	// Description describes the change.
+	Description string `json:"description"`
+	// Breaking is set if the change is not backward compatible, e.g. the existing
+	// objects or the clients of the previous schema may not work anymore.
+	Breaking bool `json:"breaking"`
+}
+
+// String returns the change in a human-readable form.
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %s", c.CRD, c.Description)
+}
+
+// Parse returns the CRDs found in the YAML documents. The other documents are skipped.
+func Parse(docs []string) ([]apiextv1.CustomResourceDefinition, error) {
+	result := []apiextv1.CustomResourceDefinition{}
+	for _, doc := range docs {
+		crd := apiextv1.CustomResourceDefinition{}
+		if err := yaml.Unmarshal([]byte(doc), &crd); err != nil {
+			return nil, fmt.Errorf("could not parse manifest: %w", err)
+		}
+		if crd.Kind != crdKind {
+			continue
+		}
+		result = append(result, crd)
+	}
+	return result, nil
+}
+
+// Groups returns the API groups of the CRDs.
+func Groups(list []apiextv1.CustomResourceDefinition) []string {
+	groups := []string{}
+	for _, crd := range list {
+		if !slices.Contains(groups, crd.Spec.Group) {
+			groups = append(groups, crd.Spec.Group)
+		}
+	}
+	return groups
+}
+
+// Compare returns the changes between the from and the to CRDs, sorted by CRD name.
+func Compare(from, to []apiextv1.CustomResourceDefinition) []Change {
+	toByName := make(map[string]apiextv1.CustomResourceDefinition, len(to))
+	for _, crd := range to {
+		toByName[crd.GetName()] = crd
+	}
+
+	changes := []Change{}
+	seen := make(map[string]struct{}, len(from))
+	for _, fromCRD := range from {
+		name := fromCRD.GetName()
+		seen[name] = struct{}{}
+		toCRD, ok := toByName[name]
+		if !ok {
+			changes = append(changes, Change{CRD: name, Description: "removed", Breaking: true})
+			continue
+		}
+		changes = append(changes, compareCRD(fromCRD, toCRD)...)
+	}
+	for _, crd := range to {
+		if _, ok := seen[crd.GetName()]; !ok {
+			changes = append(changes, Change{CRD: crd.GetName(), Description: "added"})
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].CRD < changes[j].CRD
+	})
+	return changes
+}
+
+// Breaking returns the changes that are not backward compatible.
+func Breaking(changes []Change) []Change {
+	result := []Change{}
+	for _, c := range changes {
+		if c.Breaking {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+func compareCRD(from, to apiextv1.CustomResourceDefinition) []Change {
+	name := from.GetName()
+	changes := []Change{}
+	add := func(breaking bool, format string, args ...any) {
+		changes = append(changes, Change{CRD: name, Description: fmt.Sprintf(format, args...), Breaking: breaking})
+	}
+
+	if from.Spec.Scope != to.Spec.Scope {
+		add(true, "scope changed from %s to %s", from.Spec.Scope, to.Spec.Scope)
+	}
+
+	for _, fromVer := range from.Spec.Versions {
+		idx := slices.IndexFunc(to.Spec.Versions, func(v apiextv1.CustomResourceDefinitionVersion) bool {
+			return v.Name == fromVer.Name
+		})
+		if idx == -1 {
+			add(fromVer.Served, "version %s removed", fromVer.Name)
+			continue
+		}
+		toVer := to.Spec.Versions[idx]
+		if fromVer.Served && !toVer.Served {
+			add(true, "version %s is not served anymore", fromVer.Name)
+		}
+		if fromVer.Storage != toVer.Storage && toVer.Storage {
+			add(false, "storage version changed to %s", toVer.Name)
+		}
+		var fromSchema, toSchema *apiextv1.JSONSchemaProps
+		if fromVer.Schema != nil {
+			fromSchema = fromVer.Schema.OpenAPIV3Schema
+		}
+		if toVer.Schema != nil {
+			toSchema = toVer.Schema.OpenAPIV3Schema
+		}
+		for _, c := range compareSchema("", fromSchema, toSchema) {
+			add(c.Breaking, "%s: %s", fromVer.Name, c.Description)
+		}
+	}
+	for _, toVer := range to.Spec.Versions {
+		if !slices.ContainsFunc(from.Spec.Versions, func(v apiextv1.CustomResourceDefinitionVersion) bool {
+			return v.Name == toVer.Name
+		}) {
+			add(false, "version %s added", toVer.Name)
+		}
+	}
+	return changes
+}
+
+// compareSchema compares the schema of a field and of its sub-fields.
+func compareSchema(path string, from, to *apiextv1.JSONSchemaProps) []Change {
+	if from == nil || to == nil {
+		if from != nil && !preservesUnknownFields(to) {
+			return []Change{{Description: fmt.Sprintf("schema of %s removed", fieldPath(path)), Breaking: true}}
+		}
+		return nil
+	}
+
+	changes := []Change{}
+	add := func(breaking bool, format string, args ...any) {
+		changes = append(changes, Change{Description: fmt.Sprintf(format, args...), Breaking: breaking})
+	}
+
+	if from.Type != to.Type && from.Type != "" {
+		add(true, "type of %s changed from %s to %s", fieldPath(path), from.Type, typeName(to.Type))
+		// The sub-fields are not comparable anymore.
+		return changes
+	}
+
+	for _, req := range to.Required {
+		if !slices.Contains(from.Required, req) {
+			add(true, "field %s is now required", fieldPath(path+"."+req))
+		}
+	}
+
+	for _, v := range from.Enum {
+		if !slices.ContainsFunc(to.Enum, func(e apiextv1.JSON) bool { return string(e.Raw) == string(v.Raw) }) && len(to.Enum) > 0 {
+			add(true, "value %s of %s is not allowed anymore", v.Raw, fieldPath(path))
+		}
+	}
+
+	for _, name := range sortedKeys(from.Properties) {
+		fromProp := from.Properties[name]
+		toProp, ok := to.Properties[name]
+		if !ok {
+			if !preservesUnknownFields(to) {
+				add(true, "field %s removed", fieldPath(path+"."+name))
+			}
+			continue
+		}
+		changes = append(changes, compareSchema(path+"."+name, &fromProp, &toProp)...)
+	}
+	for _, name := range sortedKeys(to.Properties) {
+		if _, ok := from.Properties[name]; !ok {
+			add(false, "field %s added", fieldPath(path+"."+name))
+		}
+	}
+
+	if from.Items != nil && to.Items != nil {
+		changes = append(changes, compareSchema(path+"[]", from.Items.Schema, to.Items.Schema)...)
+	}
+	if from.AdditionalProperties != nil && to.AdditionalProperties != nil {
+		changes = append(changes, compareSchema(path+"{}", from.AdditionalProperties.Schema, to.AdditionalProperties.Schema)...)
+	}
+	return changes
+}
+
+func preservesUnknownFields(s *apiextv1.JSONSchemaProps) bool {
+	return s != nil && s.XPreserveUnknownFields != nil && *s.XPreserveUnknownFields
+}
+
+func fieldPath(path string) string {
+	if path == "" {
+		return "the root object"
+	}
+	return path
+}
+
+func typeName(t string) string {
+	if t == "" {
+		return "any"
+	}
+	return t
+}
+
+func sortedKeys(m map[string]apiextv1.JSONSchemaProps) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,158 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crds
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCRD = `# Source: everest-crds/templates/databaseclusters.yaml
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: databaseclusters.everest.percona.com
+spec:
+  group: everest.percona.com
+  scope: Namespaced
+  versions:
+    - name: v1alpha1
+      served: true
+      storage: true
+      schema:
+        openAPIV3Schema:
+          type: object
+          properties:
+            spec:
+              type: object
+              required: [engine]
+              properties:
+                engine:
+                  type: object
+                  properties:
+                    type:
+                      type: string
+                      enum: [pxc, psmdb, postgresql]
+                    replicas:
+                      type: integer
+                paused:
+                  type: boolean
+                dataSource:
+                  type: object
+                  x-kubernetes-preserve-unknown-fields: true
+                  properties:
+                    dbClusterBackupName:
+                      type: string
+`
+
+const testCRDUpgraded = `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: databaseclusters.everest.percona.com
+spec:
+  group: everest.percona.com
+  scope: Namespaced
+  versions:
+    - name: v1alpha1
+      served: true
+      storage: true
+      schema:
+        openAPIV3Schema:
+          type: object
+          properties:
+            spec:
+              type: object
+              required: [engine, proxy]
+              properties:
+                engine:
+                  type: object
+                  properties:
+                    type:
+                      type: string
+                      enum: [pxc, psmdb]
+                    replicas:
+                      type: string
+                proxy:
+                  type: object
+                dataSource:
+                  type: object
+                  x-kubernetes-preserve-unknown-fields: true
+    - name: v1beta1
+      served: true
+      storage: false
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: podschedulingpolicies.everest.percona.com
+spec:
+  group: everest.percona.com
+  scope: Cluster
+  versions:
+    - name: v1alpha1
+      served: true
+      storage: true
+`
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	crds, err := Parse([]string{testCRD, "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cm\n"})
+	require.NoError(t, err)
+	require.Len(t, crds, 1)
+	assert.Equal(t, "databaseclusters.everest.percona.com", crds[0].GetName())
+	assert.Equal(t, []string{"everest.percona.com"}, Groups(crds))
+}
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	from, err := Parse([]string{testCRD})
+	require.NoError(t, err)
+	to, err := Parse(strings.Split(testCRDUpgraded, "\n---\n"))
+	require.NoError(t, err)
+	require.Len(t, to, 2)
+
+	const name = "databaseclusters.everest.percona.com"
+	changes := Compare(from, to)
+	assert.Equal(t, []Change{
+		{CRD: name, Description: "v1alpha1: field .spec.proxy is now required", Breaking: true},
+		{CRD: name, Description: "v1alpha1: type of .spec.engine.replicas changed from integer to string", Breaking: true},
+		{CRD: name, Description: `v1alpha1: value "postgresql" of .spec.engine.type is not allowed anymore`, Breaking: true},
+		{CRD: name, Description: "v1alpha1: field .spec.paused removed", Breaking: true},
+		{CRD: name, Description: "v1alpha1: field .spec.proxy added"},
+		{CRD: name, Description: "version v1beta1 added"},
+		{CRD: "podschedulingpolicies.everest.percona.com", Description: "added"},
+	}, changes)
+	assert.Len(t, Breaking(changes), 4)
+
+	// Going back removes the new CRD, version and field.
+	reverse := []string{}
+	for _, c := range Breaking(Compare(to, from)) {
+		reverse = append(reverse, c.String())
+	}
+	assert.Equal(t, []string{
+		name + ": v1alpha1: type of .spec.engine.replicas changed from string to integer",
+		name + ": v1alpha1: field .spec.proxy removed",
+		name + ": version v1beta1 removed",
+		"podschedulingpolicies.everest.percona.com: removed",
+	}, reverse)
+
+	assert.Empty(t, Compare(from, from))
+}
@@ -0,0 +1,134 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package diagnose provides the functionality to run the health checks of database clusters.
+package diagnose
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/AlekSi/pointer"
+	"go.uber.org/zap"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/internal/server/handlers"
+	k8shandler "github.com/percona/everest/internal/server/handlers/k8s"
+	cliutils "github.com/percona/everest/pkg/cli/utils"
+	"github.com/percona/everest/pkg/diagnostics"
+	"github.com/percona/everest/pkg/output"
+)
+
+// ErrUnhealthy is returned when a critical problem has been found.
+var ErrUnhealthy = errors.New("database cluster is unhealthy")
+
+type (
+	// Config is the configuration for diagnosing a database cluster.
+	Config struct {
+		// KubeconfigPath is a path to a kubeconfig
+		KubeconfigPath string
+		// Pretty if set print the output in pretty mode.
+		Pretty bool
+		// Namespace is the namespace of the database cluster.
+		Namespace string
+		// Name is the name of the database cluster.
+		Name string
+		// Checks are the names of the checks to run. All the checks are run if empty.
+		Checks []string
+		// VersionMetadataURL is the URL of the version service used to check the engine version.
+		VersionMetadataURL string
+	}
+
+	// Diagnoser is the CLI operation to run the health checks of a database cluster.
+	Diagnoser struct {
+		cfg     Config
+		handler handlers.Handler
+		l       *zap.SugaredLogger
+	}
+)
+
+// NewDiagnoser returns a new CLI operation to diagnose a database cluster.
+func NewDiagnoser(c Config, l *zap.SugaredLogger) (*Diagnoser, error) {
+	d := &Diagnoser{
+		cfg: c,
+		l:   l.With("component", "diagnose"),
+	}
+	if c.Pretty {
+		d.l = zap.NewNop().Sugar()
+	}
+	if _, err := diagnostics.Select(c.Checks); err != nil {
+		return nil, err
+	}
+
+	k, err := cliutils.NewKubeConnector(d.l, d.cfg.KubeconfigPath)
+	if err != nil {
+		return nil, err
+	}
+	// The checks are run the same way as by the API server.
+	d.handler = k8shandler.New(d.l, k, d.cfg.VersionMetadataURL)
+	return d, nil
+}
+
+// Run runs the checks and prints their findings.
+// It returns ErrUnhealthy if a critical problem has been found.
+func (d *Diagnoser) Run(ctx context.Context) (*api.DatabaseClusterDiagnostics, error) {
+	var out io.Writer = os.Stdout
+	if !d.cfg.Pretty {
+		out = io.Discard
+	}
+
+	params := &api.GetDatabaseClusterDiagnosticsParams{}
+	if len(d.cfg.Checks) > 0 {
+		params.Check = &d.cfg.Checks
+	}
+	result, err := d.handler.GetDatabaseClusterDiagnostics(ctx, d.cfg.Namespace, d.cfg.Name, params)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range result.Checks {
+		if c.Status == api.Skipped {
+			d.l.Warnf("Check %s skipped: %s", c.Name, pointer.Get(c.Message))
+			_, _ = fmt.Fprint(out, output.Warn("Check %s skipped: %s", c.Name, pointer.Get(c.Message)))
+		}
+	}
+	for _, f := range result.Findings {
+		d.l.Infow(f.Message, "check", f.Check, "severity", f.Severity, "remediation", pointer.Get(f.Remediation))
+		switch f.Severity {
+		case api.DatabaseClusterDiagnosticFindingSeverityCritical:
+			_, _ = fmt.Fprint(out, output.Failure("[%s] %s", f.Check, f.Message))
+		case api.DatabaseClusterDiagnosticFindingSeverityWarning:
+			_, _ = fmt.Fprint(out, output.Warn("[%s] %s", f.Check, f.Message))
+		default:
+			_, _ = fmt.Fprint(out, output.Info("[%s] %s", f.Check, f.Message))
+		}
+		if f.Remediation != nil {
+			_, _ = fmt.Fprintf(out, "   %s\n", *f.Remediation)
+		}
+	}
+
+	if !result.Healthy {
+		return result, fmt.Errorf("%w: %s/%s", ErrUnhealthy, d.cfg.Namespace, d.cfg.Name)
+	}
+	if len(result.Findings) == 0 {
+		_, _ = fmt.Fprint(out, output.Success("No problem found in database cluster %s/%s", d.cfg.Namespace, d.cfg.Name))
+	} else {
+		_, _ = fmt.Fprint(out, output.Success("Database cluster %s/%s is healthy", d.cfg.Namespace, d.cfg.Name))
+	}
+	return result, nil
+}
@@ -0,0 +1,325 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doctor
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	versionpb "github.com/Percona-Lab/percona-version-service/versionpb"
+	goversion "github.com/hashicorp/go-version"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	// CheckKubernetesVersion checks the Kubernetes version against the supported versions.
+	CheckKubernetesVersion = "kubernetes-version"
+	// CheckCLIVersion checks the everestctl version against the supported versions.
+	CheckCLIVersion = "cli-version"
+	// CheckPermissions checks the permissions of the current user.
+	CheckPermissions = "permissions"
+	// CheckClusterType reports the detected cluster type.
+	CheckClusterType = "cluster-type"
+	// CheckStorageClasses checks the default storage class and volume expansion.
+	CheckStorageClasses = "storage-classes"
+	// CheckResources checks the CPU and memory available for database clusters.
+	CheckResources = "resources"
+	// CheckPodSecurity checks the Pod Security Standards enforced in the Everest namespaces.
+	CheckPodSecurity = "pod-security"
+	// CheckOLM checks the OLM deployments and the Everest catalog source.
+	CheckOLM = "olm"
+	// CheckEverestComponents checks the Everest and monitoring deployments.
+	CheckEverestComponents = "everest-components"
+	// CheckDatabaseOperators checks the versions of the installed database operators.
+	CheckDatabaseOperators = "database-operators"
+)
+
+// Status is the status of a check.
+type Status string
+
+const (
+	// StatusPass means that the check has passed.
+	StatusPass Status = "pass"
+	// StatusWarn means that the check has found a problem that does not prevent Everest from working.
+	StatusWarn Status = "warn"
+	// StatusFail means that the check has found a problem that prevents Everest from working.
+	StatusFail Status = "fail"
+)
+
+// Check is the result of a check.
+type Check struct {
+	Name        string `json:"name"`
+	Status      Status `json:"status"`
+	Message     string `json:"message"`
+	Remediation string `json:"remediation,omitempty"`
+}
+
+const (
+	// minFreeCPUMillis and minFreeMemoryBytes are the resources requested by
+	// the smallest database cluster.
+	minFreeCPUMillis   = 1000
+	minFreeMemoryBytes = 2 << 30
+	// recommendedFreeCPUMillis and recommendedFreeMemoryBytes leave room for
+	// a few database clusters and their backups.
+	recommendedFreeCPUMillis   = 4000
+	recommendedFreeMemoryBytes = 8 << 30
+
+	defaultStorageClassAnnotation     = "storageclass.kubernetes.io/is-default-class"
+	betaDefaultStorageClassAnnotation = "storageclass.beta.kubernetes.io/is-default-class"
+	podSecurityEnforceLabel           = "pod-security.kubernetes.io/enforce"
+	podSecurityRestricted             = "restricted"
+)
+
+// deploymentStatus is the rollout status of a deployment.
+type deploymentStatus struct {
+	Namespace string
+	Name      string
+	// NotFound is true if the deployment does not exist.
+	NotFound bool
+	Ready    bool
+	// Message describes why the deployment is not ready.
+	Message string
+}
+
+func checkKubernetesVersion(server *goversion.Version, supported goversion.Constraints) Check {
+	c := Check{Name: CheckKubernetesVersion}
+	if supported == nil {
+		return c.warn(fmt.Sprintf("Kubernetes %s, the supported versions are unknown", server), "")
+	}
+	if !supported.Check(server.Core()) {
+		return c.fail(
+			fmt.Sprintf("Kubernetes %s does not satisfy the constraints %q", server, supported.String()),
+			"Upgrade the cluster to a supported Kubernetes version",
+		)
+	}
+	return c.pass(fmt.Sprintf("Kubernetes %s satisfies the constraints %q", server, supported.String()))
+}
+
+func checkPermissions(denied []string) Check {
+	c := Check{Name: CheckPermissions}
+	if len(denied) > 0 {
+		return c.fail(
+			"The current user is not allowed to "+strings.Join(denied, ", "),
+			"Run everestctl with a cluster-admin kubeconfig",
+		)
+	}
+	return c.pass("The current user has the permissions required to install and manage Everest")
+}
+
+func checkStorageClasses(classes []storagev1.StorageClass) Check {
+	c := Check{Name: CheckStorageClasses}
+	if len(classes) == 0 {
+		return c.fail("No storage class found", "Create a storage class for the volumes of the database clusters")
+	}
+
+	var defaults, expandable []string
+	for _, sc := range classes {
+		if isDefaultStorageClass(sc) {
+			defaults = append(defaults, sc.GetName())
+		}
+		if sc.AllowVolumeExpansion != nil && *sc.AllowVolumeExpansion {
+			expandable = append(expandable, sc.GetName())
+		}
+	}
+
+	switch {
+	case len(defaults) == 0:
+		return c.warn(
+			"No default storage class, the storage class must be set explicitly for every database cluster",
+			fmt.Sprintf("Mark a storage class as default with the %s annotation", defaultStorageClassAnnotation),
+		)
+	case len(expandable) == 0:
+		return c.warn(
+			"No storage class allows volume expansion, the storage of the database clusters cannot be resized",
+			"Set allowVolumeExpansion in a storage class that supports it",
+		)
+	case !slices.Contains(expandable, defaults[0]):
+		return c.warn(
+			fmt.Sprintf("The default storage class %s does not allow volume expansion, use one of %s to be able to resize the storage",
+				defaults[0], strings.Join(expandable, ", ")),
+			"",
+		)
+	}
+	return c.pass(fmt.Sprintf("Default storage class %s allows volume expansion", defaults[0]))
+}
+
+func isDefaultStorageClass(sc storagev1.StorageClass) bool {
+	return sc.GetAnnotations()[defaultStorageClassAnnotation] == "true" ||
+		sc.GetAnnotations()[betaDefaultStorageClassAnnotation] == "true"
+}
+
+func checkResources(allocatableCPUMillis, allocatableMemoryBytes, consumedCPUMillis, consumedMemoryBytes uint64) Check {
+	c := Check{Name: CheckResources}
+	freeCPU := subtract(allocatableCPUMillis, consumedCPUMillis)
+	freeMemory := subtract(allocatableMemoryBytes, consumedMemoryBytes)
+	msg := fmt.Sprintf("%s CPU and %s memory available out of %s CPU and %s memory",
+		formatCPU(freeCPU), formatMemory(freeMemory), formatCPU(allocatableCPUMillis), formatMemory(allocatableMemoryBytes))
+
+	switch {
+	case freeCPU < minFreeCPUMillis || freeMemory < minFreeMemoryBytes:
+		return c.fail(msg, fmt.Sprintf("Add nodes to the cluster, a database cluster requires at least %s CPU and %s memory",
+			formatCPU(minFreeCPUMillis), formatMemory(minFreeMemoryBytes)))
+	case freeCPU < recommendedFreeCPUMillis || freeMemory < recommendedFreeMemoryBytes:
+		return c.warn(msg, fmt.Sprintf("Add nodes to the cluster, at least %s CPU and %s memory are recommended",
+			formatCPU(recommendedFreeCPUMillis), formatMemory(recommendedFreeMemoryBytes)))
+	}
+	return c.pass(msg)
+}
+
+func subtract(a, b uint64) uint64 {
+	if b > a {
+		return 0
+	}
+	return a - b
+}
+
+func formatCPU(millis uint64) string {
+	return resource.NewMilliQuantity(int64(millis), resource.DecimalSI).String() //nolint:gosec
+}
+
+func formatMemory(bytes uint64) string {
+	return resource.NewQuantity(int64(bytes), resource.BinarySI).String() //nolint:gosec
+}
+
+func checkPodSecurity(namespaces []corev1.Namespace) Check {
+	c := Check{Name: CheckPodSecurity}
+	var restricted []string
+	for _, ns := range namespaces {
+		if ns.GetLabels()[podSecurityEnforceLabel] == podSecurityRestricted {
+			restricted = append(restricted, ns.GetName())
+		}
+	}
+	if len(restricted) > 0 {
+		return c.warn(
+			fmt.Sprintf("The %s Pod Security Standard is enforced in %s, the pods of the operators and database clusters may be rejected",
+				podSecurityRestricted, strings.Join(restricted, ", ")),
+			fmt.Sprintf("Set the %s label of the namespaces to baseline or privileged", podSecurityEnforceLabel),
+		)
+	}
+	return c.pass(fmt.Sprintf("The %s Pod Security Standard is not enforced in the Everest namespaces", podSecurityRestricted))
+}
+
+func checkEverestComponents(deployments []deploymentStatus) Check {
+	c := Check{Name: CheckEverestComponents}
+	if problems := deploymentProblems(deployments); len(problems) > 0 {
+		return c.fail(strings.Join(problems, "; "), "Run everestctl support-bundle and check the events and logs of the deployments")
+	}
+	return c.pass(fmt.Sprintf("%d deployments are ready", len(deployments)))
+}
+
+// checkOLM checks the OLM deployments and the Everest catalog source.
+// catalogProblem describes why the catalog source is not ready, it is empty if it is ready.
+func checkOLM(deployments []deploymentStatus, catalogProblem string) Check {
+	c := Check{Name: CheckOLM}
+	problems := deploymentProblems(deployments)
+	if len(deployments) == 0 {
+		problems = append(problems, "no OLM deployment found")
+	}
+	if catalogProblem != "" {
+		problems = append(problems, catalogProblem)
+	}
+	if len(problems) > 0 {
+		return c.fail(strings.Join(problems, "; "), "Run everestctl support-bundle and check the events and logs of the OLM deployments")
+	}
+	return c.pass(fmt.Sprintf("%d OLM deployments and the Everest catalog source are ready", len(deployments)))
+}
+
+func deploymentProblems(deployments []deploymentStatus) []string {
+	var problems []string
+	for _, d := range deployments {
+		switch {
+		case d.NotFound:
+			problems = append(problems, fmt.Sprintf("deployment %s/%s not found", d.Namespace, d.Name))
+		case !d.Ready:
+			problems = append(problems, fmt.Sprintf("deployment %s/%s is not ready: %s", d.Namespace, d.Name, d.Message))
+		}
+	}
+	return problems
+}
+
+// operatorVersion is the version of a database operator installed in a namespace.
+type operatorVersion struct {
+	Namespace   string
+	Name        string
+	Version     *goversion.Version
+	Constraints goversion.Constraints
+}
+
+func checkOperatorVersions(operators []operatorVersion) Check {
+	c := Check{Name: CheckDatabaseOperators}
+	var problems []string
+	for _, o := range operators {
+		if o.Constraints != nil && !o.Constraints.Check(o.Version.Core()) {
+			problems = append(problems, fmt.Sprintf("%s %s in namespace %s does not satisfy the constraints %q",
+				o.Name, o.Version, o.Namespace, o.Constraints.String()))
+		}
+	}
+	if len(problems) > 0 {
+		return c.fail(strings.Join(problems, "; "), "Upgrade the database operators of the namespaces from the Everest UI")
+	}
+	if len(operators) == 0 {
+		return c.pass("No database operator installed")
+	}
+	return c.pass(fmt.Sprintf("%d database operators satisfy the version constraints", len(operators)))
+}
+
+// supportedVersionMetadata returns the metadata of the installed Everest
+// version, or of the latest version if Everest is not installed.
+func supportedVersionMetadata(meta *versionpb.MetadataResponse, installed *goversion.Version) *versionpb.MetadataVersion {
+	var (
+		latest     *goversion.Version
+		latestMeta *versionpb.MetadataVersion
+	)
+	for _, v := range meta.GetVersions() {
+		ver, err := goversion.NewSemver(v.GetVersion())
+		if err != nil {
+			continue
+		}
+		if installed != nil {
+			if ver.Core().Equal(installed.Core()) {
+				return v
+			}
+			continue
+		}
+		if latest == nil || ver.GreaterThan(latest) {
+			latest, latestMeta = ver, v
+		}
+	}
+	return latestMeta
+}
+
+func (c Check) pass(msg string) Check {
+	c.Status, c.Message = StatusPass, msg
+	return c
+}
+
+func (c Check) warn(msg, remediation string) Check {
+	c.Status, c.Message, c.Remediation = StatusWarn, msg, remediation
+	return c
+}
+
+func (c Check) fail(msg, remediation string) Check {
+	c.Status, c.Message, c.Remediation = StatusFail, msg, remediation
+	return c
+}
+
+// unknown returns a warning for a check that could not be run.
+func (c Check) unknown(err error) Check {
+	return c.warn(fmt.Sprintf("Could not run the check: %s", err), "")
+}
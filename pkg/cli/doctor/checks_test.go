@@ -0,0 +1,195 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doctor
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	versionpb "github.com/Percona-Lab/percona-version-service/versionpb"
+	goversion "github.com/hashicorp/go-version"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCheckKubernetesVersion(t *testing.T) {
+	t.Parallel()
+
+	constraints, err := goversion.NewConstraint(">= 1.27.0, < 1.33.0")
+	require.NoError(t, err)
+
+	c := checkKubernetesVersion(goversion.Must(goversion.NewVersion("v1.30.2-eks-1552ad0")), constraints)
+	assert.Equal(t, StatusPass, c.Status)
+	c = checkKubernetesVersion(goversion.Must(goversion.NewVersion("v1.25.0")), constraints)
+	assert.Equal(t, StatusFail, c.Status)
+	c = checkKubernetesVersion(goversion.Must(goversion.NewVersion("v1.25.0")), nil)
+	assert.Equal(t, StatusWarn, c.Status)
+}
+
+func TestCheckStorageClasses(t *testing.T) {
+	t.Parallel()
+
+	storageClass := func(name string, isDefault, expandable bool) storagev1.StorageClass {
+		sc := storagev1.StorageClass{
+			ObjectMeta:           metav1.ObjectMeta{Name: name},
+			AllowVolumeExpansion: pointer.To(expandable),
+		}
+		if isDefault {
+			sc.Annotations = map[string]string{defaultStorageClassAnnotation: "true"}
+		}
+		return sc
+	}
+
+	testCases := []struct {
+		name    string
+		classes []storagev1.StorageClass
+		status  Status
+		message string
+	}{
+		{
+			name:    "no storage class",
+			status:  StatusFail,
+			message: "No storage class found",
+		},
+		{
+			name:    "no default",
+			classes: []storagev1.StorageClass{storageClass("gp2", false, true)},
+			status:  StatusWarn,
+			message: "No default storage class, the storage class must be set explicitly for every database cluster",
+		},
+		{
+			name:    "no volume expansion",
+			classes: []storagev1.StorageClass{storageClass("standard", true, false)},
+			status:  StatusWarn,
+			message: "No storage class allows volume expansion, the storage of the database clusters cannot be resized",
+		},
+		{
+			name:    "default without volume expansion",
+			classes: []storagev1.StorageClass{storageClass("standard", true, false), storageClass("ssd", false, true)},
+			status:  StatusWarn,
+			message: "The default storage class standard does not allow volume expansion, use one of ssd to be able to resize the storage",
+		},
+		{
+			name:    "default with volume expansion",
+			classes: []storagev1.StorageClass{storageClass("standard", false, false), storageClass("ssd", true, true)},
+			status:  StatusPass,
+			message: "Default storage class ssd allows volume expansion",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			c := checkStorageClasses(tc.classes)
+			assert.Equal(t, tc.status, c.Status)
+			assert.Equal(t, tc.message, c.Message)
+		})
+	}
+}
+
+func TestCheckResources(t *testing.T) {
+	t.Parallel()
+
+	c := checkResources(16000, 64<<30, 2000, 8<<30)
+	assert.Equal(t, StatusPass, c.Status)
+	assert.Equal(t, "14 CPU and 56Gi memory available out of 16 CPU and 64Gi memory", c.Message)
+
+	c = checkResources(4000, 16<<30, 1500, 4<<30)
+	assert.Equal(t, StatusWarn, c.Status)
+
+	c = checkResources(4000, 16<<30, 3500, 4<<30)
+	assert.Equal(t, StatusFail, c.Status)
+
+	// The consumed resources may exceed the allocatable ones.
+	c = checkResources(4000, 16<<30, 5000, 4<<30)
+	assert.Equal(t, StatusFail, c.Status)
+	assert.Equal(t, "0 CPU and 12Gi memory available out of 4 CPU and 16Gi memory", c.Message)
+}
+
+func TestCheckPodSecurity(t *testing.T) {
+	t.Parallel()
+
+	c := checkPodSecurity([]corev1.Namespace{
+		{ObjectMeta: metav1.ObjectMeta{Name: "everest-system"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "everest", Labels: map[string]string{podSecurityEnforceLabel: "baseline"}}},
+	})
+	assert.Equal(t, StatusPass, c.Status)
+
+	c = checkPodSecurity([]corev1.Namespace{
+		{ObjectMeta: metav1.ObjectMeta{Name: "everest", Labels: map[string]string{podSecurityEnforceLabel: podSecurityRestricted}}},
+	})
+	assert.Equal(t, StatusWarn, c.Status)
+	assert.Contains(t, c.Message, "enforced in everest,")
+}
+
+func TestCheckOLM(t *testing.T) {
+	t.Parallel()
+
+	ready := deploymentStatus{Namespace: "everest-olm", Name: "olm-operator", Ready: true}
+	c := checkOLM([]deploymentStatus{ready}, "")
+	assert.Equal(t, StatusPass, c.Status)
+
+	c = checkOLM(nil, "")
+	assert.Equal(t, StatusFail, c.Status)
+	assert.Equal(t, "no OLM deployment found", c.Message)
+
+	c = checkOLM([]deploymentStatus{
+		ready,
+		{Namespace: "everest-olm", Name: "catalog-operator", Message: "0 of 1 updated replicas are available"},
+	}, "catalog source everest-olm/everest-catalog is not ready")
+	assert.Equal(t, StatusFail, c.Status)
+	assert.Equal(t,
+		"deployment everest-olm/catalog-operator is not ready: 0 of 1 updated replicas are available; catalog source everest-olm/everest-catalog is not ready",
+		c.Message,
+	)
+}
+
+func TestCheckOperatorVersions(t *testing.T) {
+	t.Parallel()
+
+	constraints, err := goversion.NewConstraint(">= 1.14.0")
+	require.NoError(t, err)
+
+	c := checkOperatorVersions([]operatorVersion{
+		{Namespace: "everest", Name: "percona-xtradb-cluster-operator", Version: goversion.Must(goversion.NewVersion("1.15.0")), Constraints: constraints},
+		// The constraints are unknown.
+		{Namespace: "everest", Name: "percona-postgresql-operator", Version: goversion.Must(goversion.NewVersion("2.3.0"))},
+	})
+	assert.Equal(t, StatusPass, c.Status)
+
+	c = checkOperatorVersions([]operatorVersion{
+		{Namespace: "everest", Name: "percona-xtradb-cluster-operator", Version: goversion.Must(goversion.NewVersion("1.13.0")), Constraints: constraints},
+	})
+	assert.Equal(t, StatusFail, c.Status)
+}
+
+func TestSupportedVersionMetadata(t *testing.T) {
+	t.Parallel()
+
+	meta := &versionpb.MetadataResponse{
+		Versions: []*versionpb.MetadataVersion{
+			{Version: "1.3.0"},
+			{Version: "1.5.0"},
+			{Version: "invalid"},
+			{Version: "1.4.0"},
+		},
+	}
+	assert.Equal(t, "1.5.0", supportedVersionMetadata(meta, nil).GetVersion())
+	assert.Equal(t, "1.4.0", supportedVersionMetadata(meta, goversion.Must(goversion.NewVersion("1.4.0"))).GetVersion())
+	assert.Nil(t, supportedVersionMetadata(meta, goversion.Must(goversion.NewVersion("1.6.0"))))
+}
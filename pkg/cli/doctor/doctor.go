@@ -0,0 +1,418 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package doctor provides the functionality to run the pre-flight and health checks of Everest.
+package doctor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	goversion "github.com/hashicorp/go-version"
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clientgo "k8s.io/client-go/kubernetes"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	cliutils "github.com/percona/everest/pkg/cli/utils"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/output"
+	"github.com/percona/everest/pkg/version"
+	versionservice "github.com/percona/everest/pkg/version_service"
+)
+
+// ErrChecksFailed is returned when at least one check has failed.
+var ErrChecksFailed = errors.New("some checks have failed")
+
+// requiredPermissions are the permissions required to install and manage Everest.
+//
+//nolint:gochecknoglobals
+var requiredPermissions = []authorizationv1.ResourceAttributes{
+	{Verb: "create", Resource: "namespaces"},
+	{Verb: "create", Group: "apiextensions.k8s.io", Resource: "customresourcedefinitions"},
+	{Verb: "create", Group: "rbac.authorization.k8s.io", Resource: "clusterroles"},
+	{Verb: "create", Group: "rbac.authorization.k8s.io", Resource: "clusterrolebindings"},
+	{Verb: "create", Group: "apps", Resource: "deployments", Namespace: common.SystemNamespace},
+	{Verb: "create", Group: "operators.coreos.com", Resource: "subscriptions", Namespace: common.SystemNamespace},
+	{Verb: "list", Resource: "nodes"},
+	{Verb: "list", Group: "storage.k8s.io", Resource: "storageclasses"},
+}
+
+type (
+	// Config is the configuration for running the checks.
+	Config struct {
+		// KubeconfigPath is a path to a kubeconfig
+		KubeconfigPath string
+		// Pretty if set print the output in pretty mode.
+		Pretty bool
+		// VersionMetadataURL is the URL of the version service used to get the supported versions.
+		VersionMetadataURL string
+	}
+
+	// Report is the result of all the checks.
+	Report struct {
+		// Status is the worst status of the checks.
+		Status Status `json:"status"`
+		// EverestVersion is the installed Everest version, empty if Everest is not installed.
+		EverestVersion string  `json:"everestVersion,omitempty"`
+		Checks         []Check `json:"checks"`
+	}
+
+	// Doctor is the CLI operation to run the pre-flight and health checks of Everest.
+	Doctor struct {
+		cfg            Config
+		kubeClient     kubernetes.KubernetesConnector
+		clientset      clientgo.Interface
+		versionService versionservice.Interface
+		l              *zap.SugaredLogger
+
+		// set during a run.
+		clusterType    kubernetes.ClusterType
+		everestVersion *goversion.Version
+		supVer         *common.SupportedVersion
+		supVerErr      error
+	}
+)
+
+// NewDoctor returns a new CLI operation to run the checks.
+func NewDoctor(c Config, l *zap.SugaredLogger) (*Doctor, error) {
+	d := &Doctor{
+		cfg: c,
+		l:   l.With("component", "doctor"),
+	}
+	if c.Pretty {
+		d.l = zap.NewNop().Sugar()
+	}
+
+	k, err := cliutils.NewKubeConnector(d.l, d.cfg.KubeconfigPath)
+	if err != nil {
+		return nil, err
+	}
+	d.kubeClient = k
+	d.versionService = versionservice.New(c.VersionMetadataURL, versionservice.WithConfigMapGetter(k))
+	if d.clientset, err = clientgo.NewForConfig(k.Config()); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Run runs the checks and prints their results.
+// It returns ErrChecksFailed if at least one check has failed.
+func (d *Doctor) Run(ctx context.Context) (*Report, error) {
+	var out io.Writer = os.Stdout
+	if !d.cfg.Pretty {
+		out = io.Discard
+	}
+
+	if err := d.init(ctx); err != nil {
+		return nil, err
+	}
+
+	report := &Report{Status: StatusPass}
+	if d.everestVersion != nil {
+		report.EverestVersion = d.everestVersion.String()
+	}
+	for _, run := range []func(context.Context) Check{
+		d.checkKubernetesVersion,
+		d.checkCLIVersion,
+		d.checkPermissions,
+		d.checkClusterType,
+		d.checkStorageClasses,
+		d.checkResources,
+		d.checkPodSecurity,
+		d.checkOLM,
+		d.checkEverestComponents,
+		d.checkDatabaseOperators,
+	} {
+		c := run(ctx)
+		d.l.Infow(c.Message, "check", c.Name, "status", c.Status, "remediation", c.Remediation)
+		switch c.Status {
+		case StatusFail:
+			report.Status = StatusFail
+			_, _ = fmt.Fprint(out, output.Failure("[%s] %s", c.Name, c.Message))
+		case StatusWarn:
+			if report.Status == StatusPass {
+				report.Status = StatusWarn
+			}
+			_, _ = fmt.Fprint(out, output.Warn("[%s] %s", c.Name, c.Message))
+		default:
+			_, _ = fmt.Fprint(out, output.Success("[%s] %s", c.Name, c.Message))
+		}
+		if c.Remediation != "" {
+			_, _ = fmt.Fprintf(out, "   %s\n", c.Remediation)
+		}
+		report.Checks = append(report.Checks, c)
+	}
+
+	if report.Status == StatusFail {
+		return report, ErrChecksFailed
+	}
+	return report, nil
+}
+
+// init detects the cluster type and the installed Everest version, and
+// fetches the versions supported by it. Everest may not be installed yet.
+func (d *Doctor) init(ctx context.Context) error {
+	clusterType, err := d.kubeClient.GetClusterType(ctx)
+	if err != nil {
+		return fmt.Errorf("could not detect the cluster type: %w", err)
+	}
+	d.clusterType = clusterType
+
+	ver, err := version.EverestVersionFromDeployment(ctx, d.kubeClient)
+	if ctrlclient.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("could not get the installed Everest version: %w", err)
+	}
+	d.everestVersion = ver
+
+	meta, err := d.versionService.GetEverestMetadata(ctx)
+	if err != nil {
+		d.supVerErr = fmt.Errorf("could not fetch version metadata: %w", err)
+		return nil //nolint:nilerr
+	}
+	versionMeta := supportedVersionMetadata(meta, d.everestVersion)
+	if versionMeta == nil {
+		d.supVerErr = errors.New("the installed Everest version is not found in the version metadata")
+		return nil
+	}
+	d.supVer, d.supVerErr = common.NewSupportedVersion(versionMeta)
+	return nil
+}
+
+func (d *Doctor) checkKubernetesVersion(_ context.Context) Check {
+	c := Check{Name: CheckKubernetesVersion}
+	info, err := d.clientset.Discovery().ServerVersion()
+	if err != nil {
+		return c.unknown(err)
+	}
+	server, err := goversion.NewVersion(info.GitVersion)
+	if err != nil {
+		return c.unknown(err)
+	}
+	if d.supVerErr != nil {
+		return c.warn(fmt.Sprintf("Kubernetes %s, the supported versions are unknown: %s", server, d.supVerErr), "")
+	}
+	return checkKubernetesVersion(server, d.supVer.Kubernetes)
+}
+
+func (d *Doctor) checkCLIVersion(_ context.Context) Check {
+	c := Check{Name: CheckCLIVersion}
+	if d.supVerErr != nil {
+		return c.unknown(d.supVerErr)
+	}
+	if err := cliutils.VerifyCLIVersion(d.supVer); err != nil {
+		return c.warn(err.Error(), "Install the everestctl version matching the installed Everest version")
+	}
+	return c.pass(fmt.Sprintf("everestctl %s satisfies the constraints %q", version.Version, d.supVer.Cli.String()))
+}
+
+func (d *Doctor) checkPermissions(ctx context.Context) Check {
+	var denied []string
+	for _, attrs := range requiredPermissions {
+		review := &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{ResourceAttributes: &attrs},
+		}
+		res, err := d.clientset.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
+		if err != nil {
+			return Check{Name: CheckPermissions}.unknown(err)
+		}
+		if !res.Status.Allowed {
+			denied = append(denied, permissionString(attrs))
+		}
+	}
+	return checkPermissions(denied)
+}
+
+func permissionString(attrs authorizationv1.ResourceAttributes) string {
+	resource := attrs.Resource
+	if attrs.Group != "" {
+		resource += "." + attrs.Group
+	}
+	if attrs.Namespace != "" {
+		return fmt.Sprintf("%s %s in namespace %s", attrs.Verb, resource, attrs.Namespace)
+	}
+	return attrs.Verb + " " + resource
+}
+
+func (d *Doctor) checkClusterType(_ context.Context) Check {
+	return Check{Name: CheckClusterType}.pass(fmt.Sprintf("Detected %s cluster", d.clusterType))
+}
+
+func (d *Doctor) checkStorageClasses(ctx context.Context) Check {
+	classes, err := d.kubeClient.ListStorageClasses(ctx)
+	if err != nil {
+		return Check{Name: CheckStorageClasses}.unknown(err)
+	}
+	return checkStorageClasses(classes.Items)
+}
+
+func (d *Doctor) checkResources(ctx context.Context) Check {
+	c := Check{Name: CheckResources}
+	volumes, err := d.kubeClient.ListPersistentVolumes(ctx)
+	if err != nil {
+		return c.unknown(err)
+	}
+	cpu, memory, _, err := d.kubeClient.GetAllClusterResources(ctx, d.clusterType, volumes)
+	if err != nil {
+		return c.unknown(err)
+	}
+	consumedCPU, consumedMemory, err := d.kubeClient.GetConsumedCPUAndMemory(ctx, "")
+	if err != nil {
+		return c.unknown(err)
+	}
+	return checkResources(cpu, memory, consumedCPU, consumedMemory)
+}
+
+func (d *Doctor) checkPodSecurity(ctx context.Context) Check {
+	c := Check{Name: CheckPodSecurity}
+	var namespaces []corev1.Namespace
+	for _, name := range []string{common.SystemNamespace, common.MonitoringNamespace} {
+		ns, err := d.kubeClient.GetNamespace(ctx, types.NamespacedName{Name: name})
+		if k8serrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return c.unknown(err)
+		}
+		namespaces = append(namespaces, *ns)
+	}
+	dbNamespaces, err := d.kubeClient.GetDBNamespaces(ctx)
+	if err != nil {
+		return c.unknown(err)
+	}
+	return checkPodSecurity(append(namespaces, dbNamespaces.Items...))
+}
+
+func (d *Doctor) checkOLM(ctx context.Context) Check {
+	c := Check{Name: CheckOLM}
+	if d.everestVersion == nil {
+		return c.pass("Everest is not installed")
+	}
+	depls, err := d.kubeClient.ListDeployments(ctx, ctrlclient.InNamespace(kubernetes.OLMNamespace))
+	if err != nil {
+		return c.unknown(err)
+	}
+	statuses := make([]deploymentStatus, 0, len(depls.Items))
+	for _, depl := range depls.Items {
+		statuses = append(statuses, rolloutStatus(&depl))
+	}
+
+	// The Everest catalog source is installed in the OLM namespace by the Helm chart.
+	var catalogProblem string
+	cs, err := d.kubeClient.GetCatalogSource(ctx, types.NamespacedName{Namespace: kubernetes.OLMNamespace, Name: common.PerconaEverestCatalogName})
+	switch {
+	case k8serrors.IsNotFound(err):
+		catalogProblem = fmt.Sprintf("catalog source %s/%s not found", kubernetes.OLMNamespace, common.PerconaEverestCatalogName)
+	case err != nil:
+		return c.unknown(err)
+	case !kubernetes.IsCatalogSourceReady(cs):
+		catalogProblem = fmt.Sprintf("catalog source %s/%s is not ready", kubernetes.OLMNamespace, common.PerconaEverestCatalogName)
+	}
+	return checkOLM(statuses, catalogProblem)
+}
+
+func (d *Doctor) checkEverestComponents(ctx context.Context) Check {
+	c := Check{Name: CheckEverestComponents}
+	if d.everestVersion == nil {
+		return c.pass("Everest is not installed")
+	}
+	keys := []types.NamespacedName{
+		{Namespace: common.SystemNamespace, Name: common.PerconaEverestDeploymentName},
+		{Namespace: common.SystemNamespace, Name: common.PerconaEverestOperatorDeploymentName},
+		{Namespace: common.MonitoringNamespace, Name: common.VictoriaMetricsOperatorDeploymentName},
+	}
+	if d.clusterType != kubernetes.ClusterTypeOpenShift {
+		keys = append(keys, types.NamespacedName{Namespace: common.MonitoringNamespace, Name: common.KubeStateMetricsDeploymentName})
+	}
+	statuses := make([]deploymentStatus, 0, len(keys))
+	for _, key := range keys {
+		depl, err := d.kubeClient.GetDeployment(ctx, key)
+		if k8serrors.IsNotFound(err) {
+			statuses = append(statuses, deploymentStatus{Namespace: key.Namespace, Name: key.Name, NotFound: true})
+			continue
+		}
+		if err != nil {
+			return c.unknown(err)
+		}
+		statuses = append(statuses, rolloutStatus(depl))
+	}
+	res := checkEverestComponents(statuses)
+	res.Message = fmt.Sprintf("Everest %s: %s", d.everestVersion, res.Message)
+	return res
+}
+
+func rolloutStatus(depl *appsv1.Deployment) deploymentStatus {
+	ready, msg, err := kubernetes.DeploymentRolloutStatus(depl)
+	if err != nil {
+		msg = err.Error()
+	}
+	return deploymentStatus{Namespace: depl.GetNamespace(), Name: depl.GetName(), Ready: ready, Message: msg}
+}
+
+func (d *Doctor) checkDatabaseOperators(ctx context.Context) Check {
+	c := Check{Name: CheckDatabaseOperators}
+	if d.everestVersion == nil {
+		return c.pass("Everest is not installed")
+	}
+	nss, err := d.kubeClient.GetDBNamespaces(ctx)
+	if err != nil {
+		return c.unknown(err)
+	}
+
+	var operators []operatorVersion
+	for _, ns := range nss.Items {
+		for _, name := range []string{common.MySQLOperatorName, common.PostgreSQLOperatorName, common.MongoDBOperatorName} {
+			v, err := d.kubeClient.GetInstalledOperatorVersion(ctx, types.NamespacedName{Namespace: ns.GetName(), Name: name})
+			if errors.Is(err, kubernetes.ErrOperatorNotInstalled) {
+				continue
+			}
+			if err != nil {
+				return c.unknown(err)
+			}
+			operators = append(operators, operatorVersion{
+				Namespace:   ns.GetName(),
+				Name:        name,
+				Version:     v,
+				Constraints: d.operatorConstraints(name),
+			})
+		}
+	}
+	return checkOperatorVersions(operators)
+}
+
+// operatorConstraints returns the supported versions of the operator, nil if they are unknown.
+func (d *Doctor) operatorConstraints(name string) goversion.Constraints {
+	if d.supVer == nil {
+		return nil
+	}
+	switch name {
+	case common.MySQLOperatorName:
+		return d.supVer.PXCOperator
+	case common.PostgreSQLOperatorName:
+		return d.supVer.PGOperator
+	case common.MongoDBOperatorName:
+		return d.supVer.PSMBDOperator
+	}
+	return nil
+}
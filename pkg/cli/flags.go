@@ -50,6 +50,14 @@ const (
 	FlagDisableTelemetry = "disable-telemetry"
 	// FlagInstallSkipDBNamespace is the name of the skip-db-namespace flag.
 	FlagInstallSkipDBNamespace = "skip-db-namespace"
+	// FlagBundle is the name of the bundle flag.
+	FlagBundle = "bundle"
+	// FlagRender is the name of the render flag.
+	FlagRender = "render"
+	// FlagRenderOutput is the name of the flag with the directory of the rendered manifests.
+	FlagRenderOutput = "output"
+	// FlagConfig is the name of the flag with the path of the declarative configuration file.
+	FlagConfig = "config"
 
 	// `namespaces` flags
 
@@ -61,6 +69,8 @@ const (
 	FlagNamespaceForce = "force"
 	// FlagNamespaceAll is the name of the all flag.
 	FlagNamespaceAll = "all"
+	// FlagNamespaceQuota is the name of the quota flag.
+	FlagNamespaceQuota = "quota"
 
 	// `upgrade` flags
 
@@ -0,0 +1,143 @@
+package helm
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/release"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const diffContextLines = 3
+
+// ReleaseTemplate returns the rendered templates of a release.
+// The CRDs and the hooks of the chart are not part of them.
+func ReleaseTemplate(rel *release.Release) RenderedTemplate {
+	if rel == nil {
+		return RenderedTemplate{}
+	}
+	return newRenderedTemplate(rel.Manifest)
+}
+
+// ReleaseTemplateWithHooks returns the rendered templates of a release, including its hooks.
+// The CRDs of the chart are not part of them.
+func ReleaseTemplateWithHooks(rel *release.Release) RenderedTemplate {
+	t := ReleaseTemplate(rel)
+	if rel == nil {
+		return t
+	}
+	for _, h := range rel.Hooks {
+		t = append(t, strings.TrimSpace(h.Manifest))
+	}
+	return t
+}
+
+// DiffValues returns the unified diff of the YAML representation of the values.
+// It returns an empty string if the values are equal.
+func DiffValues(from, to map[string]interface{}) (string, error) {
+	fromYAML, err := valuesYAML(from)
+	if err != nil {
+		return "", err
+	}
+	toYAML, err := valuesYAML(to)
+	if err != nil {
+		return "", err
+	}
+	return unifiedDiff(fromYAML, toYAML, "current values", "new values")
+}
+
+func valuesYAML(vals map[string]interface{}) (string, error) {
+	if len(vals) == 0 {
+		return "", nil
+	}
+	out, err := yaml.Marshal(chartutil.Values(vals))
+	if err != nil {
+		return "", fmt.Errorf("could not marshal values: %w", err)
+	}
+	return string(out), nil
+}
+
+// DiffManifests returns the unified diff of the objects that are added, removed or
+// changed between the rendered templates. The objects are matched by their kind,
+// namespace and name. The CRDs are skipped, use the crds package to compare them.
+// It returns an empty string if the templates are equal.
+func DiffManifests(from, to RenderedTemplate) (string, error) {
+	fromObjs, err := objectsByKey(from)
+	if err != nil {
+		return "", err
+	}
+	toObjs, err := objectsByKey(to)
+	if err != nil {
+		return "", err
+	}
+
+	keys := make([]string, 0, len(fromObjs)+len(toObjs))
+	for k := range fromObjs {
+		keys = append(keys, k)
+	}
+	for k := range toObjs {
+		if _, ok := fromObjs[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	for _, k := range keys {
+		d, err := unifiedDiff(fromObjs[k], toObjs[k], k, k)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(d)
+	}
+	return sb.String(), nil
+}
+
+// objectsByKey returns the documents of the templates by the kind, namespace and name of their object.
+func objectsByKey(t RenderedTemplate) (map[string]string, error) {
+	result := make(map[string]string, len(t))
+	for _, doc := range t.Strings() {
+		var obj metav1.PartialObjectMetadata
+		if err := yaml.Unmarshal([]byte(doc), &obj); err != nil {
+			return nil, fmt.Errorf("could not parse manifest: %w", err)
+		}
+		if obj.Kind == "" || obj.Kind == "CustomResourceDefinition" {
+			continue
+		}
+		key := obj.Kind + " " + obj.GetName()
+		if obj.GetNamespace() != "" {
+			key = obj.Kind + " " + obj.GetNamespace() + "/" + obj.GetName()
+		}
+		result[key] = doc + "\n"
+	}
+	return result, nil
+}
+
+func unifiedDiff(from, to, fromName, toName string) (string, error) {
+	if from == to {
+		return "", nil
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(from),
+		B:        splitLines(to),
+		FromFile: fromName,
+		ToFile:   toName,
+		Context:  diffContextLines,
+	})
+}
+
+// splitLines splits the text into lines keeping the line endings.
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
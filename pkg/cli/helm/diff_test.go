@@ -0,0 +1,198 @@
+package helm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+func TestDiffValues(t *testing.T) {
+	t.Parallel()
+
+	d, err := DiffValues(
+		map[string]interface{}{"server": map[string]interface{}{"replicas": 2}},
+		map[string]interface{}{"server": map[string]interface{}{"replicas": 3}},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, `--- current values
++++ new values
+@@ -1,2 +1,2 @@
+ server:
+-  replicas: 2
++  replicas: 3
+`, d)
+
+	d, err = DiffValues(map[string]interface{}{"a": "b"}, map[string]interface{}{"a": "b"})
+	require.NoError(t, err)
+	assert.Empty(t, d)
+}
+
+func TestDiffManifests(t *testing.T) {
+	t.Parallel()
+
+	from := newRenderedTemplate(`---
+# Source: everest/templates/everest-server.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: everest-server
+  namespace: everest-system
+spec:
+  replicas: 1
+---
+# Source: everest/templates/removed.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: removed
+  namespace: everest-system
+---
+# Source: everest/crds/crd.yaml
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: databaseclusters.everest.percona.com
+`)
+	to := newRenderedTemplate(`---
+# Source: everest/templates/everest-server.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: everest-server
+  namespace: everest-system
+spec:
+  replicas: 2
+---
+# Source: everest/templates/role.yaml
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: added
+`)
+
+	d, err := DiffManifests(from, to)
+	require.NoError(t, err)
+	assert.Equal(t, `--- ClusterRole added
++++ ClusterRole added
+@@ -0,0 +1,5 @@
++# Source: everest/templates/role.yaml
++apiVersion: rbac.authorization.k8s.io/v1
++kind: ClusterRole
++metadata:
++  name: added
+--- ConfigMap everest-system/removed
++++ ConfigMap everest-system/removed
+@@ -1,6 +0,0 @@
+-# Source: everest/templates/removed.yaml
+-apiVersion: v1
+-kind: ConfigMap
+-metadata:
+-  name: removed
+-  namespace: everest-system
+--- Deployment everest-system/everest-server
++++ Deployment everest-system/everest-server
+@@ -5,4 +5,4 @@
+   name: everest-server
+   namespace: everest-system
+ spec:
+-  replicas: 1
++  replicas: 2
+`, d)
+
+	d, err = DiffManifests(from, from)
+	require.NoError(t, err)
+	assert.Empty(t, d)
+}
+
+func TestReleaseTemplateWithHooks(t *testing.T) {
+	t.Parallel()
+
+	rel := &release.Release{
+		Manifest: "---\n# Source: everest/templates/cm.yaml\nkind: ConfigMap\nmetadata:\n  name: cm\n",
+		Hooks: []*release.Hook{
+			{Manifest: "kind: Job\nmetadata:\n  name: pre-upgrade\n", Events: []release.HookEvent{release.HookPreUpgrade}},
+		},
+	}
+	assert.Len(t, ReleaseTemplate(rel), 1)
+	withHooks := ReleaseTemplateWithHooks(rel)
+	require.Len(t, withHooks, 2)
+	assert.Contains(t, withHooks[1], "name: pre-upgrade")
+	assert.Empty(t, ReleaseTemplateWithHooks(nil))
+}
+
+func TestPreviousRelease(t *testing.T) {
+	t.Parallel()
+
+	rel := func(version int, chartVersion string, status release.Status) *release.Release {
+		return &release.Release{
+			Version: version,
+			Info:    &release.Info{Status: status},
+			Chart:   &chart.Chart{Metadata: &chart.Metadata{Version: chartVersion}},
+		}
+	}
+
+	t.Run("skip failed revisions", func(t *testing.T) {
+		t.Parallel()
+		current, previous, err := PreviousRelease([]*release.Release{
+			rel(4, "1.5.0", release.StatusFailed),
+			rel(3, "1.5.0", release.StatusDeployed),
+			rel(2, "1.4.0", release.StatusFailed),
+			rel(1, "1.4.0", release.StatusSuperseded),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 3, current.Version)
+		assert.Equal(t, 1, previous.Version)
+	})
+
+	t.Run("skip revisions with the same chart version", func(t *testing.T) {
+		t.Parallel()
+		current, previous, err := PreviousRelease([]*release.Release{
+			rel(4, "1.5.0", release.StatusDeployed),
+			rel(3, "1.5.0", release.StatusSuperseded),
+			rel(2, "1.4.0", release.StatusSuperseded),
+			rel(1, "1.4.0", release.StatusSuperseded),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 4, current.Version)
+		assert.Equal(t, 2, previous.Version)
+	})
+
+	t.Run("no previous chart version", func(t *testing.T) {
+		t.Parallel()
+		_, _, err := PreviousRelease([]*release.Release{
+			rel(2, "1.5.0", release.StatusDeployed),
+			rel(1, "1.5.0", release.StatusSuperseded),
+		})
+		require.ErrorIs(t, err, ErrNoPreviousRelease)
+	})
+
+	t.Run("no deployed revision", func(t *testing.T) {
+		t.Parallel()
+		_, _, err := PreviousRelease([]*release.Release{rel(1, "1.5.0", release.StatusFailed)})
+		require.Error(t, err)
+		require.NotErrorIs(t, err, ErrNoPreviousRelease)
+	})
+}
+
+func TestReleaseWithChartVersion(t *testing.T) {
+	t.Parallel()
+
+	rel := func(version int, chartVersion string) *release.Release {
+		return &release.Release{
+			Version: version,
+			Chart:   &chart.Chart{Metadata: &chart.Metadata{Version: chartVersion}},
+		}
+	}
+	history := []*release.Release{
+		rel(4, "1.5.0"),
+		rel(3, "1.4.0"),
+		rel(2, "1.4.0"),
+		{Version: 1},
+	}
+
+	assert.Equal(t, 3, ReleaseWithChartVersion(history, "1.4.0").Version)
+	assert.Nil(t, ReleaseWithChartVersion(history, "1.3.0"))
+}
@@ -15,4 +15,6 @@ const (
 	FlagHelmResetValues = "helm.reset-values"
 	// FlagHelmResetThenReuseValues is a CLI flag for resetting then reusing Helm values.
 	FlagHelmResetThenReuseValues = "helm.reset-then-reuse-values"
+	// FlagImageRegistry is a CLI flag for specifying the registry to pull the images from.
+	FlagImageRegistry = "image-registry"
 )
@@ -0,0 +1,81 @@
+package helm
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/percona/everest/pkg/output"
+)
+
+// imageLineRegex matches the lines of the rendered manifests that set an image,
+// e.g. the images of the containers and of the catalog sources.
+var imageLineRegex = regexp.MustCompile(`^(\s*(?:-\s+)?image:\s*)(["']?)([^"'\s#]+)(["']?\s*)$`)
+
+// ListImages returns the sorted images referenced by the rendered templates.
+func ListImages(t RenderedTemplate) []string {
+	images := []string{}
+	for _, doc := range t.Strings() {
+		for _, line := range strings.Split(doc, "\n") {
+			if m := imageLineRegex.FindStringSubmatch(line); m != nil {
+				images = append(images, m[3])
+			}
+		}
+	}
+	slices.Sort(images)
+	return slices.Compact(images)
+}
+
+// RewriteImageRegistry returns the image pulled from the registry instead of its
+// original registry. The repository path of the image is kept, e.g.
+// docker.io/percona/everest:1.0.0 is pulled from <registry>/percona/everest:1.0.0.
+func RewriteImageRegistry(image, registry string) string {
+	repo := image
+	if host, rest, ok := strings.Cut(image, "/"); ok && (strings.ContainsAny(host, ".:") || host == "localhost") {
+		repo = rest
+	}
+	if !strings.Contains(repo, "/") {
+		// Official Docker Hub images.
+		repo = "library/" + repo
+	}
+	return strings.TrimSuffix(registry, "/") + "/" + repo
+}
+
+// imageRegistryPostRenderer rewrites the images of the rendered manifests to be
+// pulled from a mirror registry. The manifests are rewritten line by line so
+// that the source comments used by Helm are preserved.
+type imageRegistryPostRenderer struct {
+	registry string
+}
+
+// Run implements postrender.PostRenderer.
+func (r *imageRegistryPostRenderer) Run(renderedManifests *bytes.Buffer) (*bytes.Buffer, error) {
+	lines := strings.Split(renderedManifests.String(), "\n")
+	for i, line := range lines {
+		if m := imageLineRegex.FindStringSubmatch(line); m != nil {
+			lines[i] = m[1] + m[2] + RewriteImageRegistry(m[3], r.registry) + m[4]
+		}
+	}
+	return bytes.NewBufferString(strings.Join(lines, "\n")), nil
+}
+
+// WarnImageRegistry warns that only the images of the Helm manifests, including
+// the Everest catalog, are pulled from the registry. The database operators
+// are installed by OLM from the catalog bundles, and the database engine images
+// are chosen by the operators, so both are still pulled from their original
+// registries unless the nodes are configured to pull them from a mirror.
+func WarnImageRegistry(l *zap.SugaredLogger, registry string) {
+	if registry == "" {
+		return
+	}
+	msg := fmt.Sprintf("Only the images of the Everest Helm charts and of the Everest catalog are pulled from %s. "+
+		"The database operator and engine images are still pulled from their original registries, "+
+		"configure the container runtime of the nodes to pull them from a mirror.", registry)
+	_, _ = fmt.Fprintln(os.Stdout, output.Warn("%s", msg))
+	l.Warn(msg)
+}
@@ -0,0 +1,79 @@
+package helm
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testManifests = `---
+# Source: everest/templates/everest-server.yaml
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      containers:
+        - name: everest
+          image: docker.io/percona/everest:1.4.0
+        - image: "quay.io/operator-framework/olm@sha256:1234"
+          name: olm
+---
+# Source: everest/templates/everest-catalogsource.yaml
+apiVersion: operators.coreos.com/v1alpha1
+kind: CatalogSource
+spec:
+  image: perconalab/everest-catalog:1.4.0
+  # image: commented/out:1.0.0
+`
+
+func TestListImages(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{
+		"docker.io/percona/everest:1.4.0",
+		"perconalab/everest-catalog:1.4.0",
+		"quay.io/operator-framework/olm@sha256:1234",
+	}, ListImages(newRenderedTemplate(testManifests)))
+}
+
+func TestRewriteImageRegistry(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		image string
+		want  string
+	}{
+		{image: "docker.io/percona/everest:1.4.0", want: "registry.local:5000/percona/everest:1.4.0"},
+		{image: "percona/everest:1.4.0", want: "registry.local:5000/percona/everest:1.4.0"},
+		{image: "busybox:1.36", want: "registry.local:5000/library/busybox:1.36"},
+		{image: "localhost/percona/everest:1.4.0", want: "registry.local:5000/percona/everest:1.4.0"},
+		{image: "quay.io/operator-framework/olm@sha256:1234", want: "registry.local:5000/operator-framework/olm@sha256:1234"},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.want, RewriteImageRegistry(tc.image, "registry.local:5000/"), tc.image)
+	}
+}
+
+func TestImageRegistryPostRenderer(t *testing.T) {
+	t.Parallel()
+
+	r := &imageRegistryPostRenderer{registry: "registry.local"}
+	out, err := r.Run(bytes.NewBufferString(testManifests))
+	require.NoError(t, err)
+
+	rendered := newRenderedTemplate(out.String())
+	assert.Equal(t, []string{
+		"registry.local/operator-framework/olm@sha256:1234",
+		"registry.local/percona/everest:1.4.0",
+		"registry.local/perconalab/everest-catalog:1.4.0",
+	}, ListImages(rendered))
+	// The source comments are preserved.
+	catalogSources, err := rendered.filter("everest-catalogsource.yaml")
+	require.NoError(t, err)
+	assert.Len(t, catalogSources, 1)
+	assert.Contains(t, out.String(), `image: "registry.local/operator-framework/olm@sha256:1234"`)
+	assert.Contains(t, out.String(), "# image: commented/out:1.0.0")
+}
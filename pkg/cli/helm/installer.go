@@ -24,6 +24,8 @@ import (
 	"io/fs"
 	"os"
 	"path"
+	"slices"
+	"sort"
 	"strings"
 
 	"helm.sh/helm/v3/pkg/action"
@@ -34,6 +36,7 @@ import (
 	"helm.sh/helm/v3/pkg/cli/values"
 	"helm.sh/helm/v3/pkg/downloader"
 	"helm.sh/helm/v3/pkg/getter"
+	"helm.sh/helm/v3/pkg/postrender"
 	"helm.sh/helm/v3/pkg/release"
 	"helm.sh/helm/v3/pkg/storage/driver"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
@@ -54,6 +57,9 @@ const (
 	EverestCRDChartName = "everest-crds"
 )
 
+// localRepoPrefix is the prefix of the URLs of local chart repositories.
+const localRepoPrefix = "file://"
+
 var settings = helmcli.New() //nolint:gochecknoglobals
 
 // CLIOptions contains common options for the CLI.
@@ -73,6 +79,8 @@ type (
 		ResetValues bool
 		// ResetThenReuseValues indicates whether to reset the last release's values then reuse them during release upgrade.
 		ResetThenReuseValues bool
+		// ImageRegistry is the registry to pull the images from instead of their original registries.
+		ImageRegistry string
 	}
 
 	// Installer installs a Helm chart.
@@ -85,6 +93,9 @@ type (
 		Values map[string]interface{}
 		// CreateReleaseNamespace indicates whether to create the release namespace.
 		CreateReleaseNamespace bool
+		// ImageRegistry is the registry to pull the images from instead of their original registries.
+		// If set, the images of the rendered manifests are rewritten.
+		ImageRegistry string
 		// internal fields, set only after Init() is called.
 		chart *chart.Chart
 		cfg   *action.Configuration
@@ -102,6 +113,7 @@ type (
 		// If set, ignores URL.
 		Directory string
 		// URL of the repository to pull the chart from.
+		// A file:// URL points at a local directory with the chart archives, e.g. of an extracted bundle.
 		URL string
 		// Version of the helm chart to install.
 		// If loading from a directory, needs to match the chart version.
@@ -156,9 +168,32 @@ func (i *Installer) GetParsedValues() *utils.Values {
 // If the chart has been installed, it returns the rendered templates from the installed release.
 // If the chart has not been installed, it returns the rendered templates from a dry-run install.
 // In the latter case, the installation step does not talk to the kube-apiserver. So Helm functions like `lookup` will not work.
+// The hooks of the chart are not part of the rendered templates, see RenderHooks.
 func (i *Installer) RenderTemplates(ctx context.Context) (RenderedTemplate, error) {
+	rel, err := i.renderRelease(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newRenderedTemplate(rel.Manifest), nil
+}
+
+// RenderHooks renders the hooks of the provided chart the same way as RenderTemplates,
+// sorted by their weight.
+func (i *Installer) RenderHooks(ctx context.Context) ([]*release.Hook, error) {
+	rel, err := i.renderRelease(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hooks := slices.Clone(rel.Hooks)
+	sort.SliceStable(hooks, func(a, b int) bool {
+		return hooks[a].Weight < hooks[b].Weight
+	})
+	return hooks, nil
+}
+
+func (i *Installer) renderRelease(ctx context.Context) (*release.Release, error) {
 	if i.release != nil {
-		return newRenderedTemplate(i.release.Manifest), nil
+		return i.release, nil
 	}
 
 	// create a new actions configuration so that it does not accidentally interfere with the actual installation.
@@ -166,12 +201,7 @@ func (i *Installer) RenderTemplates(ctx context.Context) (RenderedTemplate, erro
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Helm action configuration: %w", err)
 	}
-
-	rel, err := installDryRun(ctx, cfg, i.chart, i.ReleaseName, i.ReleaseNamespace, i.Values)
-	if err != nil {
-		return nil, err
-	}
-	return newRenderedTemplate(rel.Manifest), nil
+	return installDryRun(ctx, cfg, i.chart, i.ReleaseName, i.ReleaseNamespace, i.Values, i.postRenderer())
 }
 
 func installDryRun(
@@ -180,13 +210,15 @@ func installDryRun(
 	chart *chart.Chart,
 	releaseName, releaseNamespace string,
 	values map[string]interface{},
+	postRenderer postrender.PostRenderer,
 ) (*release.Release, error) {
 	install := action.NewInstall(cfg)
+	install.PostRenderer = postRenderer
 	install.ReleaseName = releaseName
 	install.Namespace = releaseNamespace
 	install.CreateNamespace = true
 	install.Wait = false
-	install.DisableHooks = true
+	// The hooks are rendered, but not run by a dry run.
 	install.DryRun = true
 	install.ClientOnly = true
 	install.Replace = true
@@ -231,12 +263,22 @@ func (i *Installer) GetRelease() (*release.Release, error) {
 	return i.release, nil
 }
 
+// GetDeployedRelease returns the last deployed revision of a Helm release.
+func GetDeployedRelease(relName, relNamespace, kubeconfigPath string) (*release.Release, error) {
+	cfg, err := newActionsCfg(relNamespace, kubeconfigPath)
+	if err != nil {
+		return nil, err
+	}
+	return action.NewGet(cfg).Run(relName)
+}
+
 func (i *Installer) install(ctx context.Context) error {
 	install := action.NewInstall(i.cfg)
 	install.ReleaseName = i.ReleaseName
 	install.Namespace = i.ReleaseNamespace
 	install.CreateNamespace = i.CreateReleaseNamespace
 	install.TakeOwnership = true
+	install.PostRenderer = i.postRenderer()
 
 	rel, err := install.RunWithContext(ctx, i.chart, i.Values)
 	if err != nil {
@@ -257,6 +299,25 @@ type UpgradeOptions struct {
 
 // Upgrade the Helm chart.
 func (i *Installer) Upgrade(ctx context.Context, opts UpgradeOptions) error {
+	rel, err := i.newUpgrade(opts).RunWithContext(ctx, i.ReleaseName, i.chart, i.Values)
+	if err != nil {
+		return err
+	}
+	i.release = rel
+	return nil
+}
+
+// UpgradeDryRun returns the release an upgrade of the Helm chart results in, without applying it.
+// Unlike RenderTemplates, the templates are rendered against the kube-apiserver,
+// so Helm functions like `lookup` and `.Release.IsUpgrade` behave as in the actual upgrade.
+func (i *Installer) UpgradeDryRun(ctx context.Context, opts UpgradeOptions) (*release.Release, error) {
+	upgrade := i.newUpgrade(opts)
+	upgrade.DryRun = true
+	upgrade.DryRunOption = "server"
+	return upgrade.RunWithContext(ctx, i.ReleaseName, i.chart, i.Values)
+}
+
+func (i *Installer) newUpgrade(opts UpgradeOptions) *action.Upgrade {
 	upgrade := action.NewUpgrade(i.cfg)
 	upgrade.Namespace = i.ReleaseNamespace
 	upgrade.TakeOwnership = true
@@ -265,13 +326,15 @@ func (i *Installer) Upgrade(ctx context.Context, opts UpgradeOptions) error {
 	upgrade.ResetThenReuseValues = opts.ResetThenReuseValues
 	upgrade.DisableHooks = opts.DisableHooks
 	upgrade.Force = opts.Force
+	upgrade.PostRenderer = i.postRenderer()
+	return upgrade
+}
 
-	rel, err := upgrade.RunWithContext(ctx, i.ReleaseName, i.chart, i.Values)
-	if err != nil {
-		return err
+func (i *Installer) postRenderer() postrender.PostRenderer { //nolint:ireturn
+	if i.ImageRegistry == "" {
+		return nil
 	}
-	i.release = rel
-	return nil
+	return &imageRegistryPostRenderer{registry: i.ImageRegistry}
 }
 
 func resolveHelmChart(version, chartName, repoURL, dir string) (*chart.Chart, error) {
@@ -300,6 +363,10 @@ func resolveDir(version, dir string) (*chart.Chart, error) {
 }
 
 func resolveRepo(version, chartName, repoURL string) (*chart.Chart, error) {
+	// The charts of local repositories are not cached.
+	if dir, ok := strings.CutPrefix(repoURL, localRepoPrefix); ok {
+		return loadArchive(path.Join(dir, ChartArchiveName(chartName, version)))
+	}
 	// Download Helm chart from repo and cache it for later use.
 	chart, err := newChartFromRemoteWithCache(version, chartName, repoURL)
 	if err != nil {
@@ -315,24 +382,41 @@ func newChartFromRemoteWithCache(version, name string, repository string) (*char
 		return nil, err
 	}
 
-	file := path.Join(cacheDir, fmt.Sprintf("%s-%s.tgz", name, version))
+	file := path.Join(cacheDir, ChartArchiveName(name, version))
 	if _, err = os.Stat(file); err != nil {
 		if !errors.Is(err, fs.ErrNotExist) {
 			return nil, err
 		}
 
 		// Download the chart from remote repository
-		actionConfig := &action.Configuration{}
-		pull := action.NewPullWithOpts(action.WithConfig(actionConfig))
-		pull.Settings = settings
-		pull.Version = version
-		pull.DestDir = cacheDir
-		pull.RepoURL = repository
-		if _, err = pull.Run(name); err != nil {
+		if file, err = PullChart(name, version, repository, cacheDir); err != nil {
 			return nil, err
 		}
 	}
+	return loadArchive(file)
+}
+
+// PullChart downloads the chart archive from the remote repository into
+// the destination directory and returns the path of the archive.
+func PullChart(name, version, repository, destDir string) (string, error) {
+	actionConfig := &action.Configuration{}
+	pull := action.NewPullWithOpts(action.WithConfig(actionConfig))
+	pull.Settings = settings
+	pull.Version = version
+	pull.DestDir = destDir
+	pull.RepoURL = repository
+	if _, err := pull.Run(name); err != nil {
+		return "", err
+	}
+	return path.Join(destDir, ChartArchiveName(name, version)), nil
+}
+
+// ChartArchiveName returns the file name of the chart archive.
+func ChartArchiveName(name, version string) string {
+	return fmt.Sprintf("%s-%s.tgz", name, version)
+}
 
+func loadArchive(file string) (*chart.Chart, error) {
 	f, err := os.Open(file) //nolint:gosec
 	if err != nil {
 		return nil, err
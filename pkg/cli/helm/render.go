@@ -0,0 +1,91 @@
+package helm
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// HooksFileSuffix is the suffix of the files with the rendered hooks of a chart.
+const HooksFileSuffix = "-hooks.yaml"
+
+// WithNamespace returns the templates with the manifest of the namespace prepended,
+// unless the templates already contain it. Helm creates the release namespace
+// imperatively, so it is missing from the rendered templates.
+func (t *RenderedTemplate) WithNamespace(ns *corev1.Namespace) (RenderedTemplate, error) {
+	for _, doc := range t.Strings() {
+		var head metav1.PartialObjectMetadata
+		if err := yaml.Unmarshal([]byte(doc), &head); err != nil {
+			return nil, err
+		}
+		if head.Kind == "Namespace" && head.GetName() == ns.GetName() {
+			return *t, nil
+		}
+	}
+
+	ns = ns.DeepCopy()
+	ns.TypeMeta = metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"}
+	data, err := yaml.Marshal(ns)
+	if err != nil {
+		return nil, err
+	}
+	return append(RenderedTemplate{strings.TrimSpace(string(data))}, t.Strings()...), nil
+}
+
+// WriteFile writes the templates to a single YAML file, sorted in the order Helm installs them.
+func (t *RenderedTemplate) WriteFile(path string) error {
+	split := releaseutil.SplitManifests(strings.Join(t.Strings(), "\n---\n"))
+	_, files, err := releaseutil.SortManifests(split, nil, releaseutil.InstallOrder)
+	if err != nil {
+		return fmt.Errorf("failed to sort manifests: %w", err)
+	}
+
+	var b strings.Builder
+	for _, file := range files {
+		b.WriteString("---\n")
+		b.WriteString(strings.TrimSpace(file.Content))
+		b.WriteString("\n")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil { //nolint:mnd
+		return err
+	}
+	return os.WriteFile(path, []byte(b.String()), 0o644) //nolint:gosec,mnd
+}
+
+// WriteHooksFile writes the manifests of the hooks to a single YAML file, in the given order.
+// Each manifest is preceded by a comment with the events Helm runs the hook on.
+func WriteHooksFile(path string, hooks []*release.Hook) error {
+	var b strings.Builder
+	for _, h := range hooks {
+		events := make([]string, 0, len(h.Events))
+		for _, e := range h.Events {
+			events = append(events, e.String())
+		}
+		b.WriteString("---\n")
+		fmt.Fprintf(&b, "# Source: %s\n", h.Path)
+		fmt.Fprintf(&b, "# Hook: %s (weight %d)\n", strings.Join(events, ","), h.Weight)
+		b.WriteString(strings.TrimSpace(h.Manifest))
+		b.WriteString("\n")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil { //nolint:mnd
+		return err
+	}
+	return os.WriteFile(path, []byte(b.String()), 0o644) //nolint:gosec,mnd
+}
+
+// RenderedFilePath returns the path of the file with the rendered templates of a chart installed in a namespace.
+func RenderedFilePath(dir, namespace, chartName string) string {
+	return filepath.Join(dir, namespace, chartName+".yaml")
+}
+
+// RenderedHooksFilePath returns the path of the file with the rendered hooks of a chart installed in a namespace.
+func RenderedHooksFilePath(dir, namespace, chartName string) string {
+	return filepath.Join(dir, namespace, chartName+HooksFileSuffix)
+}
@@ -0,0 +1,87 @@
+package helm
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/release"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRenderedTemplateWithNamespace(t *testing.T) {
+	t.Parallel()
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:   "everest",
+		Labels: map[string]string{"app.kubernetes.io/managed-by": "everest"},
+	}}
+
+	rendered := newRenderedTemplate(testManifests)
+	withNs, err := rendered.WithNamespace(ns)
+	require.NoError(t, err)
+	require.Len(t, withNs, 3)
+	assert.Contains(t, withNs[0], "kind: Namespace")
+	assert.Contains(t, withNs[0], "app.kubernetes.io/managed-by: everest")
+
+	// The namespace is not added twice.
+	again, err := withNs.WithNamespace(ns)
+	require.NoError(t, err)
+	assert.Len(t, again, 3)
+}
+
+func TestRenderedTemplateWriteFile(t *testing.T) {
+	t.Parallel()
+
+	rendered := newRenderedTemplate(testManifests)
+	withNs, err := rendered.WithNamespace(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "everest-system"}})
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	p := RenderedFilePath(dir, "everest-system", EverestChartName)
+	require.NoError(t, withNs.WriteFile(p))
+	assert.Equal(t, filepath.Join(dir, "everest-system", "everest.yaml"), p)
+
+	data, err := os.ReadFile(p)
+	require.NoError(t, err)
+	written := newRenderedTemplate(string(data))
+	require.Len(t, written, 3)
+	// The manifests are sorted in the install order.
+	assert.Contains(t, written[0], "kind: Namespace")
+	assert.Contains(t, written[1], "kind: Deployment")
+	assert.Contains(t, written[2], "kind: CatalogSource")
+	assert.True(t, strings.HasPrefix(string(data), "---\n"))
+}
+
+func TestWriteHooksFile(t *testing.T) {
+	t.Parallel()
+
+	p := RenderedHooksFilePath(t.TempDir(), "everest-system", EverestChartName)
+	require.NoError(t, WriteHooksFile(p, []*release.Hook{
+		{
+			Path:     "everest/templates/pre-install.yaml",
+			Manifest: "kind: Job\nmetadata:\n  name: pre\n",
+			Events:   []release.HookEvent{release.HookPreInstall, release.HookPreUpgrade},
+			Weight:   -1,
+		},
+		{
+			Path:     "everest/templates/post-install.yaml",
+			Manifest: "kind: Job\nmetadata:\n  name: post\n",
+			Events:   []release.HookEvent{release.HookPostInstall},
+		},
+	}))
+	assert.True(t, strings.HasSuffix(p, filepath.Join("everest-system", "everest-hooks.yaml")))
+
+	data, err := os.ReadFile(p)
+	require.NoError(t, err)
+	written := newRenderedTemplate(string(data))
+	require.Len(t, written, 2)
+	assert.Contains(t, written[0], "# Source: everest/templates/pre-install.yaml")
+	assert.Contains(t, written[0], "# Hook: pre-install,pre-upgrade (weight -1)")
+	assert.Contains(t, written[0], "name: pre")
+	assert.Contains(t, written[1], "# Hook: post-install (weight 0)")
+}
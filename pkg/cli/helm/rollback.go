@@ -0,0 +1,84 @@
+package helm
+
+import (
+	"errors"
+	"sort"
+
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+// ErrNoPreviousRelease is returned when a release has no revision with a previous chart version to roll back to.
+var ErrNoPreviousRelease = errors.New("no revision of the release with a previous version found")
+
+// GetReleaseHistory returns the revisions of a Helm release, the latest revision first.
+func GetReleaseHistory(relName, relNamespace, kubeconfigPath string) ([]*release.Release, error) {
+	cfg, err := newActionsCfg(relNamespace, kubeconfigPath)
+	if err != nil {
+		return nil, err
+	}
+	history, err := action.NewHistory(cfg).Run(relName)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(history, func(i, j int) bool {
+		return history[i].Version > history[j].Version
+	})
+	return history, nil
+}
+
+// PreviousRelease returns the deployed revision of a release and the latest revision
+// deployed before it with a different chart version, from the history returned by
+// GetReleaseHistory. The revisions which only changed the values of the deployed
+// chart version are skipped.
+func PreviousRelease(history []*release.Release) (*release.Release, *release.Release, error) {
+	var current *release.Release
+	for _, rel := range history {
+		if current == nil {
+			if rel.Info != nil && rel.Info.Status == release.StatusDeployed {
+				current = rel
+			}
+			continue
+		}
+		if rel.Info == nil || (rel.Info.Status != release.StatusSuperseded && rel.Info.Status != release.StatusDeployed) {
+			continue
+		}
+		if chartVersion(rel) != chartVersion(current) {
+			return current, rel, nil
+		}
+	}
+	if current == nil {
+		return nil, nil, errors.New("no deployed revision of the release found")
+	}
+	return nil, nil, ErrNoPreviousRelease
+}
+
+// ReleaseWithChartVersion returns the latest revision of a release installed with
+// the given chart version, from the history returned by GetReleaseHistory.
+// It returns nil if there is no such revision.
+func ReleaseWithChartVersion(history []*release.Release, version string) *release.Release {
+	for _, rel := range history {
+		if chartVersion(rel) == version {
+			return rel
+		}
+	}
+	return nil
+}
+
+func chartVersion(rel *release.Release) string {
+	if rel.Chart == nil || rel.Chart.Metadata == nil {
+		return ""
+	}
+	return rel.Chart.Metadata.Version
+}
+
+// Rollback rolls a Helm release back to the given revision.
+func Rollback(relName, relNamespace, kubeconfigPath string, revision int) error {
+	cfg, err := newActionsCfg(relNamespace, kubeconfigPath)
+	if err != nil {
+		return err
+	}
+	rollback := action.NewRollback(cfg)
+	rollback.Version = revision
+	return rollback.Run(relName)
+}
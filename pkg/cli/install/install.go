@@ -32,11 +32,13 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/percona/everest/pkg/cli/bundle"
 	"github.com/percona/everest/pkg/cli/helm"
 	helmutils "github.com/percona/everest/pkg/cli/helm/utils"
 	"github.com/percona/everest/pkg/cli/namespaces"
 	"github.com/percona/everest/pkg/cli/steps"
 	cliutils "github.com/percona/everest/pkg/cli/utils"
+	"github.com/percona/everest/pkg/cli/versionmetadata"
 	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/kubernetes"
 	"github.com/percona/everest/pkg/output"
@@ -75,6 +77,12 @@ type (
 		SkipDBNamespace bool
 		// Options related to Helm.
 		HelmConfig helm.CLIOptions
+		// Bundle is the path of an offline bundle to install Everest from.
+		// If set, the charts and the version metadata are read from the bundle.
+		Bundle string
+		// RenderDir is set if the manifests shall be written to the directory
+		// instead of being applied to the cluster. The cluster is not accessed.
+		RenderDir string
 		// NamespaceAddConfig is the configuration for the namespace add operation.
 		NamespaceAddConfig namespaces.NamespaceAddConfig
 	}
@@ -88,6 +96,8 @@ type (
 		// these are set only when Run is called.
 		installVersion string
 		helmInstaller  *helm.Installer
+		// versionMetadata is the version metadata snapshot of the bundle, if any.
+		versionMetadata *versionservice.Snapshot
 	}
 )
 
@@ -143,22 +153,35 @@ func NewInstall(c InstallConfig, l *zap.SugaredLogger) (*Installer, error) {
 	c.NamespaceAddConfig.KubeconfigPath = c.KubeconfigPath
 	c.NamespaceAddConfig.DisableTelemetry = c.DisableTelemetry
 	c.NamespaceAddConfig.SkipEnvDetection = c.SkipEnvDetection
+	c.NamespaceAddConfig.RenderDir = c.RenderDir
 	cli.cfg = c
 
-	var err error
-	cli.kubeClient, err = cliutils.NewKubeConnector(cli.l, c.KubeconfigPath)
-	if err != nil {
-		return nil, err
+	if c.RenderDir == "" {
+		var err error
+		cli.kubeClient, err = cliutils.NewKubeConnector(cli.l, c.KubeconfigPath)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	cli.versionService = versionservice.New(c.VersionMetadataURL)
+	cli.versionService = versionservice.New(c.VersionMetadataURL, versionservice.WithConfigMapGetter(cli.kubeClient))
 	return cli, nil
 }
 
 // Run the Everest installation process.
 func (o *Installer) Run(ctx context.Context) error {
-	if err := o.cfg.detectKubernetesEnv(ctx, o.l); err != nil {
-		return fmt.Errorf("failed to detect Kubernetes environment: %w", err)
+	if o.cfg.Bundle != "" {
+		b, err := o.useBundle()
+		if err != nil {
+			return err
+		}
+		defer b.Close() //nolint:errcheck
+	}
+
+	if o.cfg.RenderDir == "" {
+		if err := o.cfg.detectKubernetesEnv(ctx, o.l); err != nil {
+			return fmt.Errorf("failed to detect Kubernetes environment: %w", err)
+		}
 	}
 
 	if err := o.setVersionInfo(ctx); err != nil {
@@ -178,6 +201,11 @@ func (o *Installer) Run(ctx context.Context) error {
 	if err := o.setupHelmInstaller(ctx); err != nil {
 		return err
 	}
+	helm.WarnImageRegistry(o.l, o.cfg.HelmConfig.ImageRegistry)
+
+	if o.cfg.RenderDir != "" {
+		return o.render(ctx)
+	}
 
 	installSteps := o.newInstallSteps()
 	if !o.cfg.SkipDBNamespace {
@@ -264,6 +292,31 @@ func (o *Installer) printPostInstallMessage(out io.Writer) {
 	_, _ = fmt.Fprint(out, message)
 }
 
+// useBundle extracts the offline bundle and uses its charts and version metadata
+// instead of the remote ones.
+func (o *Installer) useBundle() (*bundle.Bundle, error) {
+	b, err := bundle.Open(o.cfg.Bundle)
+	if err != nil {
+		return nil, err
+	}
+	if err := b.CheckVersion(o.cfg.Version); err != nil {
+		_ = b.Close()
+		return nil, err
+	}
+	if o.versionMetadata, err = b.VersionMetadata(); err != nil {
+		_ = b.Close()
+		return nil, err
+	}
+	o.l.Infof("Installing from bundle %s with Everest %s", o.cfg.Bundle, b.EverestVersion)
+	o.cfg.Version = b.EverestVersion
+	// Everest serves the version metadata of the bundle imported into the cluster.
+	o.cfg.VersionMetadataURL = versionmetadata.ConfigMapURL()
+	o.cfg.HelmConfig.RepoURL = b.ChartRepoURL()
+	o.cfg.NamespaceAddConfig.HelmConfig = o.cfg.HelmConfig
+	o.versionService = versionservice.New(b.VersionMetadataURL())
+	return b, nil
+}
+
 // setVersionInfo fetches the latest Everest version information from Version service.
 func (o *Installer) setVersionInfo(ctx context.Context) error {
 	meta, err := o.versionService.GetEverestMetadata(ctx)
@@ -298,9 +351,12 @@ func (o *Installer) checkRequirements(supVer *common.SupportedVersion) error {
 
 // setupHelmInstaller initializes the Helm installer.
 func (o *Installer) setupHelmInstaller(ctx context.Context) error {
-	nsExists, err := o.namespaceExists(ctx, common.SystemNamespace)
-	if err != nil {
-		return err
+	nsExists := false
+	if o.cfg.RenderDir == "" {
+		var err error
+		if nsExists, err = o.namespaceExists(ctx, common.SystemNamespace); err != nil {
+			return err
+		}
 	}
 	overrides := helm.NewValues(helm.Values{
 		ClusterType:        o.cfg.ClusterType,
@@ -312,6 +368,7 @@ func (o *Installer) setupHelmInstaller(ctx context.Context) error {
 		ReleaseNamespace:       common.SystemNamespace,
 		Values:                 values,
 		CreateReleaseNamespace: !nsExists,
+		ImageRegistry:          o.cfg.HelmConfig.ImageRegistry,
 	}
 	if err := installer.Init(o.cfg.KubeconfigPath, helm.ChartOptions{
 		Directory: o.cfg.HelmConfig.ChartDir,
@@ -326,14 +383,20 @@ func (o *Installer) setupHelmInstaller(ctx context.Context) error {
 }
 
 func (o *Installer) newInstallSteps() []steps.Step {
-	return []steps.Step{
+	installSteps := []steps.Step{
 		o.newStepInstallEverestHelmChart(),
+		o.newStepApplyServerRBAC(),
+	}
+	if o.versionMetadata != nil {
+		installSteps = append(installSteps, o.newStepImportVersionMetadata())
+	}
+	return append(installSteps,
 		o.newStepEnsureEverestAPI(),
 		o.newStepEnsureEverestOperator(),
 		o.newStepEnsureEverestOLM(),
 		o.newStepEnsureCatalogSource(),
 		o.newStepEnsureEverestMonitoring(),
-	}
+	)
 }
 
 func (o *Installer) latestVersion(meta *versionpb.MetadataResponse) (*goversion.Version, *versionpb.MetadataVersion, error) {
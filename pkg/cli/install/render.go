@@ -0,0 +1,119 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/percona/everest/pkg/cli/helm"
+	"github.com/percona/everest/pkg/cli/namespaces"
+	"github.com/percona/everest/pkg/cli/versionmetadata"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/output"
+)
+
+// versionMetadataFile is the name of the rendered file with the version metadata ConfigMap.
+const versionMetadataFile = "version-metadata.yaml"
+
+// render writes the manifests of Everest and of the database namespaces to the
+// render directory instead of installing them.
+func (o *Installer) render(ctx context.Context) error {
+	var out io.Writer = os.Stdout
+	if !o.cfg.Pretty {
+		out = io.Discard
+	}
+
+	o.l.Infof("Rendering Everest %s manifests", o.installVersion)
+	manifests, err := o.helmInstaller.RenderTemplates(ctx)
+	if err != nil {
+		return fmt.Errorf("could not render Helm chart: %w", err)
+	}
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: common.SystemNamespace}}
+	if manifests, err = manifests.WithNamespace(ns); err != nil {
+		return err
+	}
+	p := helm.RenderedFilePath(o.cfg.RenderDir, common.SystemNamespace, helm.EverestChartName)
+	if err := manifests.WriteFile(p); err != nil {
+		return fmt.Errorf("could not write manifests: %w", err)
+	}
+	files := []string{p}
+
+	hooks, err := o.helmInstaller.RenderHooks(ctx)
+	if err != nil {
+		return fmt.Errorf("could not render Helm chart hooks: %w", err)
+	}
+	if len(hooks) > 0 {
+		p := helm.RenderedHooksFilePath(o.cfg.RenderDir, common.SystemNamespace, helm.EverestChartName)
+		if err := helm.WriteHooksFile(p, hooks); err != nil {
+			return fmt.Errorf("could not write hooks: %w", err)
+		}
+		files = append(files, p)
+	}
+
+	if o.versionMetadata != nil {
+		p, err := o.renderVersionMetadata()
+		if err != nil {
+			return err
+		}
+		files = append(files, p)
+	}
+
+	if !o.cfg.SkipDBNamespace {
+		o.cfg.NamespaceAddConfig.Version = o.installVersion
+		n, err := namespaces.NewNamespaceAdd(o.cfg.NamespaceAddConfig, o.l)
+		if err != nil {
+			return err
+		}
+		dbFiles, err := n.Render(ctx, o.installVersion)
+		if err != nil {
+			return err
+		}
+		files = append(files, dbFiles...)
+	}
+
+	_, _ = fmt.Fprint(out, output.Success("Everest %s manifests written to %s", o.installVersion, o.cfg.RenderDir))
+	for _, f := range files {
+		_, _ = fmt.Fprintf(out, "   %s\n", f)
+	}
+	namespaces.PrintHooksNote(out, files)
+	return nil
+}
+
+// renderVersionMetadata writes the ConfigMap with the version metadata snapshot of the bundle.
+func (o *Installer) renderVersionMetadata() (string, error) {
+	cm, err := o.versionMetadata.ConfigMap(versionmetadata.ConfigMap)
+	if err != nil {
+		return "", err
+	}
+	cm.TypeMeta = metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"}
+	data, err := yaml.Marshal(cm)
+	if err != nil {
+		return "", err
+	}
+	p := filepath.Join(o.cfg.RenderDir, common.SystemNamespace, versionMetadataFile)
+	if err := os.WriteFile(p, append([]byte("---\n"), data...), 0o644); err != nil { //nolint:gosec,mnd
+		return "", fmt.Errorf("could not write version metadata: %w", err)
+	}
+	return p, nil
+}
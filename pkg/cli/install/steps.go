@@ -19,12 +19,13 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/AlekSi/pointer"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/percona/everest/pkg/cli/serverrbac"
 	"github.com/percona/everest/pkg/cli/steps"
+	"github.com/percona/everest/pkg/cli/versionmetadata"
 	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/kubernetes"
 )
@@ -38,6 +39,24 @@ func (o *Installer) newStepInstallEverestHelmChart() steps.Step {
 	}
 }
 
+func (o *Installer) newStepApplyServerRBAC() steps.Step {
+	return steps.Step{
+		Desc: "Granting Everest API server access to the leader election Lease",
+		F: func(ctx context.Context) error {
+			return serverrbac.Apply(ctx, o.kubeClient)
+		},
+	}
+}
+
+func (o *Installer) newStepImportVersionMetadata() steps.Step {
+	return steps.Step{
+		Desc: "Importing version metadata",
+		F: func(ctx context.Context) error {
+			return versionmetadata.Apply(ctx, o.kubeClient, o.versionMetadata)
+		},
+	}
+}
+
 func (o *Installer) newStepEnsureEverestOperator() steps.Step {
 	return steps.Step{
 		Desc: "Ensuring Everest operator deployment is ready",
@@ -108,7 +127,7 @@ func (o *Installer) newStepEnsureCatalogSource() steps.Step {
 				if err != nil {
 					return false, fmt.Errorf("cannot get CatalogSource: %w", err)
 				}
-				return pointer.Get(cs.Status.GRPCConnectionState).LastObservedState == "READY", nil
+				return kubernetes.IsCatalogSourceReady(cs), nil
 			})
 		},
 	}
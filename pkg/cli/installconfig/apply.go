@@ -0,0 +1,438 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package installconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+
+	goversion "github.com/hashicorp/go-version"
+	"go.uber.org/zap"
+	"helm.sh/helm/v3/pkg/release"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/percona/everest/pkg/accounts"
+	"github.com/percona/everest/pkg/cli/bundle"
+	"github.com/percona/everest/pkg/cli/helm"
+	helmutils "github.com/percona/everest/pkg/cli/helm/utils"
+	"github.com/percona/everest/pkg/cli/install"
+	"github.com/percona/everest/pkg/cli/namespaces"
+	"github.com/percona/everest/pkg/cli/steps"
+	cliutils "github.com/percona/everest/pkg/cli/utils"
+	"github.com/percona/everest/pkg/cli/versionmetadata"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/oidc"
+	"github.com/percona/everest/pkg/output"
+	"github.com/percona/everest/pkg/version"
+)
+
+type (
+	// ApplyConfig is the configuration for applying a configuration file.
+	ApplyConfig struct {
+		// Config is the configuration file to apply.
+		Config *Config
+		// Install is the base configuration set by the command line flags.
+		// The settings of the configuration file take precedence.
+		Install install.InstallConfig
+		// NamespacesOnly is set if only the database namespaces are applied.
+		NamespacesOnly bool
+	}
+
+	// Applier converges an Everest installation with a configuration file.
+	// Applying the same configuration again leaves the installation unchanged.
+	// The namespaces and accounts missing from the configuration are not removed.
+	Applier struct {
+		cfg        ApplyConfig
+		l          *zap.SugaredLogger
+		kubeClient kubernetes.KubernetesConnector
+	}
+)
+
+// NewApplier returns a new CLI operation to apply a configuration file.
+func NewApplier(c ApplyConfig, l *zap.SugaredLogger) (*Applier, error) {
+	a := &Applier{
+		cfg: c,
+		l:   l.With("component", "installconfig"),
+	}
+	if c.Install.Pretty {
+		a.l = zap.NewNop().Sugar()
+	}
+
+	k, err := cliutils.NewKubeConnector(a.l, c.Install.KubeconfigPath)
+	if err != nil {
+		return nil, err
+	}
+	a.kubeClient = k
+	return a, nil
+}
+
+// Run applies the configuration file.
+func (a *Applier) Run(ctx context.Context) error {
+	if !a.cfg.NamespacesOnly {
+		if err := a.applyEverest(ctx); err != nil {
+			return err
+		}
+	}
+
+	if err := a.applyNamespaces(ctx); err != nil {
+		return err
+	}
+	if a.cfg.NamespacesOnly {
+		return nil
+	}
+
+	var applySteps []steps.Step
+	for _, account := range a.cfg.Config.Accounts {
+		applySteps = append(applySteps, a.newStepApplyAccount(account))
+	}
+	if a.cfg.Config.RBAC != nil {
+		applySteps = append(applySteps, a.newStepApplyRBAC())
+	}
+	if err := steps.RunStepsWithSpinner(ctx, a.l, applySteps, a.cfg.Install.Pretty); err != nil {
+		return err
+	}
+	return a.applyOIDC(ctx)
+}
+
+// applyEverest installs Everest, or applies the Helm values of the configuration
+// to the installed release if the same version is already installed.
+func (a *Applier) applyEverest(ctx context.Context) error {
+	c := a.installConfig()
+
+	installed, err := version.EverestVersionFromDeployment(ctx, a.kubeClient)
+	if client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("cannot check if Everest is already installed: %w", err)
+	}
+	if err != nil {
+		i, err := install.NewInstall(c, a.l)
+		if err != nil {
+			return err
+		}
+		return i.Run(ctx)
+	}
+
+	if c.Version != "" {
+		required, err := goversion.NewVersion(c.Version)
+		if err != nil {
+			return fmt.Errorf("invalid Everest version %s: %w", c.Version, err)
+		}
+		if !installed.Equal(required) {
+			return fmt.Errorf("everest %s is installed but the configuration requires %s. Use 'everestctl upgrade' to change the version",
+				installed, c.Version)
+		}
+	}
+	return a.applyEverestValues(ctx, c, installed.String())
+}
+
+// applyEverestValues upgrades the installed Helm release with the values of the
+// configuration, keeping its chart version. The release is left unchanged if
+// neither the values nor the rendered manifests differ.
+func (a *Applier) applyEverestValues(ctx context.Context, c install.InstallConfig, everestVersion string) error {
+	var out io.Writer = os.Stdout
+	if !c.Pretty {
+		out = io.Discard
+	}
+
+	if c.Bundle != "" {
+		b, err := bundle.Open(c.Bundle)
+		if err != nil {
+			return err
+		}
+		defer b.Close() //nolint:errcheck
+		c.HelmConfig.RepoURL = b.ChartRepoURL()
+		c.VersionMetadataURL = versionmetadata.ConfigMapURL()
+	}
+	if version.IsDev(everestVersion) && c.HelmConfig.ChartDir == "" {
+		cleanup, err := helmutils.SetupEverestDevChart(a.l, &c.HelmConfig.ChartDir)
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+	}
+	if !c.SkipEnvDetection {
+		t, err := a.kubeClient.GetClusterType(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to detect cluster type: %w", err)
+		}
+		c.ClusterType = t
+	}
+
+	overrides := helm.NewValues(helm.Values{
+		ClusterType:        c.ClusterType,
+		VersionMetadataURL: c.VersionMetadataURL,
+	})
+	values, err := helmutils.MergeVals(c.HelmConfig.Values, overrides)
+	if err != nil {
+		return err
+	}
+	installer := &helm.Installer{
+		ReleaseName:      common.SystemNamespace,
+		ReleaseNamespace: common.SystemNamespace,
+		Values:           values,
+		ImageRegistry:    c.HelmConfig.ImageRegistry,
+	}
+	if err := installer.Init(c.KubeconfigPath, helm.ChartOptions{
+		Directory: c.HelmConfig.ChartDir,
+		URL:       c.HelmConfig.RepoURL,
+		Name:      helm.EverestChartName,
+		Version:   everestVersion,
+	}); err != nil {
+		return fmt.Errorf("could not initialize Helm installer: %w", err)
+	}
+	helm.WarnImageRegistry(a.l, c.HelmConfig.ImageRegistry)
+
+	current, err := helm.GetDeployedRelease(common.SystemNamespace, common.SystemNamespace, c.KubeconfigPath)
+	if err != nil {
+		return fmt.Errorf("could not get Helm release: %w", err)
+	}
+	target, err := installer.UpgradeDryRun(ctx, helm.UpgradeOptions{})
+	if err != nil {
+		return fmt.Errorf("could not render Helm templates: %w", err)
+	}
+	if upToDate, err := releaseUpToDate(current, target); err != nil {
+		return err
+	} else if upToDate {
+		a.l.Infof("Everest %s is already installed with the configured Helm values", everestVersion)
+		_, _ = fmt.Fprint(out, output.Success("Everest %s is already installed with the configured Helm values", everestVersion))
+		return nil
+	}
+
+	_, _ = fmt.Fprintln(out, output.Info("Applying the Helm values to Everest %s", everestVersion))
+	if err := steps.RunStepsWithSpinner(ctx, a.l, []steps.Step{
+		{
+			Desc: "Applying Everest Helm values",
+			F: func(ctx context.Context) error {
+				return installer.Upgrade(ctx, helm.UpgradeOptions{})
+			},
+		},
+		{
+			Desc: "Ensuring Everest API deployment is ready",
+			F: func(ctx context.Context) error {
+				return a.kubeClient.WaitForRollout(ctx, types.NamespacedName{
+					Namespace: common.SystemNamespace,
+					Name:      common.PerconaEverestDeploymentName,
+				})
+			},
+		},
+	}, c.Pretty); err != nil {
+		return err
+	}
+	a.l.Infof("The Helm values have been applied to Everest %s", everestVersion)
+	return nil
+}
+
+// releaseUpToDate returns true if the upgrade to the target release changes
+// neither the values nor the rendered manifests of the current release.
+func releaseUpToDate(current, target *release.Release) (bool, error) {
+	valuesDiff, err := helm.DiffValues(current.Config, target.Config)
+	if err != nil {
+		return false, err
+	}
+	manifestDiff, err := helm.DiffManifests(helm.ReleaseTemplateWithHooks(current), helm.ReleaseTemplateWithHooks(target))
+	if err != nil {
+		return false, err
+	}
+	return valuesDiff == "" && manifestDiff == "", nil
+}
+
+func (a *Applier) installConfig() install.InstallConfig {
+	c := a.cfg.Install
+	f := a.cfg.Config
+	if f.Version != "" {
+		c.Version = f.Version
+	}
+	if f.VersionMetadataURL != "" {
+		c.VersionMetadataURL = f.VersionMetadataURL
+	}
+	if f.Helm.Repository != "" {
+		c.HelmConfig.RepoURL = f.Helm.Repository
+	}
+	if f.Helm.ImageRegistry != "" {
+		c.HelmConfig.ImageRegistry = f.Helm.ImageRegistry
+	}
+	// The inline values are parsed from YAML, so they can always be marshalled.
+	vals, _ := f.HelmValues() //nolint:errcheck
+	c.HelmConfig.Values.ValueFiles = slices.Concat(c.HelmConfig.Values.ValueFiles, vals.ValueFiles)
+	c.HelmConfig.Values.Values = slices.Concat(c.HelmConfig.Values.Values, vals.Values)
+	c.HelmConfig.Values.JSONValues = slices.Concat(c.HelmConfig.Values.JSONValues, vals.JSONValues)
+	// The namespaces are applied one by one with their own operators.
+	c.SkipDBNamespace = true
+	c.NamespaceAddConfig.NamespaceList = f.NamespaceNames()
+	c.NamespaceAddConfig.SkipWizard = true
+	return c
+}
+
+// applyNamespaces adds the namespaces that are not managed by Everest yet
+// and updates the operators and the quota of the others.
+func (a *Applier) applyNamespaces(ctx context.Context) error {
+	if len(a.cfg.Config.Namespaces) == 0 {
+		return nil
+	}
+
+	base := a.cfg.Install.NamespaceAddConfig
+	base.KubeconfigPath = a.cfg.Install.KubeconfigPath
+	base.Pretty = a.cfg.Install.Pretty
+	base.SkipWizard = true
+	if !a.cfg.NamespacesOnly {
+		base.DisableTelemetry = a.cfg.Install.DisableTelemetry
+		base.HelmConfig = a.installConfig().HelmConfig
+		if a.cfg.Install.Bundle != "" {
+			// The DB namespace chart is read from the bundle as well.
+			b, err := bundle.Open(a.cfg.Install.Bundle)
+			if err != nil {
+				return err
+			}
+			defer b.Close() //nolint:errcheck
+			base.HelmConfig.RepoURL = b.ChartRepoURL()
+		}
+	}
+	if !base.SkipEnvDetection {
+		t, err := a.kubeClient.GetClusterType(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to detect cluster type: %w", err)
+		}
+		base.ClusterType = t
+		base.SkipEnvDetection = true
+	}
+
+	for _, ns := range a.cfg.Config.Namespaces {
+		exists, managed, err := namespaces.NamespaceExists(ctx, a.kubeClient, ns.Name)
+		if err != nil {
+			return err
+		}
+		if exists && !managed && !ns.TakeOwnership {
+			return fmt.Errorf("%w. HINT: set 'takeOwnership: true' to use the existing namespace",
+				namespaces.NewErrNamespaceAlreadyExists(ns.Name))
+		}
+
+		c := base
+		c.NamespaceList = []string{ns.Name}
+		c.Operators = ns.OperatorConfig()
+		c.TakeOwnership = ns.TakeOwnership
+		c.Quota = ns.Quota
+		c.Update = managed
+		adder, err := namespaces.NewNamespaceAdd(c, a.l)
+		if err != nil {
+			return err
+		}
+		if err := adder.Run(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newStepApplyAccount creates the account, or sets its password if it differs.
+func (a *Applier) newStepApplyAccount(account Account) steps.Step {
+	return steps.Step{
+		Desc: fmt.Sprintf("Configuring account '%s'", account.Username),
+		F: func(ctx context.Context) error {
+			m := a.kubeClient.Accounts()
+			_, err := m.Get(ctx, account.Username)
+			if errors.Is(err, accounts.ErrAccountNotFound) {
+				return m.Create(ctx, account.Username, account.Password)
+			} else if err != nil {
+				return err
+			}
+
+			err = m.Verify(ctx, account.Username, account.Password)
+			if errors.Is(err, accounts.ErrIncorrectPassword) {
+				return m.SetPassword(ctx, account.Username, account.Password, true)
+			}
+			return err
+		},
+	}
+}
+
+// newStepApplyRBAC updates the RBAC ConfigMap if it differs from the configuration.
+func (a *Applier) newStepApplyRBAC() steps.Step {
+	return steps.Step{
+		Desc: "Configuring RBAC",
+		F: func(ctx context.Context) error {
+			cm, err := a.kubeClient.GetConfigMap(ctx, types.NamespacedName{
+				Namespace: common.SystemNamespace,
+				Name:      common.EverestRBACConfigMapName,
+			})
+			if err != nil {
+				return fmt.Errorf("cannot get RBAC ConfigMap: %w", err)
+			}
+
+			rbacCfg := a.cfg.Config.RBAC
+			enabled := strconv.FormatBool(rbacCfg.Enabled)
+			if cm.Data["enabled"] == enabled && (rbacCfg.Policy == "" || cm.Data["policy.csv"] == rbacCfg.Policy) {
+				return nil
+			}
+			if cm.Data == nil {
+				cm.Data = make(map[string]string)
+			}
+			cm.Data["enabled"] = enabled
+			if rbacCfg.Policy != "" {
+				cm.Data["policy.csv"] = rbacCfg.Policy
+			}
+			_, err = a.kubeClient.UpdateConfigMap(ctx, cm)
+			return err
+		},
+	}
+}
+
+// applyOIDC configures the OIDC provider if the settings differ from the configuration.
+// Everest is restarted only if the settings are changed.
+func (a *Applier) applyOIDC(ctx context.Context) error {
+	oidcCfg := a.cfg.Config.OIDC
+	if oidcCfg == nil {
+		return nil
+	}
+
+	settings, err := a.kubeClient.GetEverestSettings(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot get Everest settings: %w", err)
+	}
+	if settings.OIDCConfigRaw != "" {
+		current, err := settings.OIDCConfig()
+		if err != nil {
+			return err
+		}
+		if current.IssuerURL == oidcCfg.IssuerURL &&
+			current.ClientID == oidcCfg.ClientID &&
+			slices.Equal(current.Scopes, oidcCfg.Scopes) {
+			var out io.Writer = os.Stdout
+			if !a.cfg.Install.Pretty {
+				out = io.Discard
+			}
+			_, _ = fmt.Fprint(out, output.Success("OIDC is already configured"))
+			return nil
+		}
+	}
+
+	op, err := oidc.NewOIDC(oidc.Config{
+		KubeconfigPath: a.cfg.Install.KubeconfigPath,
+		Pretty:         a.cfg.Install.Pretty,
+		IssuerURL:      oidcCfg.IssuerURL,
+		ClientID:       oidcCfg.ClientID,
+		Scopes:         oidcCfg.Scopes,
+	}, a.l)
+	if err != nil {
+		return err
+	}
+	return op.Run(ctx)
+}
@@ -0,0 +1,117 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package installconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"helm.sh/helm/v3/pkg/release"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/percona/everest/pkg/cli/steps"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+func TestApplier_idempotent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mockClient := fakeclient.NewClientBuilder().
+		WithScheme(kubernetes.CreateScheme()).
+		WithObjects(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: common.SystemNamespace, UID: "uid"}},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: common.SystemNamespace, Name: common.EverestAccountsSecretName}},
+			&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: common.SystemNamespace, Name: common.EverestRBACConfigMapName},
+				Data:       map[string]string{"enabled": "false"},
+			},
+		).
+		Build()
+	a := &Applier{
+		cfg: ApplyConfig{Config: &Config{
+			Accounts: []Account{{Username: "alice", Password: "secret"}},
+			RBAC:     &RBAC{Enabled: true, Policy: "g, alice, role:admin"},
+		}},
+		l:          zap.NewNop().Sugar(),
+		kubeClient: kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient),
+	}
+	applySteps := []steps.Step{a.newStepApplyAccount(a.cfg.Config.Accounts[0]), a.newStepApplyRBAC()}
+
+	resourceVersions := func() []string {
+		secret := &corev1.Secret{}
+		require.NoError(t, mockClient.Get(ctx, types.NamespacedName{
+			Namespace: common.SystemNamespace, Name: common.EverestAccountsSecretName,
+		}, secret))
+		cm := &corev1.ConfigMap{}
+		require.NoError(t, mockClient.Get(ctx, types.NamespacedName{
+			Namespace: common.SystemNamespace, Name: common.EverestRBACConfigMapName,
+		}, cm))
+		return []string{secret.GetResourceVersion(), cm.GetResourceVersion()}
+	}
+	before := resourceVersions()
+
+	require.NoError(t, steps.RunStepsWithSpinner(ctx, a.l, applySteps, false))
+	applied := resourceVersions()
+	assert.NotEqual(t, before[0], applied[0], "the account is created")
+	assert.NotEqual(t, before[1], applied[1], "the RBAC policy is updated")
+	require.NoError(t, a.kubeClient.Accounts().Verify(ctx, "alice", "secret"))
+
+	// Applying the same configuration again leaves the objects unchanged.
+	require.NoError(t, steps.RunStepsWithSpinner(ctx, a.l, applySteps, false))
+	assert.Equal(t, applied, resourceVersions())
+
+	// A changed password is applied.
+	a.cfg.Config.Accounts[0].Password = "changed"
+	require.NoError(t, steps.RunStepsWithSpinner(ctx, a.l, []steps.Step{a.newStepApplyAccount(a.cfg.Config.Accounts[0])}, false))
+	require.NoError(t, a.kubeClient.Accounts().Verify(ctx, "alice", "changed"))
+}
+
+func TestReleaseUpToDate(t *testing.T) {
+	t.Parallel()
+
+	current := &release.Release{
+		Config:   map[string]interface{}{"server": map[string]interface{}{"replicas": float64(2)}},
+		Manifest: "---\n# Source: everest/templates/cm.yaml\nkind: ConfigMap\nmetadata:\n  name: cm\n",
+	}
+
+	// The values of the current release are decoded from JSON, the numbers are float64.
+	target := &release.Release{
+		Config:   map[string]interface{}{"server": map[string]interface{}{"replicas": int64(2)}},
+		Manifest: current.Manifest,
+	}
+	upToDate, err := releaseUpToDate(current, target)
+	require.NoError(t, err)
+	assert.True(t, upToDate)
+
+	target.Config = map[string]interface{}{"server": map[string]interface{}{"replicas": int64(3)}}
+	upToDate, err = releaseUpToDate(current, target)
+	require.NoError(t, err)
+	assert.False(t, upToDate)
+
+	target.Config = current.Config
+	target.Manifest = "---\n# Source: everest/templates/cm.yaml\nkind: ConfigMap\nmetadata:\n  name: cm\ndata:\n  key: value\n"
+	upToDate, err = releaseUpToDate(current, target)
+	require.NoError(t, err)
+	assert.False(t, upToDate)
+}
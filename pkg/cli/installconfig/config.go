@@ -0,0 +1,366 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package installconfig provides the declarative configuration file used to
+// install Everest and to converge an existing installation with it.
+package installconfig
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	goversion "github.com/hashicorp/go-version"
+	"helm.sh/helm/v3/pkg/cli/values"
+	"helm.sh/helm/v3/pkg/strvals"
+	"sigs.k8s.io/yaml"
+
+	accountscli "github.com/percona/everest/pkg/accounts/cli"
+	"github.com/percona/everest/pkg/cli/namespaces"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/oidc"
+	"github.com/percona/everest/pkg/quota"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+const (
+	// APIVersion is the version of the configuration file schema.
+	APIVersion = "everestctl.percona.com/v1alpha1"
+	// Kind is the kind of the configuration file.
+	Kind = "EverestConfiguration"
+
+	// OperatorMySQL is the name of the MySQL operator in the configuration file.
+	OperatorMySQL = "mysql"
+	// OperatorMongoDB is the name of the MongoDB operator in the configuration file.
+	OperatorMongoDB = "mongodb"
+	// OperatorPostgreSQL is the name of the PostgreSQL operator in the configuration file.
+	OperatorPostgreSQL = "postgresql"
+)
+
+// ErrUnsupportedAPIVersion is returned when the configuration file has an unknown schema version.
+var ErrUnsupportedAPIVersion = errors.New("unsupported apiVersion")
+
+type (
+	// Config is the declarative configuration of an Everest installation.
+	Config struct {
+		// APIVersion is the version of the configuration file schema.
+		APIVersion string `json:"apiVersion"`
+		// Kind is the kind of the configuration file.
+		Kind string `json:"kind"`
+		// Version is the Everest version to install. The latest version is installed if empty.
+		Version string `json:"version,omitempty"`
+		// VersionMetadataURL is the URL of the version service.
+		VersionMetadataURL string `json:"versionMetadataURL,omitempty"`
+		// Helm holds the options of the Everest Helm chart.
+		Helm Helm `json:"helm,omitempty"`
+		// Namespaces are the database namespaces managed by Everest.
+		Namespaces []Namespace `json:"namespaces,omitempty"`
+		// Accounts are the initial user accounts.
+		Accounts []Account `json:"accounts,omitempty"`
+		// OIDC is the OIDC provider configuration.
+		OIDC *OIDC `json:"oidc,omitempty"`
+		// RBAC is the RBAC configuration.
+		RBAC *RBAC `json:"rbac,omitempty"`
+	}
+
+	// Helm holds the options of the Everest Helm chart.
+	Helm struct {
+		// Repository is the Helm chart repository to download the Everest charts from.
+		Repository string `json:"repository,omitempty"`
+		// ImageRegistry is the registry to pull the Everest images from.
+		ImageRegistry string `json:"imageRegistry,omitempty"`
+		// Values are the inline Helm values.
+		Values map[string]any `json:"values,omitempty"`
+		// ValuesFiles are the paths of YAML files with Helm values, relative to the configuration file.
+		ValuesFiles []string `json:"valuesFiles,omitempty"`
+		// Set are the Helm values in the key=value format, same as --helm.set.
+		Set []string `json:"set,omitempty"`
+	}
+
+	// Namespace is a database namespace managed by Everest.
+	Namespace struct {
+		// Name is the name of the namespace.
+		Name string `json:"name"`
+		// Operators are the database operators installed into the namespace.
+		// Supported values: mysql, mongodb, postgresql.
+		Operators []string `json:"operators"`
+		// TakeOwnership makes an existing namespace managed by Everest.
+		TakeOwnership bool `json:"takeOwnership,omitempty"`
+		// Quota is the Everest quota of the namespace.
+		Quota *quota.Quota `json:"quota,omitempty"`
+	}
+
+	// Account is a user account.
+	// Exactly one of Password, PasswordFile or PasswordEnv must be set.
+	Account struct {
+		// Username is the name of the account.
+		Username string `json:"username"`
+		// Password is the password of the account.
+		Password string `json:"password,omitempty"`
+		// PasswordFile is the path of a file with the password, relative to the configuration file.
+		PasswordFile string `json:"passwordFile,omitempty"`
+		// PasswordEnv is the name of an environment variable with the password.
+		PasswordEnv string `json:"passwordEnv,omitempty"`
+	}
+
+	// OIDC is the OIDC provider configuration.
+	OIDC struct {
+		// IssuerURL is the URL of the OIDC issuer.
+		IssuerURL string `json:"issuerURL"`
+		// ClientID is the ID of the client OIDC app.
+		ClientID string `json:"clientID"`
+		// Scopes are the requested scopes. The default scopes are used if empty.
+		Scopes []string `json:"scopes,omitempty"`
+	}
+
+	// RBAC is the RBAC configuration.
+	RBAC struct {
+		// Enabled is set if RBAC is enforced.
+		Enabled bool `json:"enabled"`
+		// Policy is the RBAC policy in the CSV format.
+		// The policy installed with Everest is kept if both Policy and PolicyFile are empty.
+		Policy string `json:"policy,omitempty"`
+		// PolicyFile is the path of a file with the RBAC policy, relative to the configuration file.
+		PolicyFile string `json:"policyFile,omitempty"`
+	}
+)
+
+// Load reads, parses and validates the configuration file.
+func Load(path string) (*Config, error) {
+	raw, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		return nil, fmt.Errorf("failed to read configuration file: %w", err)
+	}
+	c, err := Parse(raw, filepath.Dir(path))
+	if err != nil {
+		return nil, fmt.Errorf("invalid configuration file %s: %w", path, err)
+	}
+	return c, nil
+}
+
+// Parse parses and validates the raw YAML (or JSON) configuration.
+// The relative file paths are resolved against dir.
+func Parse(raw []byte, dir string) (*Config, error) {
+	c := &Config{}
+	if err := yaml.UnmarshalStrict(raw, c); err != nil {
+		return nil, err
+	}
+	if err := c.checkAPIVersion(); err != nil {
+		return nil, err
+	}
+	if err := c.resolve(dir); err != nil {
+		return nil, err
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Config) checkAPIVersion() error {
+	if c.APIVersion != APIVersion {
+		return fmt.Errorf("%w '%s', expected '%s'", ErrUnsupportedAPIVersion, c.APIVersion, APIVersion)
+	}
+	if c.Kind != Kind {
+		return fmt.Errorf("unsupported kind '%s', expected '%s'", c.Kind, Kind)
+	}
+	return nil
+}
+
+// resolve reads the passwords and the policy referenced by the configuration,
+// makes the paths of the values files absolute and sets the defaults.
+func (c *Config) resolve(dir string) error {
+	if c.OIDC != nil && len(c.OIDC.Scopes) == 0 {
+		c.OIDC.Scopes = common.DefaultOIDCScopes
+	}
+	for i, f := range c.Helm.ValuesFiles {
+		if !strings.Contains(f, "://") {
+			c.Helm.ValuesFiles[i] = resolvePath(dir, f)
+		}
+	}
+
+	for i := range c.Accounts {
+		a := &c.Accounts[i]
+		sources := 0
+		for _, s := range []string{a.Password, a.PasswordFile, a.PasswordEnv} {
+			if s != "" {
+				sources++
+			}
+		}
+		if sources != 1 {
+			return fmt.Errorf("accounts[%d]: exactly one of password, passwordFile or passwordEnv must be set", i)
+		}
+		switch {
+		case a.PasswordFile != "":
+			data, err := os.ReadFile(resolvePath(dir, a.PasswordFile))
+			if err != nil {
+				return fmt.Errorf("accounts[%d]: failed to read password file: %w", i, err)
+			}
+			a.Password = strings.TrimSpace(string(data))
+		case a.PasswordEnv != "":
+			a.Password = os.Getenv(a.PasswordEnv)
+			if a.Password == "" {
+				return fmt.Errorf("accounts[%d]: environment variable %s is not set", i, a.PasswordEnv)
+			}
+		}
+	}
+
+	if c.RBAC != nil && c.RBAC.PolicyFile != "" {
+		if c.RBAC.Policy != "" {
+			return errors.New("rbac: only one of policy or policyFile can be set")
+		}
+		data, err := os.ReadFile(resolvePath(dir, c.RBAC.PolicyFile))
+		if err != nil {
+			return fmt.Errorf("rbac: failed to read policy file: %w", err)
+		}
+		c.RBAC.Policy = string(data)
+	}
+	return nil
+}
+
+func resolvePath(dir, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(dir, path)
+}
+
+// Validate checks that the configuration is well-formed.
+// All the problems found are returned.
+func (c *Config) Validate() error {
+	var errs []error
+	if c.Version != "" {
+		if _, err := goversion.NewSemver(c.Version); err != nil {
+			errs = append(errs, fmt.Errorf("version: %w", err))
+		}
+	}
+	for _, s := range c.Helm.Set {
+		if err := strvals.ParseInto(s, map[string]any{}); err != nil {
+			errs = append(errs, fmt.Errorf("helm.set: %w", err))
+		}
+	}
+	errs = append(errs, c.validateNamespaces()...)
+	errs = append(errs, c.validateAccounts()...)
+	if c.OIDC != nil {
+		for _, err := range []error{
+			oidc.ValidateURL(c.OIDC.IssuerURL),
+			oidc.ValidateClientID(c.OIDC.ClientID),
+			oidc.ValidateScopes(c.OIDC.Scopes),
+		} {
+			if err != nil {
+				errs = append(errs, fmt.Errorf("oidc: %w", err))
+			}
+		}
+	}
+	if c.RBAC != nil && c.RBAC.Policy != "" {
+		if err := rbac.ValidatePolicyContent(c.RBAC.Policy); err != nil {
+			errs = append(errs, fmt.Errorf("rbac.policy: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (c *Config) validateNamespaces() []error {
+	var errs []error
+	seen := make(map[string]struct{}, len(c.Namespaces))
+	for i, ns := range c.Namespaces {
+		if err := namespaces.ValidateNamespaceNames([]string{ns.Name}); err != nil {
+			errs = append(errs, fmt.Errorf("namespaces[%d]: %w", i, err))
+		}
+		if _, ok := seen[ns.Name]; ok {
+			errs = append(errs, fmt.Errorf("namespaces[%d]: duplicate namespace '%s'", i, ns.Name))
+		}
+		seen[ns.Name] = struct{}{}
+
+		if len(ns.Operators) == 0 {
+			errs = append(errs, fmt.Errorf("namespaces[%d]: %w", i, namespaces.ErrOperatorsNotSelected))
+		}
+		for _, op := range ns.Operators {
+			if !slices.Contains([]string{OperatorMySQL, OperatorMongoDB, OperatorPostgreSQL}, op) {
+				errs = append(errs, fmt.Errorf("namespaces[%d]: unknown operator '%s', expected one of %s, %s, %s",
+					i, op, OperatorMySQL, OperatorMongoDB, OperatorPostgreSQL))
+			}
+		}
+		if ns.Quota != nil {
+			if err := ns.Quota.Validate(); err != nil {
+				errs = append(errs, fmt.Errorf("namespaces[%d].quota: %w", i, err))
+			}
+		}
+	}
+	return errs
+}
+
+func (c *Config) validateAccounts() []error {
+	var errs []error
+	seen := make(map[string]struct{}, len(c.Accounts))
+	for i, a := range c.Accounts {
+		if err := accountscli.ValidateUsername(a.Username); err != nil {
+			errs = append(errs, fmt.Errorf("accounts[%d]: %w", i, err))
+		}
+		if _, ok := seen[a.Username]; ok {
+			errs = append(errs, fmt.Errorf("accounts[%d]: duplicate account '%s'", i, a.Username))
+		}
+		seen[a.Username] = struct{}{}
+		if err := accountscli.ValidatePassword(a.Password); err != nil {
+			errs = append(errs, fmt.Errorf("accounts[%d]: %w", i, err))
+		}
+	}
+	return errs
+}
+
+// HelmValues returns the Helm values of the Everest chart.
+func (c *Config) HelmValues() (values.Options, error) {
+	opts := values.Options{
+		ValueFiles: c.Helm.ValuesFiles,
+		Values:     c.Helm.Set,
+	}
+	// The inline values are passed as JSON values of their top level keys.
+	keys := make([]string, 0, len(c.Helm.Values))
+	for k := range c.Helm.Values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		v, err := json.Marshal(c.Helm.Values[k])
+		if err != nil {
+			return values.Options{}, fmt.Errorf("invalid helm value '%s': %w", k, err)
+		}
+		opts.JSONValues = append(opts.JSONValues, k+"="+string(v))
+	}
+	return opts, nil
+}
+
+// OperatorConfig returns the operators to install into the namespace.
+func (n Namespace) OperatorConfig() namespaces.OperatorConfig {
+	return namespaces.OperatorConfig{
+		PXC:   slices.Contains(n.Operators, OperatorMySQL),
+		PSMDB: slices.Contains(n.Operators, OperatorMongoDB),
+		PG:    slices.Contains(n.Operators, OperatorPostgreSQL),
+	}
+}
+
+// NamespaceNames returns the names of the database namespaces.
+func (c *Config) NamespaceNames() []string {
+	names := make([]string, 0, len(c.Namespaces))
+	for _, ns := range c.Namespaces {
+		names = append(names, ns.Name)
+	}
+	return names
+}
@@ -0,0 +1,162 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package installconfig
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/everest/pkg/cli/namespaces"
+	"github.com/percona/everest/pkg/common"
+)
+
+const testConfig = `
+apiVersion: everestctl.percona.com/v1alpha1
+kind: EverestConfiguration
+version: 1.4.0
+helm:
+  values:
+    server:
+      tls:
+        enabled: true
+  valuesFiles:
+    - values.yaml
+  set:
+    - upgrade.preflightChecks=false
+namespaces:
+  - name: dev
+    operators: [mysql, postgresql]
+  - name: prod
+    operators: [mongodb]
+    takeOwnership: true
+    quota:
+      maxDatabaseClusters: 3
+accounts:
+  - username: alice
+    passwordFile: alice.txt
+  - username: bob
+    passwordEnv: EVEREST_TEST_BOB_PASSWORD
+oidc:
+  issuerURL: https://idp.example.com
+  clientID: everest
+rbac:
+  enabled: true
+  policy: |
+    p, role:admin, namespaces, read, *
+    g, alice, role:admin
+`
+
+func TestParse(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "alice.txt"), []byte("alice-secret\n"), 0o600))
+	t.Setenv("EVEREST_TEST_BOB_PASSWORD", "bob-secret")
+
+	c, err := Parse([]byte(testConfig), dir)
+	require.NoError(t, err)
+
+	assert.Equal(t, "1.4.0", c.Version)
+	assert.Equal(t, []string{"dev", "prod"}, c.NamespaceNames())
+	assert.Equal(t, namespaces.OperatorConfig{PXC: true, PG: true}, c.Namespaces[0].OperatorConfig())
+	assert.Equal(t, namespaces.OperatorConfig{PSMDB: true}, c.Namespaces[1].OperatorConfig())
+	assert.Equal(t, "alice-secret", c.Accounts[0].Password)
+	assert.Equal(t, "bob-secret", c.Accounts[1].Password)
+	assert.Equal(t, common.DefaultOIDCScopes, c.OIDC.Scopes)
+
+	vals, err := c.HelmValues()
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "values.yaml")}, vals.ValueFiles)
+	assert.Equal(t, []string{"upgrade.preflightChecks=false"}, vals.Values)
+	assert.Equal(t, []string{`server={"tls":{"enabled":true}}`}, vals.JSONValues)
+}
+
+func TestParseErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		config string
+		errs   []string
+	}{
+		{
+			name:   "unsupported apiVersion",
+			config: "apiVersion: v2\nkind: EverestConfiguration\n",
+			errs:   []string{"unsupported apiVersion 'v2'"},
+		},
+		{
+			name:   "unknown field",
+			config: "apiVersion: everestctl.percona.com/v1alpha1\nkind: EverestConfiguration\nnamespace: dev\n",
+			errs:   []string{`unknown field "namespace"`},
+		},
+		{
+			name: "password sources",
+			config: `apiVersion: everestctl.percona.com/v1alpha1
+kind: EverestConfiguration
+accounts:
+  - username: alice
+`,
+			errs: []string{"accounts[0]: exactly one of password, passwordFile or passwordEnv must be set"},
+		},
+		{
+			name: "all problems are reported",
+			config: `apiVersion: everestctl.percona.com/v1alpha1
+kind: EverestConfiguration
+version: latest
+namespaces:
+  - name: dev
+    operators: [mysql, redis]
+  - name: dev
+  - name: everest-system
+    operators: [mysql]
+accounts:
+  - username: al
+    password: short
+oidc:
+  issuerURL: idp.example.com
+  clientID: everest
+  scopes: [email]
+rbac:
+  enabled: true
+  policy: p, role:admin, unknown-resources, read, *
+`,
+			errs: []string{
+				"version: ",
+				"namespaces[0]: unknown operator 'redis'",
+				"namespaces[1]: duplicate namespace 'dev'",
+				"namespaces[1]: " + namespaces.ErrOperatorsNotSelected.Error(),
+				"namespaces[2]: 'everest-system' namespace is reserved",
+				"accounts[0]: username may contain only",
+				"accounts[0]: password may contain only",
+				"oidc: invalid URL",
+				"oidc: scopes must contain 'openid'",
+				"rbac.policy: ",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := Parse([]byte(tc.config), t.TempDir())
+			require.Error(t, err)
+			for _, msg := range tc.errs {
+				assert.Contains(t, err.Error(), msg)
+			}
+		})
+	}
+}
@@ -24,6 +24,7 @@ import (
 
 	"go.uber.org/zap"
 	"helm.sh/helm/v3/pkg/cli/values"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/percona/everest/pkg/cli/helm"
@@ -34,6 +35,7 @@ import (
 	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/kubernetes"
 	"github.com/percona/everest/pkg/output"
+	"github.com/percona/everest/pkg/quota"
 	. "github.com/percona/everest/pkg/utils/must" //nolint:revive,stylecheck
 	"github.com/percona/everest/pkg/version"
 )
@@ -80,6 +82,15 @@ type (
 		Update bool
 		// Helm related options
 		HelmConfig helm.CLIOptions
+		// Quota is the Everest quota to be set on the namespaces.
+		// If it is nil, the existing quota (if any) is left untouched.
+		Quota *quota.Quota
+		// RenderDir is set if the manifests shall be written to the directory
+		// instead of being applied to the cluster. The cluster is not accessed.
+		RenderDir string
+		// Version is the Everest version of the manifests to render.
+		// It is required if RenderDir is set.
+		Version string
 	}
 
 	// NamespaceAdder provides the functionality to add namespaces.
@@ -182,9 +193,13 @@ func (cfg *NamespaceAddConfig) PopulateOperators(ctx context.Context) error {
 // - namespace names
 // - namespace ownership
 func (cfg *NamespaceAddConfig) ValidateNamespaces(ctx context.Context, nsList []string) error {
-	if err := validateNamespaceNames(nsList); err != nil {
+	if err := ValidateNamespaceNames(nsList); err != nil {
 		return err
 	}
+	if cfg.RenderDir != "" {
+		// The cluster is not accessed when rendering the manifests.
+		return nil
+	}
 
 	k, err := cliutils.NewKubeConnector(zap.NewNop().Sugar(), cfg.KubeconfigPath)
 	if err != nil {
@@ -205,7 +220,7 @@ func (cfg *NamespaceAddConfig) validateNamespaceOwnership(
 	k kubernetes.KubernetesConnector,
 	namespace string,
 ) error {
-	nsExists, ownedByEverest, err := namespaceExists(ctx, k, namespace)
+	nsExists, ownedByEverest, err := NamespaceExists(ctx, k, namespace)
 	if err != nil {
 		return err
 	}
@@ -233,6 +248,20 @@ func (cfg *NamespaceAddConfig) validateNamespaceOwnership(
 	return nil
 }
 
+// PopulateQuota reads the namespace quota from the given YAML or JSON file.
+func (cfg *NamespaceAddConfig) PopulateQuota(path string) error {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read quota file: %w", err)
+	}
+	q, err := quota.Parse(raw)
+	if err != nil {
+		return err
+	}
+	cfg.Quota = q
+	return nil
+}
+
 // detectKubernetesEnv detects the Kubernetes environment where Everest is installed.
 func (cfg *NamespaceAddConfig) detectKubernetesEnv(ctx context.Context, l *zap.SugaredLogger) error {
 	if cfg.SkipEnvDetection {
@@ -280,6 +309,12 @@ func NewNamespaceAdd(c NamespaceAddConfig, l *zap.SugaredLogger) (*NamespaceAdde
 	if c.Pretty {
 		n.l = zap.NewNop().Sugar()
 	}
+	if c.RenderDir != "" {
+		if c.Version == "" {
+			return nil, ErrRenderVersionMissing
+		}
+		return n, nil
+	}
 
 	k, err := cliutils.NewKubeConnector(n.l, c.KubeconfigPath)
 	if err != nil {
@@ -291,6 +326,11 @@ func NewNamespaceAdd(c NamespaceAddConfig, l *zap.SugaredLogger) (*NamespaceAdde
 
 // Run namespace add operation.
 func (n *NamespaceAdder) Run(ctx context.Context) error {
+	helm.WarnImageRegistry(n.l, n.cfg.HelmConfig.ImageRegistry)
+	if n.cfg.RenderDir != "" {
+		return n.runRender(ctx)
+	}
+
 	// This command expects a Helm based installation (>= 1.4.0)
 	dbNSChartVersion, err := cliutils.CheckHelmInstallation(ctx, n.kubeClient)
 	if err != nil {
@@ -343,6 +383,9 @@ func (n *NamespaceAdder) GetNamespaceInstallSteps(ctx context.Context, dbNSChart
 		installSteps = append(installSteps,
 			n.newStepInstallNamespace(dbNSChartVersion, namespace),
 		)
+		if n.cfg.Quota != nil {
+			installSteps = append(installSteps, n.newStepConfigureQuota(namespace))
+		}
 	}
 
 	return installSteps, nil
@@ -375,32 +418,61 @@ func (n *NamespaceAdder) newStepInstallNamespace(version, namespace string) step
 	}
 }
 
+func (n *NamespaceAdder) newStepConfigureQuota(namespace string) steps.Step {
+	return steps.Step{
+		Desc: fmt.Sprintf("Configuring quota for database namespace '%s'", namespace),
+		F: func(ctx context.Context) error {
+			ns, err := n.kubeClient.GetNamespace(ctx, types.NamespacedName{Name: namespace})
+			if err != nil {
+				return fmt.Errorf("cannot get namespace: %w", err)
+			}
+			if err := n.cfg.Quota.ApplyTo(ns); err != nil {
+				return fmt.Errorf("cannot set quota: %w", err)
+			}
+			_, err = n.kubeClient.UpdateNamespace(ctx, ns)
+			return err
+		},
+	}
+}
+
 func (n *NamespaceAdder) provisionDBNamespace(
 	ctx context.Context,
 	version string,
 	namespace string,
 ) error {
-	nsExists, _, err := namespaceExists(ctx, n.kubeClient, namespace)
+	nsExists, _, err := NamespaceExists(ctx, n.kubeClient, namespace)
+	if err != nil {
+		return err
+	}
+	installer, err := n.newDBNamespaceInstaller(n.cfg.KubeconfigPath, version, namespace, !nsExists)
 	if err != nil {
 		return err
 	}
+	n.l.Info("Installing DB namespace Helm chart in namespace ", namespace)
+	return installer.Install(ctx)
+}
+
+func (n *NamespaceAdder) newDBNamespaceInstaller(
+	kubeconfigPath, version, namespace string,
+	createNamespace bool,
+) (*helm.Installer, error) {
 	values := Must(helmutils.MergeVals(n.getValues(), nil))
-	installer := helm.Installer{
+	installer := &helm.Installer{
 		ReleaseName:            namespace,
 		ReleaseNamespace:       namespace,
 		Values:                 values,
-		CreateReleaseNamespace: !nsExists,
+		CreateReleaseNamespace: createNamespace,
+		ImageRegistry:          n.cfg.HelmConfig.ImageRegistry,
 	}
-	if err := installer.Init(n.cfg.KubeconfigPath, helm.ChartOptions{
+	if err := installer.Init(kubeconfigPath, helm.ChartOptions{
 		Directory: cliutils.DBNamespaceSubChartPath(n.cfg.HelmConfig.ChartDir),
 		URL:       n.cfg.HelmConfig.RepoURL,
 		Name:      helm.EverestDBNamespaceChartName,
 		Version:   version,
 	}); err != nil {
-		return fmt.Errorf("could not initialize Helm installer: %w", err)
+		return nil, fmt.Errorf("could not initialize Helm installer: %w", err)
 	}
-	n.l.Info("Installing DB namespace Helm chart in namespace ", namespace)
-	return installer.Install(ctx)
+	return installer, nil
 }
 
 func (n *NamespaceAdder) validateNamespaceUpdate(ctx context.Context, namespace string) error {
@@ -71,6 +71,9 @@ var (
 	// ErrNamespaceNotEmpty is returned when the namespace is not empty.
 	ErrNamespaceNotEmpty = errors.New("cannot remove namespace with running database clusters")
 
+	// ErrRenderVersionMissing is returned when the version of the manifests to render is not set.
+	ErrRenderVersionMissing = errors.New("the Everest version must be set to render the manifests")
+
 	// ErrInteractiveModeDisabled is returned when interactive mode is disabled.
 	ErrInteractiveModeDisabled = errors.New("interactive mode is disabled")
 )
@@ -71,7 +71,7 @@ type (
 // - namespace names
 // - namespace ownership
 func (cfg *NamespaceRemoveConfig) ValidateNamespaces(ctx context.Context, nsList []string) error {
-	if err := validateNamespaceNames(nsList); err != nil {
+	if err := ValidateNamespaceNames(nsList); err != nil {
 		return err
 	}
 
@@ -105,7 +105,7 @@ func (cfg *NamespaceRemoveConfig) validateNamespaceOwnership(
 	namespace string,
 ) error {
 	// Check that the namespace exists.
-	exists, managedByEverest, err := namespaceExists(ctx, k, namespace)
+	exists, managedByEverest, err := NamespaceExists(ctx, k, namespace)
 	if err != nil {
 		return err
 	}
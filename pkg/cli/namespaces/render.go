@@ -0,0 +1,120 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespaces
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/percona/everest/pkg/cli/helm"
+	helmutils "github.com/percona/everest/pkg/cli/helm/utils"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/output"
+	"github.com/percona/everest/pkg/version"
+)
+
+func (n *NamespaceAdder) runRender(ctx context.Context) error {
+	var out io.Writer = os.Stdout
+	if !n.cfg.Pretty {
+		out = io.Discard
+	}
+
+	if version.IsDev(n.cfg.Version) && n.cfg.HelmConfig.ChartDir == "" {
+		// Note: new value will be set to n.cfg.ChartDir inside SetupEverestDevChart
+		cleanup, err := helmutils.SetupEverestDevChart(n.l, &n.cfg.HelmConfig.ChartDir)
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+	}
+
+	files, err := n.Render(ctx, n.cfg.Version)
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprint(out, output.Success("Manifests of the database namespaces written to %s", n.cfg.RenderDir))
+	for _, f := range files {
+		_, _ = fmt.Fprintf(out, "   %s\n", f)
+	}
+	PrintHooksNote(out, files)
+	return nil
+}
+
+// Render writes the manifests of the database namespaces, including the
+// subscriptions of the operators, to the render directory without accessing
+// the cluster. It returns the paths of the written files.
+func (n *NamespaceAdder) Render(ctx context.Context, version string) ([]string, error) {
+	files := make([]string, 0, len(n.cfg.NamespaceList))
+	for _, namespace := range n.cfg.NamespaceList {
+		n.l.Infof("Rendering DB namespace Helm chart for namespace %s", namespace)
+		installer, err := n.newDBNamespaceInstaller("", version, namespace, true)
+		if err != nil {
+			return nil, err
+		}
+		manifests, err := installer.RenderTemplates(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not render Helm chart for namespace %s: %w", namespace, err)
+		}
+
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:   namespace,
+			Labels: map[string]string{common.KubernetesManagedByLabel: common.Everest},
+		}}
+		if n.cfg.Quota != nil {
+			if err := n.cfg.Quota.ApplyTo(ns); err != nil {
+				return nil, fmt.Errorf("cannot set quota: %w", err)
+			}
+		}
+		if manifests, err = manifests.WithNamespace(ns); err != nil {
+			return nil, err
+		}
+
+		p := helm.RenderedFilePath(n.cfg.RenderDir, namespace, helm.EverestDBNamespaceChartName)
+		if err := manifests.WriteFile(p); err != nil {
+			return nil, fmt.Errorf("could not write manifests for namespace %s: %w", namespace, err)
+		}
+		files = append(files, p)
+
+		hooks, err := installer.RenderHooks(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not render Helm chart hooks for namespace %s: %w", namespace, err)
+		}
+		if len(hooks) > 0 {
+			p := helm.RenderedHooksFilePath(n.cfg.RenderDir, namespace, helm.EverestDBNamespaceChartName)
+			if err := helm.WriteHooksFile(p, hooks); err != nil {
+				return nil, fmt.Errorf("could not write hooks for namespace %s: %w", namespace, err)
+			}
+			files = append(files, p)
+		}
+	}
+	return files, nil
+}
+
+// PrintHooksNote explains how to apply the rendered Helm hooks, if any.
+func PrintHooksNote(out io.Writer, files []string) {
+	if !slices.ContainsFunc(files, func(f string) bool { return strings.HasSuffix(f, helm.HooksFileSuffix) }) {
+		return
+	}
+	_, _ = fmt.Fprint(out, output.Warn("The *%s files contain the Helm hooks of the charts. "+
+		"Helm runs them before or after applying the manifests, apply them according to the events in their comments", helm.HooksFileSuffix))
+}
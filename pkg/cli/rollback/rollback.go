@@ -0,0 +1,295 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rollback implements the rollback of Everest to the previously installed version.
+package rollback
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/percona/everest/pkg/cli/crds"
+	"github.com/percona/everest/pkg/cli/helm"
+	"github.com/percona/everest/pkg/cli/steps"
+	cliutils "github.com/percona/everest/pkg/cli/utils"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/output"
+)
+
+const (
+	pollInterval = 5 * time.Second
+	pollTimeout  = 10 * time.Minute
+)
+
+// ErrIncompatibleCRDs is returned when the CRDs installed in the cluster are not
+// backward compatible with the version to roll back to.
+var ErrIncompatibleCRDs = errors.New("the installed CRDs are not backward compatible with the previous version")
+
+// Config stores configuration for the Rollback command.
+type Config struct {
+	// KubeconfigPath is a path to a kubeconfig
+	KubeconfigPath string
+	// If set, we will print the pretty output.
+	Pretty bool
+}
+
+// Rollback implements the rollback command.
+type Rollback struct {
+	config        Config
+	kubeConnector kubernetes.KubernetesConnector
+	l             *zap.SugaredLogger
+
+	// releaseHistory returns the history of a Helm release, the latest revision first.
+	releaseHistory func(relName, relNamespace string) ([]*release.Release, error)
+}
+
+// NewRollback returns a new Rollback struct.
+func NewRollback(c Config, l *zap.SugaredLogger) (*Rollback, error) {
+	cli := &Rollback{
+		config: c,
+		l:      l.With("component", "rollback"),
+	}
+	if c.Pretty {
+		cli.l = zap.NewNop().Sugar()
+	}
+
+	kubeClient, err := cliutils.NewKubeConnector(cli.l, c.KubeconfigPath)
+	if err != nil {
+		return nil, err
+	}
+	cli.kubeConnector = kubeClient
+	cli.releaseHistory = func(relName, relNamespace string) ([]*release.Release, error) {
+		return helm.GetReleaseHistory(relName, relNamespace, c.KubeconfigPath)
+	}
+	return cli, nil
+}
+
+// Run rolls the Everest Helm release back to the previous revision, and the
+// releases of the DB namespaces back to their revision of the same version.
+// The CRDs are not rolled back, so the rollback is refused if the previous
+// version cannot work with the CRDs installed in the cluster.
+func (r *Rollback) Run(ctx context.Context) error {
+	if _, err := cliutils.CheckHelmInstallation(ctx, r.kubeConnector); err != nil {
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	if !r.config.Pretty {
+		out = io.Discard
+	}
+
+	history, err := r.releaseHistory(common.SystemNamespace, common.SystemNamespace)
+	if err != nil {
+		return fmt.Errorf("could not get Helm release history: %w", err)
+	}
+	current, previous, err := helm.PreviousRelease(history)
+	if err != nil {
+		return err
+	}
+	currentVersion := current.Chart.Metadata.Version
+	previousVersion := previous.Chart.Metadata.Version
+
+	if err := r.checkCRDs(ctx, previous); err != nil {
+		return err
+	}
+	dbNamespaceRevisions, skipped, err := r.dbNamespaceRevisions(ctx, previousVersion)
+	if err != nil {
+		return err
+	}
+	for _, namespace := range skipped {
+		r.l.Warnf("DB namespace '%s' has no Helm release revision with version %s, it will not be rolled back", namespace, previousVersion)
+		_, _ = fmt.Fprintln(out, output.Warn("DB namespace '%s' has no Helm release revision with version %s, it will not be rolled back", namespace, previousVersion))
+	}
+
+	r.l.Infof("Rolling back Everest from %s to %s (revision %d)", currentVersion, previousVersion, previous.Version)
+	_, _ = fmt.Fprintln(out, output.Info("Rolling back Everest from version %s to %s", currentVersion, previousVersion))
+	if err := steps.RunStepsWithSpinner(ctx, r.l, r.newRollbackSteps(previous, dbNamespaceRevisions), r.config.Pretty); err != nil {
+		return err
+	}
+
+	r.l.Infof("Everest has been rolled back to version %s", previousVersion)
+	_, _ = fmt.Fprintln(out, "\n", output.Rocket("Everest has been rolled back to version %s", previousVersion))
+	return nil
+}
+
+func (r *Rollback) newRollbackSteps(previous *release.Release, dbNamespaceRevisions map[string]int) []steps.Step {
+	return []steps.Step{
+		{
+			Desc: "Rolling back DB namespaces Helm releases",
+			F: func(_ context.Context) error {
+				return r.rollbackDBNamespaces(dbNamespaceRevisions)
+			},
+		},
+		{
+			Desc: "Rolling back Helm release",
+			F: func(_ context.Context) error {
+				return helm.Rollback(common.SystemNamespace, common.SystemNamespace, r.config.KubeconfigPath, previous.Version)
+			},
+		},
+		{
+			Desc: "Ensuring Everest API deployment is ready",
+			F: func(ctx context.Context) error {
+				return r.waitForDeployment(ctx, common.PerconaEverestDeploymentName)
+			},
+		},
+		{
+			Desc: "Ensuring Everest operator deployment is ready",
+			F: func(ctx context.Context) error {
+				return r.waitForDeployment(ctx, common.PerconaEverestOperatorDeploymentName)
+			},
+		},
+		{
+			Desc: "Ensuring Everest CatalogSource is ready",
+			F: func(ctx context.Context) error {
+				return r.waitForCatalogSource(ctx, previous)
+			},
+		},
+	}
+}
+
+// dbNamespaceRevisions returns the revisions to roll the release of each DB
+// namespace back to, i.e. their latest revision with the chart of the previous
+// version. The DB namespaces already on the previous version are omitted.
+// The DB namespaces without a revision with the previous version, e.g. the ones
+// added after the upgrade, are returned separately and are not rolled back.
+func (r *Rollback) dbNamespaceRevisions(ctx context.Context, previousVersion string) (map[string]int, []string, error) {
+	dbNamespaces, err := r.kubeConnector.GetDBNamespaces(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not get database namespaces: %w", err)
+	}
+	revisions := make(map[string]int, len(dbNamespaces.Items))
+	var skipped []string
+	for _, ns := range dbNamespaces.Items {
+		namespace := ns.GetName()
+		history, err := r.releaseHistory(namespace, namespace)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not get Helm release history of DB namespace '%s': %w", namespace, err)
+		}
+		rel := helm.ReleaseWithChartVersion(history, previousVersion)
+		if rel == nil {
+			skipped = append(skipped, namespace)
+			continue
+		}
+		if rel.Info != nil && rel.Info.Status == release.StatusDeployed {
+			continue
+		}
+		revisions[namespace] = rel.Version
+	}
+	return revisions, skipped, nil
+}
+
+// rollbackDBNamespaces rolls the release of each DB namespace back to the given revision.
+func (r *Rollback) rollbackDBNamespaces(revisions map[string]int) error {
+	for namespace, revision := range revisions {
+		r.l.Infof("Rolling back DB namespace '%s' Helm release to revision %d", namespace, revision)
+		if err := helm.Rollback(namespace, namespace, r.config.KubeconfigPath, revision); err != nil {
+			return fmt.Errorf("could not roll back DB namespace '%s' Helm release: %w", namespace, err)
+		}
+	}
+	return nil
+}
+
+// waitForCatalogSource waits for the Everest CatalogSource of the release to be ready.
+func (r *Rollback) waitForCatalogSource(ctx context.Context, rel *release.Release) error {
+	manifests := helm.ReleaseTemplate(rel)
+	catalogNs, err := manifests.GetEverestCatalogNamespace()
+	if err != nil {
+		return fmt.Errorf("could not get Everest CatalogSource namespace: %w", err)
+	}
+	return wait.PollUntilContextTimeout(ctx, pollInterval, pollTimeout, false, func(ctx context.Context) (bool, error) {
+		cs, err := r.kubeConnector.GetCatalogSource(ctx, types.NamespacedName{Namespace: catalogNs, Name: common.PerconaEverestCatalogName})
+		if err != nil {
+			return false, fmt.Errorf("cannot get CatalogSource: %w", err)
+		}
+		return kubernetes.IsCatalogSourceReady(cs), nil
+	})
+}
+
+func (r *Rollback) waitForDeployment(ctx context.Context, name string) error {
+	r.l.Infof("Waiting for Deployment '%s' in namespace '%s'", name, common.SystemNamespace)
+	if err := r.kubeConnector.WaitForRollout(ctx, types.NamespacedName{Namespace: common.SystemNamespace, Name: name}); err != nil {
+		return err
+	}
+	r.l.Infof("Deployment '%s' in namespace '%s' is ready", name, common.SystemNamespace)
+	return nil
+}
+
+// checkCRDs returns an error if the changes from the CRDs of the previous release
+// to the CRDs installed in the cluster are not backward compatible.
+func (r *Rollback) checkCRDs(ctx context.Context, previous *release.Release) error {
+	previousCRDs, err := r.releaseCRDs(previous)
+	if err != nil {
+		return err
+	}
+	if len(previousCRDs) == 0 {
+		return fmt.Errorf("could not find the CRDs of Everest %s", previous.Chart.Metadata.Version)
+	}
+
+	list, err := r.kubeConnector.ListCRDs(ctx)
+	if err != nil {
+		return fmt.Errorf("could not list CRDs: %w", err)
+	}
+	groups := crds.Groups(previousCRDs)
+	installed := []apiextv1.CustomResourceDefinition{}
+	for _, crd := range list.Items {
+		if slices.Contains(groups, crd.Spec.Group) {
+			installed = append(installed, crd)
+		}
+	}
+
+	breaking := crds.Breaking(crds.Compare(previousCRDs, installed))
+	if len(breaking) == 0 {
+		return nil
+	}
+	msgs := make([]string, 0, len(breaking))
+	for _, c := range breaking {
+		msgs = append(msgs, "- "+c.String())
+	}
+	return fmt.Errorf("%w:\n%s", ErrIncompatibleCRDs, strings.Join(msgs, "\n"))
+}
+
+// releaseCRDs returns the CRDs that were installed with the given Everest release.
+// Starting from 1.9.0 the CRDs are installed with a separate chart, the revision
+// of its release with the same chart version is used.
+func (r *Rollback) releaseCRDs(rel *release.Release) ([]apiextv1.CustomResourceDefinition, error) {
+	history, err := r.releaseHistory(helm.EverestCRDChartName, common.SystemNamespace)
+	if err != nil && !errors.Is(err, driver.ErrReleaseNotFound) {
+		return nil, fmt.Errorf("could not get Helm release history: %w", err)
+	}
+	if crdRel := helm.ReleaseWithChartVersion(history, rel.Chart.Metadata.Version); crdRel != nil {
+		rel = crdRel
+	}
+
+	manifests := helm.ReleaseTemplate(rel)
+	docs := manifests.Strings()
+	for _, crd := range rel.Chart.CRDObjects() {
+		docs = append(docs, string(crd.File.Data))
+	}
+	return crds.Parse(docs)
+}
@@ -0,0 +1,95 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rollback
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+func TestRollback_dbNamespaceRevisions(t *testing.T) {
+	t.Parallel()
+
+	rel := func(version int, chartVersion string, status release.Status) *release.Release {
+		return &release.Release{
+			Version: version,
+			Info:    &release.Info{Status: status},
+			Chart:   &chart.Chart{Metadata: &chart.Metadata{Version: chartVersion}},
+		}
+	}
+	dbNamespace := func(name string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{common.KubernetesManagedByLabel: common.Everest},
+		}}
+	}
+
+	mockClient := fakeclient.NewClientBuilder().
+		WithScheme(kubernetes.CreateScheme()).
+		WithObjects(
+			dbNamespace("upgraded"),
+			dbNamespace("not-upgraded"),
+			dbNamespace("added"),
+			dbNamespace("broken"),
+		)
+	histories := map[string][]*release.Release{
+		"upgraded": {
+			rel(3, "1.5.0", release.StatusDeployed),
+			rel(2, "1.4.0", release.StatusSuperseded),
+			rel(1, "1.4.0", release.StatusSuperseded),
+		},
+		"not-upgraded": {
+			rel(1, "1.4.0", release.StatusDeployed),
+		},
+		"added": {
+			rel(1, "1.5.0", release.StatusDeployed),
+		},
+	}
+
+	r := &Rollback{
+		kubeConnector: kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient.Build()),
+		l:             zap.NewNop().Sugar(),
+		releaseHistory: func(relName, _ string) ([]*release.Release, error) {
+			history, ok := histories[relName]
+			if !ok {
+				return nil, errors.New("release not found")
+			}
+			return history, nil
+		},
+	}
+
+	_, _, err := r.dbNamespaceRevisions(context.Background(), "1.4.0")
+	require.ErrorContains(t, err, "DB namespace 'broken'")
+
+	histories["broken"] = []*release.Release{rel(1, "1.5.0", release.StatusDeployed)}
+	revisions, skipped, err := r.dbNamespaceRevisions(context.Background(), "1.4.0")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"upgraded": 2}, revisions)
+	assert.ElementsMatch(t, []string{"added", "broken"}, skipped)
+}
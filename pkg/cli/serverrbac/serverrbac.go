@@ -0,0 +1,88 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package serverrbac provides the functionality to grant the everest API Server
+// the permissions that are not part of the Helm chart.
+package serverrbac
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/fs"
+	"text/template"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/percona/everest/data"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+const (
+	leaderElectionManifest = "server/leader-election.yaml"
+	defaultServiceAccount  = "default"
+)
+
+type leaderElectionParams struct {
+	Namespace      string
+	ServiceAccount string
+	LeaseName      string
+}
+
+// Apply creates or updates the Role and the RoleBinding that allow the
+// everest API Server to use the Lease electing the replica that runs the
+// background jobs.
+func Apply(ctx context.Context, k kubernetes.KubernetesConnector) error {
+	depl, err := k.GetDeployment(ctx, types.NamespacedName{
+		Namespace: common.SystemNamespace,
+		Name:      common.PerconaEverestDeploymentName,
+	})
+	if err != nil {
+		return fmt.Errorf("could not get the everest API Server deployment: %w", err)
+	}
+	sa := depl.Spec.Template.Spec.ServiceAccountName
+	if sa == "" {
+		sa = defaultServiceAccount
+	}
+	manifest, err := leaderElectionRBAC(leaderElectionParams{
+		Namespace:      common.SystemNamespace,
+		ServiceAccount: sa,
+		LeaseName:      common.PerconaEverestServerLeaseName,
+	})
+	if err != nil {
+		return err
+	}
+	if err := k.ApplyManifestFile(ctx, manifest, common.SystemNamespace); err != nil {
+		return fmt.Errorf("could not apply the leader election RBAC: %w", err)
+	}
+	return nil
+}
+
+func leaderElectionRBAC(params leaderElectionParams) ([]byte, error) {
+	raw, err := fs.ReadFile(data.ServerRBAC, leaderElectionManifest)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := template.New(leaderElectionManifest).Option("missingkey=error").Parse(string(raw))
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, params); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
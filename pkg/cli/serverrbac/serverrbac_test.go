@@ -0,0 +1,56 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serverrbac
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	rbacv1 "k8s.io/api/rbac/v1"
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+func TestLeaderElectionRBAC(t *testing.T) {
+	t.Parallel()
+
+	manifest, err := leaderElectionRBAC(leaderElectionParams{
+		Namespace:      "everest-system",
+		ServiceAccount: "everest-admin",
+		LeaseName:      "everest-server-background-jobs",
+	})
+	require.NoError(t, err)
+
+	decoder := yamlutil.NewYAMLOrJSONDecoder(bytes.NewReader(manifest), len(manifest))
+	role := &rbacv1.Role{}
+	require.NoError(t, decoder.Decode(role))
+	binding := &rbacv1.RoleBinding{}
+	require.NoError(t, decoder.Decode(binding))
+
+	assert.Equal(t, "Role", role.Kind)
+	require.Len(t, role.Rules, 2)
+	assert.Equal(t, []string{"create"}, role.Rules[0].Verbs)
+	assert.Equal(t, []string{"everest-server-background-jobs"}, role.Rules[1].ResourceNames)
+	assert.Equal(t, []string{"get", "update"}, role.Rules[1].Verbs)
+
+	assert.Equal(t, role.Name, binding.RoleRef.Name)
+	assert.Equal(t, []rbacv1.Subject{{
+		Kind:      rbacv1.ServiceAccountKind,
+		Name:      "everest-admin",
+		Namespace: "everest-system",
+	}}, binding.Subjects)
+}
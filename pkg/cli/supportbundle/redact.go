@@ -0,0 +1,145 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package supportbundle
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// Redacted replaces the redacted values.
+	Redacted = "<redacted>"
+
+	lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+// sensitiveKeys are the substrings of the keys of the values that are redacted.
+//
+//nolint:gochecknoglobals
+var sensitiveKeys = []string{"password", "passwd", "secret", "token", "accesskey", "apikey", "privatekey", "jwtkey"}
+
+// Redaction is a value that has been redacted from the support bundle.
+type Redaction struct {
+	// File is the path of the file in the support bundle.
+	File string `json:"file"`
+	// Path is the path of the value in the file.
+	Path   string `json:"path"`
+	Reason string `json:"reason"`
+}
+
+// Redactor redacts the sensitive values of the collected objects and
+// records the redactions for the report.
+type Redactor struct {
+	redactions []Redaction
+}
+
+// Report returns the redactions, in the order they have been made.
+func (r *Redactor) Report() []Redaction {
+	return r.redactions
+}
+
+// Object returns the object as an unstructured map with its sensitive values
+// redacted. The data of secrets is always redacted, only their keys are kept.
+func (r *Redactor) Object(file string, obj ctrlclient.Object) (map[string]any, error) {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	if metadata, ok := u["metadata"].(map[string]any); ok {
+		// Managed fields are noise for troubleshooting.
+		delete(metadata, "managedFields")
+		if annotations, ok := metadata["annotations"].(map[string]any); ok {
+			if _, ok := annotations[lastAppliedConfigAnnotation]; ok {
+				annotations[lastAppliedConfigAnnotation] = Redacted
+				r.add(file, "metadata.annotations."+lastAppliedConfigAnnotation, "may contain the values of the applied object")
+			}
+		}
+	}
+	if _, ok := obj.(*corev1.Secret); ok {
+		for _, field := range []string{"data", "stringData"} {
+			data, ok := u[field].(map[string]any)
+			if !ok {
+				continue
+			}
+			for _, key := range slices.Sorted(maps.Keys(data)) {
+				data[key] = Redacted
+				r.add(file, field+"."+key, "secret data")
+			}
+		}
+		return u, nil
+	}
+	r.walk(file, "", u)
+	return u, nil
+}
+
+// Values redacts the sensitive values of the Helm values in place.
+func (r *Redactor) Values(file string, values map[string]any) map[string]any {
+	r.walk(file, "", values)
+	return values
+}
+
+// walk redacts the non-empty string values with a sensitive key.
+func (r *Redactor) walk(file, path string, v any) {
+	switch v := v.(type) {
+	case map[string]any:
+		// Name/value pairs, e.g. the environment variables of containers.
+		if name, ok := v["name"].(string); ok && isSensitive(name) {
+			if s, ok := v["value"].(string); ok && s != "" {
+				v["value"] = Redacted
+				r.add(file, path+".value", "sensitive field")
+			}
+		}
+		for _, key := range slices.Sorted(maps.Keys(v)) {
+			p := key
+			if path != "" {
+				p = path + "." + key
+			}
+			if s, ok := v[key].(string); ok && s != "" && isSensitive(key) {
+				v[key] = Redacted
+				r.add(file, p, "sensitive field")
+				continue
+			}
+			r.walk(file, p, v[key])
+		}
+	case []any:
+		for i, item := range v {
+			r.walk(file, fmt.Sprintf("%s[%d]", path, i), item)
+		}
+	}
+}
+
+func (r *Redactor) add(file, path, reason string) {
+	r.redactions = append(r.redactions, Redaction{File: file, Path: path, Reason: reason})
+}
+
+// isSensitive returns true if the key holds a sensitive value. The keys that
+// name or reference a secret, e.g. credentialsSecretName, are not sensitive.
+func isSensitive(key string) bool {
+	key = strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key))
+	if strings.HasSuffix(key, "name") || strings.HasSuffix(key, "ref") {
+		return false
+	}
+	return slices.ContainsFunc(sensitiveKeys, func(s string) bool {
+		return strings.Contains(key, s)
+	})
+}
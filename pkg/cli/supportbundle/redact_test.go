@@ -0,0 +1,120 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package supportbundle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestRedactSecret(t *testing.T) {
+	t.Parallel()
+
+	r := &Redactor{}
+	u, err := r.Object("secret.yaml", &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:          "s3-credentials",
+			Annotations:   map[string]string{lastAppliedConfigAnnotation: `{"data":{"AWS_SECRET_ACCESS_KEY":"c2VjcmV0"}}`},
+			ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "kubectl"}},
+		},
+		Data:       map[string][]byte{"AWS_ACCESS_KEY_ID": []byte("id"), "AWS_SECRET_ACCESS_KEY": []byte("secret")},
+		StringData: map[string]string{"token": "t"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]any{"AWS_ACCESS_KEY_ID": Redacted, "AWS_SECRET_ACCESS_KEY": Redacted}, u["data"])
+	assert.Equal(t, map[string]any{"token": Redacted}, u["stringData"])
+	metadata := u["metadata"].(map[string]any) //nolint:forcetypeassert
+	assert.NotContains(t, metadata, "managedFields")
+	assert.Equal(t, map[string]any{lastAppliedConfigAnnotation: Redacted}, metadata["annotations"])
+	assert.Equal(t, []Redaction{
+		{File: "secret.yaml", Path: "metadata.annotations." + lastAppliedConfigAnnotation, Reason: "may contain the values of the applied object"},
+		{File: "secret.yaml", Path: "data.AWS_ACCESS_KEY_ID", Reason: "secret data"},
+		{File: "secret.yaml", Path: "data.AWS_SECRET_ACCESS_KEY", Reason: "secret data"},
+		{File: "secret.yaml", Path: "stringData.token", Reason: "secret data"},
+	}, r.Report())
+}
+
+func TestRedactObject(t *testing.T) {
+	t.Parallel()
+
+	r := &Redactor{}
+	d := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "everest-server"}}
+	d.Spec.Template.Spec.Containers = []corev1.Container{{
+		Name: "everest",
+		Env: []corev1.EnvVar{
+			{Name: "PORT", Value: "8080"},
+			{Name: "DB_PASSWORD", Value: "hunter2"},
+			{Name: "API_TOKEN", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{Key: "token"}}},
+		},
+	}}
+	u, err := r.Object("deployment.yaml", d)
+	require.NoError(t, err)
+
+	containers, _, err := unstructured.NestedSlice(u, "spec", "template", "spec", "containers")
+	require.NoError(t, err)
+	env, _, err := unstructured.NestedSlice(containers[0].(map[string]any), "env") //nolint:forcetypeassert
+	require.NoError(t, err)
+	assert.Equal(t, []any{
+		map[string]any{"name": "PORT", "value": "8080"},
+		map[string]any{"name": "DB_PASSWORD", "value": Redacted},
+		map[string]any{"name": "API_TOKEN", "valueFrom": map[string]any{"secretKeyRef": map[string]any{"key": "token"}}},
+	}, env)
+	assert.Equal(t, []Redaction{
+		{File: "deployment.yaml", Path: "spec.template.spec.containers[0].env[1].value", Reason: "sensitive field"},
+	}, r.Report())
+}
+
+func TestRedactValues(t *testing.T) {
+	t.Parallel()
+
+	r := &Redactor{}
+	values := r.Values("values.yaml", map[string]any{
+		"server": map[string]any{
+			"jwtKey": "key",
+			"oidc":   map[string]any{"clientSecret": "s", "clientId": "everest"},
+		},
+		"pmm": map[string]any{
+			"enabled":               true,
+			"password":              "",
+			"credentialsSecretName": "pmm-credentials",
+		},
+		"users": []any{map[string]any{"name": "admin", "password": "p"}},
+	})
+	assert.Equal(t, map[string]any{
+		"server": map[string]any{
+			"jwtKey": Redacted,
+			"oidc":   map[string]any{"clientSecret": Redacted, "clientId": "everest"},
+		},
+		"pmm": map[string]any{
+			"enabled":               true,
+			"password":              "",
+			"credentialsSecretName": "pmm-credentials",
+		},
+		"users": []any{map[string]any{"name": "admin", "password": Redacted}},
+	}, values)
+	assert.Equal(t, []Redaction{
+		{File: "values.yaml", Path: "server.jwtKey", Reason: "sensitive field"},
+		{File: "values.yaml", Path: "server.oidc.clientSecret", Reason: "sensitive field"},
+		{File: "values.yaml", Path: "users[0].password", Reason: "sensitive field"},
+	}, r.Report())
+}
@@ -0,0 +1,434 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package supportbundle provides the functionality to collect the support bundle of Everest.
+package supportbundle
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	clientgo "k8s.io/client-go/kubernetes"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/percona/everest/pkg/cli/helm"
+	cliutils "github.com/percona/everest/pkg/cli/utils"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/output"
+	"github.com/percona/everest/pkg/version"
+)
+
+// DefaultLogTailLines is the default number of lines collected from the logs of each container.
+const DefaultLogTailLines = 10000
+
+type (
+	// Config is the configuration for collecting a support bundle.
+	Config struct {
+		// KubeconfigPath is a path to a kubeconfig
+		KubeconfigPath string
+		// Pretty if set print the output in pretty mode.
+		Pretty bool
+		// Namespaces are the DB namespaces to collect.
+		// All the DB namespaces managed by Everest are collected if empty.
+		Namespaces []string
+		// Output is the path of the archive. It defaults to a file named
+		// after the time of the collection in the current directory.
+		Output string
+		// LogTailLines is the number of lines collected from the logs of each container.
+		LogTailLines int64
+	}
+
+	// Result is the summary of a collected support bundle.
+	Result struct {
+		// Path is the path of the archive.
+		Path string `json:"path"`
+		// Files is the number of files in the archive.
+		Files int `json:"files"`
+		// Redactions are the values that have been redacted.
+		Redactions []Redaction `json:"redactions"`
+		// Errors are the errors of the collection of the parts that are
+		// missing from the archive.
+		Errors []string `json:"errors,omitempty"`
+	}
+
+	// SupportBundle is the CLI operation to collect a support bundle.
+	SupportBundle struct {
+		cfg        Config
+		kubeClient kubernetes.KubernetesConnector
+		clientset  clientgo.Interface
+		l          *zap.SugaredLogger
+
+		// set during a collection.
+		archive  *tar.Writer
+		redactor *Redactor
+		result   *Result
+		now      time.Time
+	}
+)
+
+// NewSupportBundle returns a new CLI operation to collect a support bundle.
+func NewSupportBundle(c Config, l *zap.SugaredLogger) (*SupportBundle, error) {
+	b := &SupportBundle{
+		cfg: c,
+		l:   l.With("component", "support-bundle"),
+	}
+	if c.Pretty {
+		b.l = zap.NewNop().Sugar()
+	}
+
+	k, err := cliutils.NewKubeConnector(b.l, b.cfg.KubeconfigPath)
+	if err != nil {
+		return nil, err
+	}
+	b.kubeClient = k
+	if b.clientset, err = clientgo.NewForConfig(k.Config()); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// Run collects the support bundle into a tar.gz archive and prints a summary.
+// The parts that cannot be collected are skipped and reported in the archive.
+func (b *SupportBundle) Run(ctx context.Context) (*Result, error) {
+	var out io.Writer = os.Stdout
+	if !b.cfg.Pretty {
+		out = io.Discard
+	}
+
+	b.now = time.Now().UTC()
+	b.result = &Result{Path: b.cfg.Output}
+	if b.result.Path == "" {
+		b.result.Path = fmt.Sprintf("everest-support-bundle-%s.tar.gz", b.now.Format("20060102-150405"))
+	}
+	f, err := os.Create(b.result.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close() //nolint:errcheck
+	gw := gzip.NewWriter(f)
+	b.archive = tar.NewWriter(gw)
+	b.redactor = &Redactor{}
+
+	namespaces, err := b.dbNamespaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+	b.collectVersions(ctx)
+	_, _ = fmt.Fprint(out, output.Info("Collecting the Everest namespaces"))
+	for _, ns := range []string{common.SystemNamespace, common.MonitoringNamespace, kubernetes.OLMNamespace} {
+		b.collectNamespace(ctx, ns)
+	}
+	b.collectRelease(common.SystemNamespace, common.SystemNamespace)
+	b.collectRelease(helm.EverestCRDChartName, common.SystemNamespace)
+	for _, ns := range namespaces {
+		_, _ = fmt.Fprint(out, output.Info("Collecting namespace %s", ns))
+		b.collectNamespace(ctx, ns)
+		b.collectRelease(ns, ns)
+	}
+
+	b.result.Redactions = b.redactor.Report()
+	if b.result.Redactions == nil {
+		b.result.Redactions = []Redaction{}
+	}
+	if err := b.addYAML("redaction-report.yaml", b.result.Redactions); err != nil {
+		return nil, err
+	}
+	if len(b.result.Errors) > 0 {
+		if err := b.addYAML("collection-errors.yaml", b.result.Errors); err != nil {
+			return nil, err
+		}
+	}
+	if err := errors.Join(b.archive.Close(), gw.Close(), f.Close()); err != nil {
+		return nil, err
+	}
+
+	for _, e := range b.result.Errors {
+		_, _ = fmt.Fprint(out, output.Warn("%s", e))
+	}
+	_, _ = fmt.Fprint(out, output.Info("%d values have been redacted, see redaction-report.yaml in the archive", len(b.result.Redactions)))
+	_, _ = fmt.Fprint(out, output.Success("Support bundle written to %s", b.result.Path))
+	return b.result, nil
+}
+
+// dbNamespaces returns the DB namespaces to collect.
+func (b *SupportBundle) dbNamespaces(ctx context.Context) ([]string, error) {
+	if len(b.cfg.Namespaces) > 0 {
+		return b.cfg.Namespaces, nil
+	}
+	list, err := b.kubeClient.GetDBNamespaces(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the DB namespaces: %w", err)
+	}
+	namespaces := make([]string, 0, len(list.Items))
+	for _, ns := range list.Items {
+		namespaces = append(namespaces, ns.GetName())
+	}
+	return namespaces, nil
+}
+
+func (b *SupportBundle) collectVersions(ctx context.Context) {
+	versions := map[string]string{
+		"everestctl":       version.Version,
+		"everestctlCommit": version.FullCommit,
+	}
+	if v, err := version.EverestVersionFromDeployment(ctx, b.kubeClient); err != nil {
+		b.fail("Everest version", err)
+	} else {
+		versions["everest"] = v.String()
+	}
+	if v, err := b.clientset.Discovery().ServerVersion(); err != nil {
+		b.fail("Kubernetes version", err)
+	} else {
+		versions["kubernetes"] = v.GitVersion
+		versions["platform"] = v.Platform
+	}
+	if err := b.addYAML("version.yaml", versions); err != nil {
+		b.fail("versions", err)
+	}
+}
+
+// collectNamespace collects the deployments and their logs, the Everest
+// custom resources, the OLM resources and the events of the namespace.
+//
+//nolint:cyclop,funlen
+func (b *SupportBundle) collectNamespace(ctx context.Context, ns string) {
+	opts := ctrlclient.InNamespace(ns)
+	b.collectDeployments(ctx, ns)
+
+	if list, err := b.kubeClient.ListDatabaseClusters(ctx, opts); err != nil {
+		b.fail(ns+" database clusters", err)
+	} else {
+		b.addObjects(ns, "databaseclusters", objects(list.Items))
+	}
+	if list, err := b.kubeClient.ListDatabaseEngines(ctx, opts); err != nil {
+		b.fail(ns+" database engines", err)
+	} else {
+		b.addObjects(ns, "databaseengines", objects(list.Items))
+	}
+	if list, err := b.kubeClient.ListDatabaseClusterBackups(ctx, opts); err != nil {
+		b.fail(ns+" database cluster backups", err)
+	} else {
+		b.addObjects(ns, "databaseclusterbackups", objects(list.Items))
+	}
+	if list, err := b.kubeClient.ListDatabaseClusterRestores(ctx, opts); err != nil {
+		b.fail(ns+" database cluster restores", err)
+	} else {
+		b.addObjects(ns, "databaseclusterrestores", objects(list.Items))
+	}
+
+	// The secrets of the storages and monitoring configs are collected with their data redacted.
+	secrets := map[string]bool{}
+	if list, err := b.kubeClient.ListBackupStorages(ctx, opts); err != nil {
+		b.fail(ns+" backup storages", err)
+	} else {
+		b.addObjects(ns, "backupstorages", objects(list.Items))
+		for _, bs := range list.Items {
+			secrets[bs.Spec.CredentialsSecretName] = true
+		}
+	}
+	if list, err := b.kubeClient.ListMonitoringConfigs(ctx, opts); err != nil {
+		b.fail(ns+" monitoring configs", err)
+	} else {
+		b.addObjects(ns, "monitoringconfigs", objects(list.Items))
+		for _, mc := range list.Items {
+			secrets[mc.Spec.CredentialsSecretName] = true
+		}
+	}
+	if list, err := b.kubeClient.ListSecrets(ctx, opts); err != nil {
+		b.fail(ns+" secrets", err)
+	} else {
+		var referenced []corev1.Secret
+		for _, s := range list.Items {
+			if secrets[s.GetName()] {
+				referenced = append(referenced, s)
+			}
+		}
+		b.addObjects(ns, "secrets", objects(referenced))
+	}
+
+	if list, err := b.kubeClient.ListSubscriptions(ctx, opts); err != nil {
+		b.fail(ns+" OLM subscriptions", err)
+	} else {
+		b.addObjects(ns, "subscriptions", objects(list.Items))
+	}
+	if list, err := b.kubeClient.ListClusterServiceVersion(ctx, opts); err != nil {
+		b.fail(ns+" OLM cluster service versions", err)
+	} else {
+		b.addObjects(ns, "clusterserviceversions", objects(list.Items))
+	}
+	if list, err := b.kubeClient.ListInstallPlans(ctx, opts); err != nil {
+		b.fail(ns+" OLM install plans", err)
+	} else {
+		b.addObjects(ns, "installplans", objects(list.Items))
+	}
+
+	if list, err := b.kubeClient.ListEvents(ctx, opts); err != nil {
+		b.fail(ns+" events", err)
+	} else if len(list.Items) > 0 {
+		if err := b.addYAML(path.Join("namespaces", ns, "events.yaml"), list.Items); err != nil {
+			b.fail(ns+" events", err)
+		}
+	}
+}
+
+// collectDeployments collects the deployments of the namespace and the logs of their pods.
+func (b *SupportBundle) collectDeployments(ctx context.Context, ns string) {
+	list, err := b.kubeClient.ListDeployments(ctx, ctrlclient.InNamespace(ns))
+	if err != nil {
+		b.fail(ns+" deployments", err)
+		return
+	}
+	b.addObjects(ns, "deployments", objects(list.Items))
+	for _, d := range list.Items {
+		if d.Spec.Selector == nil || len(d.Spec.Selector.MatchLabels) == 0 {
+			continue
+		}
+		pods, err := b.kubeClient.ListPods(ctx, ctrlclient.InNamespace(ns), ctrlclient.MatchingLabels(d.Spec.Selector.MatchLabels))
+		if err != nil {
+			b.fail(fmt.Sprintf("%s/%s pods", ns, d.GetName()), err)
+			continue
+		}
+		for _, pod := range pods.Items {
+			for _, cs := range pod.Status.ContainerStatuses {
+				b.collectLogs(ctx, &pod, cs.Name, false)
+				if cs.RestartCount > 0 {
+					b.collectLogs(ctx, &pod, cs.Name, true)
+				}
+			}
+		}
+	}
+}
+
+func (b *SupportBundle) collectLogs(ctx context.Context, pod *corev1.Pod, container string, previous bool) {
+	name := path.Join("logs", pod.GetNamespace(), pod.GetName(), container)
+	if previous {
+		name += ".previous"
+	}
+	name += ".log"
+	tail := b.cfg.LogTailLines
+	if tail <= 0 {
+		tail = DefaultLogTailLines
+	}
+	raw, err := b.clientset.CoreV1().Pods(pod.GetNamespace()).GetLogs(pod.GetName(), &corev1.PodLogOptions{
+		Container:  container,
+		Previous:   previous,
+		TailLines:  &tail,
+		Timestamps: true,
+	}).DoRaw(ctx)
+	if err != nil {
+		b.fail(name, err)
+		return
+	}
+	if err := b.add(name, raw); err != nil {
+		b.fail(name, err)
+	}
+}
+
+// collectRelease collects the chart, the status and the values of a Helm release.
+func (b *SupportBundle) collectRelease(name, ns string) {
+	rel, err := helm.GetDeployedRelease(name, ns, b.cfg.KubeconfigPath)
+	if err != nil {
+		b.fail(fmt.Sprintf("Helm release %s/%s", ns, name), err)
+		return
+	}
+	file := path.Join("helm", ns, name+".yaml")
+	summary := map[string]any{
+		"name":      rel.Name,
+		"namespace": rel.Namespace,
+		"revision":  rel.Version,
+		"values":    b.redactor.Values(file, rel.Config),
+	}
+	if rel.Chart != nil && rel.Chart.Metadata != nil {
+		summary["chart"] = rel.Chart.Metadata.Name
+		summary["chartVersion"] = rel.Chart.Metadata.Version
+		summary["appVersion"] = rel.Chart.Metadata.AppVersion
+	}
+	if rel.Info != nil {
+		summary["status"] = rel.Info.Status.String()
+		summary["lastDeployed"] = rel.Info.LastDeployed.UTC()
+	}
+	if err := b.addYAML(file, summary); err != nil {
+		b.fail(file, err)
+	}
+}
+
+// addObjects adds the objects to the archive with their sensitive values redacted.
+func (b *SupportBundle) addObjects(ns, resource string, objs []ctrlclient.Object) {
+	for _, obj := range objs {
+		file := path.Join("namespaces", ns, resource, obj.GetName()+".yaml")
+		u, err := b.redactor.Object(file, obj)
+		if err != nil {
+			b.fail(file, err)
+			continue
+		}
+		if err := b.addYAML(file, u); err != nil {
+			b.fail(file, err)
+		}
+	}
+}
+
+func (b *SupportBundle) addYAML(name string, v any) error {
+	raw, err := yaml.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return b.add(name, raw)
+}
+
+func (b *SupportBundle) add(name string, data []byte) error {
+	if err := b.archive.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0o644, //nolint:mnd
+		Size:    int64(len(data)),
+		ModTime: b.now,
+	}); err != nil {
+		return err
+	}
+	if _, err := b.archive.Write(data); err != nil {
+		return err
+	}
+	b.result.Files++
+	return nil
+}
+
+// fail records that a part of the support bundle could not be collected.
+func (b *SupportBundle) fail(what string, err error) {
+	b.l.Warnf("Could not collect %s: %v", what, err)
+	b.result.Errors = append(b.result.Errors, fmt.Sprintf("could not collect %s: %v", what, err))
+}
+
+// objects returns the items of a list as objects.
+func objects[T any, PT interface {
+	*T
+	ctrlclient.Object
+}](items []T,
+) []ctrlclient.Object {
+	result := make([]ctrlclient.Object, 0, len(items))
+	for i := range items {
+		result = append(result, PT(&items[i]))
+	}
+	return result
+}
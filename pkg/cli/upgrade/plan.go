@@ -0,0 +1,199 @@
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	"github.com/percona/everest/pkg/cli/crds"
+	"github.com/percona/everest/pkg/cli/helm"
+	"github.com/percona/everest/pkg/output"
+)
+
+// Plan describes the changes an upgrade makes to the cluster.
+type Plan struct {
+	// CurrentVersion is the installed Everest version.
+	CurrentVersion string `json:"currentVersion"`
+	// TargetVersion is the Everest version to upgrade to.
+	TargetVersion string `json:"targetVersion"`
+	// CRDChanges are the schema changes of the Custom Resource Definitions.
+	CRDChanges []crds.Change `json:"crdChanges"`
+	// ValuesDiff is the unified diff of the Helm values.
+	ValuesDiff string `json:"valuesDiff"`
+	// ManifestDiff is the unified diff of the rendered manifests.
+	ManifestDiff string `json:"manifestDiff"`
+	// DBNamespaces are the changes of the Helm charts of the DB namespaces.
+	DBNamespaces []DBNamespacePlan `json:"dbNamespaces"`
+}
+
+// DBNamespacePlan describes the changes an upgrade makes to a DB namespace.
+type DBNamespacePlan struct {
+	// Namespace is the name of the DB namespace.
+	Namespace string `json:"namespace"`
+	// ValuesDiff is the unified diff of the Helm values.
+	ValuesDiff string `json:"valuesDiff"`
+	// ManifestDiff is the unified diff of the rendered manifests.
+	ManifestDiff string `json:"manifestDiff"`
+}
+
+// plan computes the changes of the upgrade without applying them.
+func (u *Upgrade) plan(ctx context.Context, currentVersion string) (*Plan, error) {
+	p := &Plan{
+		CurrentVersion: currentVersion,
+		TargetVersion:  u.upgradeToVersion,
+	}
+
+	var err error
+	if u.helmReleaseExists {
+		if p.ValuesDiff, p.ManifestDiff, err = u.planRelease(ctx, u.helmInstaller, u.upgradeOptions()); err != nil {
+			return nil, err
+		}
+	} else {
+		// Installations below 1.4.0 have no Helm release, they are adopted by the upgrade,
+		// i.e. the chart is installed.
+		if p.ValuesDiff, err = helm.DiffValues(nil, u.helmInstaller.Values); err != nil {
+			return nil, err
+		}
+		var manifests helm.RenderedTemplate
+		if manifests, err = u.helmInstaller.RenderTemplates(ctx); err != nil {
+			return nil, fmt.Errorf("could not render Helm templates: %w", err)
+		}
+		if p.ManifestDiff, err = helm.DiffManifests(nil, manifests); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.CRDChanges, err = u.planCRDs(ctx); err != nil {
+		return nil, err
+	}
+	// Installations without a Helm release have their DB namespaces adopted
+	// rather than upgraded, their resources are left unchanged.
+	if u.helmReleaseExists {
+		if p.DBNamespaces, err = u.planDBNamespaces(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// planDBNamespaces computes the changes of the Helm charts of the DB namespaces.
+func (u *Upgrade) planDBNamespaces(ctx context.Context) ([]DBNamespacePlan, error) {
+	dbNamespaces, err := u.kubeConnector.GetDBNamespaces(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get database namespaces: %w", err)
+	}
+	plans := make([]DBNamespacePlan, 0, len(dbNamespaces.Items))
+	for _, ns := range dbNamespaces.Items {
+		namespace := ns.GetName()
+		installer, err := u.newDBNamespaceInstaller(namespace)
+		if err != nil {
+			return nil, err
+		}
+		p := DBNamespacePlan{Namespace: namespace}
+		if p.ValuesDiff, p.ManifestDiff, err = u.planRelease(ctx, installer, dbNamespaceUpgradeOptions); err != nil {
+			return nil, fmt.Errorf("could not plan the upgrade of DB namespace '%s': %w", namespace, err)
+		}
+		plans = append(plans, p)
+	}
+	return plans, nil
+}
+
+// planRelease computes the changes of the upgrade of the Helm release of the installer
+// with a dry-run upgrade against the cluster, so that the templates are rendered and
+// the values are merged as in the actual upgrade. It returns the values and manifest diffs.
+func (u *Upgrade) planRelease(ctx context.Context, installer *helm.Installer, opts helm.UpgradeOptions) (string, string, error) {
+	current, err := helm.GetDeployedRelease(installer.ReleaseName, installer.ReleaseNamespace, u.config.KubeconfigPath)
+	if err != nil {
+		return "", "", fmt.Errorf("could not get Helm release: %w", err)
+	}
+	target, err := installer.UpgradeDryRun(ctx, opts)
+	if err != nil {
+		return "", "", fmt.Errorf("could not render Helm templates: %w", err)
+	}
+
+	valuesDiff, err := helm.DiffValues(current.Config, target.Config)
+	if err != nil {
+		return "", "", err
+	}
+	// The hooks are not run if they are disabled.
+	templates := helm.ReleaseTemplateWithHooks
+	if opts.DisableHooks {
+		templates = helm.ReleaseTemplate
+	}
+	manifestDiff, err := helm.DiffManifests(templates(current), templates(target))
+	if err != nil {
+		return "", "", err
+	}
+	return valuesDiff, manifestDiff, nil
+}
+
+// planCRDs compares the CRDs installed in the cluster with the CRDs of the target version.
+func (u *Upgrade) planCRDs(ctx context.Context) ([]crds.Change, error) {
+	// The CRDs are rendered with a dry-run install, which includes the crds directory of the chart.
+	installer := u.helmInstaller
+	if u.hasCRDChart() {
+		var err error
+		if installer, err = u.newCRDInstaller(); err != nil {
+			return nil, err
+		}
+	}
+	manifests, err := installer.RenderTemplates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not render Helm templates: %w", err)
+	}
+	target, err := crds.Parse(manifests.Strings())
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := u.kubeConnector.ListCRDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not list CRDs: %w", err)
+	}
+	groups := crds.Groups(target)
+	current := []apiextv1.CustomResourceDefinition{}
+	for _, crd := range list.Items {
+		if slices.Contains(groups, crd.Spec.Group) {
+			current = append(current, crd)
+		}
+	}
+	return crds.Compare(current, target), nil
+}
+
+func printPlan(out io.Writer, p *Plan) {
+	_, _ = fmt.Fprintln(out, output.Info("Everest will be upgraded from version %s to %s", p.CurrentVersion, p.TargetVersion))
+
+	_, _ = fmt.Fprintln(out, "Custom Resource Definition changes:")
+	if len(p.CRDChanges) == 0 {
+		_, _ = fmt.Fprint(out, "  No changes\n\n")
+	}
+	for _, c := range p.CRDChanges {
+		if c.Breaking {
+			_, _ = fmt.Fprint(out, "  ", output.Warn("%s (not backward compatible)", c))
+			continue
+		}
+		_, _ = fmt.Fprintf(out, "  - %s\n", c)
+	}
+	if len(p.CRDChanges) > 0 {
+		_, _ = fmt.Fprintln(out)
+	}
+
+	printDiff(out, "Helm values changes:", p.ValuesDiff)
+	printDiff(out, "Manifest changes:", p.ManifestDiff)
+	for _, ns := range p.DBNamespaces {
+		printDiff(out, fmt.Sprintf("DB namespace %s Helm values changes:", ns.Namespace), ns.ValuesDiff)
+		printDiff(out, fmt.Sprintf("DB namespace %s manifest changes:", ns.Namespace), ns.ManifestDiff)
+	}
+}
+
+func printDiff(out io.Writer, title, diff string) {
+	_, _ = fmt.Fprintln(out, title)
+	if diff == "" {
+		_, _ = fmt.Fprint(out, "  No changes\n\n")
+		return
+	}
+	_, _ = fmt.Fprintln(out, diff)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/AlekSi/pointer"
 	goversion "github.com/hashicorp/go-version"
 	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	"helm.sh/helm/v3/pkg/cli/values"
@@ -18,14 +17,25 @@ import (
 	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
 	"github.com/percona/everest/pkg/cli/helm"
 	helmutils "github.com/percona/everest/pkg/cli/helm/utils"
+	"github.com/percona/everest/pkg/cli/serverrbac"
 	"github.com/percona/everest/pkg/cli/steps"
 	"github.com/percona/everest/pkg/cli/utils"
+	"github.com/percona/everest/pkg/cli/versionmetadata"
 	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/kubernetes"
 	. "github.com/percona/everest/pkg/utils/must" //nolint:revive,stylecheck
 	"github.com/percona/everest/pkg/version"
 )
 
+func (u *Upgrade) newStepImportVersionMetadata() steps.Step {
+	return steps.Step{
+		Desc: "Importing version metadata",
+		F: func(ctx context.Context) error {
+			return versionmetadata.Apply(ctx, u.kubeConnector, u.versionMetadata)
+		},
+	}
+}
+
 func (u *Upgrade) newStepUpgradeCRDs() steps.Step {
 	return steps.Step{
 		Desc: "Upgrading Custom Resource Definitions",
@@ -44,6 +54,15 @@ func (u *Upgrade) newStepUpgradeHelmChart() steps.Step {
 	}
 }
 
+func (u *Upgrade) newStepApplyServerRBAC() steps.Step {
+	return steps.Step{
+		Desc: "Granting Everest API server access to the leader election Lease",
+		F: func(ctx context.Context) error {
+			return serverrbac.Apply(ctx, u.kubeConnector)
+		},
+	}
+}
+
 func (u *Upgrade) newStepEnsureEverestOperator() steps.Step {
 	return steps.Step{
 		Desc: "Ensuring Everest operator deployment is ready",
@@ -79,7 +98,7 @@ func (u *Upgrade) newStepEnsureCatalogSource() steps.Step {
 				if err != nil {
 					return false, fmt.Errorf("cannot get CatalogSource: %w", err)
 				}
-				return pointer.Get(cs.Status.GRPCConnectionState).LastObservedState == "READY", nil
+				return kubernetes.IsCatalogSourceReady(cs), nil
 			})
 		},
 	}
@@ -96,11 +115,24 @@ func (u *Upgrade) waitForDeployment(ctx context.Context, name, namespace string)
 
 func (u *Upgrade) upgradeCustomResourceDefinitions(ctx context.Context) error {
 	// Use legacy method for versions below 1.9.0.
-	if goversion.Must(goversion.NewVersion(u.upgradeToVersion)).LessThan(goversion.Must(goversion.NewVersion("1.9.0"))) &&
-		!version.IsDev(u.upgradeToVersion) {
+	if !u.hasCRDChart() {
 		return u.legacyUpgradeCRDs(ctx)
 	}
-	installer := helm.Installer{
+	installer, err := u.newCRDInstaller()
+	if err != nil {
+		return err
+	}
+	return installer.Install(ctx)
+}
+
+// hasCRDChart returns true if the CRDs of the target version are installed with a separate chart.
+func (u *Upgrade) hasCRDChart() bool {
+	return !goversion.Must(goversion.NewVersion(u.upgradeToVersion)).LessThan(goversion.Must(goversion.NewVersion("1.9.0"))) ||
+		version.IsDev(u.upgradeToVersion)
+}
+
+func (u *Upgrade) newCRDInstaller() (*helm.Installer, error) {
+	installer := &helm.Installer{
 		ReleaseName:      helm.EverestCRDChartName,
 		ReleaseNamespace: common.SystemNamespace,
 	}
@@ -110,9 +142,9 @@ func (u *Upgrade) upgradeCustomResourceDefinitions(ctx context.Context) error {
 		Name:      helm.EverestCRDChartName,
 		Version:   u.upgradeToVersion,
 	}); err != nil {
-		return fmt.Errorf("could not initialize Helm installer: %w", err)
+		return nil, fmt.Errorf("could not initialize Helm installer: %w", err)
 	}
-	return installer.Install(ctx)
+	return installer, nil
 }
 
 // legacyUpgradeCRDs upgrades the CRDs for any version below 1.9.0.
@@ -142,11 +174,15 @@ func (u *Upgrade) upgradeHelmChart(ctx context.Context) error {
 		return fmt.Errorf("could not upgrade DB namespaces Helm charts: %w", err)
 	}
 	// Upgrade the main chart.
-	return u.helmInstaller.Upgrade(ctx, helm.UpgradeOptions{
+	return u.helmInstaller.Upgrade(ctx, u.upgradeOptions())
+}
+
+func (u *Upgrade) upgradeOptions() helm.UpgradeOptions {
+	return helm.UpgradeOptions{
 		ReuseValues:          u.config.ReuseValues,
 		ResetValues:          u.config.ResetValues,
 		ResetThenReuseValues: u.config.ResetThenReuseValues,
-	})
+	}
 }
 
 func (u *Upgrade) upgradeEverestDBNamespaceHelmCharts(ctx context.Context) error {
@@ -163,9 +199,27 @@ func (u *Upgrade) upgradeEverestDBNamespaceHelmCharts(ctx context.Context) error
 }
 
 func (u *Upgrade) upgradeEverestDBNamespaceHelmChart(ctx context.Context, namespace string) error {
-	installer := helm.Installer{
+	installer, err := u.newDBNamespaceInstaller(namespace)
+	if err != nil {
+		return err
+	}
+	return installer.Upgrade(ctx, dbNamespaceUpgradeOptions)
+}
+
+// dbNamespaceUpgradeOptions are the options to upgrade the Helm chart of a DB namespace.
+var dbNamespaceUpgradeOptions = helm.UpgradeOptions{
+	DisableHooks: true,
+	// This will preserve old values and use any new values from the chart.
+	ResetThenReuseValues: true,
+}
+
+// newDBNamespaceInstaller returns the installer of the target version of the
+// Helm chart of the DB namespace.
+func (u *Upgrade) newDBNamespaceInstaller(namespace string) (*helm.Installer, error) {
+	installer := &helm.Installer{
 		ReleaseName:      namespace,
 		ReleaseNamespace: namespace,
+		ImageRegistry:    u.config.ImageRegistry,
 	}
 	if err := installer.Init(u.config.KubeconfigPath, helm.ChartOptions{
 		URL:       u.config.RepoURL,
@@ -173,14 +227,9 @@ func (u *Upgrade) upgradeEverestDBNamespaceHelmChart(ctx context.Context, namesp
 		Name:      helm.EverestDBNamespaceChartName,
 		Version:   u.upgradeToVersion,
 	}); err != nil {
-		return fmt.Errorf("could not initialize Helm installer: %w", err)
+		return nil, fmt.Errorf("could not initialize Helm installer: %w", err)
 	}
-
-	return installer.Upgrade(ctx, helm.UpgradeOptions{
-		DisableHooks: true,
-		// This will preserve old values and use any new values from the chart.
-		ResetThenReuseValues: true,
-	})
+	return installer, nil
 }
 
 func (u *Upgrade) migrateLegacyInstallationToHelm(ctx context.Context) error {
@@ -219,6 +268,7 @@ func (u *Upgrade) helmAdoptDBNamespaces(ctx context.Context, namespace, version
 		ReleaseName:      namespace,
 		ReleaseNamespace: namespace,
 		Values:           values,
+		ImageRegistry:    u.config.ImageRegistry,
 	}
 
 	if err := installer.Init(u.config.KubeconfigPath, helm.ChartOptions{
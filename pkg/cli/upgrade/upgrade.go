@@ -30,10 +30,12 @@ import (
 	"go.uber.org/zap"
 	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/percona/everest/pkg/cli/bundle"
 	"github.com/percona/everest/pkg/cli/helm"
 	helmutils "github.com/percona/everest/pkg/cli/helm/utils"
 	"github.com/percona/everest/pkg/cli/steps"
 	cliutils "github.com/percona/everest/pkg/cli/utils"
+	"github.com/percona/everest/pkg/cli/versionmetadata"
 	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/kubernetes"
 	"github.com/percona/everest/pkg/output"
@@ -56,7 +58,8 @@ type (
 		InCluster bool
 		// VersionMetadataURL stores hostname to retrieve version metadata information from.
 		VersionMetadataURL string
-		// DryRun is set if the upgrade process should only perform pre-upgrade checks and not perform the actual upgrade.
+		// DryRun is set if the upgrade process should only perform pre-upgrade checks and show the changes
+		// of the upgrade, without performing the actual upgrade.
 		DryRun bool
 		// If set, we will print the pretty output.
 		Pretty bool
@@ -67,6 +70,10 @@ type (
 		// This version may be ahead by at most one minor version from the current version.
 		VersionToUpgrade string
 
+		// Bundle is the path of an offline bundle to upgrade Everest from.
+		// If set, the charts and the version metadata are read from the bundle.
+		Bundle string
+
 		helm.CLIOptions
 	}
 
@@ -83,6 +90,8 @@ type (
 		helmReleaseExists bool
 		upgradeToVersion  string
 		helmInstaller     *helm.Installer
+		// versionMetadata is the version metadata snapshot of the bundle, if any.
+		versionMetadata *versionservice.Snapshot
 	}
 
 	requirementsCheck struct {
@@ -128,12 +137,20 @@ func NewUpgrade(cfg *Config, l *zap.SugaredLogger) (*Upgrade, error) {
 	}
 
 	cli.kubeConnector = kubeClient
-	cli.versionService = versionservice.New(cfg.VersionMetadataURL)
+	cli.versionService = versionservice.New(cfg.VersionMetadataURL, versionservice.WithConfigMapGetter(kubeClient))
 	return cli, nil
 }
 
 // Run runs the operators installation process.
 func (u *Upgrade) Run(ctx context.Context) error {
+	if u.config.Bundle != "" {
+		b, err := u.useBundle()
+		if err != nil {
+			return err
+		}
+		defer b.Close() //nolint:errcheck
+	}
+
 	everestVersion, err := cliVersion.EverestVersionFromDeployment(ctx, u.kubeConnector)
 	if err != nil {
 		return errors.Join(err, errors.New("could not retrieve Everest version"))
@@ -153,10 +170,6 @@ func (u *Upgrade) Run(ctx context.Context) error {
 		return err
 	}
 
-	if u.config.DryRun {
-		return nil
-	}
-
 	if err := u.setKubernetesEnv(ctx); err != nil {
 		return fmt.Errorf("could not detect Kubernetes environment: %w", err)
 	}
@@ -164,13 +177,24 @@ func (u *Upgrade) Run(ctx context.Context) error {
 	if err := u.setupHelmInstaller(ctx); err != nil {
 		return fmt.Errorf("could not initialize Helm installer: %w", err)
 	}
-
-	u.l.Infof("Upgrading Everest to %s in namespace %s", u.upgradeToVersion, common.SystemNamespace)
+	helm.WarnImageRegistry(u.l, u.config.ImageRegistry)
 
 	// Helm based installation was added to the CLI in 1.4.0. Versions below that are not managed by helm.
 	// We use this flag to trigger an adoption of the existing installation to Helm chart.
 	u.helmReleaseExists = common.CheckConstraint(everestVersion, ">= 1.4.0")
 
+	if u.config.DryRun {
+		plan, err := u.plan(ctx, everestVersion.String())
+		if err != nil {
+			return fmt.Errorf("could not compute the upgrade plan: %w", err)
+		}
+		u.l.Infow("Upgrade plan", "plan", plan)
+		printPlan(out, plan)
+		return nil
+	}
+
+	u.l.Infof("Upgrading Everest to %s in namespace %s", u.upgradeToVersion, common.SystemNamespace)
+
 	upgradeSteps := u.newUpgradeSteps()
 
 	// Run steps.
@@ -183,6 +207,30 @@ func (u *Upgrade) Run(ctx context.Context) error {
 	return u.printPostUpgradeMessage(ctx, out)
 }
 
+// useBundle extracts the offline bundle and uses its charts and version metadata
+// instead of the remote ones.
+func (u *Upgrade) useBundle() (*bundle.Bundle, error) {
+	b, err := bundle.Open(u.config.Bundle)
+	if err != nil {
+		return nil, err
+	}
+	if err := b.CheckVersion(u.config.VersionToUpgrade); err != nil {
+		_ = b.Close()
+		return nil, err
+	}
+	if u.versionMetadata, err = b.VersionMetadata(); err != nil {
+		_ = b.Close()
+		return nil, err
+	}
+	u.l.Infof("Upgrading from bundle %s with Everest %s", u.config.Bundle, b.EverestVersion)
+	u.config.VersionToUpgrade = b.EverestVersion
+	// Everest serves the version metadata of the bundle imported into the cluster.
+	u.config.VersionMetadataURL = versionmetadata.ConfigMapURL()
+	u.config.RepoURL = b.ChartRepoURL()
+	u.versionService = versionservice.New(b.VersionMetadataURL())
+	return b, nil
+}
+
 func (u *Upgrade) setVersionInfo(ctx context.Context, everestVersion *goversion.Version) error {
 	upgradeEverestTo, err := u.canUpgrade(ctx, everestVersion)
 	if err != nil {
@@ -215,6 +263,7 @@ func (u *Upgrade) setupHelmInstaller(ctx context.Context) error {
 		ReleaseName:      common.SystemNamespace,
 		ReleaseNamespace: common.SystemNamespace,
 		Values:           values,
+		ImageRegistry:    u.config.ImageRegistry,
 	}
 	if err := installer.Init(u.config.KubeconfigPath, helm.ChartOptions{
 		URL:       u.config.RepoURL,
@@ -239,13 +288,18 @@ func (u *Upgrade) printPostUpgradeMessage(ctx context.Context, out io.Writer) er
 }
 
 func (u *Upgrade) newUpgradeSteps() []steps.Step {
-	return []steps.Step{
+	var upgradeSteps []steps.Step
+	if u.versionMetadata != nil {
+		upgradeSteps = append(upgradeSteps, u.newStepImportVersionMetadata())
+	}
+	return append(upgradeSteps,
 		u.newStepUpgradeCRDs(),
 		u.newStepUpgradeHelmChart(),
+		u.newStepApplyServerRBAC(),
 		u.newStepEnsureEverestAPI(),
 		u.newStepEnsureEverestOperator(),
 		u.newStepEnsureCatalogSource(),
-	}
+	)
 }
 
 // ensureManagedByLabelOnDBNamespaces ensures that all database namespaces have the managed-by label set.
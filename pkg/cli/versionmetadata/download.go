@@ -0,0 +1,89 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versionmetadata
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"go.uber.org/zap"
+
+	"github.com/percona/everest/pkg/output"
+	versionservice "github.com/percona/everest/pkg/version_service"
+)
+
+// DefaultSnapshotFile is the default path of the downloaded snapshot.
+const DefaultSnapshotFile = "everest-version-metadata.json"
+
+type (
+	// DownloadConfig is the configuration for downloading a snapshot.
+	DownloadConfig struct {
+		// Pretty if set print the output in pretty mode.
+		Pretty bool
+		// VersionMetadataURL is the URL of the version service to snapshot.
+		VersionMetadataURL string
+		// Output is the path of the snapshot file.
+		Output string
+	}
+
+	// Downloader is the CLI operation to download a snapshot of the version metadata.
+	Downloader struct {
+		cfg DownloadConfig
+		l   *zap.SugaredLogger
+	}
+)
+
+// NewDownloader returns a new CLI operation to download a snapshot of the version metadata.
+func NewDownloader(c DownloadConfig, l *zap.SugaredLogger) *Downloader {
+	d := &Downloader{
+		cfg: c,
+		l:   l.With("component", "version-metadata"),
+	}
+	if c.Pretty {
+		d.l = zap.NewNop().Sugar()
+	}
+	return d
+}
+
+// Run downloads the snapshot and writes it to the output file.
+func (d *Downloader) Run(ctx context.Context) (*Result, error) {
+	var out io.Writer = os.Stdout
+	if !d.cfg.Pretty {
+		out = io.Discard
+	}
+
+	d.l.Infof("Downloading version metadata from %s", d.cfg.VersionMetadataURL)
+	s, err := versionservice.TakeSnapshot(ctx, d.cfg.VersionMetadataURL)
+	if err != nil {
+		return nil, fmt.Errorf("could not download version metadata: %w", err)
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	if d.cfg.Output == "" {
+		d.cfg.Output = DefaultSnapshotFile
+	}
+	if err := os.WriteFile(d.cfg.Output, data, 0o644); err != nil { //nolint:gosec,mnd
+		return nil, fmt.Errorf("could not write version metadata snapshot: %w", err)
+	}
+
+	_, _ = fmt.Fprint(out, output.Success("Version metadata written to %s", d.cfg.Output))
+	return newResult(d.cfg.Output, s), nil
+}
@@ -0,0 +1,96 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versionmetadata
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"go.uber.org/zap"
+
+	"github.com/percona/everest/pkg/cli"
+	cliutils "github.com/percona/everest/pkg/cli/utils"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/output"
+	versionservice "github.com/percona/everest/pkg/version_service"
+)
+
+type (
+	// ImportConfig is the configuration for importing a snapshot.
+	ImportConfig struct {
+		// KubeconfigPath is a path to a kubeconfig
+		KubeconfigPath string
+		// Pretty if set print the output in pretty mode.
+		Pretty bool
+		// File is the path of the snapshot file.
+		File string
+	}
+
+	// Importer is the CLI operation to import a snapshot of the version metadata into the cluster.
+	Importer struct {
+		cfg        ImportConfig
+		kubeClient kubernetes.KubernetesConnector
+		l          *zap.SugaredLogger
+	}
+)
+
+// NewImporter returns a new CLI operation to import a snapshot of the version metadata.
+func NewImporter(c ImportConfig, l *zap.SugaredLogger) (*Importer, error) {
+	i := &Importer{
+		cfg: c,
+		l:   l.With("component", "version-metadata"),
+	}
+	if c.Pretty {
+		i.l = zap.NewNop().Sugar()
+	}
+
+	k, err := cliutils.NewKubeConnector(i.l, c.KubeconfigPath)
+	if err != nil {
+		return nil, err
+	}
+	i.kubeClient = k
+	return i, nil
+}
+
+// Run validates the snapshot and stores it in the version metadata ConfigMap.
+func (i *Importer) Run(ctx context.Context) (*Result, error) {
+	var out io.Writer = os.Stdout
+	if !i.cfg.Pretty {
+		out = io.Discard
+	}
+
+	data, err := os.ReadFile(i.cfg.File)
+	if err != nil {
+		return nil, fmt.Errorf("could not read version metadata snapshot: %w", err)
+	}
+	s, err := versionservice.ParseSnapshot(data)
+	if err != nil {
+		return nil, err
+	}
+
+	i.l.Infof("Importing version metadata into ConfigMap %s", ConfigMap)
+	if err := Apply(ctx, i.kubeClient, s); err != nil {
+		return nil, fmt.Errorf("could not import version metadata into ConfigMap %s: %w", ConfigMap, err)
+	}
+
+	res := newResult(ConfigMapURL(), s)
+	_, _ = fmt.Fprint(out, output.Success("Version metadata imported into ConfigMap %s", ConfigMap))
+	_, _ = fmt.Fprintf(out, "   Everest reads it when its versionMetadataURL Helm value is %s,\n", res.Location)
+	_, _ = fmt.Fprintf(out, "   e.g. when installed or upgraded with --%s %s\n", cli.FlagVersionMetadataURL, res.Location)
+	return res, nil
+}
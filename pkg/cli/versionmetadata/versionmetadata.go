@@ -0,0 +1,82 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package versionmetadata provides the functionality to manage the snapshots of
+// the version metadata used by Everest in disconnected environments.
+package versionmetadata
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+	versionservice "github.com/percona/everest/pkg/version_service"
+)
+
+// ConfigMap is the ConfigMap the version metadata snapshot is imported into.
+//
+//nolint:gochecknoglobals
+var ConfigMap = types.NamespacedName{
+	Namespace: common.SystemNamespace,
+	Name:      common.EverestVersionMetadataConfigMapName,
+}
+
+// ConfigMapURL returns the version metadata URL of the imported snapshot.
+func ConfigMapURL() string {
+	return versionservice.ConfigMapURL(ConfigMap)
+}
+
+// Result is the summary of a version metadata snapshot.
+type Result struct {
+	// Location is the file or the ConfigMap URL of the snapshot.
+	Location string `json:"location"`
+	// CreatedAt is the time the snapshot has been taken.
+	CreatedAt time.Time `json:"createdAt"`
+	// EverestVersions are the Everest versions of the snapshot.
+	EverestVersions []string `json:"everestVersions"`
+}
+
+func newResult(location string, s *versionservice.Snapshot) *Result {
+	res := &Result{Location: location, CreatedAt: s.CreatedAt}
+	for _, v := range s.Everest.GetVersions() {
+		res.EverestVersions = append(res.EverestVersions, v.GetVersion())
+	}
+	slices.Sort(res.EverestVersions)
+	return res
+}
+
+// Apply creates or updates the ConfigMap with the version metadata snapshot.
+func Apply(ctx context.Context, k kubernetes.KubernetesConnector, s *versionservice.Snapshot) error {
+	cm, err := s.ConfigMap(ConfigMap)
+	if err != nil {
+		return err
+	}
+	existing, err := k.GetConfigMap(ctx, ConfigMap)
+	if k8serrors.IsNotFound(err) {
+		_, err = k.CreateConfigMap(ctx, cm)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	existing.Data = cm.Data
+	_, err = k.UpdateConfigMap(ctx, existing)
+	return err
+}
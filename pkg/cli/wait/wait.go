@@ -0,0 +1,163 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package wait provides the functionality to wait for operations to complete.
+package wait
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/percona/everest/api"
+	cliutils "github.com/percona/everest/pkg/cli/utils"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/operations"
+	"github.com/percona/everest/pkg/output"
+)
+
+const (
+	// DefaultTimeout is the default time to wait for an operation.
+	DefaultTimeout = 30 * time.Minute
+	// DefaultInterval is the default interval between two checks of an operation.
+	DefaultInterval = 5 * time.Second
+)
+
+var (
+	// ErrOperationFailed is returned when the operation has failed.
+	ErrOperationFailed = errors.New("operation failed")
+	// ErrTimeout is returned when the operation is not done before the timeout.
+	ErrTimeout = errors.New("timed out waiting for the operation")
+)
+
+type (
+	// Config is the configuration for waiting for an operation.
+	Config struct {
+		// KubeconfigPath is a path to a kubeconfig
+		KubeconfigPath string
+		// Pretty if set print the output in pretty mode.
+		Pretty bool
+		// Namespace is the namespace of the operation.
+		Namespace string
+		// Operation is the name of the operation, as returned in the Operation-Id header.
+		Operation string
+		// Timeout is the maximum time to wait for the operation.
+		Timeout time.Duration
+		// Interval is the interval between two checks of the operation.
+		Interval time.Duration
+	}
+
+	// Waiter is the CLI operation to wait for an operation to complete.
+	Waiter struct {
+		cfg        Config
+		kubeClient kubernetes.KubernetesConnector
+		l          *zap.SugaredLogger
+	}
+)
+
+// NewWaiter returns a new CLI operation to wait for an operation.
+func NewWaiter(c Config, l *zap.SugaredLogger) (*Waiter, error) {
+	w := &Waiter{
+		cfg: c,
+		l:   l.With("component", "wait"),
+	}
+	if c.Pretty {
+		w.l = zap.NewNop().Sugar()
+	}
+
+	k, err := cliutils.NewKubeConnector(w.l, w.cfg.KubeconfigPath)
+	if err != nil {
+		return nil, err
+	}
+	w.kubeClient = k
+	return w, nil
+}
+
+// Run waits until the operation has succeeded, and prints its progress.
+// It returns an error if the operation has failed or is not done before the timeout.
+func (w *Waiter) Run(ctx context.Context) (*api.Operation, error) {
+	var out io.Writer = os.Stdout
+	if !w.cfg.Pretty {
+		out = io.Discard
+	}
+
+	timeout := w.cfg.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	interval := w.cfg.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	printed := 0
+	for {
+		op, err := w.observe(ctx)
+		if err != nil {
+			return nil, err
+		}
+		messages := pointer.Get(op.Messages)
+		for _, msg := range messages[printed:] {
+			w.l.Info(msg)
+			_, _ = fmt.Fprint(out, output.Info("%s", msg))
+		}
+		printed = len(messages)
+
+		switch op.State {
+		case api.OperationStateSucceeded:
+			_, _ = fmt.Fprint(out, output.Success("Operation %s has succeeded", op.Name))
+			return op, nil
+		case api.OperationStateFailed:
+			return op, fmt.Errorf("%w: %s", ErrOperationFailed, op.Name)
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return op, fmt.Errorf("%w %s after %s", ErrTimeout, op.Name, timeout)
+			}
+			return op, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// observe returns the operation with its current state.
+func (w *Waiter) observe(ctx context.Context) (*api.Operation, error) {
+	cm, err := w.kubeClient.GetConfigMap(ctx, types.NamespacedName{Namespace: w.cfg.Namespace, Name: w.cfg.Operation})
+	if err != nil {
+		return nil, fmt.Errorf("could not get operation %s: %w", w.cfg.Operation, err)
+	}
+	op, err := operations.FromConfigMap(cm)
+	if err != nil {
+		return nil, fmt.Errorf("could not get operation %s: %w", w.cfg.Operation, err)
+	}
+	if err := operations.Observe(ctx, w.kubeClient, op, time.Now()); err != nil {
+		return nil, fmt.Errorf("could not observe operation %s: %w", w.cfg.Operation, err)
+	}
+	return op, nil
+}
@@ -39,12 +39,17 @@ const (
 
 	// DefaultDBNamespaceName is the name of the default DB namespace during installation.
 	DefaultDBNamespaceName = "everest"
+	// DefaultVersionMetadataURL is the default URL of the version service.
+	DefaultVersionMetadataURL = "https://check.percona.com"
 	// SystemNamespace is the namespace where everest is installed.
 	SystemNamespace = "everest-system"
 	// MonitoringNamespace is the namespace where monitoring configs are created.
 	MonitoringNamespace = "everest-monitoring"
 	// PerconaEverestDeploymentName stores the name of everest API Server deployment.
 	PerconaEverestDeploymentName = "everest-server"
+	// PerconaEverestServerLeaseName is the name of the Lease that elects the
+	// replica of the everest API Server that runs the background jobs.
+	PerconaEverestServerLeaseName = "everest-server-background-jobs"
 	// PerconaEverestDeploymentNameLegacy stores the legacy name (> 1.4.0) of everest API Server deployment.
 	// This is kept only for backward compatibility.
 	PerconaEverestDeploymentNameLegacy = "percona-everest"
@@ -91,10 +96,55 @@ const (
 	EverestSettingsConfigMapName = "everest-settings"
 	// EverestRBACConfigMapName is the name of the Everest RBAC ConfigMap.
 	EverestRBACConfigMapName = "everest-rbac"
+	// EverestVersionMetadataConfigMapName is the name of the ConfigMap with the version metadata snapshot.
+	EverestVersionMetadataConfigMapName = "everest-version-metadata"
 	// KubernetesManagedByLabel is the label used to identify resources managed by Everest.
 	KubernetesManagedByLabel = "app.kubernetes.io/managed-by"
 	// DatabaseClusterNameLabel is the label used to identify resources by DB cluster name.
 	DatabaseClusterNameLabel = "clusterName"
+	// EverestNamespaceQuotaAnnotation is the annotation that holds the Everest quota of a DB namespace.
+	EverestNamespaceQuotaAnnotation = "everest.percona.com/quota"
+	// EverestNamespaceApprovalPolicyAnnotation is the annotation that holds the approval policy of a DB namespace.
+	EverestNamespaceApprovalPolicyAnnotation = "everest.percona.com/approval-policy"
+	// EverestChangeRequestLabel is the label used to identify ConfigMaps that store change requests.
+	EverestChangeRequestLabel = "everest.percona.com/change-request"
+	// EverestDatabaseClusterScheduleLabel is the label used to identify ConfigMaps that store database cluster schedules.
+	// Its value is the name of the database cluster the schedule belongs to.
+	EverestDatabaseClusterScheduleLabel = "everest.percona.com/database-cluster-schedule"
+	// EverestDatabaseClusterTimelineLabel is the label used to identify ConfigMaps that store the timeline of a database cluster.
+	// Its value is the name of the database cluster the timeline belongs to.
+	EverestDatabaseClusterTimelineLabel = "everest.percona.com/database-cluster-timeline"
+	// RestartAnnotation is the annotation that requests the restart of a database cluster.
+	RestartAnnotation = "everest.percona.com/restart"
+	// DeletionProtectionAnnotation is the annotation that prevents a database cluster from being deleted.
+	DeletionProtectionAnnotation = "everest.percona.com/deletion-protection"
+	// SoftDeletedAtAnnotation is the annotation that holds the time a database cluster was soft-deleted.
+	SoftDeletedAtAnnotation = "everest.percona.com/deleted-at"
+	// SoftDeletePurgeAtAnnotation is the annotation that holds the time a soft-deleted database cluster is purged.
+	SoftDeletePurgeAtAnnotation = "everest.percona.com/purge-at"
+	// SoftDeleteCleanupBackupStorageAnnotation is the annotation that holds the cleanupBackupStorage
+	// option of the deletion request of a soft-deleted database cluster.
+	SoftDeleteCleanupBackupStorageAnnotation = "everest.percona.com/purge-cleanup-backup-storage"
+	// SoftDeletePausedAnnotation is the annotation that holds whether a soft-deleted database
+	// cluster was paused before it was deleted.
+	SoftDeletePausedAnnotation = "everest.percona.com/paused-before-deletion"
+	// TTLAnnotation is the annotation that holds the time-to-live of a database cluster.
+	// It is converted to ExpiresAtAnnotation when the database cluster is created or updated.
+	TTLAnnotation = "everest.percona.com/ttl"
+	// ExpiresAtAnnotation is the annotation that holds the expiry time of a database cluster.
+	ExpiresAtAnnotation = "everest.percona.com/expires-at"
+	// ExpirySetByAnnotation is the annotation that holds the user who set the expiry time of a database cluster.
+	ExpirySetByAnnotation = "everest.percona.com/expiry-set-by"
+	// ExpiryWarnedAnnotation is the annotation that holds the expiry time a warning was emitted for.
+	ExpiryWarnedAnnotation = "everest.percona.com/expiry-warned"
+	// ExpiryHandledAnnotation is the annotation that holds the expiry time the expiry policy was applied for.
+	ExpiryHandledAnnotation = "everest.percona.com/expiry-handled"
+	// EverestNamespaceExpiryPolicyAnnotation is the annotation that holds the expiry policy of a DB namespace.
+	EverestNamespaceExpiryPolicyAnnotation = "everest.percona.com/expiry-policy"
+	// EverestIdempotencyKeyLabel is the label used to identify ConfigMaps that store idempotency records.
+	EverestIdempotencyKeyLabel = "everest.percona.com/idempotency-key"
+	// EverestOperationLabel is the label used to identify ConfigMaps that store operations.
+	EverestOperationLabel = "everest.percona.com/operation"
 	// ForegroundDeletionFinalizer is the finalizer used to delete resources in foreground.
 	ForegroundDeletionFinalizer = "foregroundDeletion"
 	// UserCtxKey is the key used to store the user in the context.
@@ -0,0 +1,239 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagnostics
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/AlekSi/pointer"
+	corev1 "k8s.io/api/core/v1"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/api"
+)
+
+const (
+	// VolumeUsageWarning is the ratio of used volume capacity reported as a warning.
+	VolumeUsageWarning = 0.8
+	// VolumeUsageCritical is the ratio of used volume capacity reported as critical.
+	VolumeUsageCritical = 0.9
+
+	// monitoringAgentContainer is the name of the PMM agent sidecar container.
+	monitoringAgentContainer = "pmm-client"
+)
+
+func finding(
+	severity api.DatabaseClusterDiagnosticFindingSeverity,
+	kind, name, remediation, format string,
+	args ...any,
+) api.DatabaseClusterDiagnosticFinding {
+	f := api.DatabaseClusterDiagnosticFinding{
+		Severity: severity,
+		Message:  fmt.Sprintf(format, args...),
+	}
+	if kind != "" {
+		f.Object = &api.DatabaseClusterDiagnosticObject{Kind: kind, Name: name}
+	}
+	if remediation != "" {
+		f.Remediation = pointer.ToString(remediation)
+	}
+	return f
+}
+
+// checkPodScheduling reports the pods that cannot be scheduled, and the pods
+// that cannot be created because of the pod security admission or policies.
+func checkPodScheduling(in *Input) ([]api.DatabaseClusterDiagnosticFinding, error) {
+	var findings []api.DatabaseClusterDiagnosticFinding
+	for _, pod := range in.Pods {
+		if pod.Status.Phase != corev1.PodPending {
+			continue
+		}
+		for _, c := range pod.Status.Conditions {
+			if c.Type != corev1.PodScheduled || c.Status != corev1.ConditionFalse || c.Reason != corev1.PodReasonUnschedulable {
+				continue
+			}
+			findings = append(findings, finding(api.DatabaseClusterDiagnosticFindingSeverityCritical,
+				"Pod", pod.GetName(),
+				"Check the resources requested by the database cluster, the node selectors, affinity and tolerations of its pod scheduling policy, and the capacity of the nodes.",
+				"Pod %s cannot be scheduled: %s", pod.GetName(), c.Message,
+			))
+		}
+	}
+	prefix := in.Cluster.GetName() + "-"
+	for _, ev := range in.Events {
+		if ev.Reason != "FailedCreate" ||
+			!strings.HasPrefix(ev.InvolvedObject.Name, prefix) ||
+			!strings.Contains(strings.ToLower(ev.Message), "podsecurity") {
+			continue
+		}
+		findings = append(findings, finding(api.DatabaseClusterDiagnosticFindingSeverityCritical,
+			ev.InvolvedObject.Kind, ev.InvolvedObject.Name,
+			"Allow the pods of the database cluster in the pod security admission level or the pod security policies of the namespace.",
+			"Pods of %s cannot be created: %s", ev.InvolvedObject.Name, ev.Message,
+		))
+	}
+	return findings, nil
+}
+
+// checkVolumes reports the PVCs that are not bound.
+func checkVolumes(in *Input) ([]api.DatabaseClusterDiagnosticFinding, error) {
+	var findings []api.DatabaseClusterDiagnosticFinding
+	for _, pvc := range in.PVCs {
+		if pvc.Status.Phase != corev1.ClaimBound {
+			findings = append(findings, finding(api.DatabaseClusterDiagnosticFindingSeverityCritical,
+				"PersistentVolumeClaim", pvc.GetName(),
+				"Check that the storage class of the database cluster exists and can provision volumes, see the events of the PVC.",
+				"PVC %s is not bound, its phase is %s", pvc.GetName(), cmp.Or(string(pvc.Status.Phase), "unknown"),
+			))
+		}
+	}
+	return findings, nil
+}
+
+// checkVolumeUsage reports the volumes that are nearly full.
+func checkVolumeUsage(in *Input) ([]api.DatabaseClusterDiagnosticFinding, error) {
+	if in.VolumeStats == nil {
+		return nil, fmt.Errorf("the volume usage is unknown: %w", in.VolumeStatsErr)
+	}
+	var findings []api.DatabaseClusterDiagnosticFinding
+	for _, pvc := range in.PVCs {
+		if pvc.Status.Phase != corev1.ClaimBound {
+			continue
+		}
+		stats, ok := in.VolumeStats[pvc.GetName()]
+		if !ok || stats.CapacityBytes == 0 {
+			continue
+		}
+		usage := float64(stats.UsedBytes) / float64(stats.CapacityBytes)
+		severity := api.DatabaseClusterDiagnosticFindingSeverityWarning
+		switch {
+		case usage >= VolumeUsageCritical:
+			severity = api.DatabaseClusterDiagnosticFindingSeverityCritical
+		case usage < VolumeUsageWarning:
+			continue
+		}
+		findings = append(findings, finding(severity,
+			"PersistentVolumeClaim", pvc.GetName(),
+			"Increase the storage size of the database cluster, or remove unneeded data.",
+			"Volume of PVC %s is %.0f%% full", pvc.GetName(), usage*100, //nolint:mnd
+		))
+	}
+	return findings, nil
+}
+
+// checkCrashLoops reports the containers that are crash looping or have been
+// killed because they ran out of memory.
+func checkCrashLoops(in *Input) ([]api.DatabaseClusterDiagnosticFinding, error) {
+	var findings []api.DatabaseClusterDiagnosticFinding
+	for _, pod := range in.Pods {
+		statuses := slices.Concat(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses)
+		for _, cs := range statuses {
+			object := pod.GetName() + "/" + cs.Name
+			if w := cs.State.Waiting; w != nil && w.Reason == "CrashLoopBackOff" {
+				findings = append(findings, finding(api.DatabaseClusterDiagnosticFindingSeverityCritical,
+					"Container", object,
+					"Check the logs of the previous instance of the container.",
+					"Container %s is crash looping after %d restarts", object, cs.RestartCount,
+				))
+			}
+			if t := cs.LastTerminationState.Terminated; t != nil && t.Reason == "OOMKilled" {
+				findings = append(findings, finding(api.DatabaseClusterDiagnosticFindingSeverityWarning,
+					"Container", object,
+					"Increase the memory of the database cluster, or reduce the memory used by the database engine.",
+					"Container %s has been killed because it ran out of memory", object,
+				))
+			}
+		}
+	}
+	return findings, nil
+}
+
+// checkLastBackup reports the latest backup of the database cluster if it has failed.
+func checkLastBackup(in *Input) ([]api.DatabaseClusterDiagnosticFinding, error) {
+	if len(in.Backups) == 0 {
+		return nil, nil
+	}
+	latest := slices.MaxFunc(in.Backups, func(a, b everestv1alpha1.DatabaseClusterBackup) int {
+		return a.GetCreationTimestamp().Compare(b.GetCreationTimestamp().Time)
+	})
+	switch strings.ToLower(string(latest.Status.State)) {
+	case "failed", "error":
+	default:
+		return nil, nil
+	}
+	return []api.DatabaseClusterDiagnosticFinding{finding(api.DatabaseClusterDiagnosticFindingSeverityWarning,
+		"DatabaseClusterBackup", latest.GetName(),
+		"Check the credentials and the connectivity of the backup storage, and the logs of the backup.",
+		"Latest backup %s has failed", latest.GetName(),
+	)}, nil
+}
+
+// checkPitrGaps reports the gaps in the point-in-time recovery logs.
+func checkPitrGaps(in *Input) ([]api.DatabaseClusterDiagnosticFinding, error) {
+	if in.Pitr == nil || !pointer.Get(in.Pitr.Gaps) {
+		return nil, nil
+	}
+	backup := pointer.Get(in.Pitr.LatestBackupName)
+	return []api.DatabaseClusterDiagnosticFinding{finding(api.DatabaseClusterDiagnosticFindingSeverityWarning,
+		"DatabaseClusterBackup", backup,
+		"Take a new full backup, the database cluster cannot be restored to a point in time after the gap.",
+		"Point-in-time recovery logs have gaps after backup %s", backup,
+	)}, nil
+}
+
+// checkMonitoring reports a database cluster with monitoring configured but
+// without a running monitoring agent.
+func checkMonitoring(in *Input) ([]api.DatabaseClusterDiagnosticFinding, error) {
+	config := pointer.Get(in.Cluster.Spec.Monitoring).MonitoringConfigName
+	if config == "" || len(in.Pods) == 0 {
+		return nil, nil
+	}
+	for _, pod := range in.Pods {
+		for _, cs := range pod.Status.ContainerStatuses {
+			if cs.Name == monitoringAgentContainer && cs.Ready {
+				return nil, nil
+			}
+		}
+	}
+	return []api.DatabaseClusterDiagnosticFinding{finding(api.DatabaseClusterDiagnosticFindingSeverityWarning,
+		"MonitoringConfig", config,
+		"Check that the monitoring instance is reachable and its credentials are valid, then restart the database cluster.",
+		"Monitoring is configured with %s but no monitoring agent is running", config,
+	)}, nil
+}
+
+// checkEngineVersion reports an engine version that is no longer supported by
+// the version service.
+func checkEngineVersion(in *Input) ([]api.DatabaseClusterDiagnosticFinding, error) {
+	version := in.Cluster.Spec.Engine.Version
+	if version == "" {
+		return nil, nil
+	}
+	if in.SupportedVersions == nil {
+		return nil, fmt.Errorf("the supported engine versions are unknown: %w", in.SupportedVersionsErr)
+	}
+	if slices.Contains(in.SupportedVersions, version) {
+		return nil, nil
+	}
+	return []api.DatabaseClusterDiagnosticFinding{finding(api.DatabaseClusterDiagnosticFindingSeverityWarning,
+		"DatabaseCluster", in.Cluster.GetName(),
+		"Upgrade the database engine to one of the supported versions: "+strings.Join(in.SupportedVersions, ", ")+".",
+		"Engine version %s is deprecated", version,
+	)}, nil
+}
@@ -0,0 +1,142 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package diagnostics provides the health checks of database clusters.
+//
+// The objects related to a database cluster are collected once into an Input,
+// then each check inspects the Input and returns its findings. The checks do
+// not access the cluster, so they can be run by the API server and the CLI.
+package diagnostics
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/AlekSi/pointer"
+	corev1 "k8s.io/api/core/v1"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+// ErrUnknownCheck is returned when a check does not exist.
+var ErrUnknownCheck = errors.New("unknown check")
+
+// Input holds the objects inspected by the checks of a database cluster.
+type Input struct {
+	Cluster *everestv1alpha1.DatabaseCluster
+	Pods    []corev1.Pod
+	PVCs    []corev1.PersistentVolumeClaim
+	// VolumeStats holds the usage of the volumes by PVC name.
+	// It is nil if the usage could not be retrieved, in which case
+	// VolumeStatsErr holds the reason.
+	VolumeStats    map[string]kubernetes.VolumeStats
+	VolumeStatsErr error
+	// Events holds the events of the namespace of the database cluster.
+	Events  []corev1.Event
+	Backups []everestv1alpha1.DatabaseClusterBackup
+	Pitr    *api.DatabaseClusterPitr
+	// SupportedVersions holds the engine versions supported by the version
+	// service for the installed operator. It is nil if they are unknown, in
+	// which case SupportedVersionsErr holds the reason.
+	SupportedVersions    []string
+	SupportedVersionsErr error
+}
+
+// Check is a health check of a database cluster.
+type Check struct {
+	Name string
+	// Run returns the problems found in the input. It returns an error if
+	// the check could not run, the check is then reported as skipped.
+	Run func(in *Input) ([]api.DatabaseClusterDiagnosticFinding, error)
+}
+
+// Checks returns all the checks, in the order they are run.
+func Checks() []Check {
+	return []Check{
+		{Name: "pod-scheduling", Run: checkPodScheduling},
+		{Name: "volumes", Run: checkVolumes},
+		{Name: "volume-usage", Run: checkVolumeUsage},
+		{Name: "crash-loops", Run: checkCrashLoops},
+		{Name: "last-backup", Run: checkLastBackup},
+		{Name: "pitr-gaps", Run: checkPitrGaps},
+		{Name: "monitoring", Run: checkMonitoring},
+		{Name: "engine-version", Run: checkEngineVersion},
+	}
+}
+
+// Select returns the checks with the given names, or all the checks if no
+// name is given.
+func Select(names []string) ([]Check, error) {
+	checks := Checks()
+	if len(names) == 0 {
+		return checks, nil
+	}
+	selected := make([]Check, 0, len(names))
+	for _, name := range names {
+		i := slices.IndexFunc(checks, func(c Check) bool { return c.Name == name })
+		if i < 0 {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownCheck, name)
+		}
+		selected = append(selected, checks[i])
+	}
+	return selected, nil
+}
+
+// Run runs the checks on the input. The findings are sorted by severity, the
+// most severe first. The database cluster is healthy if no finding is critical.
+func Run(in *Input, checks []Check) *api.DatabaseClusterDiagnostics {
+	result := &api.DatabaseClusterDiagnostics{
+		Checks:   make([]api.DatabaseClusterDiagnosticCheck, 0, len(checks)),
+		Findings: []api.DatabaseClusterDiagnosticFinding{},
+		Healthy:  true,
+	}
+	for _, c := range checks {
+		findings, err := c.Run(in)
+		check := api.DatabaseClusterDiagnosticCheck{Name: c.Name, Status: api.Ok}
+		switch {
+		case err != nil:
+			check.Status = api.Skipped
+			check.Message = pointer.To(err.Error())
+		case len(findings) > 0:
+			check.Status = api.Problems
+		}
+		result.Checks = append(result.Checks, check)
+		for _, f := range findings {
+			f.Check = c.Name
+			result.Findings = append(result.Findings, f)
+		}
+	}
+	slices.SortStableFunc(result.Findings, func(a, b api.DatabaseClusterDiagnosticFinding) int {
+		return cmp.Compare(severityRank(a.Severity), severityRank(b.Severity))
+	})
+	result.Healthy = !slices.ContainsFunc(result.Findings, func(f api.DatabaseClusterDiagnosticFinding) bool {
+		return f.Severity == api.DatabaseClusterDiagnosticFindingSeverityCritical
+	})
+	return result
+}
+
+func severityRank(s api.DatabaseClusterDiagnosticFindingSeverity) int {
+	switch s {
+	case api.DatabaseClusterDiagnosticFindingSeverityCritical:
+		return 0
+	case api.DatabaseClusterDiagnosticFindingSeverityWarning:
+		return 1
+	}
+	return 2 //nolint:mnd
+}
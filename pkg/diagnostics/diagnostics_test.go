@@ -0,0 +1,187 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagnostics
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+func healthyInput() *Input {
+	db := &everestv1alpha1.DatabaseCluster{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "ns"}}
+	db.Spec.Engine.Version = "8.0.36"
+	db.Spec.Monitoring = &everestv1alpha1.Monitoring{MonitoringConfigName: "pmm"}
+	return &Input{
+		Cluster: db,
+		Pods: []corev1.Pod{{
+			ObjectMeta: metav1.ObjectMeta{Name: "db-pxc-0"},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodRunning,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{Name: "pxc", Ready: true},
+					{Name: "pmm-client", Ready: true},
+				},
+			},
+		}},
+		PVCs: []corev1.PersistentVolumeClaim{{
+			ObjectMeta: metav1.ObjectMeta{Name: "datadir-db-pxc-0"},
+			Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimBound},
+		}},
+		VolumeStats:       map[string]kubernetes.VolumeStats{"datadir-db-pxc-0": {UsedBytes: 10, CapacityBytes: 100}},
+		SupportedVersions: []string{"8.0.36", "8.0.39"},
+	}
+}
+
+func TestRunHealthy(t *testing.T) {
+	t.Parallel()
+
+	result := Run(healthyInput(), Checks())
+	assert.True(t, result.Healthy)
+	assert.Empty(t, result.Findings)
+	require.Len(t, result.Checks, len(Checks()))
+	for _, c := range result.Checks {
+		assert.Equal(t, api.Ok, c.Status, c.Name)
+	}
+}
+
+func TestRunProblems(t *testing.T) {
+	t.Parallel()
+
+	in := healthyInput()
+	in.Pods = append(in.Pods, corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "db-pxc-1"},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			Conditions: []corev1.PodCondition{{
+				Type:    corev1.PodScheduled,
+				Status:  corev1.ConditionFalse,
+				Reason:  corev1.PodReasonUnschedulable,
+				Message: "0/3 nodes are available: 3 Insufficient memory.",
+			}},
+		},
+	})
+	in.Pods[0].Status.ContainerStatuses = []corev1.ContainerStatus{{
+		Name:                 "pxc",
+		RestartCount:         7,
+		State:                corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+		LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled"}},
+	}}
+	in.Events = []corev1.Event{
+		{
+			InvolvedObject: corev1.ObjectReference{Kind: "StatefulSet", Name: "db-haproxy"},
+			Reason:         "FailedCreate",
+			Message:        `pods "db-haproxy-0" is forbidden: violates PodSecurity "restricted:latest"`,
+		},
+		{
+			InvolvedObject: corev1.ObjectReference{Kind: "StatefulSet", Name: "other-haproxy"},
+			Reason:         "FailedCreate",
+			Message:        `pods "other-haproxy-0" is forbidden: violates PodSecurity "restricted:latest"`,
+		},
+	}
+	in.PVCs = append(in.PVCs, corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "datadir-db-pxc-1"},
+		Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimPending},
+	})
+	in.VolumeStats["datadir-db-pxc-0"] = kubernetes.VolumeStats{UsedBytes: 85, CapacityBytes: 100}
+	now := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	in.Backups = []everestv1alpha1.DatabaseClusterBackup{
+		{ObjectMeta: metav1.ObjectMeta{Name: "old", CreationTimestamp: metav1.NewTime(now)}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "new", CreationTimestamp: metav1.NewTime(now.Add(time.Hour))}},
+	}
+	in.Backups[0].Status.State = everestv1alpha1.BackupSucceeded
+	in.Backups[1].Status.State = "Failed"
+	in.Pitr = &api.DatabaseClusterPitr{Gaps: pointer.ToBool(true), LatestBackupName: pointer.ToString("old")}
+	in.SupportedVersions = []string{"8.0.39"}
+
+	result := Run(in, Checks())
+	assert.False(t, result.Healthy)
+	type found struct {
+		check    string
+		severity api.DatabaseClusterDiagnosticFindingSeverity
+		object   string
+	}
+	findings := make([]found, 0, len(result.Findings))
+	for _, f := range result.Findings {
+		findings = append(findings, found{f.Check, f.Severity, pointer.Get(f.Object).Name})
+	}
+	assert.Equal(t, []found{
+		{"pod-scheduling", api.DatabaseClusterDiagnosticFindingSeverityCritical, "db-pxc-1"},
+		{"pod-scheduling", api.DatabaseClusterDiagnosticFindingSeverityCritical, "db-haproxy"},
+		{"volumes", api.DatabaseClusterDiagnosticFindingSeverityCritical, "datadir-db-pxc-1"},
+		{"crash-loops", api.DatabaseClusterDiagnosticFindingSeverityCritical, "db-pxc-0/pxc"},
+		{"volume-usage", api.DatabaseClusterDiagnosticFindingSeverityWarning, "datadir-db-pxc-0"},
+		{"crash-loops", api.DatabaseClusterDiagnosticFindingSeverityWarning, "db-pxc-0/pxc"},
+		{"last-backup", api.DatabaseClusterDiagnosticFindingSeverityWarning, "new"},
+		{"pitr-gaps", api.DatabaseClusterDiagnosticFindingSeverityWarning, "old"},
+		{"monitoring", api.DatabaseClusterDiagnosticFindingSeverityWarning, "pmm"},
+		{"engine-version", api.DatabaseClusterDiagnosticFindingSeverityWarning, "db"},
+	}, findings)
+	for _, f := range result.Findings {
+		assert.NotEmpty(t, pointer.Get(f.Remediation), f.Check)
+	}
+	assert.Equal(t, "Volume of PVC datadir-db-pxc-0 is 85% full", result.Findings[4].Message)
+	for _, c := range result.Checks {
+		assert.Equal(t, api.Problems, c.Status, c.Name)
+	}
+}
+
+func TestRunSkipped(t *testing.T) {
+	t.Parallel()
+
+	in := healthyInput()
+	in.SupportedVersions = nil
+	in.SupportedVersionsErr = errors.New("version service is unreachable")
+	in.VolumeStats = nil
+	in.VolumeStatsErr = errors.New(`nodes "node-1" is forbidden: cannot get resource "nodes/proxy"`)
+	checks, err := Select([]string{"engine-version", "volume-usage"})
+	require.NoError(t, err)
+
+	result := Run(in, checks)
+	assert.True(t, result.Healthy)
+	require.Len(t, result.Checks, 2)
+	assert.Equal(t, api.Skipped, result.Checks[0].Status)
+	assert.Contains(t, pointer.Get(result.Checks[0].Message), "version service is unreachable")
+	assert.Equal(t, api.Skipped, result.Checks[1].Status)
+	assert.Contains(t, pointer.Get(result.Checks[1].Message), "nodes/proxy")
+}
+
+func TestSelect(t *testing.T) {
+	t.Parallel()
+
+	checks, err := Select(nil)
+	require.NoError(t, err)
+	assert.Len(t, checks, len(Checks()))
+
+	checks, err = Select([]string{"volumes", "monitoring"})
+	require.NoError(t, err)
+	require.Len(t, checks, 2)
+	assert.Equal(t, "volumes", checks[0].Name)
+	assert.Equal(t, "monitoring", checks[1].Name)
+
+	_, err = Select([]string{"volumes", "unknown"})
+	require.ErrorIs(t, err, ErrUnknownCheck)
+}
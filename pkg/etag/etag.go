@@ -0,0 +1,98 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package etag provides optimistic concurrency control of Everest resources.
+//
+// The entity tag of a resource is derived from its resourceVersion. Clients
+// send it back in the If-Match header of a modifying request, which then
+// fails with ErrPreconditionFailed if the resource has been modified in the
+// meantime.
+package etag
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Any is the If-Match value that matches any current version of a resource.
+const Any = "*"
+
+// ErrPreconditionFailed is returned when the resource does not match the If-Match header.
+var ErrPreconditionFailed = errors.New("the resource has been modified since it was read")
+
+type ifMatchKey struct{}
+
+// FromResourceVersion returns the entity tag of the given resource version.
+func FromResourceVersion(resourceVersion string) string {
+	if resourceVersion == "" {
+		return ""
+	}
+	return `"` + resourceVersion + `"`
+}
+
+// Of returns the entity tag of the object.
+func Of(obj metav1.Object) string {
+	return FromResourceVersion(obj.GetResourceVersion())
+}
+
+// WithIfMatch returns a copy of the context that carries the value of the If-Match header.
+func WithIfMatch(ctx context.Context, header string) context.Context {
+	var tags []string
+	for _, t := range strings.Split(header, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			tags = append(tags, t)
+		}
+	}
+	if len(tags) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, ifMatchKey{}, tags)
+}
+
+// IfMatch returns the entity tags of the If-Match header carried by the context.
+func IfMatch(ctx context.Context) ([]string, bool) {
+	tags, ok := ctx.Value(ifMatchKey{}).([]string)
+	return tags, ok
+}
+
+// Check returns ErrPreconditionFailed if the context carries an If-Match header
+// that does not match the current version of the object.
+func Check(ctx context.Context, obj metav1.Object) error {
+	tags, ok := IfMatch(ctx)
+	if !ok {
+		return nil
+	}
+	current := Of(obj)
+	for _, t := range tags {
+		// Weak comparison is not allowed by If-Match, so weak tags never match.
+		if t == Any || (current != "" && t == current) {
+			return nil
+		}
+	}
+	return ErrPreconditionFailed
+}
+
+// FromConflict returns ErrPreconditionFailed instead of a conflict
+// if the request was made with an If-Match header.
+func FromConflict(ctx context.Context, err error) error {
+	if _, ok := IfMatch(ctx); ok && k8serrors.IsConflict(err) {
+		return errors.Join(ErrPreconditionFailed, err)
+	}
+	return err
+}
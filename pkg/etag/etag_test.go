@@ -0,0 +1,64 @@
+package etag
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	obj := &metav1.ObjectMeta{ResourceVersion: "42"}
+	testCases := []struct {
+		name    string
+		header  string
+		wantErr bool
+	}{
+		{name: "no header"},
+		{name: "empty header", header: " "},
+		{name: "matching", header: `"42"`},
+		{name: "any", header: "*"},
+		{name: "one of several", header: `"41", "42"`},
+		{name: "not matching", header: `"41"`, wantErr: true},
+		{name: "unquoted", header: "42", wantErr: true},
+		{name: "weak", header: `W/"42"`, wantErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			if tc.header != "" {
+				ctx = WithIfMatch(ctx, tc.header)
+			}
+			err := Check(ctx, obj)
+			if tc.wantErr {
+				require.ErrorIs(t, err, ErrPreconditionFailed)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestOf(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, `"42"`, Of(&metav1.ObjectMeta{ResourceVersion: "42"}))
+	assert.Empty(t, Of(&metav1.ObjectMeta{}))
+}
+
+func TestFromConflict(t *testing.T) {
+	t.Parallel()
+
+	conflict := k8serrors.NewConflict(schema.GroupResource{Resource: "databaseclusters"}, "db", errors.New("modified"))
+	require.NotErrorIs(t, FromConflict(context.Background(), conflict), ErrPreconditionFailed)
+	require.ErrorIs(t, FromConflict(WithIfMatch(context.Background(), `"1"`), conflict), ErrPreconditionFailed)
+	require.NoError(t, FromConflict(WithIfMatch(context.Background(), `"1"`), nil))
+}
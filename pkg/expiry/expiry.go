@@ -0,0 +1,237 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package expiry provides time-to-live for ephemeral database clusters.
+//
+// The expiry time of a database cluster is kept in its annotations. Once it
+// is reached, the cluster is paused or deleted according to the expiry
+// policy of its namespace.
+package expiry
+
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/pkg/common"
+)
+
+// Action is the action performed on expired database clusters.
+type Action string
+
+const (
+	// ActionPause pauses expired database clusters.
+	ActionPause Action = "pause"
+	// ActionDelete deletes expired database clusters.
+	ActionDelete Action = "delete"
+
+	// DefaultWarnBefore is how long before the expiry a warning is emitted
+	// if the policy does not specify it.
+	DefaultWarnBefore = time.Hour
+)
+
+// Policy defines what happens to expired database clusters in a DB namespace.
+type Policy struct {
+	// Action is the action performed on expired database clusters. Defaults to ActionPause.
+	Action Action `json:"action,omitempty" yaml:"action,omitempty"`
+	// WarnBefore is how long before the expiry a warning is emitted. Defaults to DefaultWarnBefore.
+	WarnBefore string `json:"warnBefore,omitempty" yaml:"warnBefore,omitempty"`
+}
+
+// DefaultPolicy is used for namespaces without an expiry policy.
+var DefaultPolicy = Policy{Action: ActionPause} //nolint:gochecknoglobals
+
+// ParsePolicy parses the raw YAML (or JSON) representation of an expiry policy.
+func ParsePolicy(raw []byte) (*Policy, error) {
+	p := &Policy{}
+	if err := yaml.Unmarshal(raw, p); err != nil {
+		return nil, fmt.Errorf("failed to parse expiry policy: %w", err)
+	}
+	if p.Action == "" {
+		p.Action = ActionPause
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// PolicyFromNamespace returns the expiry policy configured for the given namespace.
+// Returns DefaultPolicy if the namespace has no expiry policy.
+func PolicyFromNamespace(ns *corev1.Namespace) (*Policy, error) {
+	raw, ok := ns.GetAnnotations()[common.EverestNamespaceExpiryPolicyAnnotation]
+	if !ok || raw == "" {
+		p := DefaultPolicy
+		return &p, nil
+	}
+	return ParsePolicy([]byte(raw))
+}
+
+// Validate validates the expiry policy.
+func (p *Policy) Validate() error {
+	if p.Action != ActionPause && p.Action != ActionDelete {
+		return fmt.Errorf("unsupported action '%s'", p.Action)
+	}
+	if p.WarnBefore != "" {
+		if d, err := time.ParseDuration(p.WarnBefore); err != nil || d < 0 {
+			return fmt.Errorf("invalid warnBefore '%s'", p.WarnBefore)
+		}
+	}
+	return nil
+}
+
+// WarnBeforeDuration returns how long before the expiry a warning is emitted.
+func (p *Policy) WarnBeforeDuration() time.Duration {
+	d, err := time.ParseDuration(p.WarnBefore)
+	if p.WarnBefore == "" || err != nil {
+		return DefaultWarnBefore
+	}
+	return d
+}
+
+// Validate validates the expiry annotations of the database cluster.
+func Validate(db *everestv1alpha1.DatabaseCluster) error {
+	annotations := db.GetAnnotations()
+	if ttl, ok := annotations[common.TTLAnnotation]; ok {
+		d, err := time.ParseDuration(ttl)
+		if err != nil {
+			return fmt.Errorf("invalid %s annotation: %w", common.TTLAnnotation, err)
+		}
+		if d <= 0 {
+			return fmt.Errorf("%s annotation must be positive", common.TTLAnnotation)
+		}
+	}
+	if expiresAt, ok := annotations[common.ExpiresAtAnnotation]; ok {
+		if _, err := time.Parse(time.RFC3339, expiresAt); err != nil {
+			return fmt.Errorf("invalid %s annotation: %w", common.ExpiresAtAnnotation, err)
+		}
+	}
+	return nil
+}
+
+// ApplyTTL converts the TTL annotation of the database cluster to an expiry time.
+func ApplyTTL(db *everestv1alpha1.DatabaseCluster, now time.Time) error {
+	annotations := db.GetAnnotations()
+	ttl, ok := annotations[common.TTLAnnotation]
+	if !ok {
+		return nil
+	}
+	d, err := time.ParseDuration(ttl)
+	if err != nil {
+		return fmt.Errorf("invalid %s annotation: %w", common.TTLAnnotation, err)
+	}
+	annotations[common.ExpiresAtAnnotation] = now.UTC().Add(d).Format(time.RFC3339)
+	delete(annotations, common.TTLAnnotation)
+	db.SetAnnotations(annotations)
+	return nil
+}
+
+// IsChanged returns true if the database cluster sets an expiry time that differs
+// from the one of the current database cluster. The current database cluster is nil
+// when the database cluster is created.
+func IsChanged(db, current *everestv1alpha1.DatabaseCluster) bool {
+	annotations := db.GetAnnotations()
+	if _, ok := annotations[common.TTLAnnotation]; ok {
+		return true
+	}
+	expiresAt, ok := annotations[common.ExpiresAtAnnotation]
+	if !ok {
+		return false
+	}
+	return current == nil || expiresAt != current.GetAnnotations()[common.ExpiresAtAnnotation]
+}
+
+// RecordSetBy records the user who set the expiry time of the database cluster.
+// The record is kept from the current database cluster as long as the expiry time
+// does not change, so it cannot be set by the user directly. An empty user is not recorded.
+func RecordSetBy(db, current *everestv1alpha1.DatabaseCluster, user string) {
+	annotations := db.GetAnnotations()
+	expiresAt, ok := annotations[common.ExpiresAtAnnotation]
+	switch {
+	case !ok:
+		delete(annotations, common.ExpirySetByAnnotation)
+	case current == nil || expiresAt != current.GetAnnotations()[common.ExpiresAtAnnotation]:
+		if user == "" {
+			delete(annotations, common.ExpirySetByAnnotation)
+			break
+		}
+		annotations[common.ExpirySetByAnnotation] = user
+	default:
+		setBy, recorded := current.GetAnnotations()[common.ExpirySetByAnnotation]
+		if !recorded {
+			delete(annotations, common.ExpirySetByAnnotation)
+			break
+		}
+		annotations[common.ExpirySetByAnnotation] = setBy
+	}
+	db.SetAnnotations(annotations)
+}
+
+// SetBy returns the user who set the expiry time of the database cluster.
+// Returns false if the user is not recorded.
+func SetBy(db *everestv1alpha1.DatabaseCluster) (string, bool) {
+	user, ok := db.GetAnnotations()[common.ExpirySetByAnnotation]
+	return user, ok && user != ""
+}
+
+// ExpiresAt returns the expiry time of the database cluster.
+// Returns false if the database cluster does not expire or the expiry time is invalid.
+func ExpiresAt(db *everestv1alpha1.DatabaseCluster) (time.Time, bool) {
+	raw, ok := db.GetAnnotations()[common.ExpiresAtAnnotation]
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// IsWarned returns true if a warning has been emitted for the current expiry time.
+func IsWarned(db *everestv1alpha1.DatabaseCluster) bool {
+	return markedForCurrentExpiry(db, common.ExpiryWarnedAnnotation)
+}
+
+// MarkWarned records that a warning has been emitted for the current expiry time.
+func MarkWarned(db *everestv1alpha1.DatabaseCluster) {
+	markForCurrentExpiry(db, common.ExpiryWarnedAnnotation)
+}
+
+// IsHandled returns true if the expiry policy has been applied for the current expiry time.
+// Extending the expiry time of a database cluster resets it.
+func IsHandled(db *everestv1alpha1.DatabaseCluster) bool {
+	return markedForCurrentExpiry(db, common.ExpiryHandledAnnotation)
+}
+
+// MarkHandled records that the expiry policy has been applied for the current expiry time.
+func MarkHandled(db *everestv1alpha1.DatabaseCluster) {
+	markForCurrentExpiry(db, common.ExpiryHandledAnnotation)
+}
+
+func markedForCurrentExpiry(db *everestv1alpha1.DatabaseCluster, key string) bool {
+	annotations := db.GetAnnotations()
+	marker, ok := annotations[key]
+	return ok && marker == annotations[common.ExpiresAtAnnotation]
+}
+
+func markForCurrentExpiry(db *everestv1alpha1.DatabaseCluster, key string) {
+	annotations := db.GetAnnotations()
+	annotations[key] = annotations[common.ExpiresAtAnnotation]
+	db.SetAnnotations(annotations)
+}
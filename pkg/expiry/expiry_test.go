@@ -0,0 +1,163 @@
+package expiry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/pkg/common"
+)
+
+func TestApplyTTL(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	db := &everestv1alpha1.DatabaseCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{common.TTLAnnotation: "72h"},
+		},
+	}
+	require.NoError(t, Validate(db))
+	require.NoError(t, ApplyTTL(db, now))
+	assert.NotContains(t, db.GetAnnotations(), common.TTLAnnotation)
+	expiresAt, ok := ExpiresAt(db)
+	require.True(t, ok)
+	assert.Equal(t, now.Add(72*time.Hour), expiresAt)
+
+	// Clusters without a TTL are not changed.
+	db = &everestv1alpha1.DatabaseCluster{}
+	require.NoError(t, ApplyTTL(db, now))
+	_, ok = ExpiresAt(db)
+	assert.False(t, ok)
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		annotations map[string]string
+		wantErr     bool
+	}{
+		{name: "no expiry"},
+		{name: "valid ttl", annotations: map[string]string{common.TTLAnnotation: "1h30m"}},
+		{name: "invalid ttl", annotations: map[string]string{common.TTLAnnotation: "tomorrow"}, wantErr: true},
+		{name: "negative ttl", annotations: map[string]string{common.TTLAnnotation: "-1h"}, wantErr: true},
+		{name: "valid expires-at", annotations: map[string]string{common.ExpiresAtAnnotation: "2025-01-01T00:00:00Z"}},
+		{name: "invalid expires-at", annotations: map[string]string{common.ExpiresAtAnnotation: "2025-01-01"}, wantErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			db := &everestv1alpha1.DatabaseCluster{
+				ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations},
+			}
+			err := Validate(db)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMarkers(t *testing.T) {
+	t.Parallel()
+
+	db := &everestv1alpha1.DatabaseCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{common.ExpiresAtAnnotation: "2025-01-01T00:00:00Z"},
+		},
+	}
+	assert.False(t, IsWarned(db))
+	assert.False(t, IsHandled(db))
+	MarkWarned(db)
+	MarkHandled(db)
+	assert.True(t, IsWarned(db))
+	assert.True(t, IsHandled(db))
+
+	// Extending the expiry time resets the markers.
+	db.GetAnnotations()[common.ExpiresAtAnnotation] = "2025-02-01T00:00:00Z"
+	assert.False(t, IsWarned(db))
+	assert.False(t, IsHandled(db))
+}
+
+func TestRecordSetBy(t *testing.T) {
+	t.Parallel()
+
+	newDB := func(annotations map[string]string) *everestv1alpha1.DatabaseCluster {
+		return &everestv1alpha1.DatabaseCluster{
+			ObjectMeta: metav1.ObjectMeta{Annotations: annotations},
+		}
+	}
+
+	// Setting the expiry records the user.
+	db := newDB(map[string]string{common.TTLAnnotation: "1h"})
+	assert.True(t, IsChanged(db, nil))
+	require.NoError(t, ApplyTTL(db, time.Now()))
+	RecordSetBy(db, nil, "alice")
+	setBy, ok := SetBy(db)
+	require.True(t, ok)
+	assert.Equal(t, "alice", setBy)
+
+	// The record cannot be changed without changing the expiry.
+	current := db.DeepCopy()
+	db.GetAnnotations()[common.ExpirySetByAnnotation] = "mallory"
+	assert.False(t, IsChanged(db, current))
+	RecordSetBy(db, current, "bob")
+	setBy, _ = SetBy(db)
+	assert.Equal(t, "alice", setBy)
+
+	// Changing the expiry records the new user.
+	db.GetAnnotations()[common.ExpiresAtAnnotation] = "2030-01-01T00:00:00Z"
+	assert.True(t, IsChanged(db, current))
+	RecordSetBy(db, current, "bob")
+	setBy, _ = SetBy(db)
+	assert.Equal(t, "bob", setBy)
+
+	// An unknown user is not recorded.
+	db = newDB(map[string]string{
+		common.ExpiresAtAnnotation:   "2030-01-01T00:00:00Z",
+		common.ExpirySetByAnnotation: "mallory",
+	})
+	RecordSetBy(db, nil, "")
+	_, ok = SetBy(db)
+	assert.False(t, ok)
+
+	// Removing the expiry removes the record.
+	db = newDB(map[string]string{common.ExpirySetByAnnotation: "mallory"})
+	assert.False(t, IsChanged(db, current))
+	RecordSetBy(db, current, "bob")
+	_, ok = SetBy(db)
+	assert.False(t, ok)
+}
+
+func TestPolicyFromNamespace(t *testing.T) {
+	t.Parallel()
+
+	ns := &corev1.Namespace{}
+	p, err := PolicyFromNamespace(ns)
+	require.NoError(t, err)
+	assert.Equal(t, ActionPause, p.Action)
+	assert.Equal(t, DefaultWarnBefore, p.WarnBeforeDuration())
+
+	ns.SetAnnotations(map[string]string{
+		common.EverestNamespaceExpiryPolicyAnnotation: "action: delete\nwarnBefore: 24h",
+	})
+	p, err = PolicyFromNamespace(ns)
+	require.NoError(t, err)
+	assert.Equal(t, ActionDelete, p.Action)
+	assert.Equal(t, 24*time.Hour, p.WarnBeforeDuration())
+
+	ns.SetAnnotations(map[string]string{
+		common.EverestNamespaceExpiryPolicyAnnotation: "action: archive",
+	})
+	_, err = PolicyFromNamespace(ns)
+	assert.Error(t, err)
+}
@@ -0,0 +1,167 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package idempotency provides idempotent create requests.
+//
+// A client that retries a create request with the same Idempotency-Key header
+// gets the response of the original request instead of creating a second
+// object. The requests are recorded in ConfigMaps in the namespace of the
+// created object, so that the records are shared by all API server replicas.
+// Records expire after TTL, or after PendingTTL if the request was never completed.
+package idempotency
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/percona/everest/pkg/common"
+)
+
+const (
+	// TTL is the time an idempotency key is remembered for after the request completed.
+	TTL = 24 * time.Hour
+	// PendingTTL is the time after which a request that never completed,
+	// e.g. because the API server was restarted, may be retried.
+	PendingTTL = 10 * time.Minute
+	// MaxKeyLength is the maximum length of an idempotency key.
+	MaxKeyLength = 255
+
+	configMapNamePrefix = "everest-idempotency-"
+	configMapDataKey    = "record"
+)
+
+var (
+	// ErrInvalidKey is returned when the idempotency key is empty or too long.
+	ErrInvalidKey = fmt.Errorf("idempotency key must be between 1 and %d characters long", MaxKeyLength)
+	// ErrKeyReused is returned when an idempotency key is reused with a different request.
+	ErrKeyReused = errors.New("idempotency key has already been used with a different request")
+	// ErrInProgress is returned when the request with the same idempotency key is still being processed.
+	ErrInProgress = errors.New("a request with the same idempotency key is being processed")
+	// ErrNotRecord is returned when a ConfigMap does not hold an idempotency record.
+	ErrNotRecord = errors.New("not an idempotency record")
+)
+
+// Record is the record of a request made with an idempotency key.
+type Record struct {
+	// Fingerprint identifies the request the key was used with.
+	Fingerprint string `json:"fingerprint"`
+	// ExpiresAt is the time the key is forgotten.
+	ExpiresAt time.Time `json:"expiresAt"`
+	// Completed is true once the response of the request has been recorded.
+	Completed bool `json:"completed"`
+	// StatusCode is the status code of the response.
+	StatusCode int `json:"statusCode,omitempty"`
+	// ContentType is the content type of the response.
+	ContentType string `json:"contentType,omitempty"`
+	// Body is the body of the response.
+	Body []byte `json:"body,omitempty"`
+}
+
+// ValidateKey validates the idempotency key.
+func ValidateKey(key string) error {
+	if key == "" || len(key) > MaxKeyLength {
+		return ErrInvalidKey
+	}
+	return nil
+}
+
+// Fingerprint returns the fingerprint of a request.
+func Fingerprint(method, path string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(method + " " + path + "\n"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// NewRecord returns the record of a request that is being processed.
+func NewRecord(fingerprint string, now time.Time) *Record {
+	return &Record{
+		Fingerprint: fingerprint,
+		ExpiresAt:   now.Add(PendingTTL).UTC(),
+	}
+}
+
+// Complete records the response of the request.
+func (r *Record) Complete(statusCode int, contentType string, body []byte, now time.Time) {
+	r.Completed = true
+	r.ExpiresAt = now.Add(TTL).UTC()
+	r.StatusCode = statusCode
+	r.ContentType = contentType
+	r.Body = body
+}
+
+// IsExpired returns true if the key has expired.
+func (r *Record) IsExpired(now time.Time) bool {
+	return !now.Before(r.ExpiresAt)
+}
+
+// Check returns an error if the record cannot be replayed for a request with the given fingerprint.
+func (r *Record) Check(fingerprint string) error {
+	if r.Fingerprint != fingerprint {
+		return ErrKeyReused
+	}
+	if !r.Completed {
+		return ErrInProgress
+	}
+	return nil
+}
+
+// ConfigMapName returns the name of the ConfigMap that stores the record of
+// the idempotency key of the given user. Keys are scoped to users, so that
+// users cannot see each other's responses.
+func ConfigMapName(user, key string) string {
+	sum := sha256.Sum256([]byte(user + "\x00" + key))
+	return configMapNamePrefix + hex.EncodeToString(sum[:])
+}
+
+// ToConfigMap returns the ConfigMap that stores the given record.
+func ToConfigMap(namespace, name string, r *Record) (*corev1.ConfigMap, error) {
+	raw, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels: map[string]string{
+				common.EverestIdempotencyKeyLabel: "true",
+			},
+		},
+		Data: map[string]string{
+			configMapDataKey: string(raw),
+		},
+	}, nil
+}
+
+// FromConfigMap returns the record stored in the given ConfigMap.
+func FromConfigMap(cm *corev1.ConfigMap) (*Record, error) {
+	raw, ok := cm.Data[configMapDataKey]
+	if _, labeled := cm.GetLabels()[common.EverestIdempotencyKeyLabel]; !ok || !labeled {
+		return nil, ErrNotRecord
+	}
+	r := &Record{}
+	if err := json.Unmarshal([]byte(raw), r); err != nil {
+		return nil, fmt.Errorf("failed to parse idempotency record: %w", err)
+	}
+	return r, nil
+}
@@ -0,0 +1,68 @@
+package idempotency
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestValidateKey(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ValidateKey("2f1c8b4e-retry"))
+	require.ErrorIs(t, ValidateKey(""), ErrInvalidKey)
+	require.ErrorIs(t, ValidateKey(strings.Repeat("k", MaxKeyLength+1)), ErrInvalidKey)
+}
+
+func TestConfigMapName(t *testing.T) {
+	t.Parallel()
+
+	name := ConfigMapName("alice", "key")
+	assert.Equal(t, name, ConfigMapName("alice", "key"))
+	assert.NotEqual(t, name, ConfigMapName("bob", "key"))
+	assert.NotEqual(t, name, ConfigMapName("alice", "other"))
+	assert.LessOrEqual(t, len(name), 253)
+}
+
+func TestRecord(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	fp := Fingerprint(http.MethodPost, "/v1/namespaces/ns/database-clusters", []byte(`{"a":1}`))
+	r := NewRecord(fp, now)
+
+	require.ErrorIs(t, r.Check(fp), ErrInProgress)
+	require.ErrorIs(t, r.Check(Fingerprint(http.MethodPost, "/v1/namespaces/ns/database-clusters", []byte(`{"a":2}`))), ErrKeyReused)
+
+	assert.True(t, r.IsExpired(now.Add(PendingTTL)))
+
+	r.Complete(http.StatusCreated, "application/json", []byte(`{"ok":true}`), now)
+	require.NoError(t, r.Check(fp))
+
+	assert.False(t, r.IsExpired(now.Add(TTL-time.Second)))
+	assert.True(t, r.IsExpired(now.Add(TTL)))
+}
+
+func TestConfigMap(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	r := NewRecord("fp", now)
+	r.Complete(http.StatusCreated, "application/json", []byte(`{"ok":true}`), now)
+
+	cm, err := ToConfigMap("ns", ConfigMapName("alice", "key"), r)
+	require.NoError(t, err)
+	assert.Equal(t, "ns", cm.GetNamespace())
+
+	got, err := FromConfigMap(cm)
+	require.NoError(t, err)
+	assert.Equal(t, r, got)
+
+	_, err = FromConfigMap(&corev1.ConfigMap{Data: cm.Data})
+	require.ErrorIs(t, err, ErrNotRecord)
+}
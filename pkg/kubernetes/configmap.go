@@ -7,6 +7,15 @@ import (
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// ListConfigMaps returns list of configmaps that match the criteria.
+func (k *Kubernetes) ListConfigMaps(ctx context.Context, opts ...ctrlclient.ListOption) (*corev1.ConfigMapList, error) {
+	result := &corev1.ConfigMapList{}
+	if err := k.k8sClient.List(ctx, result, opts...); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 // GetConfigMap returns k8s configmap that matches the criteria.
 func (k *Kubernetes) GetConfigMap(ctx context.Context, key ctrlclient.ObjectKey) (*corev1.ConfigMap, error) {
 	result := &corev1.ConfigMap{}
@@ -31,3 +40,8 @@ func (k *Kubernetes) UpdateConfigMap(ctx context.Context, config *corev1.ConfigM
 	}
 	return config, nil
 }
+
+// DeleteConfigMap deletes k8s configmap.
+func (k *Kubernetes) DeleteConfigMap(ctx context.Context, obj *corev1.ConfigMap) error {
+	return k.k8sClient.Delete(ctx, obj)
+}
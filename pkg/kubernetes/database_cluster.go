@@ -25,6 +25,7 @@ import (
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/pkg/logger"
 )
 
 // ListDatabaseClusters returns list of managed database clusters that match the criteria.
@@ -84,7 +85,7 @@ func (k *Kubernetes) DeleteDatabaseClusters(ctx context.Context, opts ...ctrlcli
 	// No need to fetch full objects, we only need the fact there are objects that match the criteria(opts).
 	delList, err := k.listDatabaseClustersMeta(ctx, opts...)
 	if err != nil {
-		k.l.Errorf("Could not list DB clusters: %s", err)
+		logger.FromContext(ctx, k.l).Errorf("Could not list DB clusters: %s", err)
 		return err
 	}
 
@@ -103,13 +104,13 @@ func (k *Kubernetes) DeleteDatabaseClusters(ctx context.Context, opts ...ctrlcli
 		opt.ApplyToList(&delOpts.ListOptions)
 	}
 
-	k.l.Debugf("Setting DB clusters removal timeout to %s", timeout)
+	logger.FromContext(ctx, k.l).Debugf("Setting DB clusters removal timeout to %s", timeout)
 	return wait.PollUntilContextTimeout(ctx, pollInterval, timeout, true, func(ctx context.Context) (bool, error) {
 		// Skip fetching the list of objects to delete again, we already have it (see code above).
 		if delList == nil {
 			var err error
 			if delList, err = k.listDatabaseClustersMeta(ctx, opts...); err != nil {
-				k.l.Errorf("Could not list DB clusters in polling: %s", err)
+				logger.FromContext(ctx, k.l).Errorf("Could not list DB clusters in polling: %s", err)
 				return false, err
 			}
 
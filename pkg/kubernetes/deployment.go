@@ -102,29 +102,34 @@ func (k *Kubernetes) WaitForRollout(ctx context.Context, key ctrlclient.ObjectKe
 			}
 			return false, err
 		}
-
-		if deployment.Generation <= deployment.Status.ObservedGeneration {
-			cond := deploymentutil.GetDeploymentCondition(deployment.Status, appsv1.DeploymentProgressing)
-			if cond != nil && cond.Reason == deploymentutil.TimedOutReason {
-				return false, errors.New("progress deadline exceeded")
-			}
-			if deployment.Spec.Replicas != nil && deployment.Status.UpdatedReplicas < *deployment.Spec.Replicas {
-				// Waiting for Deployment to roll out. Not all replicas have been updated
-				return false, nil
-			}
-			if deployment.Status.Replicas > deployment.Status.UpdatedReplicas {
-				// Waiting for Deployment to roll out. Old replicas are pending termination
-				return false, nil
-			}
-			if deployment.Status.AvailableReplicas < deployment.Status.UpdatedReplicas {
-				// Waiting for Deployment to roll out. Not all updated replicas are available
-				return false, nil
-			}
-			// Deployment successfully rolled out
-			return true, nil
-		}
-		// Waiting for Deployment to roll out: waiting for deployment spec update to be observed
-		return false, nil
+		done, _, err := DeploymentRolloutStatus(deployment)
+		return done, err
 	}
 	return wait.PollUntilContextCancel(ctx, time.Second, true, rolloutComplete)
 }
+
+// DeploymentRolloutStatus returns true if the deployment has been successfully rolled out.
+// Otherwise, it returns a message describing what the rollout is waiting for.
+// An error is returned if the rollout cannot progress anymore.
+func DeploymentRolloutStatus(deployment *appsv1.Deployment) (bool, string, error) {
+	if deployment.Generation > deployment.Status.ObservedGeneration {
+		return false, "waiting for deployment spec update to be observed", nil
+	}
+	cond := deploymentutil.GetDeploymentCondition(deployment.Status, appsv1.DeploymentProgressing)
+	if cond != nil && cond.Reason == deploymentutil.TimedOutReason {
+		return false, "", errors.New("progress deadline exceeded")
+	}
+	if deployment.Spec.Replicas != nil && deployment.Status.UpdatedReplicas < *deployment.Spec.Replicas {
+		return false, fmt.Sprintf("%d out of %d new replicas have been updated",
+			deployment.Status.UpdatedReplicas, *deployment.Spec.Replicas), nil
+	}
+	if deployment.Status.Replicas > deployment.Status.UpdatedReplicas {
+		return false, fmt.Sprintf("%d old replicas are pending termination",
+			deployment.Status.Replicas-deployment.Status.UpdatedReplicas), nil
+	}
+	if deployment.Status.AvailableReplicas < deployment.Status.UpdatedReplicas {
+		return false, fmt.Sprintf("%d of %d updated replicas are available",
+			deployment.Status.AvailableReplicas, deployment.Status.UpdatedReplicas), nil
+	}
+	return true, "", nil
+}
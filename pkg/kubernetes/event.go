@@ -0,0 +1,25 @@
+package kubernetes
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ListEvents returns list of events that match the criteria.
+func (k *Kubernetes) ListEvents(ctx context.Context, opts ...ctrlclient.ListOption) (*corev1.EventList, error) {
+	result := &corev1.EventList{}
+	if err := k.k8sClient.List(ctx, result, opts...); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CreateEvent creates an event.
+func (k *Kubernetes) CreateEvent(ctx context.Context, event *corev1.Event) (*corev1.Event, error) {
+	if err := k.k8sClient.Create(ctx, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
@@ -15,4 +15,4 @@
 
 package kubernetes
 
-//go:generate go tool ifacemaker -f accounts.go -f backup_storage.go -f olm_catalog_source.go -f configmap.go -f olm_cluster_service_version.go -f crd.go -f database_cluster.go -f database_cluster_backup.go -f database_cluster_restore.go -f database_engine.go -f data_importer.go -f data_import_job.go -f deployment.go -f olm_install_plan.go -f kubernetes.go -f monitoring_config.go -f namespace.go -f object.go -f operator.go -f jwt.go -f oidc.go -f pod_scheduling_policy.go -f load_balancer_config.go -f resources.go -f secret.go -f service.go -f storage.go -f split_horizon_dns_config.go -f olm_subscription.go -f pod.go -s Kubernetes -i KubernetesConnector -p kubernetes -o kubernetes_interface.gen.go
+//go:generate go tool ifacemaker -f accounts.go -f backup_storage.go -f olm_catalog_source.go -f configmap.go -f olm_cluster_service_version.go -f crd.go -f database_cluster.go -f database_cluster_backup.go -f database_cluster_restore.go -f database_engine.go -f data_importer.go -f data_import_job.go -f deployment.go -f event.go -f olm_install_plan.go -f kubernetes.go -f monitoring_config.go -f namespace.go -f object.go -f operator.go -f jwt.go -f oidc.go -f pod_scheduling_policy.go -f load_balancer_config.go -f resources.go -f secret.go -f service.go -f storage.go -f split_horizon_dns_config.go -f olm_subscription.go -f pod.go -s Kubernetes -i KubernetesConnector -p kubernetes -o kubernetes_interface.gen.go
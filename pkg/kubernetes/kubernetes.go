@@ -28,6 +28,7 @@ import (
 	"time"
 
 	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
 	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -119,13 +120,13 @@ func New(kubeconfigPath string, l *zap.SugaredLogger) (KubernetesConnector, erro
 
 	restConfig.QPS = defaultQPSLimit
 	restConfig.Burst = defaultBurstLimit
-	k8client, err := ctrlclient.New(restConfig, getKubernetesClientOptions(nil))
+	k8client, err := ctrlclient.NewWithWatch(restConfig, getKubernetesClientOptions(nil))
 	if err != nil {
 		return nil, err
 	}
 
 	return &Kubernetes{
-		k8sClient:  k8client,
+		k8sClient:  newTracingClient(k8client, otel.GetTracerProvider()),
 		l:          l.With("component", "kubernetes"),
 		restConfig: restConfig,
 		kubeconfig: path,
@@ -154,13 +155,13 @@ func NewInCluster(l *zap.SugaredLogger, ctx context.Context, cacheOptions *cache
 		}()
 	}
 
-	k8sclient, err := ctrlclient.New(restConfig, getKubernetesClientOptions(k8sCache))
+	k8sclient, err := ctrlclient.NewWithWatch(restConfig, getKubernetesClientOptions(k8sCache))
 	if err != nil {
 		return nil, err
 	}
 
 	return &Kubernetes{
-		k8sClient:  k8sclient,
+		k8sClient:  newTracingClient(k8sclient, otel.GetTracerProvider()),
 		l:          l.With("component", "kubernetes"),
 		restConfig: restConfig,
 	}, nil
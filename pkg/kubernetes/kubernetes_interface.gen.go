@@ -13,6 +13,7 @@ import (
 	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -47,12 +48,16 @@ type KubernetesConnector interface {
 	GetCatalogSource(ctx context.Context, key ctrlclient.ObjectKey) (*olmv1alpha1.CatalogSource, error)
 	// DeleteCatalogSource deletes catalog source that matches the criteria.
 	DeleteCatalogSource(ctx context.Context, obj *olmv1alpha1.CatalogSource) error
+	// ListConfigMaps returns list of configmaps that match the criteria.
+	ListConfigMaps(ctx context.Context, opts ...ctrlclient.ListOption) (*corev1.ConfigMapList, error)
 	// GetConfigMap returns k8s configmap that matches the criteria.
 	GetConfigMap(ctx context.Context, key ctrlclient.ObjectKey) (*corev1.ConfigMap, error)
 	// CreateConfigMap creates k8s configmap.
 	CreateConfigMap(ctx context.Context, config *corev1.ConfigMap) (*corev1.ConfigMap, error)
 	// UpdateConfigMap updates k8s configmap.
 	UpdateConfigMap(ctx context.Context, config *corev1.ConfigMap) (*corev1.ConfigMap, error)
+	// DeleteConfigMap deletes k8s configmap.
+	DeleteConfigMap(ctx context.Context, obj *corev1.ConfigMap) error
 	// GetClusterServiceVersion retrieves a ClusterServiceVersion that matches the criteria.
 	GetClusterServiceVersion(ctx context.Context, key ctrlclient.ObjectKey) (*olmv1alpha1.ClusterServiceVersion, error)
 	// ListClusterServiceVersion list all CSVs that match the criteria.
@@ -133,8 +138,15 @@ type KubernetesConnector interface {
 	RestartDeployment(ctx context.Context, key ctrlclient.ObjectKey) error
 	// WaitForRollout waits for rollout of deployment that matches the criteria.
 	WaitForRollout(ctx context.Context, key ctrlclient.ObjectKey) error
+	// ListEvents returns list of events that match the criteria.
+	ListEvents(ctx context.Context, opts ...ctrlclient.ListOption) (*corev1.EventList, error)
+	// CreateEvent creates an event.
+	CreateEvent(ctx context.Context, event *corev1.Event) (*corev1.Event, error)
 	// GetInstallPlan retrieves an OLM install plan that matches the criteria.
 	GetInstallPlan(ctx context.Context, key ctrlclient.ObjectKey) (*olmv1alpha1.InstallPlan, error)
+	// ListInstallPlans returns a list of OLM install plans that match the criteria.
+	// This method returns a list of full objects (meta and spec).
+	ListInstallPlans(ctx context.Context, opts ...ctrlclient.ListOption) (*olmv1alpha1.InstallPlanList, error)
 	// UpdateInstallPlan updates OLM install plan.
 	UpdateInstallPlan(ctx context.Context, installPlan *olmv1alpha1.InstallPlan) (*olmv1alpha1.InstallPlan, error)
 	// ApproveInstallPlan approves OLM install plan that matches the criteria.
@@ -240,9 +252,13 @@ type KubernetesConnector interface {
 	DeleteSecret(ctx context.Context, obj *corev1.Secret) error
 	// GetService returns service that matches the criteria.
 	GetService(ctx context.Context, key ctrlclient.ObjectKey) (*corev1.Service, error)
+	// ListServices returns list of services that match the criteria.
+	ListServices(ctx context.Context, opts ...ctrlclient.ListOption) (*corev1.ServiceList, error)
 	// ListPersistentVolumes returns list of persistent volumes that match the criteria.
 	// This method returns a list of full objects (meta and spec).
 	ListPersistentVolumes(ctx context.Context, opts ...ctrlclient.ListOption) (*corev1.PersistentVolumeList, error)
+	// ListPersistentVolumeClaims returns list of persistent volume claims that match the criteria.
+	ListPersistentVolumeClaims(ctx context.Context, opts ...ctrlclient.ListOption) (*corev1.PersistentVolumeClaimList, error)
 	// ListStorageClasses returns list of storage classes that match the criteria.
 	// This method returns a list of full objects (meta and spec).
 	ListStorageClasses(ctx context.Context, opts ...ctrlclient.ListOption) (*storagev1.StorageClassList, error)
@@ -266,4 +282,8 @@ type KubernetesConnector interface {
 	// ListPods returns list of pods that match the criteria.
 	// This method returns a list of full objects (meta and spec).
 	ListPods(ctx context.Context, opts ...ctrlclient.ListOption) (*corev1.PodList, error)
+	// GetVolumeStats returns the usage of the volumes of the persistent volume claims
+	// mounted by the pods running on the node, as reported by the kubelet of the node.
+	// It requires the permission to get the nodes/proxy resource.
+	GetVolumeStats(ctx context.Context, nodeName string) (map[types.NamespacedName]VolumeStats, error)
 }
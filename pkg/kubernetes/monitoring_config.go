@@ -24,6 +24,7 @@ import (
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/pkg/logger"
 )
 
 // ListMonitoringConfigs returns list of managed monitoring configs that match the criteria.
@@ -93,7 +94,7 @@ func (k *Kubernetes) DeleteMonitoringConfigs(ctx context.Context, opts ...ctrlcl
 	// No need to fetch full objects, we only need the fact there are objects that match the criteria(opts).
 	delList, err := k.listMonitoringConfigsMeta(ctx, opts...)
 	if err != nil {
-		k.l.Errorf("Could not list monitoring configs: %s", err)
+		logger.FromContext(ctx, k.l).Errorf("Could not list monitoring configs: %s", err)
 		return err
 	}
 
@@ -108,13 +109,13 @@ func (k *Kubernetes) DeleteMonitoringConfigs(ctx context.Context, opts ...ctrlcl
 		opt.ApplyToList(&delOpts.ListOptions)
 	}
 
-	k.l.Debugf("Setting monitoring configs removal timeout to %s", pollTimeout)
+	logger.FromContext(ctx, k.l).Debugf("Setting monitoring configs removal timeout to %s", pollTimeout)
 	return wait.PollUntilContextTimeout(ctx, pollInterval, pollTimeout, true, func(ctx context.Context) (bool, error) {
 		// Skip fetching the list of objects to delete again, we already have it (see code above).
 		if delList == nil {
 			var err error
 			if delList, err = k.listMonitoringConfigsMeta(ctx, opts...); err != nil {
-				k.l.Errorf("Could not list monitoring configs in polling: %s", err)
+				logger.FromContext(ctx, k.l).Errorf("Could not list monitoring configs in polling: %s", err)
 				return false, err
 			}
 
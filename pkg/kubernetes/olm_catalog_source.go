@@ -18,10 +18,14 @@ package kubernetes
 import (
 	"context"
 
+	"github.com/AlekSi/pointer"
 	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// catalogSourceReadyState is the state of the connection to a ready catalog source registry.
+const catalogSourceReadyState = "READY"
+
 // GetCatalogSource returns catalog source that matches the criteria.
 func (k *Kubernetes) GetCatalogSource(ctx context.Context, key ctrlclient.ObjectKey) (*olmv1alpha1.CatalogSource, error) {
 	result := &olmv1alpha1.CatalogSource{}
@@ -35,3 +39,8 @@ func (k *Kubernetes) GetCatalogSource(ctx context.Context, key ctrlclient.Object
 func (k *Kubernetes) DeleteCatalogSource(ctx context.Context, obj *olmv1alpha1.CatalogSource) error {
 	return k.k8sClient.Delete(ctx, obj)
 }
+
+// IsCatalogSourceReady returns true if the catalog source is serving its registry.
+func IsCatalogSourceReady(cs *olmv1alpha1.CatalogSource) bool {
+	return pointer.Get(cs.Status.GRPCConnectionState).LastObservedState == catalogSourceReadyState
+}
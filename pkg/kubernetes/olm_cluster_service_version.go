@@ -22,6 +22,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/percona/everest/pkg/logger"
 )
 
 // GetClusterServiceVersion retrieves a ClusterServiceVersion that matches the criteria.
@@ -65,7 +67,7 @@ func (k *Kubernetes) DeleteClusterServiceVersions(ctx context.Context, opts ...c
 	// No need to fetch full objects, we only need the fact there are objects that match the criteria(opts).
 	delList, err := k.listClusterServiceVersionMeta(ctx, opts...)
 	if err != nil {
-		k.l.Errorf("Could not list cluster service versions: %s", err)
+		logger.FromContext(ctx, k.l).Errorf("Could not list cluster service versions: %s", err)
 		return err
 	}
 
@@ -80,13 +82,13 @@ func (k *Kubernetes) DeleteClusterServiceVersions(ctx context.Context, opts ...c
 		opt.ApplyToList(&delOpts.ListOptions)
 	}
 
-	k.l.Debugf("Setting cluster service versions removal timeout to %s", pollTimeout)
+	logger.FromContext(ctx, k.l).Debugf("Setting cluster service versions removal timeout to %s", pollTimeout)
 	return wait.PollUntilContextTimeout(ctx, pollInterval, pollTimeout, true, func(ctx context.Context) (bool, error) {
 		// Skip fetching the list of objects to delete again, we already have it (see code above).
 		if delList == nil {
 			var err error
 			if delList, err = k.listClusterServiceVersionMeta(ctx, opts...); err != nil {
-				k.l.Errorf("Could not list cluster service versions in polling: %s", err)
+				logger.FromContext(ctx, k.l).Errorf("Could not list cluster service versions in polling: %s", err)
 				return false, err
 			}
 
@@ -8,6 +8,8 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/percona/everest/pkg/logger"
 )
 
 // GetInstallPlan retrieves an OLM install plan that matches the criteria.
@@ -19,6 +21,16 @@ func (k *Kubernetes) GetInstallPlan(ctx context.Context, key ctrlclient.ObjectKe
 	return result, nil
 }
 
+// ListInstallPlans returns a list of OLM install plans that match the criteria.
+// This method returns a list of full objects (meta and spec).
+func (k *Kubernetes) ListInstallPlans(ctx context.Context, opts ...ctrlclient.ListOption) (*olmv1alpha1.InstallPlanList, error) {
+	result := &olmv1alpha1.InstallPlanList{}
+	if err := k.k8sClient.List(ctx, result, opts...); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 // UpdateInstallPlan updates OLM install plan.
 func (k *Kubernetes) UpdateInstallPlan(ctx context.Context, installPlan *olmv1alpha1.InstallPlan) (*olmv1alpha1.InstallPlan, error) {
 	if err := k.k8sClient.Update(ctx, installPlan); err != nil {
@@ -34,7 +46,7 @@ func (k *Kubernetes) ApproveInstallPlan(ctx context.Context, key ctrlclient.Obje
 		return false, err
 	}
 
-	k.l.Debugf("Approving install plan='%s' in namespace='%s'", key.Name, key.Namespace)
+	logger.FromContext(ctx, k.l).Debugf("Approving install plan='%s' in namespace='%s'", key.Name, key.Namespace)
 
 	ip.Spec.Approved = true
 	_, err = k.UpdateInstallPlan(ctx, ip)
@@ -42,7 +54,7 @@ func (k *Kubernetes) ApproveInstallPlan(ctx context.Context, key ctrlclient.Obje
 		var sErr *apierrors.StatusError
 		if ok := errors.As(err, &sErr); ok && sErr.Status().Reason == metav1.StatusReasonConflict {
 			// The installation plan has changed. We retry to get an updated install plan.
-			k.l.Debugf("Retrying install plan update due to a version conflict. Error: %s", err)
+			logger.FromContext(ctx, k.l).Debugf("Retrying install plan update due to a version conflict. Error: %s", err)
 			return false, nil
 		}
 
@@ -2,11 +2,20 @@ package kubernetes
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// VolumeStats is the usage of the volume of a persistent volume claim.
+type VolumeStats struct {
+	UsedBytes     uint64 `json:"usedBytes"`
+	CapacityBytes uint64 `json:"capacityBytes"`
+}
+
 // ListPods returns list of pods that match the criteria.
 // This method returns a list of full objects (meta and spec).
 func (k *Kubernetes) ListPods(ctx context.Context, opts ...ctrlclient.ListOption) (*corev1.PodList, error) {
@@ -16,3 +25,43 @@ func (k *Kubernetes) ListPods(ctx context.Context, opts ...ctrlclient.ListOption
 	}
 	return result, nil
 }
+
+// GetVolumeStats returns the usage of the volumes of the persistent volume claims
+// mounted by the pods running on the node, as reported by the kubelet of the node.
+// It requires the permission to get the nodes/proxy resource.
+func (k *Kubernetes) GetVolumeStats(ctx context.Context, nodeName string) (map[types.NamespacedName]VolumeStats, error) {
+	if k.restConfig == nil {
+		return nil, errors.New("kubelet stats are not available without a REST config")
+	}
+	raw, err := k.getDiscoveryClient().RESTClient().Get().
+		AbsPath("/api/v1/nodes", nodeName, "proxy", "stats", "summary").
+		DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Only the fields used from the kubelet stats summary API.
+	summary := struct {
+		Pods []struct {
+			Volume []struct {
+				VolumeStats
+				PVCRef *struct {
+					Name      string `json:"name"`
+					Namespace string `json:"namespace"`
+				} `json:"pvcRef"`
+			} `json:"volume"`
+		} `json:"pods"`
+	}{}
+	if err := json.Unmarshal(raw, &summary); err != nil {
+		return nil, errors.Join(err, errors.New("failed to parse kubelet stats summary"))
+	}
+	result := map[types.NamespacedName]VolumeStats{}
+	for _, pod := range summary.Pods {
+		for _, vol := range pod.Volume {
+			if vol.PVCRef == nil {
+				continue
+			}
+			result[types.NamespacedName{Namespace: vol.PVCRef.Namespace, Name: vol.PVCRef.Name}] = vol.VolumeStats
+		}
+	}
+	return result, nil
+}
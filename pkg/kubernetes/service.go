@@ -30,3 +30,12 @@ func (k *Kubernetes) GetService(ctx context.Context, key ctrlclient.ObjectKey) (
 	}
 	return result, nil
 }
+
+// ListServices returns list of services that match the criteria.
+func (k *Kubernetes) ListServices(ctx context.Context, opts ...ctrlclient.ListOption) (*corev1.ServiceList, error) {
+	result := &corev1.ServiceList{}
+	if err := k.k8sClient.List(ctx, result, opts...); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -18,6 +18,15 @@ func (k *Kubernetes) ListPersistentVolumes(ctx context.Context, opts ...ctrlclie
 	return result, nil
 }
 
+// ListPersistentVolumeClaims returns list of persistent volume claims that match the criteria.
+func (k *Kubernetes) ListPersistentVolumeClaims(ctx context.Context, opts ...ctrlclient.ListOption) (*corev1.PersistentVolumeClaimList, error) {
+	result := &corev1.PersistentVolumeClaimList{}
+	if err := k.k8sClient.List(ctx, result, opts...); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 // ListStorageClasses returns list of storage classes that match the criteria.
 // This method returns a list of full objects (meta and spec).
 func (k *Kubernetes) ListStorageClasses(ctx context.Context, opts ...ctrlclient.ListOption) (*storagev1.StorageClassList, error) {
@@ -0,0 +1,119 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/percona/everest/pkg/tracing"
+)
+
+const (
+	kindAttr          = attribute.Key("k8s.kind")
+	resourceNameAttr  = attribute.Key("k8s.resource.name")
+	labelSelectorAttr = attribute.Key("k8s.label_selector")
+)
+
+// newTracingClient returns a client that records a span for each request to the Kubernetes API.
+func newTracingClient(c ctrlclient.WithWatch, tp trace.TracerProvider) ctrlclient.WithWatch { //nolint:ireturn
+	tracer := tp.Tracer(tracing.InstrumentationName)
+	start := func(ctx context.Context, verb string, obj runtime.Object, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+		kind := "unknown"
+		if gvk, err := c.GroupVersionKindFor(obj); err == nil {
+			kind = gvk.Kind
+		}
+		attrs = append(attrs, kindAttr.String(kind))
+		return tracer.Start(ctx, "kubernetes."+verb+" "+kind,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(attrs...),
+		)
+	}
+	objectAttrs := func(obj ctrlclient.Object) []attribute.KeyValue {
+		return []attribute.KeyValue{
+			semconv.K8SNamespaceName(obj.GetNamespace()),
+			resourceNameAttr.String(obj.GetName()),
+		}
+	}
+	listAttrs := func(opts []ctrlclient.ListOption) []attribute.KeyValue {
+		o := &ctrlclient.ListOptions{}
+		o.ApplyOptions(opts)
+		attrs := []attribute.KeyValue{semconv.K8SNamespaceName(o.Namespace)}
+		if o.LabelSelector != nil && !o.LabelSelector.Empty() {
+			attrs = append(attrs, labelSelectorAttr.String(o.LabelSelector.String()))
+		}
+		return attrs
+	}
+
+	return interceptor.NewClient(c, interceptor.Funcs{
+		Get: func(ctx context.Context, c ctrlclient.WithWatch, key ctrlclient.ObjectKey, obj ctrlclient.Object, opts ...ctrlclient.GetOption) error {
+			ctx, span := start(ctx, "Get", obj, semconv.K8SNamespaceName(key.Namespace), resourceNameAttr.String(key.Name))
+			err := c.Get(ctx, key, obj, opts...)
+			tracing.End(span, err)
+			return err
+		},
+		List: func(ctx context.Context, c ctrlclient.WithWatch, list ctrlclient.ObjectList, opts ...ctrlclient.ListOption) error {
+			ctx, span := start(ctx, "List", list, listAttrs(opts)...)
+			err := c.List(ctx, list, opts...)
+			tracing.End(span, err)
+			return err
+		},
+		Create: func(ctx context.Context, c ctrlclient.WithWatch, obj ctrlclient.Object, opts ...ctrlclient.CreateOption) error {
+			ctx, span := start(ctx, "Create", obj, objectAttrs(obj)...)
+			err := c.Create(ctx, obj, opts...)
+			tracing.End(span, err)
+			return err
+		},
+		Update: func(ctx context.Context, c ctrlclient.WithWatch, obj ctrlclient.Object, opts ...ctrlclient.UpdateOption) error {
+			ctx, span := start(ctx, "Update", obj, objectAttrs(obj)...)
+			err := c.Update(ctx, obj, opts...)
+			tracing.End(span, err)
+			return err
+		},
+		Patch: func(ctx context.Context, c ctrlclient.WithWatch, obj ctrlclient.Object, patch ctrlclient.Patch, opts ...ctrlclient.PatchOption) error {
+			ctx, span := start(ctx, "Patch", obj, objectAttrs(obj)...)
+			err := c.Patch(ctx, obj, patch, opts...)
+			tracing.End(span, err)
+			return err
+		},
+		Delete: func(ctx context.Context, c ctrlclient.WithWatch, obj ctrlclient.Object, opts ...ctrlclient.DeleteOption) error {
+			ctx, span := start(ctx, "Delete", obj, objectAttrs(obj)...)
+			err := c.Delete(ctx, obj, opts...)
+			tracing.End(span, err)
+			return err
+		},
+		DeleteAllOf: func(ctx context.Context, c ctrlclient.WithWatch, obj ctrlclient.Object, opts ...ctrlclient.DeleteAllOfOption) error {
+			ctx, span := start(ctx, "DeleteAllOf", obj, semconv.K8SNamespaceName(obj.GetNamespace()))
+			err := c.DeleteAllOf(ctx, obj, opts...)
+			tracing.End(span, err)
+			return err
+		},
+		Watch: func(ctx context.Context, c ctrlclient.WithWatch, list ctrlclient.ObjectList, opts ...ctrlclient.ListOption) (watch.Interface, error) {
+			// Only the start of the watch is traced, its events are not.
+			ctx, span := start(ctx, "Watch", list, listAttrs(opts)...)
+			w, err := c.Watch(ctx, list, opts...)
+			tracing.End(span, err)
+			return w, err
+		},
+	})
+}
@@ -0,0 +1,77 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestTracingClient(t *testing.T) {
+	t.Parallel()
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "cm", Namespace: "ns", Labels: map[string]string{"app": "everest"}}}
+	c := newTracingClient(fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(cm).Build(), tp)
+
+	ctx := context.Background()
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Namespace: "ns", Name: "cm"}, &corev1.ConfigMap{}))
+	require.NoError(t, c.List(ctx, &corev1.ConfigMapList{}, ctrlclient.InNamespace("ns"), ctrlclient.MatchingLabels{"app": "everest"}))
+	require.Error(t, c.Get(ctx, types.NamespacedName{Namespace: "ns", Name: "missing"}, &corev1.ConfigMap{}))
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+
+	attrs := func(s sdktrace.ReadOnlySpan) map[attribute.Key]string {
+		m := map[attribute.Key]string{}
+		for _, kv := range s.Attributes() {
+			m[kv.Key] = kv.Value.Emit()
+		}
+		return m
+	}
+
+	assert.Equal(t, "kubernetes.Get ConfigMap", spans[0].Name())
+	assert.Equal(t, map[attribute.Key]string{
+		"k8s.namespace.name": "ns",
+		"k8s.resource.name":  "cm",
+		"k8s.kind":           "ConfigMap",
+	}, attrs(spans[0]))
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+
+	assert.Equal(t, "kubernetes.List ConfigMapList", spans[1].Name())
+	assert.Equal(t, map[attribute.Key]string{
+		"k8s.namespace.name": "ns",
+		"k8s.label_selector": "app=everest",
+		"k8s.kind":           "ConfigMapList",
+	}, attrs(spans[1]))
+
+	assert.Equal(t, "kubernetes.Get ConfigMap", spans[2].Name())
+	assert.Equal(t, codes.Error, spans[2].Status().Code)
+}
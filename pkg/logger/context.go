@@ -0,0 +1,41 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"context"
+	"slices"
+
+	"go.uber.org/zap"
+)
+
+type fieldsCtxKey struct{}
+
+// WithFields returns a copy of ctx carrying the given key-value pairs, which are
+// added to the loggers returned by FromContext, e.g. the ID of the request being served.
+func WithFields(ctx context.Context, keysAndValues ...interface{}) context.Context {
+	fields, _ := ctx.Value(fieldsCtxKey{}).([]interface{})
+	return context.WithValue(ctx, fieldsCtxKey{}, append(slices.Clip(fields), keysAndValues...))
+}
+
+// FromContext returns the given logger with the key-value pairs carried by ctx.
+func FromContext(ctx context.Context, l *zap.SugaredLogger) *zap.SugaredLogger {
+	fields, _ := ctx.Value(fieldsCtxKey{}).([]interface{})
+	if len(fields) == 0 {
+		return l
+	}
+	return l.With(fields...)
+}
@@ -0,0 +1,49 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestFromContext(t *testing.T) {
+	t.Parallel()
+
+	core, logs := observer.New(zap.InfoLevel)
+	l := zap.New(core).Sugar().With("component", "test")
+
+	ctx := context.Background()
+	FromContext(ctx, l).Info("no fields")
+
+	parent := WithFields(ctx, "request_id", "1")
+	child := WithFields(parent, "user", "alice")
+	sibling := WithFields(parent, "user", "bob")
+	FromContext(child, l).Info("child")
+	FromContext(sibling, l).Info("sibling")
+	FromContext(parent, l).Info("parent")
+
+	entries := logs.All()
+	assert.Len(t, entries, 4)
+	assert.Equal(t, map[string]interface{}{"component": "test"}, entries[0].ContextMap())
+	assert.Equal(t, map[string]interface{}{"component": "test", "request_id": "1", "user": "alice"}, entries[1].ContextMap())
+	assert.Equal(t, map[string]interface{}{"component": "test", "request_id": "1", "user": "bob"}, entries[2].ContextMap())
+	assert.Equal(t, map[string]interface{}{"component": "test", "request_id": "1"}, entries[3].ContextMap())
+}
@@ -0,0 +1,336 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package logstream aggregates the log streams of several containers.
+//
+// The lines of the streams are expected to be prefixed with an RFC3339 timestamp,
+// as returned by the Kubernetes API when the logs are requested with timestamps.
+package logstream
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Source is the log stream of a container.
+type Source struct {
+	// Name identifies the stream, e.g. "<pod>/<container>".
+	// It prefixes the lines of the stream and names its file in archives.
+	Name   string
+	Reader io.Reader
+}
+
+// Filter reports whether a log line should be kept.
+// A nil Filter keeps all the lines.
+type Filter func(line string) bool
+
+// NewFilter returns a filter keeping the lines that contain pattern, or that
+// match it if regex is set. It returns a nil Filter for an empty pattern.
+func NewFilter(pattern string, regex bool) (Filter, error) {
+	if pattern == "" {
+		return nil, nil //nolint:nilnil
+	}
+	if !regex {
+		return func(line string) bool {
+			return strings.Contains(line, pattern)
+		}, nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+	return re.MatchString, nil
+}
+
+func (f Filter) match(l line) bool {
+	return f == nil || f(l.text)
+}
+
+// Options configure how the lines are written.
+type Options struct {
+	Filter Filter
+	// Timestamps keeps the timestamps of the lines.
+	Timestamps bool
+	// MaxBytes is the maximum number of bytes read from each source.
+	// Zero means no limit.
+	MaxBytes int64
+}
+
+func (o Options) format(l line, prefix bool) string {
+	var b strings.Builder
+	if prefix {
+		b.WriteString("[" + l.source + "] ")
+	}
+	if o.Timestamps && l.timestamp != "" {
+		b.WriteString(l.timestamp + " ")
+	}
+	b.WriteString(l.text)
+	b.WriteString("\n")
+	return b.String()
+}
+
+type line struct {
+	source    string
+	timestamp string
+	time      time.Time
+	text      string
+}
+
+// parseLine splits the timestamp from a log line.
+// Lines without a timestamp get the time of the previous line of the stream.
+func parseLine(source, s string, prev time.Time) line {
+	s = strings.TrimSuffix(s, "\n")
+	ts, text, found := strings.Cut(s, " ")
+	if found {
+		if t, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+			return line{source: source, timestamp: ts, time: t, text: text}
+		}
+	}
+	return line{source: source, time: prev, text: s}
+}
+
+// lineReader reads the lines of a source one at a time.
+type lineReader struct {
+	name string
+	r    *bufio.Reader
+	prev time.Time
+	done bool
+}
+
+func newLineReader(src Source, maxBytes int64) *lineReader {
+	r := src.Reader
+	if maxBytes > 0 {
+		r = io.LimitReader(r, maxBytes)
+	}
+	return &lineReader{name: src.Name, r: bufio.NewReader(r)}
+}
+
+// next returns the next line of the source.
+// It returns false once the source is exhausted.
+func (lr *lineReader) next() (line, bool, error) {
+	for !lr.done {
+		s, err := lr.r.ReadString('\n')
+		if errors.Is(err, io.EOF) {
+			lr.done = true
+		} else if err != nil {
+			lr.done = true
+			return line{}, false, fmt.Errorf("failed to read logs of %s: %w", lr.name, err)
+		}
+		if s != "" {
+			l := parseLine(lr.name, s, lr.prev)
+			lr.prev = l.time
+			return l, true, nil
+		}
+	}
+	return line{}, false, nil
+}
+
+// readLines calls fn for each line of the source until the source is
+// exhausted or fn returns false.
+func readLines(src Source, maxBytes int64, fn func(line) bool) error {
+	lr := newLineReader(src, maxBytes)
+	for {
+		l, ok, err := lr.next()
+		if err != nil || !ok {
+			return err
+		}
+		if !fn(l) {
+			return nil
+		}
+	}
+}
+
+// Merge reads the sources until they are exhausted and writes their lines to w
+// ordered by time, each line prefixed with the name of its source.
+// The sources are read in turn a line at a time, so that only the next
+// line of each source is held in memory.
+func Merge(w io.Writer, sources []Source, opts Options) error {
+	readers := make([]*lineReader, len(sources))
+	heads := make([]*line, len(sources))
+	for i, src := range sources {
+		readers[i] = newLineReader(src, opts.MaxBytes)
+	}
+	// advance reads the next line of the i-th source that matches the filter.
+	advance := func(i int) error {
+		heads[i] = nil
+		for {
+			l, ok, err := readers[i].next()
+			if err != nil || !ok {
+				return err
+			}
+			if opts.Filter.match(l) {
+				heads[i] = &l
+				return nil
+			}
+		}
+	}
+	for i := range readers {
+		if err := advance(i); err != nil {
+			return err
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	for {
+		// The lines of each source are already ordered. On equal times, the
+		// first source wins to keep the output stable.
+		next := -1
+		for i, l := range heads {
+			if l != nil && (next < 0 || l.time.Before(heads[next].time)) {
+				next = i
+			}
+		}
+		if next < 0 {
+			return bw.Flush()
+		}
+		if _, err := bw.WriteString(opts.format(*heads[next], true)); err != nil {
+			return err
+		}
+		if err := advance(next); err != nil {
+			return err
+		}
+	}
+}
+
+// Follow writes the lines of the sources to w as they are read, each line
+// prefixed with the name of its source, and calls flush after each write.
+// It returns when all the sources are exhausted or ctx is done.
+func Follow(ctx context.Context, w io.Writer, flush func(), sources []Source, opts Options) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	lines := make(chan line)
+	errs := make(chan error, len(sources))
+	var wg sync.WaitGroup
+	for _, src := range sources {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- readLines(src, opts.MaxBytes, func(l line) bool {
+				select {
+				case lines <- l:
+					return true
+				case <-ctx.Done():
+					return false
+				}
+			})
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(lines)
+		close(errs)
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case l, ok := <-lines:
+			if !ok {
+				// The streams are closed when the request is done, in
+				// which case their read errors are expected.
+				if ctx.Err() != nil {
+					return nil
+				}
+				var err error
+				for e := range errs {
+					err = errors.Join(err, e)
+				}
+				return err
+			}
+			if !opts.Filter.match(l) {
+				continue
+			}
+			if _, err := io.WriteString(w, opts.format(l, true)); err != nil {
+				return nil //nolint:nilerr // client disconnected
+			}
+			flush()
+		}
+	}
+}
+
+// WriteArchive reads the sources until they are exhausted and writes a tar.gz
+// archive to w with a "<name>.log" file for each of them.
+// Since the size of a file must be known before its content is written, each
+// source is spooled to a temporary file rather than held in memory.
+func WriteArchive(w io.Writer, sources []Source, opts Options) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	now := time.Now()
+	for _, src := range sources {
+		if err := writeArchiveFile(tw, src, opts, now); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+func writeArchiveFile(tw *tar.Writer, src Source, opts Options, modTime time.Time) error {
+	f, err := os.CreateTemp("", "logstream-*.log")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file for the logs of %s: %w", src.Name, err)
+	}
+	defer func() {
+		f.Close()           //nolint:errcheck
+		os.Remove(f.Name()) //nolint:errcheck
+	}()
+
+	bw := bufio.NewWriter(f)
+	var writeErr error
+	err = readLines(src, opts.MaxBytes, func(l line) bool {
+		if opts.Filter.match(l) {
+			_, writeErr = bw.WriteString(opts.format(l, false))
+		}
+		return writeErr == nil
+	})
+	if err = errors.Join(err, writeErr); err != nil {
+		return err
+	}
+	if err := bw.Flush(); err != nil {
+		return err
+	}
+	size, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    src.Name + ".log",
+		Mode:    0o644, //nolint:mnd
+		Size:    size,
+		ModTime: modTime,
+	}); err != nil {
+		return err
+	}
+	_, err = io.Copy(tw, f)
+	return err
+}
@@ -0,0 +1,156 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logstream
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSources() []Source {
+	return []Source{
+		{Name: "db-pxc-0/pxc", Reader: strings.NewReader(
+			"2025-01-01T10:00:00.000000001Z starting\n" +
+				"2025-01-01T10:00:02Z ready for connections\n" +
+				"2025-01-01T10:00:04Z [ERROR] out of memory\n",
+		)},
+		{Name: "db-haproxy-0/haproxy", Reader: strings.NewReader(
+			"2025-01-01T10:00:01Z listening\n" +
+				"2025-01-01T10:00:03Z backend pxc is DOWN\n" +
+				"continuation without timestamp",
+		)},
+	}
+}
+
+func TestNewFilter(t *testing.T) {
+	t.Parallel()
+
+	f, err := NewFilter("", false)
+	require.NoError(t, err)
+	assert.Nil(t, f)
+
+	f, err = NewFilter("ERROR", false)
+	require.NoError(t, err)
+	assert.True(t, f("[ERROR] out of memory"))
+	assert.False(t, f("ready"))
+
+	f, err = NewFilter(`(?i)error|down`, true)
+	require.NoError(t, err)
+	assert.True(t, f("[error] out of memory"))
+	assert.True(t, f("backend pxc is down"))
+	assert.False(t, f("ready"))
+
+	_, err = NewFilter("(", true)
+	require.Error(t, err)
+}
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	require.NoError(t, Merge(&out, testSources(), Options{}))
+	assert.Equal(t, ""+
+		"[db-pxc-0/pxc] starting\n"+
+		"[db-haproxy-0/haproxy] listening\n"+
+		"[db-pxc-0/pxc] ready for connections\n"+
+		"[db-haproxy-0/haproxy] backend pxc is DOWN\n"+
+		"[db-haproxy-0/haproxy] continuation without timestamp\n"+
+		"[db-pxc-0/pxc] [ERROR] out of memory\n",
+		out.String())
+
+	filter, err := NewFilter("ERROR|DOWN", true)
+	require.NoError(t, err)
+	out.Reset()
+	require.NoError(t, Merge(&out, testSources(), Options{Filter: filter, Timestamps: true}))
+	assert.Equal(t, ""+
+		"[db-haproxy-0/haproxy] 2025-01-01T10:00:03Z backend pxc is DOWN\n"+
+		"[db-pxc-0/pxc] 2025-01-01T10:00:04Z [ERROR] out of memory\n",
+		out.String())
+
+	// Only the first bytes of each source are read, the last line may be truncated.
+	out.Reset()
+	require.NoError(t, Merge(&out, testSources(), Options{MaxBytes: 40}))
+	assert.Equal(t, ""+
+		"[db-pxc-0/pxc] starting\n"+
+		"[db-haproxy-0/haproxy] listening\n"+
+		"[db-haproxy-0/haproxy] 2025-01-0\n",
+		out.String())
+}
+
+func TestFollow(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	flushes := 0
+	filter, err := NewFilter("pxc", false)
+	require.NoError(t, err)
+	require.NoError(t, Follow(context.Background(), &out, func() { flushes++ }, testSources(), Options{Filter: filter}))
+	assert.Equal(t, 1, flushes)
+	assert.Equal(t, "[db-haproxy-0/haproxy] backend pxc is DOWN\n", out.String())
+
+	// Following stops when the context is done, even if the sources are not exhausted.
+	ctx, cancel := context.WithCancel(context.Background())
+	r, w := io.Pipe()
+	defer w.Close() //nolint:errcheck
+	done := make(chan error)
+	go func() {
+		done <- Follow(ctx, io.Discard, func() {}, []Source{{Name: "a/b", Reader: r}}, Options{})
+	}()
+	_, err = io.WriteString(w, "2025-01-01T10:00:00Z line\n")
+	require.NoError(t, err)
+	cancel()
+	require.NoError(t, <-done)
+}
+
+func TestWriteArchive(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	require.NoError(t, WriteArchive(&out, testSources(), Options{Timestamps: true}))
+
+	gr, err := gzip.NewReader(&out)
+	require.NoError(t, err)
+	tr := tar.NewReader(gr)
+	files := map[string]string{}
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		files[hdr.Name] = string(content)
+	}
+	assert.Equal(t, map[string]string{
+		"db-pxc-0/pxc.log": "" +
+			"2025-01-01T10:00:00.000000001Z starting\n" +
+			"2025-01-01T10:00:02Z ready for connections\n" +
+			"2025-01-01T10:00:04Z [ERROR] out of memory\n",
+		"db-haproxy-0/haproxy.log": "" +
+			"2025-01-01T10:00:01Z listening\n" +
+			"2025-01-01T10:00:03Z backend pxc is DOWN\n" +
+			"continuation without timestamp\n",
+	}, files)
+}
@@ -0,0 +1,243 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package operations provides the tracking of asynchronous actions.
+//
+// Deleting a database cluster, restoring a backup or approving an upgrade
+// plan returns as soon as the action is accepted by Kubernetes, while the
+// operator performs it in the background. An operation records such an
+// action, and its state is derived by observing the resources it is
+// performed on.
+package operations
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/common"
+)
+
+const (
+	// TTL is the time after which an operation is purged.
+	TTL = 24 * time.Hour
+	// UpgradePlanTarget is the target of the operations that approve the upgrade plan of a namespace.
+	UpgradePlanTarget = "upgrade-plan"
+
+	namePrefix       = "operation-"
+	nameSuffixLength = 5
+	configMapDataKey = "operation"
+)
+
+// ErrNotOperation is returned when a ConfigMap does not hold an operation.
+var ErrNotOperation = errors.New("not an operation")
+
+// Client provides access to the resources observed by operations.
+type Client interface {
+	GetDatabaseCluster(ctx context.Context, key ctrlclient.ObjectKey) (*everestv1alpha1.DatabaseCluster, error)
+	GetDatabaseClusterRestore(ctx context.Context, key ctrlclient.ObjectKey) (*everestv1alpha1.DatabaseClusterRestore, error)
+	ListDatabaseEngines(ctx context.Context, opts ...ctrlclient.ListOption) (*everestv1alpha1.DatabaseEngineList, error)
+}
+
+// New returns a new pending operation.
+func New(namespace string, opType api.OperationType, target, createdBy string, now time.Time) *api.Operation {
+	now = now.UTC().Truncate(time.Second)
+	return &api.Operation{
+		Name:      namePrefix + utilrand.String(nameSuffixLength),
+		Namespace: namespace,
+		Type:      opType,
+		Target:    target,
+		State:     api.OperationStatePending,
+		CreatedBy: createdBy,
+		CreatedAt: now,
+		ExpiresAt: now.Add(TTL),
+	}
+}
+
+// IsDone returns true if the operation has succeeded or failed.
+func IsDone(op *api.Operation) bool {
+	return op.State == api.OperationStateSucceeded || op.State == api.OperationStateFailed
+}
+
+// Observe updates the state, the progress messages and the result of the
+// operation from the resources it is performed on. Operations that are done
+// are not observed anymore.
+func Observe(ctx context.Context, c Client, op *api.Operation, now time.Time) error {
+	if IsDone(op) {
+		return nil
+	}
+	var err error
+	switch op.Type {
+	case api.OperationTypeDatabaseClusterDeletion:
+		err = observeDatabaseClusterDeletion(ctx, c, op)
+	case api.OperationTypeDatabaseClusterRestore:
+		err = observeDatabaseClusterRestore(ctx, c, op)
+	case api.OperationTypeUpgradePlanApproval:
+		err = observeUpgradePlanApproval(ctx, c, op)
+	default:
+		return fmt.Errorf("unsupported operation type '%s'", op.Type)
+	}
+	if err != nil {
+		return err
+	}
+	if IsDone(op) {
+		completedAt := now.UTC().Truncate(time.Second)
+		op.CompletedAt = &completedAt
+	}
+	return nil
+}
+
+func observeDatabaseClusterDeletion(ctx context.Context, c Client, op *api.Operation) error {
+	db, err := c.GetDatabaseCluster(ctx, ctrlclient.ObjectKey{Namespace: op.Namespace, Name: op.Target})
+	if k8serrors.IsNotFound(err) {
+		setState(op, api.OperationStateSucceeded, fmt.Sprintf("Database cluster '%s' has been deleted", op.Target))
+		return nil
+	} else if err != nil {
+		return err
+	}
+	switch {
+	case db.GetAnnotations()[common.SoftDeletedAtAnnotation] != "":
+		setState(op, api.OperationStateSucceeded, fmt.Sprintf("Database cluster '%s' has been moved to the trash", op.Target))
+	case !db.GetDeletionTimestamp().IsZero():
+		setState(op, api.OperationStateRunning, fmt.Sprintf("Database cluster '%s' is being deleted", op.Target))
+	}
+	return nil
+}
+
+func observeDatabaseClusterRestore(ctx context.Context, c Client, op *api.Operation) error {
+	restore, err := c.GetDatabaseClusterRestore(ctx, ctrlclient.ObjectKey{Namespace: op.Namespace, Name: op.Target})
+	if k8serrors.IsNotFound(err) {
+		setState(op, api.OperationStateFailed, fmt.Sprintf("Restore '%s' has been deleted", op.Target))
+		return nil
+	} else if err != nil {
+		return err
+	}
+	state := restoreState(restore.Status.State)
+	if state == api.OperationStatePending {
+		return nil
+	}
+	progress := map[api.OperationState]string{
+		api.OperationStateRunning:   "is in progress",
+		api.OperationStateSucceeded: "has completed",
+		api.OperationStateFailed:    "has failed",
+	}[state]
+	setState(op, state, fmt.Sprintf("Restore '%s' of database cluster '%s' %s", op.Target, restore.Spec.DBClusterName, progress))
+	if state == api.OperationStateSucceeded {
+		result, err := runtime.DefaultUnstructuredConverter.ToUnstructured(restore)
+		if err != nil {
+			return fmt.Errorf("failed to convert restore: %w", err)
+		}
+		op.Result = &result
+	}
+	return nil
+}
+
+// restoreState returns the state of the operation that restores a backup for
+// the given restore state, which is specific to the database engine.
+func restoreState(state everestv1alpha1.RestoreState) api.OperationState {
+	switch strings.ToLower(string(state)) {
+	case "starting", "restoring", "running":
+		return api.OperationStateRunning
+	case "succeeded", "ready":
+		return api.OperationStateSucceeded
+	case "failed", "error", "rejected":
+		return api.OperationStateFailed
+	}
+	return api.OperationStatePending
+}
+
+func observeUpgradePlanApproval(ctx context.Context, c Client, op *api.Operation) error {
+	engines, err := c.ListDatabaseEngines(ctx, ctrlclient.InNamespace(op.Namespace))
+	if err != nil {
+		return err
+	}
+	var upgrading []string
+	for _, engine := range engines.Items {
+		if _, locked := engine.GetAnnotations()[everestv1alpha1.DatabaseOperatorUpgradeLockAnnotation]; locked {
+			upgrading = append(upgrading, engine.GetName())
+		}
+	}
+	if len(upgrading) > 0 {
+		setState(op, api.OperationStateRunning,
+			fmt.Sprintf("Upgrading the operators of %s", strings.Join(upgrading, ", ")))
+		return nil
+	}
+	versions := make(map[string]interface{}, len(engines.Items))
+	for _, engine := range engines.Items {
+		versions[engine.GetName()] = engine.Status.OperatorVersion
+	}
+	setState(op, api.OperationStateSucceeded, "The operators have been upgraded")
+	op.Result = &map[string]interface{}{"operatorVersions": versions}
+	return nil
+}
+
+// setState sets the state of the operation and records the message, unless
+// it is the same as the last one.
+func setState(op *api.Operation, state api.OperationState, message string) {
+	op.State = state
+	messages := pointer.Get(op.Messages)
+	if len(messages) == 0 || messages[len(messages)-1] != message {
+		messages = append(messages, message)
+	}
+	op.Messages = &messages
+}
+
+// ToConfigMap returns the ConfigMap that stores the operation.
+func ToConfigMap(op *api.Operation) (*corev1.ConfigMap, error) {
+	raw, err := json.Marshal(op)
+	if err != nil {
+		return nil, err
+	}
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      op.Name,
+			Namespace: op.Namespace,
+			Labels: map[string]string{
+				common.EverestOperationLabel: string(op.Type),
+			},
+		},
+		Data: map[string]string{
+			configMapDataKey: string(raw),
+		},
+	}, nil
+}
+
+// FromConfigMap returns the operation stored in the given ConfigMap.
+func FromConfigMap(cm *corev1.ConfigMap) (*api.Operation, error) {
+	raw, ok := cm.Data[configMapDataKey]
+	if _, labeled := cm.GetLabels()[common.EverestOperationLabel]; !ok || !labeled {
+		return nil, ErrNotOperation
+	}
+	op := &api.Operation{}
+	if err := json.Unmarshal([]byte(raw), op); err != nil {
+		return nil, fmt.Errorf("failed to parse operation: %w", err)
+	}
+	op.Name = cm.GetName()
+	op.Namespace = cm.GetNamespace()
+	return op, nil
+}
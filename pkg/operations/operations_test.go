@@ -0,0 +1,218 @@
+package operations
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/common"
+)
+
+type fakeClient struct {
+	db      *everestv1alpha1.DatabaseCluster
+	restore *everestv1alpha1.DatabaseClusterRestore
+	engines []everestv1alpha1.DatabaseEngine
+}
+
+func (f *fakeClient) GetDatabaseCluster(_ context.Context, key ctrlclient.ObjectKey) (*everestv1alpha1.DatabaseCluster, error) {
+	if f.db == nil {
+		return nil, k8serrors.NewNotFound(schema.GroupResource{Resource: "databaseclusters"}, key.Name)
+	}
+	return f.db, nil
+}
+
+func (f *fakeClient) GetDatabaseClusterRestore(_ context.Context, key ctrlclient.ObjectKey) (*everestv1alpha1.DatabaseClusterRestore, error) {
+	if f.restore == nil {
+		return nil, k8serrors.NewNotFound(schema.GroupResource{Resource: "databaseclusterrestores"}, key.Name)
+	}
+	return f.restore, nil
+}
+
+func (f *fakeClient) ListDatabaseEngines(_ context.Context, _ ...ctrlclient.ListOption) (*everestv1alpha1.DatabaseEngineList, error) {
+	return &everestv1alpha1.DatabaseEngineList{Items: f.engines}, nil
+}
+
+func TestObserve(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	deleting := metav1.NewTime(now)
+	restore := func(state everestv1alpha1.RestoreState) *everestv1alpha1.DatabaseClusterRestore {
+		return &everestv1alpha1.DatabaseClusterRestore{
+			ObjectMeta: metav1.ObjectMeta{Name: "restore", Namespace: "ns"},
+			Spec:       everestv1alpha1.DatabaseClusterRestoreSpec{DBClusterName: "db"},
+			Status:     everestv1alpha1.DatabaseClusterRestoreStatus{State: state},
+		}
+	}
+	engine := func(name string, locked bool) everestv1alpha1.DatabaseEngine {
+		e := everestv1alpha1.DatabaseEngine{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"}}
+		if locked {
+			e.SetAnnotations(map[string]string{everestv1alpha1.DatabaseOperatorUpgradeLockAnnotation: now.Format(time.RFC3339)})
+		}
+		return e
+	}
+
+	testCases := []struct {
+		name       string
+		opType     api.OperationType
+		target     string
+		client     *fakeClient
+		wantState  api.OperationState
+		wantResult bool
+	}{
+		{
+			name:      "database cluster deleted",
+			opType:    api.OperationTypeDatabaseClusterDeletion,
+			target:    "db",
+			client:    &fakeClient{},
+			wantState: api.OperationStateSucceeded,
+		},
+		{
+			name:   "database cluster being deleted",
+			opType: api.OperationTypeDatabaseClusterDeletion,
+			target: "db",
+			client: &fakeClient{db: &everestv1alpha1.DatabaseCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "ns", DeletionTimestamp: &deleting},
+			}},
+			wantState: api.OperationStateRunning,
+		},
+		{
+			name:   "database cluster moved to the trash",
+			opType: api.OperationTypeDatabaseClusterDeletion,
+			target: "db",
+			client: &fakeClient{db: &everestv1alpha1.DatabaseCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "db",
+					Namespace:   "ns",
+					Annotations: map[string]string{common.SoftDeletedAtAnnotation: now.Format(time.RFC3339)},
+				},
+			}},
+			wantState: api.OperationStateSucceeded,
+		},
+		{
+			name:      "restore not started",
+			opType:    api.OperationTypeDatabaseClusterRestore,
+			target:    "restore",
+			client:    &fakeClient{restore: restore("")},
+			wantState: api.OperationStatePending,
+		},
+		{
+			name:      "restore running",
+			opType:    api.OperationTypeDatabaseClusterRestore,
+			target:    "restore",
+			client:    &fakeClient{restore: restore("Restoring")},
+			wantState: api.OperationStateRunning,
+		},
+		{
+			name:       "restore succeeded",
+			opType:     api.OperationTypeDatabaseClusterRestore,
+			target:     "restore",
+			client:     &fakeClient{restore: restore("ready")},
+			wantState:  api.OperationStateSucceeded,
+			wantResult: true,
+		},
+		{
+			name:      "restore failed",
+			opType:    api.OperationTypeDatabaseClusterRestore,
+			target:    "restore",
+			client:    &fakeClient{restore: restore("error")},
+			wantState: api.OperationStateFailed,
+		},
+		{
+			name:      "restore deleted",
+			opType:    api.OperationTypeDatabaseClusterRestore,
+			target:    "restore",
+			client:    &fakeClient{},
+			wantState: api.OperationStateFailed,
+		},
+		{
+			name:      "operators upgrading",
+			opType:    api.OperationTypeUpgradePlanApproval,
+			target:    UpgradePlanTarget,
+			client:    &fakeClient{engines: []everestv1alpha1.DatabaseEngine{engine("pxc", true), engine("psmdb", false)}},
+			wantState: api.OperationStateRunning,
+		},
+		{
+			name:       "operators upgraded",
+			opType:     api.OperationTypeUpgradePlanApproval,
+			target:     UpgradePlanTarget,
+			client:     &fakeClient{engines: []everestv1alpha1.DatabaseEngine{engine("pxc", false)}},
+			wantState:  api.OperationStateSucceeded,
+			wantResult: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			op := New("ns", tc.opType, tc.target, "alice", now)
+			require.NoError(t, Observe(context.Background(), tc.client, op, now))
+			assert.Equal(t, tc.wantState, op.State)
+			assert.Equal(t, tc.wantResult, op.Result != nil)
+			assert.Equal(t, IsDone(op), op.CompletedAt != nil)
+			if tc.wantState != api.OperationStatePending {
+				assert.Len(t, pointer.Get(op.Messages), 1)
+			}
+		})
+	}
+}
+
+func TestObserveDone(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	op := New("ns", api.OperationTypeDatabaseClusterRestore, "restore", "alice", now)
+	op.State = api.OperationStateSucceeded
+
+	// Operations that are done are final, even if the restore is deleted afterwards.
+	require.NoError(t, Observe(context.Background(), &fakeClient{}, op, now))
+	assert.Equal(t, api.OperationStateSucceeded, op.State)
+	assert.Nil(t, op.Messages)
+}
+
+func TestObserveMessages(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	op := New("ns", api.OperationTypeDatabaseClusterRestore, "restore", "alice", now)
+	client := &fakeClient{restore: &everestv1alpha1.DatabaseClusterRestore{
+		Spec:   everestv1alpha1.DatabaseClusterRestoreSpec{DBClusterName: "db"},
+		Status: everestv1alpha1.DatabaseClusterRestoreStatus{State: "Restoring"},
+	}}
+
+	// The same progress is reported once.
+	require.NoError(t, Observe(context.Background(), client, op, now))
+	require.NoError(t, Observe(context.Background(), client, op, now))
+	client.restore.Status.State = "Succeeded"
+	require.NoError(t, Observe(context.Background(), client, op, now))
+	assert.Equal(t, []string{
+		"Restore 'restore' of database cluster 'db' is in progress",
+		"Restore 'restore' of database cluster 'db' has completed",
+	}, pointer.Get(op.Messages))
+}
+
+func TestConfigMap(t *testing.T) {
+	t.Parallel()
+
+	op := New("ns", api.OperationTypeDatabaseClusterDeletion, "db", "alice", time.Now())
+	cm, err := ToConfigMap(op)
+	require.NoError(t, err)
+	assert.Equal(t, string(api.OperationTypeDatabaseClusterDeletion), cm.GetLabels()[common.EverestOperationLabel])
+
+	got, err := FromConfigMap(cm)
+	require.NoError(t, err)
+	assert.Equal(t, op, got)
+
+	_, err = FromConfigMap(&corev1.ConfigMap{Data: cm.Data})
+	assert.ErrorIs(t, err, ErrNotOperation)
+}
@@ -0,0 +1,196 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pagination provides filtering, sorting and pagination of list endpoints.
+//
+// Label selectors are always evaluated by the Kubernetes API server. Pages are
+// also requested from the API server, unless the items are sorted or filtered
+// in memory. In that case, the whole list is fetched and paginated in memory,
+// and the continue token holds the offset of the next page.
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/AlekSi/pointer"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// descendingPrefix is the prefix of a sort field that sorts in descending order.
+const descendingPrefix = "-"
+
+var (
+	// ErrInvalidLimit is returned when the limit is not positive.
+	ErrInvalidLimit = errors.New("limit must be greater than 0")
+	// ErrInvalidContinue is returned when the continue token cannot be used with the request.
+	ErrInvalidContinue = errors.New("invalid continue token")
+)
+
+// Options holds the filtering, sorting and pagination options of a list request.
+type Options struct {
+	// Limit is the maximum number of items to return. All items are returned if it is zero.
+	Limit int64
+	// Continue is the token of the page to return.
+	Continue string
+	// LabelSelector is the label selector the items have to match.
+	LabelSelector string
+	// SortBy is the field to sort the items by, prefixed with "-" for descending order.
+	SortBy string
+	// Filtered is true if the items are filtered in memory.
+	Filtered bool
+}
+
+// NewOptions returns the options of a list request.
+func NewOptions(limit *int64, continueToken, labelSelector, sortBy *string, filtered bool) Options {
+	return Options{
+		Limit:         pointer.Get(limit),
+		Continue:      pointer.Get(continueToken),
+		LabelSelector: pointer.Get(labelSelector),
+		SortBy:        pointer.Get(sortBy),
+		Filtered:      filtered,
+	}
+}
+
+// SortKey compares two items by a field.
+type SortKey[T any] func(a, b *T) int
+
+// inMemory returns true if the items have to be paginated in memory.
+func (o Options) inMemory() bool {
+	return o.SortBy != "" || o.Filtered
+}
+
+// Validate validates the options against the supported sort keys.
+func Validate[T any](o Options, keys map[string]SortKey[T]) error {
+	if o.Limit < 0 {
+		return ErrInvalidLimit
+	}
+	if o.SortBy != "" {
+		if _, ok := keys[strings.TrimPrefix(o.SortBy, descendingPrefix)]; !ok {
+			return fmt.Errorf("unsupported sortBy field '%s'", o.SortBy)
+		}
+	}
+	if _, err := labels.Parse(o.LabelSelector); err != nil {
+		return fmt.Errorf("invalid labelSelector: %w", err)
+	}
+	if o.inMemory() && o.Continue != "" {
+		if _, err := decodeOffset(o.Continue); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListOptions returns the options of the request to the Kubernetes API server.
+// The items are also required to have the given labels.
+func (o Options) ListOptions(namespace string, matchLabels map[string]string) ([]ctrlclient.ListOption, error) {
+	sel, err := labels.Parse(o.LabelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid labelSelector: %w", err)
+	}
+	for k, v := range matchLabels {
+		req, err := labels.NewRequirement(k, selection.Equals, []string{v})
+		if err != nil {
+			return nil, err
+		}
+		sel = sel.Add(*req)
+	}
+	opts := []ctrlclient.ListOption{
+		ctrlclient.InNamespace(namespace),
+		ctrlclient.MatchingLabelsSelector{Selector: sel},
+	}
+	if o.inMemory() {
+		return opts, nil
+	}
+	if o.Limit > 0 {
+		opts = append(opts, ctrlclient.Limit(o.Limit))
+	}
+	if o.Continue != "" {
+		opts = append(opts, ctrlclient.Continue(o.Continue))
+	}
+	return opts, nil
+}
+
+// Paginate sorts the items and returns the requested page together with the
+// continue token of the next page. Items paginated by the Kubernetes API
+// server are returned as they are, together with the continue token
+// returned by the API server.
+func Paginate[T any](items []T, serverContinue string, o Options, keys map[string]SortKey[T]) ([]T, string, error) {
+	if !o.inMemory() {
+		return items, serverContinue, nil
+	}
+
+	if o.SortBy != "" {
+		field := strings.TrimPrefix(o.SortBy, descendingPrefix)
+		cmp, ok := keys[field]
+		if !ok {
+			return nil, "", fmt.Errorf("unsupported sortBy field '%s'", o.SortBy)
+		}
+		descending := field != o.SortBy
+		slices.SortStableFunc(items, func(a, b T) int {
+			if descending {
+				return cmp(&b, &a)
+			}
+			return cmp(&a, &b)
+		})
+	}
+
+	offset := 0
+	if o.Continue != "" {
+		var err error
+		if offset, err = decodeOffset(o.Continue); err != nil {
+			return nil, "", err
+		}
+	}
+	if offset > len(items) {
+		offset = len(items)
+	}
+	end := len(items)
+	if o.Limit > 0 && int64(end-offset) > o.Limit {
+		end = offset + int(o.Limit)
+	}
+	next := ""
+	if end < len(items) {
+		next = encodeOffset(end)
+	}
+	return items[offset:end], next, nil
+}
+
+type continueToken struct {
+	Offset int `json:"offset"`
+}
+
+func encodeOffset(offset int) string {
+	raw, _ := json.Marshal(continueToken{Offset: offset}) //nolint:errchkjson
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeOffset(token string) (int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, ErrInvalidContinue
+	}
+	t := continueToken{Offset: -1}
+	if err := json.Unmarshal(raw, &t); err != nil || t.Offset < 0 {
+		return 0, ErrInvalidContinue
+	}
+	return t.Offset, nil
+}
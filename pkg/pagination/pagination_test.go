@@ -0,0 +1,106 @@
+package pagination
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type testItem struct {
+	name string
+}
+
+//nolint:gochecknoglobals
+var testSortKeys = map[string]SortKey[testItem]{
+	"name": func(a, b *testItem) int { return strings.Compare(a.name, b.name) },
+}
+
+func TestPaginate(t *testing.T) {
+	t.Parallel()
+
+	items := []testItem{{"c"}, {"a"}, {"e"}, {"b"}, {"d"}}
+	o := Options{Limit: 2, SortBy: "-name"}
+	var names []string
+	for {
+		page, next, err := Paginate(append([]testItem(nil), items...), "", o, testSortKeys)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, len(page), 2)
+		for _, item := range page {
+			names = append(names, item.name)
+		}
+		if next == "" {
+			break
+		}
+		o.Continue = next
+	}
+	assert.Equal(t, []string{"e", "d", "c", "b", "a"}, names)
+}
+
+func TestPaginateServerSide(t *testing.T) {
+	t.Parallel()
+
+	// Items paginated by the API server are returned as they are.
+	items := []testItem{{"b"}, {"a"}}
+	page, next, err := Paginate(items, "server-token", Options{Limit: 1}, testSortKeys)
+	require.NoError(t, err)
+	assert.Equal(t, items, page)
+	assert.Equal(t, "server-token", next)
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		options Options
+		wantErr bool
+	}{
+		{name: "empty"},
+		{name: "sort ascending", options: Options{SortBy: "name"}},
+		{name: "sort descending", options: Options{SortBy: "-name"}},
+		{name: "unsupported sort field", options: Options{SortBy: "size"}, wantErr: true},
+		{name: "negative limit", options: Options{Limit: -1}, wantErr: true},
+		{name: "invalid label selector", options: Options{LabelSelector: "env in (dev"}, wantErr: true},
+		// The continue token of the API server is opaque, so it is only checked by the API server.
+		{name: "server continue token", options: Options{Continue: "opaque"}},
+		{name: "invalid continue token", options: Options{SortBy: "name", Continue: "opaque"}, wantErr: true},
+		{name: "continue token", options: Options{SortBy: "name", Continue: encodeOffset(10)}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := Validate(tc.options, testSortKeys)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestListOptions(t *testing.T) {
+	t.Parallel()
+
+	o := Options{LabelSelector: "env=dev", Limit: 10, Continue: "token"}
+	opts, err := o.ListOptions("ns", map[string]string{"clusterName": "db"})
+	require.NoError(t, err)
+	listOpts := &ctrlclient.ListOptions{}
+	listOpts.ApplyOptions(opts)
+	assert.Equal(t, "ns", listOpts.Namespace)
+	assert.Equal(t, "clusterName=db,env=dev", listOpts.LabelSelector.String())
+	assert.Equal(t, int64(10), listOpts.Limit)
+	assert.Equal(t, "token", listOpts.Continue)
+
+	// Items sorted in memory are fetched at once.
+	o.SortBy = "name"
+	o.Continue = ""
+	opts, err = o.ListOptions("ns", nil)
+	require.NoError(t, err)
+	listOpts = &ctrlclient.ListOptions{}
+	listOpts.ApplyOptions(opts)
+	assert.Zero(t, listOpts.Limit)
+}
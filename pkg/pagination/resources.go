@@ -0,0 +1,148 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pagination
+
+import (
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/api"
+)
+
+// ByName compares two objects by name.
+func ByName(a, b metav1.Object) int {
+	return strings.Compare(a.GetName(), b.GetName())
+}
+
+// ByCreationTimestamp compares two objects by creation time, then by name.
+func ByCreationTimestamp(a, b metav1.Object) int {
+	if c := a.GetCreationTimestamp().Time.Compare(b.GetCreationTimestamp().Time); c != 0 {
+		return c
+	}
+	return ByName(a, b)
+}
+
+// DatabaseClusterOptions returns the options of a database cluster list request.
+// The soft-deleted clusters are always filtered out in memory, as well as the
+// clusters the user is not allowed to read, so the clusters are always
+// paginated in memory.
+func DatabaseClusterOptions(params *api.ListDatabaseClustersParams) Options {
+	if params == nil {
+		return Options{Filtered: true}
+	}
+	return NewOptions(params.Limit, params.Continue, params.LabelSelector, params.SortBy, true)
+}
+
+// DatabaseClusterParamsWithoutPage returns the parameters of a database cluster
+// list request for all the matching clusters, so that they can be filtered
+// before being paginated.
+func DatabaseClusterParamsWithoutPage(params *api.ListDatabaseClustersParams) *api.ListDatabaseClustersParams {
+	if params == nil {
+		return nil
+	}
+	all := *params
+	all.Limit = nil
+	all.Continue = nil
+	return &all
+}
+
+// DatabaseClusterBackupOptions returns the options of a database cluster backup list request.
+func DatabaseClusterBackupOptions(params *api.ListDatabaseClusterBackupsParams) Options {
+	if params == nil {
+		return Options{}
+	}
+	return NewOptions(params.Limit, params.Continue, params.LabelSelector, params.SortBy, params.Status != nil)
+}
+
+// DatabaseClusterRestoreOptions returns the options of a database cluster restore list request.
+func DatabaseClusterRestoreOptions(params *api.ListDatabaseClusterRestoresParams) Options {
+	if params == nil {
+		return Options{}
+	}
+	return NewOptions(params.Limit, params.Continue, params.LabelSelector, params.SortBy, params.Status != nil)
+}
+
+// BackupStorageOptions returns the options of a backup storage list request.
+func BackupStorageOptions(params *api.ListBackupStoragesParams) Options {
+	if params == nil {
+		return Options{}
+	}
+	return NewOptions(nil, nil, params.LabelSelector, nil, false)
+}
+
+// MonitoringInstanceOptions returns the options of a monitoring instance list request.
+func MonitoringInstanceOptions(params *api.ListMonitoringInstancesParams) Options {
+	if params == nil {
+		return Options{}
+	}
+	return NewOptions(nil, nil, params.LabelSelector, nil, false)
+}
+
+// DatabaseClusterSortKeys are the fields database clusters can be sorted by.
+//
+//nolint:gochecknoglobals
+var DatabaseClusterSortKeys = map[string]SortKey[everestv1alpha1.DatabaseCluster]{
+	"name": func(a, b *everestv1alpha1.DatabaseCluster) int { return ByName(a, b) },
+	"creationTimestamp": func(a, b *everestv1alpha1.DatabaseCluster) int {
+		return ByCreationTimestamp(a, b)
+	},
+	"engineType": func(a, b *everestv1alpha1.DatabaseCluster) int {
+		if c := strings.Compare(string(a.Spec.Engine.Type), string(b.Spec.Engine.Type)); c != 0 {
+			return c
+		}
+		return ByName(a, b)
+	},
+	"status": func(a, b *everestv1alpha1.DatabaseCluster) int {
+		if c := strings.Compare(string(a.Status.Status), string(b.Status.Status)); c != 0 {
+			return c
+		}
+		return ByName(a, b)
+	},
+}
+
+// DatabaseClusterBackupSortKeys are the fields database cluster backups can be sorted by.
+//
+//nolint:gochecknoglobals
+var DatabaseClusterBackupSortKeys = map[string]SortKey[everestv1alpha1.DatabaseClusterBackup]{
+	"name": func(a, b *everestv1alpha1.DatabaseClusterBackup) int { return ByName(a, b) },
+	"creationTimestamp": func(a, b *everestv1alpha1.DatabaseClusterBackup) int {
+		return ByCreationTimestamp(a, b)
+	},
+	"status": func(a, b *everestv1alpha1.DatabaseClusterBackup) int {
+		if c := strings.Compare(string(a.Status.State), string(b.Status.State)); c != 0 {
+			return c
+		}
+		return ByName(a, b)
+	},
+}
+
+// DatabaseClusterRestoreSortKeys are the fields database cluster restores can be sorted by.
+//
+//nolint:gochecknoglobals
+var DatabaseClusterRestoreSortKeys = map[string]SortKey[everestv1alpha1.DatabaseClusterRestore]{
+	"name": func(a, b *everestv1alpha1.DatabaseClusterRestore) int { return ByName(a, b) },
+	"creationTimestamp": func(a, b *everestv1alpha1.DatabaseClusterRestore) int {
+		return ByCreationTimestamp(a, b)
+	},
+	"status": func(a, b *everestv1alpha1.DatabaseClusterRestore) int {
+		if c := strings.Compare(string(a.Status.State), string(b.Status.State)); c != 0 {
+			return c
+		}
+		return ByName(a, b)
+	},
+}
@@ -0,0 +1,254 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package quota provides Everest-level resource quotas for DB namespaces.
+package quota
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/convertors"
+)
+
+// ErrQuotaExceeded is returned when a request would exceed the namespace quota.
+var ErrQuotaExceeded = errors.New("namespace quota exceeded")
+
+// Quota defines the limits for a DB namespace managed by Everest.
+// Empty fields mean that the corresponding resource is not limited.
+type Quota struct {
+	// MaxDatabaseClusters is the maximum number of database clusters in the namespace.
+	MaxDatabaseClusters *int `json:"maxDatabaseClusters,omitempty" yaml:"maxDatabaseClusters,omitempty"`
+	// CPU is the total amount of CPU that can be requested by all database clusters.
+	CPU string `json:"cpu,omitempty" yaml:"cpu,omitempty"`
+	// Memory is the total amount of memory that can be requested by all database clusters.
+	Memory string `json:"memory,omitempty" yaml:"memory,omitempty"`
+	// Disk is the total amount of storage that can be requested by all database clusters.
+	Disk string `json:"disk,omitempty" yaml:"disk,omitempty"`
+	// MaxBackups is the maximum number of database cluster backups in the namespace.
+	MaxBackups *int `json:"maxBackups,omitempty" yaml:"maxBackups,omitempty"`
+	// AllowedEngines is the list of engine types that can be used in the namespace.
+	AllowedEngines []everestv1alpha1.EngineType `json:"allowedEngines,omitempty" yaml:"allowedEngines,omitempty"`
+}
+
+// Usage represents the amount of resources consumed in a namespace.
+type Usage struct {
+	// DatabaseClusters is the number of database clusters.
+	DatabaseClusters int
+	// MilliCPU is the total amount of CPU in millicpus.
+	MilliCPU uint64
+	// MemoryBytes is the total amount of memory in bytes.
+	MemoryBytes uint64
+	// DiskBytes is the total amount of storage in bytes.
+	DiskBytes uint64
+}
+
+// Parse parses the raw YAML (or JSON) representation of a quota.
+func Parse(raw []byte) (*Quota, error) {
+	q := &Quota{}
+	if err := yaml.Unmarshal(raw, q); err != nil {
+		return nil, fmt.Errorf("failed to parse quota: %w", err)
+	}
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// FromNamespace returns the quota configured for the given namespace.
+// Returns nil if the namespace has no quota.
+func FromNamespace(ns *corev1.Namespace) (*Quota, error) {
+	raw, ok := ns.GetAnnotations()[common.EverestNamespaceQuotaAnnotation]
+	if !ok || raw == "" {
+		return nil, nil //nolint:nilnil
+	}
+	return Parse([]byte(raw))
+}
+
+// ApplyTo stores the quota in the annotations of the given namespace.
+func (q *Quota) ApplyTo(ns *corev1.Namespace) error {
+	raw, err := yaml.Marshal(q)
+	if err != nil {
+		return err
+	}
+	annotations := ns.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[common.EverestNamespaceQuotaAnnotation] = string(raw)
+	ns.SetAnnotations(annotations)
+	return nil
+}
+
+// Validate checks that the quota is well-formed.
+func (q *Quota) Validate() error {
+	if q.MaxDatabaseClusters != nil && *q.MaxDatabaseClusters < 0 {
+		return errors.New("maxDatabaseClusters cannot be negative")
+	}
+	if q.MaxBackups != nil && *q.MaxBackups < 0 {
+		return errors.New("maxBackups cannot be negative")
+	}
+	if _, err := convertors.StrToMilliCPU(q.CPU); err != nil {
+		return fmt.Errorf("invalid cpu quota '%s': %w", q.CPU, err)
+	}
+	if _, err := convertors.StrToBytes(q.Memory); err != nil {
+		return fmt.Errorf("invalid memory quota '%s': %w", q.Memory, err)
+	}
+	if _, err := convertors.StrToBytes(q.Disk); err != nil {
+		return fmt.Errorf("invalid disk quota '%s': %w", q.Disk, err)
+	}
+	for _, engine := range q.AllowedEngines {
+		if _, ok := common.OperatorTypeToName[engine]; !ok {
+			return fmt.Errorf("unsupported engine type '%s'", engine)
+		}
+	}
+	return nil
+}
+
+// CheckEngine returns an error if the given engine type is not allowed by the quota.
+func (q *Quota) CheckEngine(engine everestv1alpha1.EngineType) error {
+	if len(q.AllowedEngines) == 0 || slices.Contains(q.AllowedEngines, engine) {
+		return nil
+	}
+	return fmt.Errorf("%w: engine type '%s' is not allowed", ErrQuotaExceeded, engine)
+}
+
+// CheckBackups returns an error if creating one more backup exceeds the quota.
+func (q *Quota) CheckBackups(current int) error {
+	if q.MaxBackups != nil && current+1 > *q.MaxBackups {
+		return fmt.Errorf("%w: at most %d backups are allowed", ErrQuotaExceeded, *q.MaxBackups)
+	}
+	return nil
+}
+
+// Check returns an error if the requested usage exceeds the quota.
+// Only the resources that grow compared to the current usage are checked,
+// so that existing workloads can still be updated when the quota is lowered
+// below the current usage.
+func (q *Quota) Check(current, requested Usage) error {
+	if q.MaxDatabaseClusters != nil &&
+		requested.DatabaseClusters > current.DatabaseClusters &&
+		requested.DatabaseClusters > *q.MaxDatabaseClusters {
+		return fmt.Errorf("%w: at most %d database clusters are allowed", ErrQuotaExceeded, *q.MaxDatabaseClusters)
+	}
+
+	// Errors are ignored because the quota is validated on parsing.
+	cpu, _ := convertors.StrToMilliCPU(q.CPU)
+	if cpu > 0 && requested.MilliCPU > current.MilliCPU && requested.MilliCPU > cpu {
+		return fmt.Errorf("%w: requested cpu %s exceeds the limit %s",
+			ErrQuotaExceeded, FormatMilliCPU(requested.MilliCPU), q.CPU)
+	}
+	memory, _ := convertors.StrToBytes(q.Memory)
+	if memory > 0 && requested.MemoryBytes > current.MemoryBytes && requested.MemoryBytes > memory {
+		return fmt.Errorf("%w: requested memory %s exceeds the limit %s",
+			ErrQuotaExceeded, FormatBytes(requested.MemoryBytes), q.Memory)
+	}
+	disk, _ := convertors.StrToBytes(q.Disk)
+	if disk > 0 && requested.DiskBytes > current.DiskBytes && requested.DiskBytes > disk {
+		return fmt.Errorf("%w: requested disk %s exceeds the limit %s",
+			ErrQuotaExceeded, FormatBytes(requested.DiskBytes), q.Disk)
+	}
+	return nil
+}
+
+// Add returns the sum of two usages.
+func (u Usage) Add(o Usage) Usage {
+	return Usage{
+		DatabaseClusters: u.DatabaseClusters + o.DatabaseClusters,
+		MilliCPU:         u.MilliCPU + o.MilliCPU,
+		MemoryBytes:      u.MemoryBytes + o.MemoryBytes,
+		DiskBytes:        u.DiskBytes + o.DiskBytes,
+	}
+}
+
+// DatabaseClustersUsage returns the total usage of the given database clusters.
+func DatabaseClustersUsage(dbs ...everestv1alpha1.DatabaseCluster) (Usage, error) {
+	total := Usage{}
+	for _, db := range dbs {
+		u, err := DatabaseClusterUsage(&db)
+		if err != nil {
+			return Usage{}, err
+		}
+		total = total.Add(u)
+	}
+	return total, nil
+}
+
+// DatabaseClusterUsage returns the resources requested by the given database cluster.
+// Paused clusters do not consume CPU and memory, but they keep their storage.
+func DatabaseClusterUsage(db *everestv1alpha1.DatabaseCluster) (Usage, error) {
+	nodes := uint64(max(db.Spec.Engine.Replicas, 1))
+	if db.Spec.Sharding != nil && db.Spec.Sharding.Enabled {
+		nodes = nodes*uint64(max(db.Spec.Sharding.Shards, 1)) + uint64(max(db.Spec.Sharding.ConfigServer.Replicas, 0))
+	}
+
+	diskPerNode, err := convertors.StrToBytes(db.Spec.Engine.Storage.Size.String())
+	if err != nil {
+		return Usage{}, err
+	}
+	usage := Usage{
+		DatabaseClusters: 1,
+		DiskBytes:        diskPerNode * nodes,
+	}
+	if db.Spec.Paused {
+		return usage, nil
+	}
+
+	cpu, mem, err := resourcesUsage(db.Spec.Engine.Resources, nodes)
+	if err != nil {
+		return Usage{}, err
+	}
+	usage.MilliCPU += cpu
+	usage.MemoryBytes += mem
+
+	if proxyReplicas := db.Spec.Proxy.Replicas; proxyReplicas != nil && *proxyReplicas > 0 {
+		cpu, mem, err := resourcesUsage(db.Spec.Proxy.Resources, uint64(*proxyReplicas))
+		if err != nil {
+			return Usage{}, err
+		}
+		usage.MilliCPU += cpu
+		usage.MemoryBytes += mem
+	}
+	return usage, nil
+}
+
+func resourcesUsage(res everestv1alpha1.Resources, replicas uint64) (uint64, uint64, error) {
+	cpu, err := convertors.StrToMilliCPU(res.CPU.String())
+	if err != nil {
+		return 0, 0, err
+	}
+	mem, err := convertors.StrToBytes(res.Memory.String())
+	if err != nil {
+		return 0, 0, err
+	}
+	return cpu * replicas, mem * replicas, nil
+}
+
+// FormatMilliCPU formats millicpus as a Kubernetes quantity.
+func FormatMilliCPU(m uint64) string {
+	return resource.NewMilliQuantity(int64(m), resource.DecimalSI).String() //nolint:gosec
+}
+
+// FormatBytes formats bytes as a Kubernetes quantity.
+func FormatBytes(b uint64) string {
+	return resource.NewQuantity(int64(b), resource.BinarySI).String() //nolint:gosec
+}
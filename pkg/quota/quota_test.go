@@ -0,0 +1,177 @@
+package quota
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name    string
+		raw     string
+		want    *Quota
+		wantErr bool
+	}{
+		{
+			name: "full quota",
+			raw: `
+maxDatabaseClusters: 5
+cpu: "16"
+memory: 64Gi
+disk: 1Ti
+maxBackups: 100
+allowedEngines: [pxc, psmdb]
+`,
+			want: &Quota{
+				MaxDatabaseClusters: pointer.ToInt(5),
+				CPU:                 "16",
+				Memory:              "64Gi",
+				Disk:                "1Ti",
+				MaxBackups:          pointer.ToInt(100),
+				AllowedEngines:      []everestv1alpha1.EngineType{everestv1alpha1.DatabaseEnginePXC, everestv1alpha1.DatabaseEnginePSMDB},
+			},
+		},
+		{
+			name: "json quota",
+			raw:  `{"cpu": "500m"}`,
+			want: &Quota{CPU: "500m"},
+		},
+		{
+			name:    "invalid memory",
+			raw:     `memory: 10Zi`,
+			wantErr: true,
+		},
+		{
+			name:    "negative clusters",
+			raw:     `maxDatabaseClusters: -1`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown engine",
+			raw:     `allowedEngines: [oracle]`,
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			q, err := Parse([]byte(tc.raw))
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, q)
+		})
+	}
+}
+
+func TestDatabaseClusterUsage(t *testing.T) {
+	t.Parallel()
+	newDB := func(replicas int32, paused bool) everestv1alpha1.DatabaseCluster {
+		return everestv1alpha1.DatabaseCluster{
+			Spec: everestv1alpha1.DatabaseClusterSpec{
+				Paused: paused,
+				Engine: everestv1alpha1.Engine{
+					Replicas: replicas,
+					Storage:  everestv1alpha1.Storage{Size: resource.MustParse("10Gi")},
+					Resources: everestv1alpha1.Resources{
+						CPU:    resource.MustParse("1"),
+						Memory: resource.MustParse("2Gi"),
+					},
+				},
+				Proxy: everestv1alpha1.Proxy{
+					Replicas: pointer.ToInt32(2),
+					Resources: everestv1alpha1.Resources{
+						CPU:    resource.MustParse("200m"),
+						Memory: resource.MustParse("256Mi"),
+					},
+				},
+			},
+		}
+	}
+
+	usage, err := DatabaseClustersUsage(newDB(3, false), newDB(1, true))
+	require.NoError(t, err)
+	assert.Equal(t, Usage{
+		DatabaseClusters: 2,
+		MilliCPU:         3*1000 + 2*200,
+		MemoryBytes:      3*2*1024*1024*1024 + 2*256*1024*1024,
+		DiskBytes:        4 * 10 * 1024 * 1024 * 1024,
+	}, usage)
+}
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+	q := &Quota{
+		MaxDatabaseClusters: pointer.ToInt(2),
+		CPU:                 "4",
+		Memory:              "8Gi",
+		Disk:                "100Gi",
+	}
+	gi := uint64(1024 * 1024 * 1024)
+	testCases := []struct {
+		name      string
+		current   Usage
+		requested Usage
+		wantErr   bool
+	}{
+		{
+			name:      "within quota",
+			requested: Usage{DatabaseClusters: 2, MilliCPU: 4000, MemoryBytes: 8 * gi, DiskBytes: 100 * gi},
+		},
+		{
+			name:      "too many clusters",
+			current:   Usage{DatabaseClusters: 2},
+			requested: Usage{DatabaseClusters: 3},
+			wantErr:   true,
+		},
+		{
+			name:      "too much cpu",
+			requested: Usage{DatabaseClusters: 1, MilliCPU: 4001},
+			wantErr:   true,
+		},
+		{
+			name:      "too much memory",
+			requested: Usage{DatabaseClusters: 1, MemoryBytes: 9 * gi},
+			wantErr:   true,
+		},
+		{
+			name:      "too much disk",
+			requested: Usage{DatabaseClusters: 1, DiskBytes: 101 * gi},
+			wantErr:   true,
+		},
+		{
+			name:      "already over quota but not growing",
+			current:   Usage{DatabaseClusters: 3, MilliCPU: 8000},
+			requested: Usage{DatabaseClusters: 3, MilliCPU: 7000},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := q.Check(tc.current, tc.requested)
+			if tc.wantErr {
+				assert.True(t, errors.Is(err, ErrQuotaExceeded))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCheckEngine(t *testing.T) {
+	t.Parallel()
+	q := &Quota{AllowedEngines: []everestv1alpha1.EngineType{everestv1alpha1.DatabaseEnginePostgresql}}
+	require.NoError(t, q.CheckEngine(everestv1alpha1.DatabaseEnginePostgresql))
+	require.ErrorIs(t, q.CheckEngine(everestv1alpha1.DatabaseEnginePXC), ErrQuotaExceeded)
+	require.NoError(t, (&Quota{}).CheckEngine(everestv1alpha1.DatabaseEnginePXC))
+}
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"io"
 	"io/fs"
+	"maps"
 	"os"
 	"slices"
 	"strings"
@@ -42,19 +43,23 @@ import (
 	configmapadapter "github.com/percona/everest/pkg/rbac/configmap-adapter"
 	readeradapter "github.com/percona/everest/pkg/rbac/io-reader-adapter"
 	"github.com/percona/everest/pkg/session"
+	"github.com/percona/everest/pkg/userlabels"
 )
 
 // Everest API resource names.
 const (
 	ResourceBackupStorages             = "backup-storages"
+	ResourceChangeRequests             = "change-requests"
 	ResourceDatabaseClusters           = "database-clusters"
 	ResourceDatabaseClusterBackups     = "database-cluster-backups"
 	ResourceDatabaseClusterCredentials = "database-cluster-credentials"
 	ResourceDatabaseClusterRestores    = "database-cluster-restores"
+	ResourceDatabaseClusterSchedules   = "database-cluster-schedules"
 	ResourceDatabaseEngines            = "database-engines"
 	ResourceLoadBalancerConfigs        = "load-balancer-configs"
 	ResourceMonitoringInstances        = "monitoring-instances"
 	ResourceNamespaces                 = "namespaces"
+	ResourceOperations                 = "operations"
 	ResourcePodSchedulingPolicies      = "pod-scheduling-policies"
 	ResourceDataImporters              = "data-importers"
 	ResourceDataImportJobs             = "data-import-jobs"
@@ -83,18 +88,19 @@ func IsGlobalResource(resource string) bool {
 
 // RBAC actions.
 const (
-	ActionCreate = "create"
-	ActionRead   = "read"
-	ActionUpdate = "update"
-	ActionDelete = "delete"
-	ActionAll    = "*"
+	ActionCreate  = "create"
+	ActionRead    = "read"
+	ActionUpdate  = "update"
+	ActionDelete  = "delete"
+	ActionApprove = "approve"
+	ActionAll     = "*"
 )
 
 const (
 	rbacEnabledValueTrue = "true"
 )
 
-var SupportedActions = []string{ActionCreate, ActionRead, ActionUpdate, ActionDelete, ActionAll}
+var SupportedActions = []string{ActionCreate, ActionRead, ActionUpdate, ActionDelete, ActionApprove, ActionAll}
 
 type User struct {
 	Subject string
@@ -210,8 +216,24 @@ func NewEnforcer(ctx context.Context, kubeConnector kubernetes.KubernetesConnect
 	return enforcer, nil
 }
 
+// systemUserCtxKey is the context key of the system user.
+type systemUserCtxKey struct{}
+
+// WithSystemUser returns a context that acts as the Everest system user with the given name.
+// The system user has the admin role. It is used by the background jobs that go through the
+// API handlers, so they are subject to the same validation as requests of users.
+func WithSystemUser(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, systemUserCtxKey{}, User{
+		Subject: "system:" + name,
+		Groups:  []string{common.EverestAdminRole},
+	})
+}
+
 // GetUser extracts the user from the JWT token in the context.
 func GetUser(ctx context.Context) (User, error) {
+	if user, ok := ctx.Value(systemUserCtxKey{}).(User); ok {
+		return user, nil
+	}
 	token, ok := ctx.Value(common.UserCtxKey).(*jwt.Token)
 	if !ok {
 		return User{}, errors.New("failed to get token from context")
@@ -363,6 +385,22 @@ func ObjectName(args ...string) string {
 	return strings.Join(args, "/")
 }
 
+// labelObjectPrefix is the prefix of the object names that match objects by their labels.
+const labelObjectPrefix = "label:"
+
+// LabelObjectNames returns the names that match an object with the given labels in policies,
+// one for each of its user-managed labels, in the `<namespace>/label:<key>=<value>` format.
+// For example, the policy `p, role:team-a, database-clusters, *, dev/label:team=a` grants
+// all permissions on the database clusters in the dev namespace with the label team=a.
+func LabelObjectNames(namespace string, labels map[string]string) []string {
+	userLabels := userlabels.Filter(labels)
+	names := make([]string, 0, len(userLabels))
+	for _, k := range slices.Sorted(maps.Keys(userLabels)) {
+		names = append(names, ObjectName(namespace, labelObjectPrefix+k+"="+userLabels[k]))
+	}
+	return names
+}
+
 // ValidateAction validates the action is supported.
 func ValidateAction(action string) bool {
 	return slices.Contains(SupportedActions, action)
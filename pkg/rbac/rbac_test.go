@@ -1,10 +1,14 @@
 package rbac
 
 import (
+	"context"
 	"testing"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/everest/pkg/common"
 )
 
 func TestGetScopeValues(t *testing.T) {
@@ -66,3 +70,29 @@ func TestGetScopeValues(t *testing.T) {
 		})
 	}
 }
+
+func TestGetUserSystemUser(t *testing.T) {
+	t.Parallel()
+
+	_, err := GetUser(context.Background())
+	require.Error(t, err)
+
+	user, err := GetUser(WithSystemUser(context.Background(), "scheduler"))
+	require.NoError(t, err)
+	assert.Equal(t, "system:scheduler", user.Subject)
+	assert.Equal(t, []string{common.EverestAdminRole}, user.Groups)
+}
+
+func TestLabelObjectNames(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{
+		"dev/label:example.com/cost-center=42",
+		"dev/label:team=a",
+	}, LabelObjectNames("dev", map[string]string{
+		"team":                    "a",
+		"example.com/cost-center": "42",
+		"clusterName":             "db",
+	}))
+	assert.Empty(t, LabelObjectNames("dev", nil))
+}
@@ -59,6 +59,20 @@ func ValidatePolicy(
 	return validatePolicy(enforcer)
 }
 
+// ValidatePolicyContent validates a policy in the CSV format.
+func ValidatePolicyContent(policy string) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Join(errPolicySyntax, fmt.Errorf("cannot create enforcer: %v", r))
+		}
+	}()
+	enforcer, err := NewIOReaderEnforcer(strings.NewReader(policy))
+	if err != nil {
+		return errors.Join(errPolicySyntax, err)
+	}
+	return validatePolicy(enforcer)
+}
+
 func checkResourceNames(policies [][]string) error {
 	resourcePathMap, _, err := buildPathResourceMap("")
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -63,6 +64,18 @@ func TestValidatePolicy(t *testing.T) {
 	}
 }
 
+func TestValidatePolicyContent(t *testing.T) {
+	t.Parallel()
+
+	good, err := os.ReadFile("./testdata/policy-1-good.csv")
+	assert.NoError(t, err)
+	assert.NoError(t, ValidatePolicyContent(string(good)))
+
+	bad, err := os.ReadFile("./testdata/policy-2-bad.csv")
+	assert.NoError(t, err)
+	assert.ErrorIs(t, ValidatePolicyContent(string(bad)), errPolicySyntax)
+}
+
 func TestCheckResourceNames(t *testing.T) {
 	t.Parallel()
 	testcases := []struct {
@@ -0,0 +1,255 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package schedule provides scheduled actions on database clusters.
+//
+// A schedule pauses, resumes or scales a database cluster at the times given
+// by a cron expression evaluated in a time zone. Schedules are stored in
+// ConfigMaps in the namespace of the database cluster and executed by the
+// scheduler of the API server, which records the executed actions in the
+// status of the schedule.
+package schedule
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/robfig/cron/v3"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/common"
+)
+
+const (
+	// MaxHistory is the number of executions kept in the status of a schedule.
+	MaxHistory = 10
+
+	configMapNamePrefix = "everest-schedule."
+	configMapDataKey    = "schedule"
+)
+
+var (
+	// ErrNotSchedule is returned when a ConfigMap does not hold a database cluster schedule.
+	ErrNotSchedule = errors.New("not a database cluster schedule")
+	// ErrScaleRequired is returned when a scale schedule does not specify what to scale.
+	ErrScaleRequired = errors.New("scale action requires at least one of engineReplicas, proxyReplicas, engineCPU or engineMemory")
+)
+
+//nolint:gochecknoglobals
+var parser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// Validate validates the schedule.
+func Validate(s *api.DatabaseClusterSchedule) error {
+	if _, err := parser.Parse(s.Cron); err != nil {
+		return fmt.Errorf("invalid cron expression '%s': %w", s.Cron, err)
+	}
+	if _, err := Location(s); err != nil {
+		return err
+	}
+	switch s.Action {
+	case api.Pause, api.Resume:
+		return nil
+	case api.Scale:
+		return validateScale(s.Scale)
+	default:
+		return fmt.Errorf("unsupported action '%s'", s.Action)
+	}
+}
+
+func validateScale(scale *api.DatabaseClusterScheduleScale) error {
+	if scale == nil ||
+		(scale.EngineReplicas == nil && scale.ProxyReplicas == nil &&
+			scale.EngineCPU == nil && scale.EngineMemory == nil) {
+		return ErrScaleRequired
+	}
+	if scale.EngineReplicas != nil && *scale.EngineReplicas < 1 {
+		return errors.New("engineReplicas must be greater than 0")
+	}
+	if scale.ProxyReplicas != nil && *scale.ProxyReplicas < 0 {
+		return errors.New("proxyReplicas must not be negative")
+	}
+	if scale.EngineCPU != nil {
+		if _, err := resource.ParseQuantity(*scale.EngineCPU); err != nil {
+			return fmt.Errorf("invalid engineCPU '%s': %w", *scale.EngineCPU, err)
+		}
+	}
+	if scale.EngineMemory != nil {
+		if _, err := resource.ParseQuantity(*scale.EngineMemory); err != nil {
+			return fmt.Errorf("invalid engineMemory '%s': %w", *scale.EngineMemory, err)
+		}
+	}
+	return nil
+}
+
+// Location returns the time zone the cron expression of the schedule is evaluated in.
+func Location(s *api.DatabaseClusterSchedule) (*time.Location, error) {
+	tz := pointer.Get(s.TimeZone)
+	if tz == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time zone '%s': %w", tz, err)
+	}
+	return loc, nil
+}
+
+// Next returns the first time the schedule fires after the given time.
+func Next(s *api.DatabaseClusterSchedule, after time.Time) (time.Time, error) {
+	sched, err := parser.Parse(s.Cron)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid cron expression '%s': %w", s.Cron, err)
+	}
+	loc, err := Location(s)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return sched.Next(after.In(loc)).UTC(), nil
+}
+
+// IsEnabled returns true if the schedule is enabled.
+func IsEnabled(s *api.DatabaseClusterSchedule) bool {
+	return s.Enabled == nil || *s.Enabled
+}
+
+// IsDue returns true if the schedule has fired since it was last evaluated.
+// Runs missed while the API server was unavailable are executed once.
+func IsDue(s *api.DatabaseClusterSchedule, now time.Time) (bool, error) {
+	if !IsEnabled(s) || s.Status == nil || s.Status.LastScheduleTime == nil {
+		return false, nil
+	}
+	next, err := Next(s, *s.Status.LastScheduleTime)
+	if err != nil {
+		return false, err
+	}
+	return !now.Before(next), nil
+}
+
+// Reset starts evaluating the schedule from the given time, keeping its history.
+func Reset(s *api.DatabaseClusterSchedule, now time.Time) error {
+	if s.Status == nil {
+		s.Status = &api.DatabaseClusterScheduleStatus{}
+	}
+	next, err := Next(s, now)
+	if err != nil {
+		return err
+	}
+	s.Status.LastScheduleTime = pointer.To(now.UTC())
+	s.Status.NextRunTime = pointer.To(next)
+	return nil
+}
+
+// RecordRun records the execution of the schedule at the given time.
+// A nil runErr records a successful execution.
+func RecordRun(s *api.DatabaseClusterSchedule, now time.Time, runErr error) error {
+	run := api.DatabaseClusterScheduleRun{
+		Time:   now.UTC(),
+		Action: string(s.Action),
+		Result: api.Succeeded,
+	}
+	if runErr != nil {
+		run.Result = api.Failed
+		run.Message = pointer.ToString(runErr.Error())
+	}
+	if err := Reset(s, now); err != nil {
+		return err
+	}
+	history := append([]api.DatabaseClusterScheduleRun{run}, pointer.Get(s.Status.History)...)
+	if len(history) > MaxHistory {
+		history = history[:MaxHistory]
+	}
+	s.Status.History = &history
+	return nil
+}
+
+// Apply applies the action of the schedule to the database cluster.
+func Apply(db *everestv1alpha1.DatabaseCluster, s *api.DatabaseClusterSchedule) error {
+	switch s.Action {
+	case api.Pause:
+		db.Spec.Paused = true
+	case api.Resume:
+		db.Spec.Paused = false
+	case api.Scale:
+		if err := validateScale(s.Scale); err != nil {
+			return err
+		}
+		if s.Scale.EngineReplicas != nil {
+			db.Spec.Engine.Replicas = *s.Scale.EngineReplicas
+		}
+		if s.Scale.ProxyReplicas != nil {
+			db.Spec.Proxy.Replicas = pointer.ToInt32(*s.Scale.ProxyReplicas)
+		}
+		if s.Scale.EngineCPU != nil {
+			db.Spec.Engine.Resources.CPU = resource.MustParse(*s.Scale.EngineCPU)
+		}
+		if s.Scale.EngineMemory != nil {
+			db.Spec.Engine.Resources.Memory = resource.MustParse(*s.Scale.EngineMemory)
+		}
+	default:
+		return fmt.Errorf("unsupported action '%s'", s.Action)
+	}
+	return nil
+}
+
+// ConfigMapName returns the name of the ConfigMap that stores the schedule
+// of the given database cluster. Database cluster and schedule names cannot
+// contain dots, so the name is unambiguous.
+func ConfigMapName(dbName, name string) string {
+	return configMapNamePrefix + dbName + "." + name
+}
+
+// ToConfigMap returns the ConfigMap that stores the given schedule.
+func ToConfigMap(s *api.DatabaseClusterSchedule) (*corev1.ConfigMap, error) {
+	dbName := pointer.Get(s.DbClusterName)
+	raw, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ConfigMapName(dbName, s.Name),
+			Namespace: pointer.Get(s.Namespace),
+			Labels: map[string]string{
+				common.EverestDatabaseClusterScheduleLabel: dbName,
+			},
+		},
+		Data: map[string]string{
+			configMapDataKey: string(raw),
+		},
+	}, nil
+}
+
+// FromConfigMap returns the schedule stored in the given ConfigMap.
+func FromConfigMap(cm *corev1.ConfigMap) (*api.DatabaseClusterSchedule, error) {
+	raw, ok := cm.Data[configMapDataKey]
+	dbName, labeled := cm.GetLabels()[common.EverestDatabaseClusterScheduleLabel]
+	if !ok || !labeled {
+		return nil, ErrNotSchedule
+	}
+	s := &api.DatabaseClusterSchedule{}
+	if err := json.Unmarshal([]byte(raw), s); err != nil {
+		return nil, fmt.Errorf("failed to parse database cluster schedule: %w", err)
+	}
+	s.Namespace = pointer.ToString(cm.GetNamespace())
+	s.DbClusterName = pointer.ToString(dbName)
+	return s, nil
+}
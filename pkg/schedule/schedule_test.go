@@ -0,0 +1,170 @@
+package schedule
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/api"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		schedule api.DatabaseClusterSchedule
+		wantErr  bool
+	}{
+		{
+			name:     "pause",
+			schedule: api.DatabaseClusterSchedule{Cron: "0 20 * * 1-5", Action: api.Pause},
+		},
+		{
+			name:     "resume with time zone",
+			schedule: api.DatabaseClusterSchedule{Cron: "0 8 * * 1-5", Action: api.Resume, TimeZone: pointer.ToString("Europe/Berlin")},
+		},
+		{
+			name: "scale",
+			schedule: api.DatabaseClusterSchedule{
+				Cron:   "@daily",
+				Action: api.Scale,
+				Scale:  &api.DatabaseClusterScheduleScale{EngineReplicas: pointer.ToInt32(3), EngineCPU: pointer.ToString("2")},
+			},
+		},
+		{
+			name:     "invalid cron",
+			schedule: api.DatabaseClusterSchedule{Cron: "every day", Action: api.Pause},
+			wantErr:  true,
+		},
+		{
+			name:     "cron with seconds",
+			schedule: api.DatabaseClusterSchedule{Cron: "0 0 8 * * *", Action: api.Pause},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid time zone",
+			schedule: api.DatabaseClusterSchedule{Cron: "0 8 * * *", Action: api.Pause, TimeZone: pointer.ToString("Mars/Olympus")},
+			wantErr:  true,
+		},
+		{
+			name:     "scale without target",
+			schedule: api.DatabaseClusterSchedule{Cron: "0 8 * * *", Action: api.Scale},
+			wantErr:  true,
+		},
+		{
+			name: "scale to zero engine replicas",
+			schedule: api.DatabaseClusterSchedule{
+				Cron:   "0 8 * * *",
+				Action: api.Scale,
+				Scale:  &api.DatabaseClusterScheduleScale{EngineReplicas: pointer.ToInt32(0)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid memory",
+			schedule: api.DatabaseClusterSchedule{
+				Cron:   "0 8 * * *",
+				Action: api.Scale,
+				Scale:  &api.DatabaseClusterScheduleScale{EngineMemory: pointer.ToString("lots")},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := Validate(&tc.schedule)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestNextHonoursTimeZone(t *testing.T) {
+	t.Parallel()
+
+	s := &api.DatabaseClusterSchedule{Cron: "0 8 * * *", TimeZone: pointer.ToString("Europe/Berlin")}
+	next, err := Next(s, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	// 08:00 CET is 07:00 UTC.
+	assert.Equal(t, time.Date(2025, 1, 1, 7, 0, 0, 0, time.UTC), next)
+}
+
+func TestIsDueAndRecordRun(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := &api.DatabaseClusterSchedule{Cron: "0 8 * * *", Action: api.Resume}
+
+	// Schedules are not evaluated before they are initialised.
+	due, err := IsDue(s, created.Add(24*time.Hour))
+	require.NoError(t, err)
+	assert.False(t, due)
+
+	require.NoError(t, Reset(s, created))
+	assert.Equal(t, time.Date(2025, 1, 1, 8, 0, 0, 0, time.UTC), *s.Status.NextRunTime)
+
+	due, err = IsDue(s, created.Add(7*time.Hour))
+	require.NoError(t, err)
+	assert.False(t, due)
+	due, err = IsDue(s, created.Add(8*time.Hour))
+	require.NoError(t, err)
+	assert.True(t, due)
+
+	// Disabled schedules are never due.
+	s.Enabled = pointer.ToBool(false)
+	due, err = IsDue(s, created.Add(8*time.Hour))
+	require.NoError(t, err)
+	assert.False(t, due)
+	s.Enabled = nil
+
+	require.NoError(t, RecordRun(s, created.Add(8*time.Hour), nil))
+	require.NoError(t, RecordRun(s, created.Add(32*time.Hour), errors.New("boom")))
+	history := pointer.Get(s.Status.History)
+	require.Len(t, history, 2)
+	assert.Equal(t, api.Failed, history[0].Result)
+	assert.Equal(t, "boom", pointer.Get(history[0].Message))
+	assert.Equal(t, api.Succeeded, history[1].Result)
+	due, err = IsDue(s, created.Add(32*time.Hour))
+	require.NoError(t, err)
+	assert.False(t, due)
+
+	// The history is bounded.
+	for i := range 2 * MaxHistory {
+		require.NoError(t, RecordRun(s, created.Add(time.Duration(i)*time.Hour), nil))
+	}
+	assert.Len(t, pointer.Get(s.Status.History), MaxHistory)
+}
+
+func TestApply(t *testing.T) {
+	t.Parallel()
+
+	db := &everestv1alpha1.DatabaseCluster{}
+	require.NoError(t, Apply(db, &api.DatabaseClusterSchedule{Action: api.Pause}))
+	assert.True(t, db.Spec.Paused)
+	require.NoError(t, Apply(db, &api.DatabaseClusterSchedule{Action: api.Resume}))
+	assert.False(t, db.Spec.Paused)
+
+	require.NoError(t, Apply(db, &api.DatabaseClusterSchedule{
+		Action: api.Scale,
+		Scale: &api.DatabaseClusterScheduleScale{
+			EngineReplicas: pointer.ToInt32(1),
+			ProxyReplicas:  pointer.ToInt32(0),
+			EngineMemory:   pointer.ToString("1G"),
+		},
+	}))
+	assert.Equal(t, int32(1), db.Spec.Engine.Replicas)
+	assert.Equal(t, int32(0), pointer.Get(db.Spec.Proxy.Replicas))
+	assert.True(t, resource.MustParse("1G").Equal(db.Spec.Engine.Resources.Memory))
+	assert.True(t, db.Spec.Engine.Resources.CPU.IsZero())
+}
@@ -0,0 +1,121 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package softdelete provides deletion protection and soft-delete for database clusters.
+//
+// A soft-deleted database cluster is paused and retained together with its
+// backups until its retention period is over. Until then, it can be restored.
+// The state is kept in the annotations of the DatabaseCluster.
+package softdelete
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/pkg/common"
+)
+
+var (
+	// ErrDeletionProtected is returned when deleting a database cluster that has deletion protection enabled.
+	ErrDeletionProtected = errors.New("database cluster has deletion protection enabled")
+	// ErrDeleted is returned when modifying a soft-deleted database cluster.
+	ErrDeleted = errors.New("database cluster is deleted, restore it first")
+	// ErrNotDeleted is returned when restoring or purging a database cluster that is not soft-deleted.
+	ErrNotDeleted = errors.New("database cluster is not deleted")
+	// ErrInvalidDeletionProtection is returned when the deletion protection annotation is not a boolean.
+	ErrInvalidDeletionProtection = errors.New("deletion protection must be either 'true' or 'false'")
+)
+
+// ValidateDeletionProtection checks that the deletion protection of the database cluster,
+// if set, is either "true" or "false".
+func ValidateDeletionProtection(db *everestv1alpha1.DatabaseCluster) error {
+	v, ok := db.GetAnnotations()[common.DeletionProtectionAnnotation]
+	if !ok || v == "true" || v == "false" {
+		return nil
+	}
+	return ErrInvalidDeletionProtection
+}
+
+// IsDeletionProtected returns true if the database cluster has deletion protection enabled.
+func IsDeletionProtected(db *everestv1alpha1.DatabaseCluster) bool {
+	protected, _ := strconv.ParseBool(db.GetAnnotations()[common.DeletionProtectionAnnotation])
+	return protected
+}
+
+// SetDeletionProtection enables or disables the deletion protection of the database cluster.
+func SetDeletionProtection(db *everestv1alpha1.DatabaseCluster, enabled bool) {
+	annotations := db.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[common.DeletionProtectionAnnotation] = strconv.FormatBool(enabled)
+	db.SetAnnotations(annotations)
+}
+
+// IsDeleted returns true if the database cluster is soft-deleted.
+func IsDeleted(db *everestv1alpha1.DatabaseCluster) bool {
+	_, ok := db.GetAnnotations()[common.SoftDeletedAtAnnotation]
+	return ok
+}
+
+// MarkDeleted soft-deletes the database cluster: it is paused and
+// scheduled to be purged after the retention period.
+func MarkDeleted(db *everestv1alpha1.DatabaseCluster, retention time.Duration, cleanupBackupStorage bool, now time.Time) {
+	now = now.UTC()
+	annotations := db.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[common.SoftDeletedAtAnnotation] = now.Format(time.RFC3339)
+	annotations[common.SoftDeletePurgeAtAnnotation] = now.Add(retention).Format(time.RFC3339)
+	annotations[common.SoftDeleteCleanupBackupStorageAnnotation] = strconv.FormatBool(cleanupBackupStorage)
+	annotations[common.SoftDeletePausedAnnotation] = strconv.FormatBool(db.Spec.Paused)
+	db.SetAnnotations(annotations)
+	db.Spec.Paused = true
+}
+
+// Restore reverts MarkDeleted.
+func Restore(db *everestv1alpha1.DatabaseCluster) {
+	annotations := db.GetAnnotations()
+	paused, _ := strconv.ParseBool(annotations[common.SoftDeletePausedAnnotation])
+	db.Spec.Paused = paused
+	delete(annotations, common.SoftDeletedAtAnnotation)
+	delete(annotations, common.SoftDeletePurgeAtAnnotation)
+	delete(annotations, common.SoftDeleteCleanupBackupStorageAnnotation)
+	delete(annotations, common.SoftDeletePausedAnnotation)
+	db.SetAnnotations(annotations)
+}
+
+// CleanupBackupStorage returns true if the backed up data shall be removed
+// from storage when the soft-deleted database cluster is purged.
+func CleanupBackupStorage(db *everestv1alpha1.DatabaseCluster) bool {
+	cleanup, _ := strconv.ParseBool(db.GetAnnotations()[common.SoftDeleteCleanupBackupStorageAnnotation])
+	return cleanup
+}
+
+// ShouldPurge returns true if the database cluster is soft-deleted and its retention period is over.
+// Clusters with a missing or invalid purge time are never purged automatically.
+func ShouldPurge(db *everestv1alpha1.DatabaseCluster, now time.Time) bool {
+	if !IsDeleted(db) {
+		return false
+	}
+	purgeAt, err := time.Parse(time.RFC3339, db.GetAnnotations()[common.SoftDeletePurgeAtAnnotation])
+	if err != nil {
+		return false
+	}
+	return !now.Before(purgeAt)
+}
@@ -0,0 +1,86 @@
+package softdelete
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/pkg/common"
+)
+
+func TestSoftDelete(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	db := &everestv1alpha1.DatabaseCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "db",
+			Annotations: map[string]string{"foo": "bar"},
+		},
+	}
+	assert.False(t, IsDeleted(db))
+	assert.False(t, ShouldPurge(db, now))
+
+	MarkDeleted(db, time.Hour, true, now)
+	assert.True(t, IsDeleted(db))
+	assert.True(t, db.Spec.Paused)
+	assert.True(t, CleanupBackupStorage(db))
+	assert.False(t, ShouldPurge(db, now.Add(time.Minute)))
+	assert.True(t, ShouldPurge(db, now.Add(time.Hour)))
+
+	Restore(db)
+	assert.False(t, IsDeleted(db))
+	assert.False(t, db.Spec.Paused)
+	assert.Equal(t, map[string]string{"foo": "bar"}, db.GetAnnotations())
+}
+
+func TestSoftDeleteKeepsPausedState(t *testing.T) {
+	t.Parallel()
+
+	db := &everestv1alpha1.DatabaseCluster{
+		Spec: everestv1alpha1.DatabaseClusterSpec{Paused: true},
+	}
+	MarkDeleted(db, time.Hour, false, time.Now())
+	Restore(db)
+	assert.True(t, db.Spec.Paused)
+}
+
+func TestIsDeletionProtected(t *testing.T) {
+	t.Parallel()
+
+	db := &everestv1alpha1.DatabaseCluster{}
+	assert.False(t, IsDeletionProtected(db))
+	db.SetAnnotations(map[string]string{common.DeletionProtectionAnnotation: "true"})
+	assert.True(t, IsDeletionProtected(db))
+	db.SetAnnotations(map[string]string{common.DeletionProtectionAnnotation: "false"})
+	assert.False(t, IsDeletionProtected(db))
+	SetDeletionProtection(db, true)
+	assert.True(t, IsDeletionProtected(db))
+}
+
+func TestValidateDeletionProtection(t *testing.T) {
+	t.Parallel()
+
+	db := &everestv1alpha1.DatabaseCluster{}
+	require.NoError(t, ValidateDeletionProtection(db))
+	db.SetAnnotations(map[string]string{common.DeletionProtectionAnnotation: "true"})
+	require.NoError(t, ValidateDeletionProtection(db))
+	db.SetAnnotations(map[string]string{common.DeletionProtectionAnnotation: "yes"})
+	require.ErrorIs(t, ValidateDeletionProtection(db), ErrInvalidDeletionProtection)
+}
+
+func TestShouldPurgeInvalidTime(t *testing.T) {
+	t.Parallel()
+
+	db := &everestv1alpha1.DatabaseCluster{}
+	db.SetAnnotations(map[string]string{
+		common.SoftDeletedAtAnnotation:     "now",
+		common.SoftDeletePurgeAtAnnotation: "soon",
+	})
+	assert.True(t, IsDeleted(db))
+	assert.False(t, ShouldPurge(db, time.Now()))
+}
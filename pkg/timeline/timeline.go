@@ -0,0 +1,198 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package timeline provides the timeline of database clusters.
+//
+// Kubernetes keeps events for a limited time only, so the API server
+// periodically observes the status and the conditions of the database
+// clusters and records their transitions in a ConfigMap in the namespace of
+// each database cluster. Only the last MaxTransitions are kept.
+package timeline
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/common"
+)
+
+const (
+	// MaxTransitions is the number of transitions kept in the timeline of a database cluster.
+	MaxTransitions = 100
+	// StatusType is the type of the transitions of the status of a database cluster.
+	// The transitions of its conditions have the type of the condition.
+	StatusType = "Status"
+	// ReasonStatusChanged is the reason of the transitions of the status of a database cluster.
+	ReasonStatusChanged = "StatusChanged"
+
+	configMapNamePrefix = "everest-timeline."
+	configMapDataKey    = "timeline"
+)
+
+// ErrNotTimeline is returned when a ConfigMap does not hold a database cluster timeline.
+var ErrNotTimeline = errors.New("not a database cluster timeline")
+
+// Transition is a change of the status or of a condition of a database cluster.
+type Transition struct {
+	Time time.Time `json:"time"`
+	// Type is StatusType or the type of the condition.
+	Type     string `json:"type"`
+	Status   string `json:"status"`
+	Previous string `json:"previous,omitempty"`
+	Reason   string `json:"reason"`
+	Message  string `json:"message,omitempty"`
+}
+
+// Timeline holds the transitions of a database cluster, oldest first.
+type Timeline struct {
+	Transitions []Transition `json:"transitions"`
+	// Observed holds the status and the state of each condition of the
+	// database cluster when it was last observed.
+	Observed map[string]string `json:"observed,omitempty"`
+}
+
+// Observe records the transitions of the status and the conditions of the
+// database cluster since it was last observed. It returns true if any
+// transition has been recorded.
+func (t *Timeline) Observe(db *everestv1alpha1.DatabaseCluster, now time.Time) bool {
+	if t.Observed == nil {
+		t.Observed = map[string]string{}
+	}
+	now = now.UTC().Truncate(time.Second)
+	recorded := false
+
+	if status := string(db.Status.Status); status != "" && status != t.Observed[StatusType] {
+		t.add(Transition{
+			Time:     now,
+			Type:     StatusType,
+			Status:   status,
+			Previous: t.Observed[StatusType],
+			Reason:   ReasonStatusChanged,
+			Message:  db.Status.Message,
+		})
+		t.Observed[StatusType] = status
+		recorded = true
+	}
+
+	for _, c := range db.Status.Conditions {
+		state := string(c.Status) + "/" + c.Reason
+		if state == t.Observed[c.Type] {
+			continue
+		}
+		at := now
+		if !c.LastTransitionTime.IsZero() {
+			at = c.LastTransitionTime.UTC()
+		}
+		t.add(Transition{
+			Time:     at,
+			Type:     c.Type,
+			Status:   string(c.Status),
+			Previous: conditionStatus(t.Observed[c.Type]),
+			Reason:   c.Reason,
+			Message:  c.Message,
+		})
+		t.Observed[c.Type] = state
+		recorded = true
+	}
+	return recorded
+}
+
+func (t *Timeline) add(tr Transition) {
+	t.Transitions = append(t.Transitions, tr)
+	if len(t.Transitions) > MaxTransitions {
+		t.Transitions = t.Transitions[len(t.Transitions)-MaxTransitions:]
+	}
+}
+
+// conditionStatus returns the status of a condition from its observed state.
+func conditionStatus(state string) string {
+	status, _, _ := strings.Cut(state, "/")
+	return status
+}
+
+// Event returns the event of the timeline of the database cluster for the transition.
+func Event(dbName string, tr Transition) api.DatabaseClusterEvent {
+	event := api.DatabaseClusterEvent{
+		Time:   tr.Time,
+		Source: api.Timeline,
+		Type:   api.DatabaseClusterEventTypeNormal,
+		Reason: tr.Reason,
+		InvolvedObject: api.DatabaseClusterEventObject{
+			Kind: "DatabaseCluster",
+			Name: dbName,
+		},
+	}
+	switch {
+	case tr.Type == StatusType && tr.Previous == "":
+		event.Message = fmt.Sprintf("Status is '%s'", tr.Status)
+	case tr.Type == StatusType:
+		event.Message = fmt.Sprintf("Status changed from '%s' to '%s'", tr.Previous, tr.Status)
+	default:
+		event.Message = fmt.Sprintf("Condition %s is %s", tr.Type, tr.Status)
+	}
+	if tr.Message != "" {
+		event.Message += ": " + tr.Message
+	}
+	if tr.Type == StatusType && tr.Status == string(everestv1alpha1.AppStateError) {
+		event.Type = api.DatabaseClusterEventTypeWarning
+	}
+	return event
+}
+
+// ConfigMapName returns the name of the ConfigMap that stores the timeline of the database cluster.
+func ConfigMapName(dbName string) string {
+	return configMapNamePrefix + dbName
+}
+
+// ToConfigMap returns the ConfigMap that stores the timeline of the database cluster.
+func ToConfigMap(namespace, dbName string, t *Timeline) (*corev1.ConfigMap, error) {
+	raw, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ConfigMapName(dbName),
+			Namespace: namespace,
+			Labels: map[string]string{
+				common.EverestDatabaseClusterTimelineLabel: dbName,
+			},
+		},
+		Data: map[string]string{
+			configMapDataKey: string(raw),
+		},
+	}, nil
+}
+
+// FromConfigMap returns the timeline stored in the given ConfigMap.
+func FromConfigMap(cm *corev1.ConfigMap) (*Timeline, error) {
+	raw, ok := cm.Data[configMapDataKey]
+	if _, labeled := cm.GetLabels()[common.EverestDatabaseClusterTimelineLabel]; !ok || !labeled {
+		return nil, ErrNotTimeline
+	}
+	t := &Timeline{}
+	if err := json.Unmarshal([]byte(raw), t); err != nil {
+		return nil, fmt.Errorf("failed to parse database cluster timeline: %w", err)
+	}
+	return t, nil
+}
@@ -0,0 +1,110 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timeline
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
+	"github.com/percona/everest/api"
+)
+
+func TestObserve(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	db := &everestv1alpha1.DatabaseCluster{}
+	db.Status.Status = everestv1alpha1.AppStateInit
+
+	tl := &Timeline{}
+	assert.True(t, tl.Observe(db, now))
+	assert.False(t, tl.Observe(db, now.Add(time.Minute)), "unchanged status")
+
+	db.Status.Status = everestv1alpha1.AppStateError
+	db.Status.Message = "pxc-0 is crash looping"
+	conditionTime := now.Add(90 * time.Second)
+	db.Status.Conditions = []metav1.Condition{{
+		Type:               "Ready",
+		Status:             metav1.ConditionFalse,
+		Reason:             "PodsNotReady",
+		LastTransitionTime: metav1.NewTime(conditionTime),
+	}}
+	assert.True(t, tl.Observe(db, now.Add(2*time.Minute)))
+
+	assert.Equal(t, []Transition{
+		{Time: now, Type: StatusType, Status: string(everestv1alpha1.AppStateInit), Reason: ReasonStatusChanged},
+		{
+			Time: now.Add(2 * time.Minute), Type: StatusType,
+			Status: string(everestv1alpha1.AppStateError), Previous: string(everestv1alpha1.AppStateInit),
+			Reason: ReasonStatusChanged, Message: "pxc-0 is crash looping",
+		},
+		{Time: conditionTime, Type: "Ready", Status: "False", Reason: "PodsNotReady"},
+	}, tl.Transitions)
+
+	events := make([]api.DatabaseClusterEvent, 0, len(tl.Transitions))
+	for _, tr := range tl.Transitions {
+		events = append(events, Event("db", tr))
+	}
+	assert.Equal(t, fmt.Sprintf("Status is '%s'", everestv1alpha1.AppStateInit), events[0].Message)
+	assert.Equal(t, api.DatabaseClusterEventTypeNormal, events[0].Type)
+	assert.Equal(t, fmt.Sprintf("Status changed from '%s' to '%s': pxc-0 is crash looping",
+		everestv1alpha1.AppStateInit, everestv1alpha1.AppStateError), events[1].Message)
+	assert.Equal(t, api.DatabaseClusterEventTypeWarning, events[1].Type)
+	assert.Equal(t, "Condition Ready is False", events[2].Message)
+	assert.Equal(t, api.DatabaseClusterEventObject{Kind: "DatabaseCluster", Name: "db"}, events[2].InvolvedObject)
+}
+
+func TestObserveMaxTransitions(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	db := &everestv1alpha1.DatabaseCluster{}
+	tl := &Timeline{}
+	for i := range MaxTransitions + 10 {
+		db.Status.Status = everestv1alpha1.AppState(fmt.Sprintf("state-%d", i))
+		tl.Observe(db, now.Add(time.Duration(i)*time.Minute))
+	}
+	require.Len(t, tl.Transitions, MaxTransitions)
+	assert.Equal(t, "state-10", tl.Transitions[0].Status)
+	assert.Equal(t, fmt.Sprintf("state-%d", MaxTransitions+9), tl.Transitions[MaxTransitions-1].Status)
+}
+
+func TestConfigMap(t *testing.T) {
+	t.Parallel()
+
+	tl := &Timeline{
+		Transitions: []Transition{{Time: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC), Type: StatusType, Status: "ready"}},
+		Observed:    map[string]string{StatusType: "ready"},
+	}
+	cm, err := ToConfigMap("ns", "db", tl)
+	require.NoError(t, err)
+	assert.Equal(t, "everest-timeline.db", cm.GetName())
+	assert.Equal(t, "ns", cm.GetNamespace())
+
+	parsed, err := FromConfigMap(cm)
+	require.NoError(t, err)
+	assert.Equal(t, tl, parsed)
+
+	cm.SetLabels(nil)
+	_, err = FromConfigMap(cm)
+	require.ErrorIs(t, err, ErrNotTimeline)
+}
@@ -0,0 +1,125 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// collector is an in-process OTLP/HTTP collector.
+type collector struct {
+	mu    sync.Mutex
+	spans []*tracepb.Span
+	attrs map[string]string
+}
+
+func (c *collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/v1/traces" {
+		http.NotFound(w, r)
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req := &coltracepb.ExportTraceServiceRequest{}
+	if err := proto.Unmarshal(body, req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, rs := range req.GetResourceSpans() {
+		for _, attr := range rs.GetResource().GetAttributes() {
+			c.attrs[attr.GetKey()] = attr.GetValue().GetStringValue()
+		}
+		for _, ss := range rs.GetScopeSpans() {
+			c.spans = append(c.spans, ss.GetSpans()...)
+		}
+	}
+	resp, _ := proto.Marshal(&coltracepb.ExportTraceServiceResponse{})
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	_, _ = w.Write(resp)
+}
+
+func TestNewTracerProvider(t *testing.T) {
+	t.Parallel()
+
+	c := &collector{attrs: map[string]string{}}
+	srv := httptest.NewServer(c)
+	defer srv.Close()
+
+	ctx := context.Background()
+	tp, err := NewTracerProvider(ctx, Config{Endpoint: srv.URL, SampleRatio: 1, ServiceVersion: "v1.2.3"})
+	require.NoError(t, err)
+
+	tracer := tp.Tracer(InstrumentationName)
+	ctx, parent := tracer.Start(ctx, "parent")
+	_, child := tracer.Start(ctx, "child")
+	End(child, errors.New("boom"))
+	End(parent, nil)
+	require.NoError(t, tp.Shutdown(ctx))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	assert.Equal(t, ServiceName, c.attrs["service.name"])
+	assert.Equal(t, "v1.2.3", c.attrs["service.version"])
+	require.Len(t, c.spans, 2)
+	spans := map[string]*tracepb.Span{}
+	for _, s := range c.spans {
+		spans[s.GetName()] = s
+	}
+	require.Contains(t, spans, "parent")
+	require.Contains(t, spans, "child")
+	assert.Equal(t, spans["parent"].GetSpanId(), spans["child"].GetParentSpanId())
+	assert.Equal(t, tracepb.Status_STATUS_CODE_ERROR, spans["child"].GetStatus().GetCode())
+	assert.Equal(t, "boom", spans["child"].GetStatus().GetMessage())
+	assert.Equal(t, tracepb.Status_STATUS_CODE_UNSET, spans["parent"].GetStatus().GetCode())
+}
+
+func TestNewTracerProviderSampleRatio(t *testing.T) {
+	t.Parallel()
+
+	c := &collector{attrs: map[string]string{}}
+	srv := httptest.NewServer(c)
+	defer srv.Close()
+
+	ctx := context.Background()
+	tp, err := NewTracerProvider(ctx, Config{Endpoint: srv.URL, SampleRatio: 0})
+	require.NoError(t, err)
+
+	_, span := tp.Tracer(InstrumentationName).Start(ctx, "span")
+	End(span, nil)
+	require.NoError(t, tp.Shutdown(ctx))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	assert.Empty(t, c.spans)
+}
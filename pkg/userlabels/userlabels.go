@@ -0,0 +1,185 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package userlabels provides user-managed labels and annotations on Everest resources.
+//
+// Users may set any valid Kubernetes label or annotation on database clusters,
+// backup storages and monitoring instances, except for reserved ones. Reserved
+// labels and annotations are managed by Everest, the operators and Kubernetes;
+// they cannot be set or changed by users and are preserved when users update
+// the labels or annotations. The Everest annotations that configure database
+// clusters, such as the TTL, are not reserved. The deletion protection is
+// reserved but may be set when a database cluster is created; afterwards it is
+// only changed with its dedicated endpoint.
+package userlabels
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/percona/everest/pkg/common"
+)
+
+// reservedDomains are the label prefix domains reserved for Everest, the operators and Kubernetes.
+// Subdomains of reserved domains are reserved as well.
+//
+//nolint:gochecknoglobals
+var reservedDomains = []string{
+	"percona.com",
+	"kubernetes.io",
+	"k8s.io",
+}
+
+// reservedKeys are the reserved labels without a prefix.
+//
+//nolint:gochecknoglobals
+var reservedKeys = []string{
+	common.DatabaseClusterNameLabel,
+}
+
+// userAnnotations are the annotations with a reserved prefix that users may set.
+//
+//nolint:gochecknoglobals
+var userAnnotations = []string{
+	common.TTLAnnotation,
+	common.ExpiresAtAnnotation,
+	common.RestartAnnotation,
+}
+
+// createAnnotations are the reserved annotations that users may set when creating a resource.
+//
+//nolint:gochecknoglobals
+var createAnnotations = []string{
+	common.DeletionProtectionAnnotation,
+}
+
+// IsReserved returns true if the label is managed by Everest, the operators or Kubernetes.
+func IsReserved(key string) bool {
+	prefix, _, found := strings.Cut(key, "/")
+	if !found {
+		return slices.Contains(reservedKeys, key)
+	}
+	for _, d := range reservedDomains {
+		if prefix == d || strings.HasSuffix(prefix, "."+d) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate validates the labels set by a user on a resource with the given current labels.
+// Reserved labels may only be present with their current value.
+func Validate(labels, current map[string]string) error {
+	var errs []error
+	for _, k := range slices.Sorted(maps.Keys(labels)) {
+		v := labels[k]
+		for _, msg := range validation.IsQualifiedName(k) {
+			errs = append(errs, fmt.Errorf("invalid label key '%s': %s", k, msg))
+		}
+		for _, msg := range validation.IsValidLabelValue(v) {
+			errs = append(errs, fmt.Errorf("invalid value of label '%s': %s", k, msg))
+		}
+		if cur, ok := current[k]; IsReserved(k) && (!ok || cur != v) {
+			errs = append(errs, fmt.Errorf("label '%s' is reserved and cannot be set or changed", k))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// IsReservedAnnotation returns true if the annotation is managed by Everest, the operators or Kubernetes.
+func IsReservedAnnotation(key string) bool {
+	return IsReserved(key) && !slices.Contains(userAnnotations, key)
+}
+
+// ValidateAnnotations validates the annotations set by a user on a resource with the given
+// current annotations. Reserved annotations may only be present with their current value.
+func ValidateAnnotations(annotations, current map[string]string) error {
+	var errs []error
+	for _, k := range slices.Sorted(maps.Keys(annotations)) {
+		for _, msg := range validation.IsQualifiedName(strings.ToLower(k)) {
+			errs = append(errs, fmt.Errorf("invalid annotation key '%s': %s", k, msg))
+		}
+		if cur, ok := current[k]; IsReservedAnnotation(k) && (!ok || cur != annotations[k]) {
+			errs = append(errs, fmt.Errorf("annotation '%s' is reserved and cannot be set or changed", k))
+		}
+	}
+	if err := apivalidation.ValidateAnnotationsSize(annotations); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateCreateAnnotations validates the annotations set by a user on a new resource.
+// Reserved annotations may not be set, except for the ones that are set on creation.
+func ValidateCreateAnnotations(annotations map[string]string) error {
+	current := make(map[string]string, len(createAnnotations))
+	for _, k := range createAnnotations {
+		if v, ok := annotations[k]; ok {
+			current[k] = v
+		}
+	}
+	return ValidateAnnotations(annotations, current)
+}
+
+// Merge returns the labels set by a user together with the reserved labels of the current labels.
+func Merge(labels, current map[string]string) map[string]string {
+	return merge(labels, current, IsReserved)
+}
+
+// MergeAnnotations returns the annotations set by a user together with the reserved
+// annotations of the current annotations.
+func MergeAnnotations(annotations, current map[string]string) map[string]string {
+	return merge(annotations, current, IsReservedAnnotation)
+}
+
+func merge(items, current map[string]string, isReserved func(string) bool) map[string]string {
+	result := make(map[string]string, len(items)+len(current))
+	maps.Copy(result, items)
+	for k, v := range current {
+		if isReserved(k) {
+			result[k] = v
+		}
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
+
+// Filter returns the labels that are not reserved.
+func Filter(labels map[string]string) map[string]string {
+	return filter(labels, IsReserved)
+}
+
+// FilterAnnotations returns the annotations that are not reserved.
+func FilterAnnotations(annotations map[string]string) map[string]string {
+	return filter(annotations, IsReservedAnnotation)
+}
+
+func filter(items map[string]string, isReserved func(string) bool) map[string]string {
+	result := make(map[string]string, len(items))
+	for k, v := range items {
+		if !isReserved(k) {
+			result[k] = v
+		}
+	}
+	return result
+}
@@ -0,0 +1,195 @@
+package userlabels
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsReserved(t *testing.T) {
+	t.Parallel()
+
+	for key, reserved := range map[string]bool{
+		"team":                         false,
+		"example.com/team":             false,
+		"clusterName":                  true,
+		"everest.percona.com/crd":      true,
+		"percona.com/backup-type":      true,
+		"kubernetes.io/metadata.name":  true,
+		"app.kubernetes.io/managed-by": true,
+		"k8s.io/name":                  true,
+		"notpercona.com/team":          false,
+	} {
+		assert.Equal(t, reserved, IsReserved(key), key)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	current := map[string]string{
+		"team":                    "a",
+		"everest.percona.com/crd": "true",
+	}
+	testCases := []struct {
+		name    string
+		labels  map[string]string
+		current map[string]string
+		wantErr bool
+	}{
+		{
+			name:   "user labels",
+			labels: map[string]string{"team": "b", "example.com/cost-center": "42"},
+		},
+		{
+			name:   "no labels",
+			labels: nil,
+		},
+		{
+			name:    "unchanged reserved label",
+			labels:  map[string]string{"team": "b", "everest.percona.com/crd": "true"},
+			current: current,
+		},
+		{
+			name:    "changed reserved label",
+			labels:  map[string]string{"everest.percona.com/crd": "false"},
+			current: current,
+			wantErr: true,
+		},
+		{
+			name:    "new reserved label",
+			labels:  map[string]string{"app.kubernetes.io/managed-by": "me"},
+			current: current,
+			wantErr: true,
+		},
+		{
+			name:    "invalid key",
+			labels:  map[string]string{"team name": "a"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid value",
+			labels:  map[string]string{"team": "a b"},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := Validate(tc.labels, tc.current)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+
+	current := map[string]string{
+		"team":                    "a",
+		"everest.percona.com/crd": "true",
+	}
+	assert.Equal(t, map[string]string{
+		"env":                     "dev",
+		"everest.percona.com/crd": "true",
+	}, Merge(map[string]string{"env": "dev"}, current))
+	assert.Nil(t, Merge(nil, map[string]string{"team": "a"}))
+}
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, map[string]string{"team": "a"}, Filter(map[string]string{
+		"team":        "a",
+		"clusterName": "db",
+	}))
+}
+
+func TestValidateAnnotations(t *testing.T) {
+	t.Parallel()
+
+	current := map[string]string{
+		"everest.percona.com/expiry-handled": "2025-01-01T00:00:00Z",
+	}
+	testCases := []struct {
+		name        string
+		annotations map[string]string
+		wantErr     bool
+	}{
+		{
+			name:        "user annotations",
+			annotations: map[string]string{"example.com/owner": "Team A <team-a@example.com>"},
+		},
+		{
+			name:        "user-managed everest annotations",
+			annotations: map[string]string{"everest.percona.com/ttl": "72h"},
+		},
+		{
+			name:        "deletion protection",
+			annotations: map[string]string{"everest.percona.com/deletion-protection": "true"},
+			wantErr:     true,
+		},
+		{
+			name:        "unchanged reserved annotation",
+			annotations: current,
+		},
+		{
+			name:        "changed reserved annotation",
+			annotations: map[string]string{"everest.percona.com/expiry-handled": "2030-01-01T00:00:00Z"},
+			wantErr:     true,
+		},
+		{
+			name:        "new reserved annotation",
+			annotations: map[string]string{"everest.percona.com/deleted-at": "2025-01-01T00:00:00Z"},
+			wantErr:     true,
+		},
+		{
+			name:        "invalid key",
+			annotations: map[string]string{"owner name": "a"},
+			wantErr:     true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := ValidateAnnotations(tc.annotations, current)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateCreateAnnotations(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ValidateCreateAnnotations(map[string]string{
+		"everest.percona.com/ttl":                 "72h",
+		"everest.percona.com/deletion-protection": "true",
+	}))
+	require.Error(t, ValidateCreateAnnotations(map[string]string{"everest.percona.com/deleted-at": "2025-01-01T00:00:00Z"}))
+}
+
+func TestMergeAnnotations(t *testing.T) {
+	t.Parallel()
+
+	current := map[string]string{
+		"owner":                                   "a",
+		"everest.percona.com/ttl":                 "1h",
+		"everest.percona.com/expiry-handled":      "2025-01-01T00:00:00Z",
+		"everest.percona.com/deletion-protection": "true",
+	}
+	assert.Equal(t, map[string]string{
+		"owner":                              "b",
+		"everest.percona.com/expiry-handled": "2025-01-01T00:00:00Z",
+		"everest.percona.com/deletion-protection": "true",
+	}, MergeAnnotations(map[string]string{"owner": "b"}, current))
+	assert.Equal(t, map[string]string{"owner": "a", "everest.percona.com/ttl": "1h"}, FilterAnnotations(current))
+}
@@ -30,6 +30,7 @@ import (
 	perconavs "github.com/Percona-Lab/percona-version-service/versionpb"
 	goversion "github.com/hashicorp/go-version"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/everest/v1alpha1"
 )
@@ -41,6 +42,9 @@ const (
 	PSMDBOperatorName = "psmdb-operator"
 	// PGOperatorName is the name of the PG operator in the version service.
 	PGOperatorName = "pg-operator"
+
+	// EverestMetadataPath is the path of the Everest metadata in the version service.
+	EverestMetadataPath = "metadata/v1/everest"
 )
 
 // EngineTypeToOperatorName maps an engine type to the operator name in the version service.
@@ -62,8 +66,38 @@ type versionServiceClient struct {
 	url string
 }
 
+// Option configures the version service client.
+type Option func(*options)
+
+type options struct {
+	configMaps ConfigMapGetter
+}
+
+// WithConfigMapGetter sets the client used to read the snapshots stored in ConfigMaps.
+// It is required for the configmap:// URLs.
+func WithConfigMapGetter(g ConfigMapGetter) Option {
+	return func(o *options) {
+		o.configMaps = g
+	}
+}
+
 // New returns a new version service client.
-func New(url string) Interface { //nolint:ireturn
+//
+// The client is selected by the scheme of the URL:
+//   - file:///path/to/snapshot.json serves the snapshot stored in the file.
+//   - configmap://<namespace>/<name> serves the snapshot stored in the ConfigMap.
+//   - any other URL is the address of the Percona version service.
+func New(url string, opts ...Option) Interface { //nolint:ireturn
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	switch {
+	case strings.HasPrefix(url, fileScheme+"://"):
+		return newFileSnapshotClient(url)
+	case strings.HasPrefix(url, configMapScheme+"://"):
+		return newConfigMapSnapshotClient(url, o.configMaps)
+	}
 	return &versionServiceClient{url: url}
 }
 
@@ -82,24 +116,11 @@ func (c *versionServiceClient) GetSupportedEngineVersions(ctx context.Context, o
 		return nil, errors.Join(err, errors.New("could not parse version service URL"))
 	}
 	version = strings.TrimPrefix(version, "v")
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.JoinPath("versions/v1", operator, version).String(), nil)
-	if err != nil {
-		return nil, errors.Join(err, errors.New("could not create version service request"))
-	}
-	res, err := http.DefaultClient.Do(req)
+	b, err := get(ctx, p.JoinPath("versions/v1", operator, version))
 	if err != nil {
 		return nil, errors.Join(err, errors.New("could not retrieve version response"))
 	}
-	defer res.Body.Close() //nolint:errcheck
-
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("invalid response from version service endpoint http %d", res.StatusCode)
-	}
 	response := &perconavs.VersionResponse{}
-	b, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, errors.Join(err, errors.New("could not read version response"))
-	}
 	if err := defaultUnmarshal.Unmarshal(b, response); err != nil {
 		return nil, errors.Join(err, errors.New("could not decode version response"))
 	}
@@ -107,15 +128,56 @@ func (c *versionServiceClient) GetSupportedEngineVersions(ctx context.Context, o
 	if len(response.GetVersions()) == 0 {
 		return nil, errors.New("no versions found")
 	}
+	return engineVersions(operator, response.GetVersions()[0])
+}
 
+// getAllOperatorVersions returns every version of the operator.
+func (c *versionServiceClient) getAllOperatorVersions(ctx context.Context, operator string) ([]*perconavs.OperatorVersion, error) {
+	p, err := url.Parse(c.url)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not parse version service URL"))
+	}
+	b, err := get(ctx, p.JoinPath("versions/v1", operator))
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not retrieve version response"))
+	}
+	response := &perconavs.VersionResponse{}
+	if err := defaultUnmarshal.Unmarshal(b, response); err != nil {
+		return nil, errors.Join(err, errors.New("could not decode version response"))
+	}
+	return response.GetVersions(), nil
+}
+
+// operatorImages returns the sorted images of the operator version, i.e. the
+// images of the operator, its engines and their components.
+func operatorImages(v *perconavs.OperatorVersion) []string {
+	var images []string
+	v.GetMatrix().ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, val protoreflect.Value) bool {
+		if !fd.IsMap() {
+			return true
+		}
+		val.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+			if ver, ok := mv.Message().Interface().(*perconavs.Version); ok && ver.GetImagePath() != "" {
+				images = append(images, ver.GetImagePath())
+			}
+			return true
+		})
+		return true
+	})
+	slices.Sort(images)
+	return slices.Compact(images)
+}
+
+// engineVersions returns the sorted engine versions supported by the operator version.
+func engineVersions(operator string, v *perconavs.OperatorVersion) ([]string, error) {
 	var versions map[string]*perconavs.Version
 	switch operator {
 	case PXCOperatorName:
-		versions = response.GetVersions()[0].GetMatrix().GetPxc()
+		versions = v.GetMatrix().GetPxc()
 	case PSMDBOperatorName:
-		versions = response.GetVersions()[0].GetMatrix().GetMongod()
+		versions = v.GetMatrix().GetMongod()
 	case PGOperatorName:
-		versions = response.GetVersions()[0].GetMatrix().GetPostgresql()
+		versions = v.GetMatrix().GetPostgresql()
 	}
 
 	result := make([]string, 0, len(versions))
@@ -143,22 +205,31 @@ func (c *versionServiceClient) GetEverestMetadata(ctx context.Context) (*percona
 	if err != nil {
 		return nil, errors.Join(err, errors.New("could not parse version Everest metadata URL"))
 	}
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.JoinPath("metadata/v1/everest").String(), nil)
+	b, err := get(ctx, p.JoinPath(EverestMetadataPath))
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not retrieve Everest metadata"))
+	}
+	requirements := &perconavs.MetadataResponse{}
+	if err = json.Unmarshal(b, requirements); err != nil {
+		return nil, errors.Join(err, errors.New("could not decode requirements from Everest metadata"))
+	}
+	return requirements, nil
+}
+
+// get returns the body of the version service response.
+func get(ctx context.Context, u *url.URL) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
 	if err != nil {
-		return nil, errors.Join(err, errors.New("could not create Everest metadata request"))
+		return nil, err
 	}
 	res, err := http.DefaultClient.Do(req)
 	if err != nil {
-		return nil, errors.Join(err, errors.New("could not retrieve Everest metadata"))
+		return nil, err
 	}
 	defer res.Body.Close() //nolint:errcheck
 
 	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("invalid response from Everest metadata endpoint http %d", res.StatusCode)
-	}
-	requirements := &perconavs.MetadataResponse{}
-	if err = json.NewDecoder(res.Body).Decode(requirements); err != nil {
-		return nil, errors.Join(err, errors.New("could not decode requirements from Everest metadata"))
+		return nil, fmt.Errorf("invalid response from version service endpoint http %d", res.StatusCode)
 	}
-	return requirements, nil
+	return io.ReadAll(res.Body)
 }
@@ -0,0 +1,260 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versionservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	perconavs "github.com/Percona-Lab/percona-version-service/versionpb"
+	goversion "github.com/hashicorp/go-version"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	fileScheme      = "file"
+	configMapScheme = "configmap"
+
+	// SnapshotConfigMapKey is the key of the ConfigMap data that stores the snapshot.
+	SnapshotConfigMapKey = "snapshot.json"
+)
+
+// ErrNotInSnapshot is returned when the snapshot does not contain the requested versions.
+var ErrNotInSnapshot = errors.New("not found in the version metadata snapshot")
+
+// ConfigMapGetter gets ConfigMaps.
+type ConfigMapGetter interface {
+	GetConfigMap(ctx context.Context, key ctrlclient.ObjectKey) (*corev1.ConfigMap, error)
+}
+
+// Snapshot is an offline copy of the version service responses used by Everest.
+// It implements Interface, so it can be used in place of the version service
+// in disconnected environments.
+type Snapshot struct {
+	// CreatedAt is the time the snapshot has been taken.
+	CreatedAt time.Time `json:"createdAt"`
+	// Everest is the Everest metadata.
+	Everest *perconavs.MetadataResponse `json:"everest"`
+	// EngineVersions are the supported engine versions by operator name and operator version.
+	EngineVersions map[string]map[string][]string `json:"engineVersions,omitempty"`
+	// Images are the images of the operators, their engines and their
+	// components by operator name and operator version.
+	Images map[string]map[string][]string `json:"images,omitempty"`
+}
+
+// TakeSnapshot downloads the Everest metadata, and the engine versions and the images
+// of all the operators from the version service.
+func TakeSnapshot(ctx context.Context, versionServiceURL string) (*Snapshot, error) {
+	c := &versionServiceClient{url: versionServiceURL}
+	meta, err := c.GetEverestMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s := &Snapshot{
+		CreatedAt:      time.Now().UTC(),
+		Everest:        meta,
+		EngineVersions: make(map[string]map[string][]string, len(EngineTypeToOperatorName)),
+		Images:         make(map[string]map[string][]string, len(EngineTypeToOperatorName)),
+	}
+	for _, operator := range EngineTypeToOperatorName {
+		versions, err := c.getAllOperatorVersions(ctx, operator)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve %s versions: %w", operator, err)
+		}
+		s.EngineVersions[operator] = make(map[string][]string, len(versions))
+		s.Images[operator] = make(map[string][]string, len(versions))
+		for _, v := range versions {
+			opVersion := strings.TrimPrefix(v.GetOperator(), "v")
+			if s.EngineVersions[operator][opVersion], err = engineVersions(operator, v); err != nil {
+				return nil, fmt.Errorf("could not retrieve %s versions: %w", operator, err)
+			}
+			s.Images[operator][opVersion] = operatorImages(v)
+		}
+	}
+	return s, nil
+}
+
+// operatorSupportedKeys are the keys of the supported operator versions in the
+// Everest metadata, by operator name.
+var operatorSupportedKeys = map[string]string{
+	PXCOperatorName:   "pxcOperator",
+	PSMDBOperatorName: "psmdbOperator",
+	PGOperatorName:    "pgOperator",
+}
+
+// OperatorImages returns the sorted images of the operator versions supported
+// by the Everest version, according to the Everest metadata.
+func (s *Snapshot) OperatorImages(everestVersion string) ([]string, error) {
+	everestVersion = strings.TrimPrefix(everestVersion, "v")
+	i := slices.IndexFunc(s.Everest.GetVersions(), func(v *perconavs.MetadataVersion) bool {
+		return strings.TrimPrefix(v.GetVersion(), "v") == everestVersion
+	})
+	if i < 0 {
+		return nil, fmt.Errorf("everest %s: %w", everestVersion, ErrNotInSnapshot)
+	}
+	supported := s.Everest.GetVersions()[i].GetSupported()
+
+	var images []string
+	for operator, key := range operatorSupportedKeys {
+		constraint, ok := supported[key]
+		if !ok {
+			continue
+		}
+		c, err := goversion.NewConstraint(constraint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s constraint %s: %w", key, constraint, err)
+		}
+		for opVersion, opImages := range s.Images[operator] {
+			v, err := goversion.NewVersion(opVersion)
+			if err != nil || !c.Check(v.Core()) {
+				continue
+			}
+			images = append(images, opImages...)
+		}
+	}
+	slices.Sort(images)
+	return slices.Compact(images), nil
+}
+
+// ParseSnapshot parses and validates a snapshot.
+func ParseSnapshot(data []byte) (*Snapshot, error) {
+	s := &Snapshot{}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("could not decode version metadata snapshot: %w", err)
+	}
+	if len(s.Everest.GetVersions()) == 0 {
+		return nil, errors.New("the version metadata snapshot does not contain any Everest version")
+	}
+	return s, nil
+}
+
+// GetSupportedEngineVersions returns the supported engine versions for a given operator and version.
+func (s *Snapshot) GetSupportedEngineVersions(_ context.Context, operator, version string) ([]string, error) {
+	versions, ok := s.EngineVersions[operator][strings.TrimPrefix(version, "v")]
+	if !ok {
+		return nil, fmt.Errorf("%s %s: %w", operator, version, ErrNotInSnapshot)
+	}
+	return versions, nil
+}
+
+// GetEverestMetadata returns the Everest metadata.
+func (s *Snapshot) GetEverestMetadata(_ context.Context) (*perconavs.MetadataResponse, error) {
+	return s.Everest, nil
+}
+
+// ConfigMap returns the ConfigMap that stores the snapshot.
+func (s *Snapshot) ConfigMap(key types.NamespacedName) (*corev1.ConfigMap, error) {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+		},
+		Data: map[string]string{SnapshotConfigMapKey: string(data)},
+	}, nil
+}
+
+// ConfigMapURL returns the version metadata URL of the snapshot stored in the ConfigMap.
+func ConfigMapURL(key types.NamespacedName) string {
+	return fmt.Sprintf("%s://%s/%s", configMapScheme, key.Namespace, key.Name)
+}
+
+// ParseConfigMapURL returns the ConfigMap of a configmap://<namespace>/<name> URL.
+func ParseConfigMapURL(rawURL string) (types.NamespacedName, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return types.NamespacedName{}, err
+	}
+	key := types.NamespacedName{Namespace: u.Host, Name: strings.Trim(u.Path, "/")}
+	if u.Scheme != configMapScheme || key.Namespace == "" || key.Name == "" || strings.Contains(key.Name, "/") {
+		return types.NamespacedName{}, fmt.Errorf("invalid ConfigMap URL %q, expected %s://<namespace>/<name>", rawURL, configMapScheme)
+	}
+	return key, nil
+}
+
+// snapshotClient serves the version metadata from a snapshot.
+// The snapshot is loaded on every call so that refreshed snapshots are served
+// without restarting.
+type snapshotClient struct {
+	load func(ctx context.Context) (*Snapshot, error)
+}
+
+func newFileSnapshotClient(rawURL string) *snapshotClient {
+	return &snapshotClient{load: func(_ context.Context) (*Snapshot, error) {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse version metadata URL: %w", err)
+		}
+		data, err := os.ReadFile(filepath.FromSlash(u.Path))
+		if err != nil {
+			return nil, fmt.Errorf("could not read version metadata snapshot: %w", err)
+		}
+		return ParseSnapshot(data)
+	}}
+}
+
+func newConfigMapSnapshotClient(rawURL string, g ConfigMapGetter) *snapshotClient {
+	return &snapshotClient{load: func(ctx context.Context) (*Snapshot, error) {
+		key, err := ParseConfigMapURL(rawURL)
+		if err != nil {
+			return nil, err
+		}
+		if g == nil {
+			return nil, errors.New("a Kubernetes client is required to read the version metadata from a ConfigMap")
+		}
+		cm, err := g.GetConfigMap(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("could not get version metadata ConfigMap %s: %w", key, err)
+		}
+		data, ok := cm.Data[SnapshotConfigMapKey]
+		if !ok {
+			return nil, fmt.Errorf("the ConfigMap %s has no %s key", key, SnapshotConfigMapKey)
+		}
+		return ParseSnapshot([]byte(data))
+	}}
+}
+
+// GetSupportedEngineVersions returns the supported engine versions for a given operator and version.
+func (c *snapshotClient) GetSupportedEngineVersions(ctx context.Context, operator, version string) ([]string, error) {
+	s, err := c.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetSupportedEngineVersions(ctx, operator, version)
+}
+
+// GetEverestMetadata returns the Everest metadata.
+func (c *snapshotClient) GetEverestMetadata(ctx context.Context) (*perconavs.MetadataResponse, error) {
+	s, err := c.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetEverestMetadata(ctx)
+}
@@ -0,0 +1,168 @@
+// everest
+// Copyright (C) 2026 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versionservice
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const testSnapshot = `{
+  "everest": {"versions": [{"version": "1.4.0", "supported": {"pxcOperator": ">= 1.15.0"}}]},
+  "engineVersions": {"pxc-operator": {"1.15.0": ["8.0.36-28.1", "8.4.0-1.1"]}}
+}`
+
+type fakeConfigMapGetter map[types.NamespacedName]*corev1.ConfigMap
+
+func (f fakeConfigMapGetter) GetConfigMap(_ context.Context, key ctrlclient.ObjectKey) (*corev1.ConfigMap, error) {
+	cm, ok := f[key]
+	if !ok {
+		return nil, k8serrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, key.Name)
+	}
+	return cm, nil
+}
+
+func TestSnapshot(t *testing.T) {
+	t.Parallel()
+
+	s, err := ParseSnapshot([]byte(testSnapshot))
+	require.NoError(t, err)
+
+	meta, err := s.GetEverestMetadata(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "1.4.0", meta.GetVersions()[0].GetVersion())
+
+	versions, err := s.GetSupportedEngineVersions(context.Background(), PXCOperatorName, "v1.15.0")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"8.0.36-28.1", "8.4.0-1.1"}, versions)
+
+	_, err = s.GetSupportedEngineVersions(context.Background(), PXCOperatorName, "1.16.0")
+	require.ErrorIs(t, err, ErrNotInSnapshot)
+	_, err = s.GetSupportedEngineVersions(context.Background(), PGOperatorName, "2.4.0")
+	require.ErrorIs(t, err, ErrNotInSnapshot)
+
+	_, err = ParseSnapshot([]byte(`{"engineVersions": {}}`))
+	require.Error(t, err)
+	_, err = ParseSnapshot([]byte(`not json`))
+	require.Error(t, err)
+}
+
+func TestParseConfigMapURL(t *testing.T) {
+	t.Parallel()
+
+	key := types.NamespacedName{Namespace: "everest-system", Name: "everest-version-metadata"}
+	got, err := ParseConfigMapURL(ConfigMapURL(key))
+	require.NoError(t, err)
+	assert.Equal(t, key, got)
+
+	for _, u := range []string{
+		"configmap://everest-system",
+		"configmap:///everest-version-metadata",
+		"configmap://everest-system/a/b",
+		"https://check.percona.com",
+	} {
+		_, err := ParseConfigMapURL(u)
+		require.Error(t, err, u)
+	}
+}
+
+func TestNewSnapshotClients(t *testing.T) {
+	t.Parallel()
+
+	p := filepath.Join(t.TempDir(), "snapshot.json")
+	require.NoError(t, os.WriteFile(p, []byte(testSnapshot), 0o600))
+	meta, err := New("file://" + p).GetEverestMetadata(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, meta.GetVersions(), 1)
+
+	key := types.NamespacedName{Namespace: "everest-system", Name: "everest-version-metadata"}
+	s, err := ParseSnapshot([]byte(testSnapshot))
+	require.NoError(t, err)
+	cm, err := s.ConfigMap(key)
+	require.NoError(t, err)
+	getter := fakeConfigMapGetter{key: cm}
+
+	versions, err := New(ConfigMapURL(key), WithConfigMapGetter(getter)).GetSupportedEngineVersions(context.Background(), PXCOperatorName, "1.15.0")
+	require.NoError(t, err)
+	assert.Len(t, versions, 2)
+
+	// The snapshot is read on every call.
+	delete(getter, key)
+	_, err = New(ConfigMapURL(key), WithConfigMapGetter(getter)).GetEverestMetadata(context.Background())
+	require.Error(t, err)
+	_, err = New(ConfigMapURL(key)).GetEverestMetadata(context.Background())
+	require.ErrorContains(t, err, "a Kubernetes client is required")
+}
+
+func TestTakeSnapshot(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/metadata/v1/everest":
+			_, _ = w.Write([]byte(`{"versions":[{"version":"1.4.0","supported":{"pxcOperator":">= 1.15.0"}}]}`))
+		case "/versions/v1/pxc-operator":
+			_, _ = w.Write([]byte(`{"versions":[
+				{"operator":"1.15.0","matrix":{
+					"pxc":{"8.0.36-28.1":{"imagePath":"percona/percona-xtradb-cluster:8.0.36-28.1"},"5.7.44-31.65":{}},
+					"operator":{"1.15.0":{"imagePath":"percona/percona-xtradb-cluster-operator:1.15.0"}},
+					"backup":{"8.0.35-30.1":{"imagePath":"percona/percona-xtrabackup:8.0.35-30.1"}}
+				}},
+				{"operator":"1.14.0","matrix":{"pxc":{"8.0.35-27.1":{"imagePath":"percona/percona-xtradb-cluster:8.0.35-27.1"}}}}
+			]}`))
+		case "/versions/v1/psmdb-operator":
+			_, _ = w.Write([]byte(`{"versions":[{"operator":"1.16.0","matrix":{"mongod":{"7.0.8-5":{},"6.0.15-12":{}}}}]}`))
+		case "/versions/v1/pg-operator":
+			_, _ = w.Write([]byte(`{"versions":[{"operator":"2.3.1","matrix":{"postgresql":{"16.1":{}}},"unknown":true}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	s, err := TakeSnapshot(context.Background(), srv.URL)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]map[string][]string{
+		PXCOperatorName:   {"1.15.0": {"8.0.36-28.1"}, "1.14.0": {"8.0.35-27.1"}},
+		PSMDBOperatorName: {"1.16.0": {"6.0.15-12", "7.0.8-5"}},
+		PGOperatorName:    {"2.3.1": {"16.1"}},
+	}, s.EngineVersions)
+	assert.Equal(t, "1.4.0", s.Everest.GetVersions()[0].GetVersion())
+	assert.Equal(t, []string{
+		"percona/percona-xtrabackup:8.0.35-30.1",
+		"percona/percona-xtradb-cluster-operator:1.15.0",
+		"percona/percona-xtradb-cluster:8.0.36-28.1",
+	}, s.Images[PXCOperatorName]["1.15.0"])
+
+	// Only the images of the operator versions supported by the Everest version are returned.
+	images, err := s.OperatorImages("v1.4.0")
+	require.NoError(t, err)
+	assert.Equal(t, s.Images[PXCOperatorName]["1.15.0"], images)
+	_, err = s.OperatorImages("1.5.0")
+	require.ErrorIs(t, err, ErrNotInSnapshot)
+}